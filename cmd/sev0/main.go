@@ -11,6 +11,7 @@ import (
 	"syscall"
 
 	"sev0/ent"
+	"sev0/internal/archive"
 	"sev0/internal/contextkeys"
 	"sev0/internal/discord"
 	"sev0/internal/genkitmagic"
@@ -69,21 +70,44 @@ func main() {
 	drv := entsql.OpenDB(dialect.Postgres, db)
 	entClient := ent.NewClient(ent.Driver(drv))
 
-	gm, err := genkitmagic.Init(ctx, entClient)
+	gm, err := genkitmagic.Init(ctx, entClient, logger)
 	if err != nil {
 		logger.Error("failed to initialize genkit", "err", err)
 		return
 	}
 
-	embedder := gm.OAI.Embedder(gm.G, "text-embedding-3-small")
-
 	// Run the auto migration tool.
 	if err := entClient.Schema.Create(context.Background()); err != nil {
 		logger.Error("failed creating schema resources", "err", err)
 		return
 	}
 
-	bot, err := discord.NewDiscordBot(entClient, embedder, gm, phc, logger)
+	if err := archive.Migrate(ctx, entClient); err != nil {
+		logger.Error("failed creating full-text search resources", "err", err)
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "reembed" {
+		if err := reembed(ctx, gm, logger, os.Args[2:]); err != nil {
+			logger.Error("failed to reembed messages", "err", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	active, err := gm.Embeddings.Bootstrap(ctx)
+	if err != nil {
+		// Searches fall back to the configured embedder
+		logger.Error("failed to register embedding model", "err", err)
+	} else if active.ID != os.Getenv("EMBEDDING_MODEL") && os.Getenv("EMBEDDING_MODEL") != "" {
+		logger.Warn(
+			"EMBEDDING_MODEL is not the active embedding model, run `sev0 reembed --model` to switch",
+			"configured", os.Getenv("EMBEDDING_MODEL"),
+			"active", active.ID,
+		)
+	}
+
+	bot, err := discord.NewDiscordBot(entClient, gm.Embeddings, gm, phc, logger)
 	if err != nil {
 		logger.Error("failed to create discord bot", "err", err)
 		return
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os/signal"
+	"syscall"
+
+	"sev0/internal/genkitmagic"
+)
+
+// reembed gives every archived message a vector from another embedding
+// model, then switches the searches over to it. The bot keeps running on the
+// current model meanwhile, and embeds new messages with both.
+//
+//	sev0 reembed --model local/nomic-embed-text [--batch-size 100] [--activate=false]
+func reembed(
+	ctx context.Context,
+	gm genkitmagic.GenkitMagic,
+	logger *slog.Logger,
+	args []string,
+) error {
+	flags := flag.NewFlagSet("reembed", flag.ContinueOnError)
+	model := flags.String("model", "", "embedding model to rebuild the vectors with, e.g. openai/text-embedding-3-large")
+	batchSize := flags.Int("batch-size", 100, "messages embedded per request")
+	activate := flags.Bool("activate", true, "switch the searches to the model once every message has a vector")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *model == "" {
+		return errors.New("--model is required")
+	}
+
+	embedder := gm.Embeddings.Lookup(*model)
+	if embedder == nil {
+		return fmt.Errorf("embedding model %q is not configured", *model)
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger.Info("Reembedding messages", "model", *model)
+	err := gm.Embeddings.Reembed(ctx, embedder, *batchSize, func(done int) {
+		logger.Info("Reembedded messages", "model", *model, "done", done)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !*activate {
+		logger.Info("Every message has a vector, not activating", "model", *model)
+		return nil
+	}
+
+	if err := gm.Embeddings.Activate(ctx, *model); err != nil {
+		return err
+	}
+	logger.Info("Searches now use the new embedding model", "model", *model)
+
+	return nil
+}
@@ -11,14 +11,31 @@ import (
 
 	"sev0/ent/migrate"
 
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/discordchimein"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordguildsetting"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
 	"sev0/ent/discorduser"
+	"sev0/ent/embeddingmodel"
+	"sev0/ent/moderationevent"
+	"sev0/ent/privacyevent"
+	"sev0/ent/privacyoptout"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -26,12 +43,42 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// DiscordChannel is the client for interacting with the DiscordChannel builders.
+	DiscordChannel *DiscordChannelClient
+	// DiscordChannelSetting is the client for interacting with the DiscordChannelSetting builders.
+	DiscordChannelSetting *DiscordChannelSettingClient
+	// DiscordChimeIn is the client for interacting with the DiscordChimeIn builders.
+	DiscordChimeIn *DiscordChimeInClient
+	// DiscordChunkEmbedding is the client for interacting with the DiscordChunkEmbedding builders.
+	DiscordChunkEmbedding *DiscordChunkEmbeddingClient
+	// DiscordDirectMessage is the client for interacting with the DiscordDirectMessage builders.
+	DiscordDirectMessage *DiscordDirectMessageClient
+	// DiscordGuildMember is the client for interacting with the DiscordGuildMember builders.
+	DiscordGuildMember *DiscordGuildMemberClient
+	// DiscordGuildSetting is the client for interacting with the DiscordGuildSetting builders.
+	DiscordGuildSetting *DiscordGuildSettingClient
 	// DiscordMessage is the client for interacting with the DiscordMessage builders.
 	DiscordMessage *DiscordMessageClient
+	// DiscordMessageChunk is the client for interacting with the DiscordMessageChunk builders.
+	DiscordMessageChunk *DiscordMessageChunkClient
 	// DiscordMessageEmbedding is the client for interacting with the DiscordMessageEmbedding builders.
 	DiscordMessageEmbedding *DiscordMessageEmbeddingClient
+	// DiscordProfileChange is the client for interacting with the DiscordProfileChange builders.
+	DiscordProfileChange *DiscordProfileChangeClient
+	// DiscordReaction is the client for interacting with the DiscordReaction builders.
+	DiscordReaction *DiscordReactionClient
+	// DiscordRole is the client for interacting with the DiscordRole builders.
+	DiscordRole *DiscordRoleClient
 	// DiscordUser is the client for interacting with the DiscordUser builders.
 	DiscordUser *DiscordUserClient
+	// EmbeddingModel is the client for interacting with the EmbeddingModel builders.
+	EmbeddingModel *EmbeddingModelClient
+	// ModerationEvent is the client for interacting with the ModerationEvent builders.
+	ModerationEvent *ModerationEventClient
+	// PrivacyEvent is the client for interacting with the PrivacyEvent builders.
+	PrivacyEvent *PrivacyEventClient
+	// PrivacyOptOut is the client for interacting with the PrivacyOptOut builders.
+	PrivacyOptOut *PrivacyOptOutClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -43,9 +90,24 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.DiscordChannel = NewDiscordChannelClient(c.config)
+	c.DiscordChannelSetting = NewDiscordChannelSettingClient(c.config)
+	c.DiscordChimeIn = NewDiscordChimeInClient(c.config)
+	c.DiscordChunkEmbedding = NewDiscordChunkEmbeddingClient(c.config)
+	c.DiscordDirectMessage = NewDiscordDirectMessageClient(c.config)
+	c.DiscordGuildMember = NewDiscordGuildMemberClient(c.config)
+	c.DiscordGuildSetting = NewDiscordGuildSettingClient(c.config)
 	c.DiscordMessage = NewDiscordMessageClient(c.config)
+	c.DiscordMessageChunk = NewDiscordMessageChunkClient(c.config)
 	c.DiscordMessageEmbedding = NewDiscordMessageEmbeddingClient(c.config)
+	c.DiscordProfileChange = NewDiscordProfileChangeClient(c.config)
+	c.DiscordReaction = NewDiscordReactionClient(c.config)
+	c.DiscordRole = NewDiscordRoleClient(c.config)
 	c.DiscordUser = NewDiscordUserClient(c.config)
+	c.EmbeddingModel = NewEmbeddingModelClient(c.config)
+	c.ModerationEvent = NewModerationEventClient(c.config)
+	c.PrivacyEvent = NewPrivacyEventClient(c.config)
+	c.PrivacyOptOut = NewPrivacyOptOutClient(c.config)
 }
 
 type (
@@ -138,9 +200,24 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		DiscordChannel:          NewDiscordChannelClient(cfg),
+		DiscordChannelSetting:   NewDiscordChannelSettingClient(cfg),
+		DiscordChimeIn:          NewDiscordChimeInClient(cfg),
+		DiscordChunkEmbedding:   NewDiscordChunkEmbeddingClient(cfg),
+		DiscordDirectMessage:    NewDiscordDirectMessageClient(cfg),
+		DiscordGuildMember:      NewDiscordGuildMemberClient(cfg),
+		DiscordGuildSetting:     NewDiscordGuildSettingClient(cfg),
 		DiscordMessage:          NewDiscordMessageClient(cfg),
+		DiscordMessageChunk:     NewDiscordMessageChunkClient(cfg),
 		DiscordMessageEmbedding: NewDiscordMessageEmbeddingClient(cfg),
+		DiscordProfileChange:    NewDiscordProfileChangeClient(cfg),
+		DiscordReaction:         NewDiscordReactionClient(cfg),
+		DiscordRole:             NewDiscordRoleClient(cfg),
 		DiscordUser:             NewDiscordUserClient(cfg),
+		EmbeddingModel:          NewEmbeddingModelClient(cfg),
+		ModerationEvent:         NewModerationEventClient(cfg),
+		PrivacyEvent:            NewPrivacyEventClient(cfg),
+		PrivacyOptOut:           NewPrivacyOptOutClient(cfg),
 	}, nil
 }
 
@@ -160,16 +237,31 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                     ctx,
 		config:                  cfg,
+		DiscordChannel:          NewDiscordChannelClient(cfg),
+		DiscordChannelSetting:   NewDiscordChannelSettingClient(cfg),
+		DiscordChimeIn:          NewDiscordChimeInClient(cfg),
+		DiscordChunkEmbedding:   NewDiscordChunkEmbeddingClient(cfg),
+		DiscordDirectMessage:    NewDiscordDirectMessageClient(cfg),
+		DiscordGuildMember:      NewDiscordGuildMemberClient(cfg),
+		DiscordGuildSetting:     NewDiscordGuildSettingClient(cfg),
 		DiscordMessage:          NewDiscordMessageClient(cfg),
+		DiscordMessageChunk:     NewDiscordMessageChunkClient(cfg),
 		DiscordMessageEmbedding: NewDiscordMessageEmbeddingClient(cfg),
+		DiscordProfileChange:    NewDiscordProfileChangeClient(cfg),
+		DiscordReaction:         NewDiscordReactionClient(cfg),
+		DiscordRole:             NewDiscordRoleClient(cfg),
 		DiscordUser:             NewDiscordUserClient(cfg),
+		EmbeddingModel:          NewEmbeddingModelClient(cfg),
+		ModerationEvent:         NewModerationEventClient(cfg),
+		PrivacyEvent:            NewPrivacyEventClient(cfg),
+		PrivacyOptOut:           NewPrivacyOptOutClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		DiscordMessage.
+//		DiscordChannel.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -191,134 +283,178 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.DiscordMessage.Use(hooks...)
-	c.DiscordMessageEmbedding.Use(hooks...)
-	c.DiscordUser.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.DiscordChannel, c.DiscordChannelSetting, c.DiscordChimeIn,
+		c.DiscordChunkEmbedding, c.DiscordDirectMessage, c.DiscordGuildMember,
+		c.DiscordGuildSetting, c.DiscordMessage, c.DiscordMessageChunk,
+		c.DiscordMessageEmbedding, c.DiscordProfileChange, c.DiscordReaction,
+		c.DiscordRole, c.DiscordUser, c.EmbeddingModel, c.ModerationEvent,
+		c.PrivacyEvent, c.PrivacyOptOut,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.DiscordMessage.Intercept(interceptors...)
-	c.DiscordMessageEmbedding.Intercept(interceptors...)
-	c.DiscordUser.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.DiscordChannel, c.DiscordChannelSetting, c.DiscordChimeIn,
+		c.DiscordChunkEmbedding, c.DiscordDirectMessage, c.DiscordGuildMember,
+		c.DiscordGuildSetting, c.DiscordMessage, c.DiscordMessageChunk,
+		c.DiscordMessageEmbedding, c.DiscordProfileChange, c.DiscordReaction,
+		c.DiscordRole, c.DiscordUser, c.EmbeddingModel, c.ModerationEvent,
+		c.PrivacyEvent, c.PrivacyOptOut,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *DiscordChannelMutation:
+		return c.DiscordChannel.mutate(ctx, m)
+	case *DiscordChannelSettingMutation:
+		return c.DiscordChannelSetting.mutate(ctx, m)
+	case *DiscordChimeInMutation:
+		return c.DiscordChimeIn.mutate(ctx, m)
+	case *DiscordChunkEmbeddingMutation:
+		return c.DiscordChunkEmbedding.mutate(ctx, m)
+	case *DiscordDirectMessageMutation:
+		return c.DiscordDirectMessage.mutate(ctx, m)
+	case *DiscordGuildMemberMutation:
+		return c.DiscordGuildMember.mutate(ctx, m)
+	case *DiscordGuildSettingMutation:
+		return c.DiscordGuildSetting.mutate(ctx, m)
 	case *DiscordMessageMutation:
 		return c.DiscordMessage.mutate(ctx, m)
+	case *DiscordMessageChunkMutation:
+		return c.DiscordMessageChunk.mutate(ctx, m)
 	case *DiscordMessageEmbeddingMutation:
 		return c.DiscordMessageEmbedding.mutate(ctx, m)
+	case *DiscordProfileChangeMutation:
+		return c.DiscordProfileChange.mutate(ctx, m)
+	case *DiscordReactionMutation:
+		return c.DiscordReaction.mutate(ctx, m)
+	case *DiscordRoleMutation:
+		return c.DiscordRole.mutate(ctx, m)
 	case *DiscordUserMutation:
 		return c.DiscordUser.mutate(ctx, m)
+	case *EmbeddingModelMutation:
+		return c.EmbeddingModel.mutate(ctx, m)
+	case *ModerationEventMutation:
+		return c.ModerationEvent.mutate(ctx, m)
+	case *PrivacyEventMutation:
+		return c.PrivacyEvent.mutate(ctx, m)
+	case *PrivacyOptOutMutation:
+		return c.PrivacyOptOut.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
-// DiscordMessageClient is a client for the DiscordMessage schema.
-type DiscordMessageClient struct {
+// DiscordChannelClient is a client for the DiscordChannel schema.
+type DiscordChannelClient struct {
 	config
 }
 
-// NewDiscordMessageClient returns a client for the DiscordMessage from the given config.
-func NewDiscordMessageClient(c config) *DiscordMessageClient {
-	return &DiscordMessageClient{config: c}
+// NewDiscordChannelClient returns a client for the DiscordChannel from the given config.
+func NewDiscordChannelClient(c config) *DiscordChannelClient {
+	return &DiscordChannelClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `discordmessage.Hooks(f(g(h())))`.
-func (c *DiscordMessageClient) Use(hooks ...Hook) {
-	c.hooks.DiscordMessage = append(c.hooks.DiscordMessage, hooks...)
+// A call to `Use(f, g, h)` equals to `discordchannel.Hooks(f(g(h())))`.
+func (c *DiscordChannelClient) Use(hooks ...Hook) {
+	c.hooks.DiscordChannel = append(c.hooks.DiscordChannel, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `discordmessage.Intercept(f(g(h())))`.
-func (c *DiscordMessageClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DiscordMessage = append(c.inters.DiscordMessage, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `discordchannel.Intercept(f(g(h())))`.
+func (c *DiscordChannelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordChannel = append(c.inters.DiscordChannel, interceptors...)
 }
 
-// Create returns a builder for creating a DiscordMessage entity.
-func (c *DiscordMessageClient) Create() *DiscordMessageCreate {
-	mutation := newDiscordMessageMutation(c.config, OpCreate)
-	return &DiscordMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DiscordChannel entity.
+func (c *DiscordChannelClient) Create() *DiscordChannelCreate {
+	mutation := newDiscordChannelMutation(c.config, OpCreate)
+	return &DiscordChannelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DiscordMessage entities.
-func (c *DiscordMessageClient) CreateBulk(builders ...*DiscordMessageCreate) *DiscordMessageCreateBulk {
-	return &DiscordMessageCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DiscordChannel entities.
+func (c *DiscordChannelClient) CreateBulk(builders ...*DiscordChannelCreate) *DiscordChannelCreateBulk {
+	return &DiscordChannelCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DiscordMessageClient) MapCreateBulk(slice any, setFunc func(*DiscordMessageCreate, int)) *DiscordMessageCreateBulk {
+func (c *DiscordChannelClient) MapCreateBulk(slice any, setFunc func(*DiscordChannelCreate, int)) *DiscordChannelCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DiscordMessageCreateBulk{err: fmt.Errorf("calling to DiscordMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DiscordChannelCreateBulk{err: fmt.Errorf("calling to DiscordChannelClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DiscordMessageCreate, rv.Len())
+	builders := make([]*DiscordChannelCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DiscordMessageCreateBulk{config: c.config, builders: builders}
+	return &DiscordChannelCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DiscordMessage.
-func (c *DiscordMessageClient) Update() *DiscordMessageUpdate {
-	mutation := newDiscordMessageMutation(c.config, OpUpdate)
-	return &DiscordMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DiscordChannel.
+func (c *DiscordChannelClient) Update() *DiscordChannelUpdate {
+	mutation := newDiscordChannelMutation(c.config, OpUpdate)
+	return &DiscordChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiscordMessageClient) UpdateOne(_m *DiscordMessage) *DiscordMessageUpdateOne {
-	mutation := newDiscordMessageMutation(c.config, OpUpdateOne, withDiscordMessage(_m))
-	return &DiscordMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscordChannelClient) UpdateOne(_m *DiscordChannel) *DiscordChannelUpdateOne {
+	mutation := newDiscordChannelMutation(c.config, OpUpdateOne, withDiscordChannel(_m))
+	return &DiscordChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiscordMessageClient) UpdateOneID(id string) *DiscordMessageUpdateOne {
-	mutation := newDiscordMessageMutation(c.config, OpUpdateOne, withDiscordMessageID(id))
-	return &DiscordMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscordChannelClient) UpdateOneID(id string) *DiscordChannelUpdateOne {
+	mutation := newDiscordChannelMutation(c.config, OpUpdateOne, withDiscordChannelID(id))
+	return &DiscordChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DiscordMessage.
-func (c *DiscordMessageClient) Delete() *DiscordMessageDelete {
-	mutation := newDiscordMessageMutation(c.config, OpDelete)
-	return &DiscordMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DiscordChannel.
+func (c *DiscordChannelClient) Delete() *DiscordChannelDelete {
+	mutation := newDiscordChannelMutation(c.config, OpDelete)
+	return &DiscordChannelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscordMessageClient) DeleteOne(_m *DiscordMessage) *DiscordMessageDeleteOne {
+func (c *DiscordChannelClient) DeleteOne(_m *DiscordChannel) *DiscordChannelDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscordMessageClient) DeleteOneID(id string) *DiscordMessageDeleteOne {
-	builder := c.Delete().Where(discordmessage.ID(id))
+func (c *DiscordChannelClient) DeleteOneID(id string) *DiscordChannelDeleteOne {
+	builder := c.Delete().Where(discordchannel.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscordMessageDeleteOne{builder}
+	return &DiscordChannelDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscordMessage.
-func (c *DiscordMessageClient) Query() *DiscordMessageQuery {
-	return &DiscordMessageQuery{
+// Query returns a query builder for DiscordChannel.
+func (c *DiscordChannelClient) Query() *DiscordChannelQuery {
+	return &DiscordChannelQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscordMessage},
+		ctx:    &QueryContext{Type: TypeDiscordChannel},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscordMessage entity by its id.
-func (c *DiscordMessageClient) Get(ctx context.Context, id string) (*DiscordMessage, error) {
-	return c.Query().Where(discordmessage.ID(id)).Only(ctx)
+// Get returns a DiscordChannel entity by its id.
+func (c *DiscordChannelClient) Get(ctx context.Context, id string) (*DiscordChannel, error) {
+	return c.Query().Where(discordchannel.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscordMessageClient) GetX(ctx context.Context, id string) *DiscordMessage {
+func (c *DiscordChannelClient) GetX(ctx context.Context, id string) *DiscordChannel {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -326,31 +462,15 @@ func (c *DiscordMessageClient) GetX(ctx context.Context, id string) *DiscordMess
 	return obj
 }
 
-// QueryUser queries the user edge of a DiscordMessage.
-func (c *DiscordMessageClient) QueryUser(_m *DiscordMessage) *DiscordUserQuery {
-	query := (&DiscordUserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
-			sqlgraph.To(discorduser.Table, discorduser.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discordmessage.UserTable, discordmessage.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryEmbeddings queries the embeddings edge of a DiscordMessage.
-func (c *DiscordMessageClient) QueryEmbeddings(_m *DiscordMessage) *DiscordMessageEmbeddingQuery {
-	query := (&DiscordMessageEmbeddingClient{config: c.config}).Query()
+// QueryMentionedIn queries the mentioned_in edge of a DiscordChannel.
+func (c *DiscordChannelClient) QueryMentionedIn(_m *DiscordChannel) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
-			sqlgraph.To(discordmessageembedding.Table, discordmessageembedding.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discordmessage.EmbeddingsTable, discordmessage.EmbeddingsColumn),
+			sqlgraph.From(discordchannel.Table, discordchannel.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, discordchannel.MentionedInTable, discordchannel.MentionedInPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -359,131 +479,131 @@ func (c *DiscordMessageClient) QueryEmbeddings(_m *DiscordMessage) *DiscordMessa
 }
 
 // Hooks returns the client hooks.
-func (c *DiscordMessageClient) Hooks() []Hook {
-	return c.hooks.DiscordMessage
+func (c *DiscordChannelClient) Hooks() []Hook {
+	return c.hooks.DiscordChannel
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscordMessageClient) Interceptors() []Interceptor {
-	return c.inters.DiscordMessage
+func (c *DiscordChannelClient) Interceptors() []Interceptor {
+	return c.inters.DiscordChannel
 }
 
-func (c *DiscordMessageClient) mutate(ctx context.Context, m *DiscordMessageMutation) (Value, error) {
+func (c *DiscordChannelClient) mutate(ctx context.Context, m *DiscordChannelMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscordMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChannelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscordMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChannelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscordMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChannelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscordMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DiscordChannelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DiscordMessage mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DiscordChannel mutation op: %q", m.Op())
 	}
 }
 
-// DiscordMessageEmbeddingClient is a client for the DiscordMessageEmbedding schema.
-type DiscordMessageEmbeddingClient struct {
+// DiscordChannelSettingClient is a client for the DiscordChannelSetting schema.
+type DiscordChannelSettingClient struct {
 	config
 }
 
-// NewDiscordMessageEmbeddingClient returns a client for the DiscordMessageEmbedding from the given config.
-func NewDiscordMessageEmbeddingClient(c config) *DiscordMessageEmbeddingClient {
-	return &DiscordMessageEmbeddingClient{config: c}
+// NewDiscordChannelSettingClient returns a client for the DiscordChannelSetting from the given config.
+func NewDiscordChannelSettingClient(c config) *DiscordChannelSettingClient {
+	return &DiscordChannelSettingClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `discordmessageembedding.Hooks(f(g(h())))`.
-func (c *DiscordMessageEmbeddingClient) Use(hooks ...Hook) {
-	c.hooks.DiscordMessageEmbedding = append(c.hooks.DiscordMessageEmbedding, hooks...)
+// A call to `Use(f, g, h)` equals to `discordchannelsetting.Hooks(f(g(h())))`.
+func (c *DiscordChannelSettingClient) Use(hooks ...Hook) {
+	c.hooks.DiscordChannelSetting = append(c.hooks.DiscordChannelSetting, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `discordmessageembedding.Intercept(f(g(h())))`.
-func (c *DiscordMessageEmbeddingClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DiscordMessageEmbedding = append(c.inters.DiscordMessageEmbedding, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `discordchannelsetting.Intercept(f(g(h())))`.
+func (c *DiscordChannelSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordChannelSetting = append(c.inters.DiscordChannelSetting, interceptors...)
 }
 
-// Create returns a builder for creating a DiscordMessageEmbedding entity.
-func (c *DiscordMessageEmbeddingClient) Create() *DiscordMessageEmbeddingCreate {
-	mutation := newDiscordMessageEmbeddingMutation(c.config, OpCreate)
-	return &DiscordMessageEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DiscordChannelSetting entity.
+func (c *DiscordChannelSettingClient) Create() *DiscordChannelSettingCreate {
+	mutation := newDiscordChannelSettingMutation(c.config, OpCreate)
+	return &DiscordChannelSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DiscordMessageEmbedding entities.
-func (c *DiscordMessageEmbeddingClient) CreateBulk(builders ...*DiscordMessageEmbeddingCreate) *DiscordMessageEmbeddingCreateBulk {
-	return &DiscordMessageEmbeddingCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DiscordChannelSetting entities.
+func (c *DiscordChannelSettingClient) CreateBulk(builders ...*DiscordChannelSettingCreate) *DiscordChannelSettingCreateBulk {
+	return &DiscordChannelSettingCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DiscordMessageEmbeddingClient) MapCreateBulk(slice any, setFunc func(*DiscordMessageEmbeddingCreate, int)) *DiscordMessageEmbeddingCreateBulk {
+func (c *DiscordChannelSettingClient) MapCreateBulk(slice any, setFunc func(*DiscordChannelSettingCreate, int)) *DiscordChannelSettingCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DiscordMessageEmbeddingCreateBulk{err: fmt.Errorf("calling to DiscordMessageEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DiscordChannelSettingCreateBulk{err: fmt.Errorf("calling to DiscordChannelSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DiscordMessageEmbeddingCreate, rv.Len())
+	builders := make([]*DiscordChannelSettingCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DiscordMessageEmbeddingCreateBulk{config: c.config, builders: builders}
+	return &DiscordChannelSettingCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DiscordMessageEmbedding.
-func (c *DiscordMessageEmbeddingClient) Update() *DiscordMessageEmbeddingUpdate {
-	mutation := newDiscordMessageEmbeddingMutation(c.config, OpUpdate)
-	return &DiscordMessageEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DiscordChannelSetting.
+func (c *DiscordChannelSettingClient) Update() *DiscordChannelSettingUpdate {
+	mutation := newDiscordChannelSettingMutation(c.config, OpUpdate)
+	return &DiscordChannelSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiscordMessageEmbeddingClient) UpdateOne(_m *DiscordMessageEmbedding) *DiscordMessageEmbeddingUpdateOne {
-	mutation := newDiscordMessageEmbeddingMutation(c.config, OpUpdateOne, withDiscordMessageEmbedding(_m))
-	return &DiscordMessageEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscordChannelSettingClient) UpdateOne(_m *DiscordChannelSetting) *DiscordChannelSettingUpdateOne {
+	mutation := newDiscordChannelSettingMutation(c.config, OpUpdateOne, withDiscordChannelSetting(_m))
+	return &DiscordChannelSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiscordMessageEmbeddingClient) UpdateOneID(id string) *DiscordMessageEmbeddingUpdateOne {
-	mutation := newDiscordMessageEmbeddingMutation(c.config, OpUpdateOne, withDiscordMessageEmbeddingID(id))
-	return &DiscordMessageEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscordChannelSettingClient) UpdateOneID(id string) *DiscordChannelSettingUpdateOne {
+	mutation := newDiscordChannelSettingMutation(c.config, OpUpdateOne, withDiscordChannelSettingID(id))
+	return &DiscordChannelSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DiscordMessageEmbedding.
-func (c *DiscordMessageEmbeddingClient) Delete() *DiscordMessageEmbeddingDelete {
-	mutation := newDiscordMessageEmbeddingMutation(c.config, OpDelete)
-	return &DiscordMessageEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DiscordChannelSetting.
+func (c *DiscordChannelSettingClient) Delete() *DiscordChannelSettingDelete {
+	mutation := newDiscordChannelSettingMutation(c.config, OpDelete)
+	return &DiscordChannelSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscordMessageEmbeddingClient) DeleteOne(_m *DiscordMessageEmbedding) *DiscordMessageEmbeddingDeleteOne {
+func (c *DiscordChannelSettingClient) DeleteOne(_m *DiscordChannelSetting) *DiscordChannelSettingDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscordMessageEmbeddingClient) DeleteOneID(id string) *DiscordMessageEmbeddingDeleteOne {
-	builder := c.Delete().Where(discordmessageembedding.ID(id))
+func (c *DiscordChannelSettingClient) DeleteOneID(id string) *DiscordChannelSettingDeleteOne {
+	builder := c.Delete().Where(discordchannelsetting.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscordMessageEmbeddingDeleteOne{builder}
+	return &DiscordChannelSettingDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscordMessageEmbedding.
-func (c *DiscordMessageEmbeddingClient) Query() *DiscordMessageEmbeddingQuery {
-	return &DiscordMessageEmbeddingQuery{
+// Query returns a query builder for DiscordChannelSetting.
+func (c *DiscordChannelSettingClient) Query() *DiscordChannelSettingQuery {
+	return &DiscordChannelSettingQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscordMessageEmbedding},
+		ctx:    &QueryContext{Type: TypeDiscordChannelSetting},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscordMessageEmbedding entity by its id.
-func (c *DiscordMessageEmbeddingClient) Get(ctx context.Context, id string) (*DiscordMessageEmbedding, error) {
-	return c.Query().Where(discordmessageembedding.ID(id)).Only(ctx)
+// Get returns a DiscordChannelSetting entity by its id.
+func (c *DiscordChannelSettingClient) Get(ctx context.Context, id string) (*DiscordChannelSetting, error) {
+	return c.Query().Where(discordchannelsetting.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscordMessageEmbeddingClient) GetX(ctx context.Context, id string) *DiscordMessageEmbedding {
+func (c *DiscordChannelSettingClient) GetX(ctx context.Context, id string) *DiscordChannelSetting {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -491,148 +611,132 @@ func (c *DiscordMessageEmbeddingClient) GetX(ctx context.Context, id string) *Di
 	return obj
 }
 
-// QueryMessage queries the message edge of a DiscordMessageEmbedding.
-func (c *DiscordMessageEmbeddingClient) QueryMessage(_m *DiscordMessageEmbedding) *DiscordMessageQuery {
-	query := (&DiscordMessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discordmessageembedding.Table, discordmessageembedding.FieldID, id),
-			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, discordmessageembedding.MessageTable, discordmessageembedding.MessageColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DiscordMessageEmbeddingClient) Hooks() []Hook {
-	return c.hooks.DiscordMessageEmbedding
+func (c *DiscordChannelSettingClient) Hooks() []Hook {
+	return c.hooks.DiscordChannelSetting
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscordMessageEmbeddingClient) Interceptors() []Interceptor {
-	return c.inters.DiscordMessageEmbedding
+func (c *DiscordChannelSettingClient) Interceptors() []Interceptor {
+	return c.inters.DiscordChannelSetting
 }
 
-func (c *DiscordMessageEmbeddingClient) mutate(ctx context.Context, m *DiscordMessageEmbeddingMutation) (Value, error) {
+func (c *DiscordChannelSettingClient) mutate(ctx context.Context, m *DiscordChannelSettingMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscordMessageEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChannelSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscordMessageEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChannelSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscordMessageEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChannelSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscordMessageEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DiscordChannelSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DiscordMessageEmbedding mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DiscordChannelSetting mutation op: %q", m.Op())
 	}
 }
 
-// DiscordUserClient is a client for the DiscordUser schema.
-type DiscordUserClient struct {
+// DiscordChimeInClient is a client for the DiscordChimeIn schema.
+type DiscordChimeInClient struct {
 	config
 }
 
-// NewDiscordUserClient returns a client for the DiscordUser from the given config.
-func NewDiscordUserClient(c config) *DiscordUserClient {
-	return &DiscordUserClient{config: c}
+// NewDiscordChimeInClient returns a client for the DiscordChimeIn from the given config.
+func NewDiscordChimeInClient(c config) *DiscordChimeInClient {
+	return &DiscordChimeInClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `discorduser.Hooks(f(g(h())))`.
-func (c *DiscordUserClient) Use(hooks ...Hook) {
-	c.hooks.DiscordUser = append(c.hooks.DiscordUser, hooks...)
+// A call to `Use(f, g, h)` equals to `discordchimein.Hooks(f(g(h())))`.
+func (c *DiscordChimeInClient) Use(hooks ...Hook) {
+	c.hooks.DiscordChimeIn = append(c.hooks.DiscordChimeIn, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `discorduser.Intercept(f(g(h())))`.
-func (c *DiscordUserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.DiscordUser = append(c.inters.DiscordUser, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `discordchimein.Intercept(f(g(h())))`.
+func (c *DiscordChimeInClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordChimeIn = append(c.inters.DiscordChimeIn, interceptors...)
 }
 
-// Create returns a builder for creating a DiscordUser entity.
-func (c *DiscordUserClient) Create() *DiscordUserCreate {
-	mutation := newDiscordUserMutation(c.config, OpCreate)
-	return &DiscordUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a DiscordChimeIn entity.
+func (c *DiscordChimeInClient) Create() *DiscordChimeInCreate {
+	mutation := newDiscordChimeInMutation(c.config, OpCreate)
+	return &DiscordChimeInCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of DiscordUser entities.
-func (c *DiscordUserClient) CreateBulk(builders ...*DiscordUserCreate) *DiscordUserCreateBulk {
-	return &DiscordUserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of DiscordChimeIn entities.
+func (c *DiscordChimeInClient) CreateBulk(builders ...*DiscordChimeInCreate) *DiscordChimeInCreateBulk {
+	return &DiscordChimeInCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *DiscordUserClient) MapCreateBulk(slice any, setFunc func(*DiscordUserCreate, int)) *DiscordUserCreateBulk {
+func (c *DiscordChimeInClient) MapCreateBulk(slice any, setFunc func(*DiscordChimeInCreate, int)) *DiscordChimeInCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &DiscordUserCreateBulk{err: fmt.Errorf("calling to DiscordUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &DiscordChimeInCreateBulk{err: fmt.Errorf("calling to DiscordChimeInClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*DiscordUserCreate, rv.Len())
+	builders := make([]*DiscordChimeInCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &DiscordUserCreateBulk{config: c.config, builders: builders}
+	return &DiscordChimeInCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for DiscordUser.
-func (c *DiscordUserClient) Update() *DiscordUserUpdate {
-	mutation := newDiscordUserMutation(c.config, OpUpdate)
-	return &DiscordUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for DiscordChimeIn.
+func (c *DiscordChimeInClient) Update() *DiscordChimeInUpdate {
+	mutation := newDiscordChimeInMutation(c.config, OpUpdate)
+	return &DiscordChimeInUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *DiscordUserClient) UpdateOne(_m *DiscordUser) *DiscordUserUpdateOne {
-	mutation := newDiscordUserMutation(c.config, OpUpdateOne, withDiscordUser(_m))
-	return &DiscordUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscordChimeInClient) UpdateOne(_m *DiscordChimeIn) *DiscordChimeInUpdateOne {
+	mutation := newDiscordChimeInMutation(c.config, OpUpdateOne, withDiscordChimeIn(_m))
+	return &DiscordChimeInUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *DiscordUserClient) UpdateOneID(id string) *DiscordUserUpdateOne {
-	mutation := newDiscordUserMutation(c.config, OpUpdateOne, withDiscordUserID(id))
-	return &DiscordUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *DiscordChimeInClient) UpdateOneID(id string) *DiscordChimeInUpdateOne {
+	mutation := newDiscordChimeInMutation(c.config, OpUpdateOne, withDiscordChimeInID(id))
+	return &DiscordChimeInUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for DiscordUser.
-func (c *DiscordUserClient) Delete() *DiscordUserDelete {
-	mutation := newDiscordUserMutation(c.config, OpDelete)
-	return &DiscordUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for DiscordChimeIn.
+func (c *DiscordChimeInClient) Delete() *DiscordChimeInDelete {
+	mutation := newDiscordChimeInMutation(c.config, OpDelete)
+	return &DiscordChimeInDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *DiscordUserClient) DeleteOne(_m *DiscordUser) *DiscordUserDeleteOne {
+func (c *DiscordChimeInClient) DeleteOne(_m *DiscordChimeIn) *DiscordChimeInDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *DiscordUserClient) DeleteOneID(id string) *DiscordUserDeleteOne {
-	builder := c.Delete().Where(discorduser.ID(id))
+func (c *DiscordChimeInClient) DeleteOneID(id string) *DiscordChimeInDeleteOne {
+	builder := c.Delete().Where(discordchimein.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &DiscordUserDeleteOne{builder}
+	return &DiscordChimeInDeleteOne{builder}
 }
 
-// Query returns a query builder for DiscordUser.
-func (c *DiscordUserClient) Query() *DiscordUserQuery {
-	return &DiscordUserQuery{
+// Query returns a query builder for DiscordChimeIn.
+func (c *DiscordChimeInClient) Query() *DiscordChimeInQuery {
+	return &DiscordChimeInQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeDiscordUser},
+		ctx:    &QueryContext{Type: TypeDiscordChimeIn},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a DiscordUser entity by its id.
-func (c *DiscordUserClient) Get(ctx context.Context, id string) (*DiscordUser, error) {
-	return c.Query().Where(discorduser.ID(id)).Only(ctx)
+// Get returns a DiscordChimeIn entity by its id.
+func (c *DiscordChimeInClient) Get(ctx context.Context, id string) (*DiscordChimeIn, error) {
+	return c.Query().Where(discordchimein.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *DiscordUserClient) GetX(ctx context.Context, id string) *DiscordUser {
+func (c *DiscordChimeInClient) GetX(ctx context.Context, id string) *DiscordChimeIn {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -640,53 +744,2432 @@ func (c *DiscordUserClient) GetX(ctx context.Context, id string) *DiscordUser {
 	return obj
 }
 
-// QueryMessages queries the messages edge of a DiscordUser.
-func (c *DiscordUserClient) QueryMessages(_m *DiscordUser) *DiscordMessageQuery {
-	query := (&DiscordMessageClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(discorduser.Table, discorduser.FieldID, id),
-			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.MessagesTable, discorduser.MessagesColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
 // Hooks returns the client hooks.
-func (c *DiscordUserClient) Hooks() []Hook {
-	return c.hooks.DiscordUser
+func (c *DiscordChimeInClient) Hooks() []Hook {
+	return c.hooks.DiscordChimeIn
 }
 
 // Interceptors returns the client interceptors.
-func (c *DiscordUserClient) Interceptors() []Interceptor {
-	return c.inters.DiscordUser
+func (c *DiscordChimeInClient) Interceptors() []Interceptor {
+	return c.inters.DiscordChimeIn
 }
 
-func (c *DiscordUserClient) mutate(ctx context.Context, m *DiscordUserMutation) (Value, error) {
+func (c *DiscordChimeInClient) mutate(ctx context.Context, m *DiscordChimeInMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&DiscordUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChimeInCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&DiscordUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChimeInUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&DiscordUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&DiscordChimeInUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&DiscordUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&DiscordChimeInDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown DiscordUser mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown DiscordChimeIn mutation op: %q", m.Op())
 	}
 }
 
-// hooks and interceptors per client, for fast access.
-type (
-	hooks struct {
-		DiscordMessage, DiscordMessageEmbedding, DiscordUser []ent.Hook
+// DiscordChunkEmbeddingClient is a client for the DiscordChunkEmbedding schema.
+type DiscordChunkEmbeddingClient struct {
+	config
+}
+
+// NewDiscordChunkEmbeddingClient returns a client for the DiscordChunkEmbedding from the given config.
+func NewDiscordChunkEmbeddingClient(c config) *DiscordChunkEmbeddingClient {
+	return &DiscordChunkEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordchunkembedding.Hooks(f(g(h())))`.
+func (c *DiscordChunkEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.DiscordChunkEmbedding = append(c.hooks.DiscordChunkEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordchunkembedding.Intercept(f(g(h())))`.
+func (c *DiscordChunkEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordChunkEmbedding = append(c.inters.DiscordChunkEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordChunkEmbedding entity.
+func (c *DiscordChunkEmbeddingClient) Create() *DiscordChunkEmbeddingCreate {
+	mutation := newDiscordChunkEmbeddingMutation(c.config, OpCreate)
+	return &DiscordChunkEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordChunkEmbedding entities.
+func (c *DiscordChunkEmbeddingClient) CreateBulk(builders ...*DiscordChunkEmbeddingCreate) *DiscordChunkEmbeddingCreateBulk {
+	return &DiscordChunkEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordChunkEmbeddingClient) MapCreateBulk(slice any, setFunc func(*DiscordChunkEmbeddingCreate, int)) *DiscordChunkEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordChunkEmbeddingCreateBulk{err: fmt.Errorf("calling to DiscordChunkEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	inters struct {
-		DiscordMessage, DiscordMessageEmbedding, DiscordUser []ent.Interceptor
+	builders := make([]*DiscordChunkEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordChunkEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordChunkEmbedding.
+func (c *DiscordChunkEmbeddingClient) Update() *DiscordChunkEmbeddingUpdate {
+	mutation := newDiscordChunkEmbeddingMutation(c.config, OpUpdate)
+	return &DiscordChunkEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordChunkEmbeddingClient) UpdateOne(_m *DiscordChunkEmbedding) *DiscordChunkEmbeddingUpdateOne {
+	mutation := newDiscordChunkEmbeddingMutation(c.config, OpUpdateOne, withDiscordChunkEmbedding(_m))
+	return &DiscordChunkEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordChunkEmbeddingClient) UpdateOneID(id string) *DiscordChunkEmbeddingUpdateOne {
+	mutation := newDiscordChunkEmbeddingMutation(c.config, OpUpdateOne, withDiscordChunkEmbeddingID(id))
+	return &DiscordChunkEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordChunkEmbedding.
+func (c *DiscordChunkEmbeddingClient) Delete() *DiscordChunkEmbeddingDelete {
+	mutation := newDiscordChunkEmbeddingMutation(c.config, OpDelete)
+	return &DiscordChunkEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordChunkEmbeddingClient) DeleteOne(_m *DiscordChunkEmbedding) *DiscordChunkEmbeddingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordChunkEmbeddingClient) DeleteOneID(id string) *DiscordChunkEmbeddingDeleteOne {
+	builder := c.Delete().Where(discordchunkembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordChunkEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordChunkEmbedding.
+func (c *DiscordChunkEmbeddingClient) Query() *DiscordChunkEmbeddingQuery {
+	return &DiscordChunkEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordChunkEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordChunkEmbedding entity by its id.
+func (c *DiscordChunkEmbeddingClient) Get(ctx context.Context, id string) (*DiscordChunkEmbedding, error) {
+	return c.Query().Where(discordchunkembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordChunkEmbeddingClient) GetX(ctx context.Context, id string) *DiscordChunkEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryChunk queries the chunk edge of a DiscordChunkEmbedding.
+func (c *DiscordChunkEmbeddingClient) QueryChunk(_m *DiscordChunkEmbedding) *DiscordMessageChunkQuery {
+	query := (&DiscordMessageChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordchunkembedding.Table, discordchunkembedding.FieldID, id),
+			sqlgraph.To(discordmessagechunk.Table, discordmessagechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordchunkembedding.ChunkTable, discordchunkembedding.ChunkColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordChunkEmbeddingClient) Hooks() []Hook {
+	return c.hooks.DiscordChunkEmbedding
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordChunkEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.DiscordChunkEmbedding
+}
+
+func (c *DiscordChunkEmbeddingClient) mutate(ctx context.Context, m *DiscordChunkEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordChunkEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordChunkEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordChunkEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordChunkEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordChunkEmbedding mutation op: %q", m.Op())
+	}
+}
+
+// DiscordDirectMessageClient is a client for the DiscordDirectMessage schema.
+type DiscordDirectMessageClient struct {
+	config
+}
+
+// NewDiscordDirectMessageClient returns a client for the DiscordDirectMessage from the given config.
+func NewDiscordDirectMessageClient(c config) *DiscordDirectMessageClient {
+	return &DiscordDirectMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discorddirectmessage.Hooks(f(g(h())))`.
+func (c *DiscordDirectMessageClient) Use(hooks ...Hook) {
+	c.hooks.DiscordDirectMessage = append(c.hooks.DiscordDirectMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discorddirectmessage.Intercept(f(g(h())))`.
+func (c *DiscordDirectMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordDirectMessage = append(c.inters.DiscordDirectMessage, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordDirectMessage entity.
+func (c *DiscordDirectMessageClient) Create() *DiscordDirectMessageCreate {
+	mutation := newDiscordDirectMessageMutation(c.config, OpCreate)
+	return &DiscordDirectMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordDirectMessage entities.
+func (c *DiscordDirectMessageClient) CreateBulk(builders ...*DiscordDirectMessageCreate) *DiscordDirectMessageCreateBulk {
+	return &DiscordDirectMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordDirectMessageClient) MapCreateBulk(slice any, setFunc func(*DiscordDirectMessageCreate, int)) *DiscordDirectMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordDirectMessageCreateBulk{err: fmt.Errorf("calling to DiscordDirectMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordDirectMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordDirectMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordDirectMessage.
+func (c *DiscordDirectMessageClient) Update() *DiscordDirectMessageUpdate {
+	mutation := newDiscordDirectMessageMutation(c.config, OpUpdate)
+	return &DiscordDirectMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordDirectMessageClient) UpdateOne(_m *DiscordDirectMessage) *DiscordDirectMessageUpdateOne {
+	mutation := newDiscordDirectMessageMutation(c.config, OpUpdateOne, withDiscordDirectMessage(_m))
+	return &DiscordDirectMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordDirectMessageClient) UpdateOneID(id string) *DiscordDirectMessageUpdateOne {
+	mutation := newDiscordDirectMessageMutation(c.config, OpUpdateOne, withDiscordDirectMessageID(id))
+	return &DiscordDirectMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordDirectMessage.
+func (c *DiscordDirectMessageClient) Delete() *DiscordDirectMessageDelete {
+	mutation := newDiscordDirectMessageMutation(c.config, OpDelete)
+	return &DiscordDirectMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordDirectMessageClient) DeleteOne(_m *DiscordDirectMessage) *DiscordDirectMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordDirectMessageClient) DeleteOneID(id string) *DiscordDirectMessageDeleteOne {
+	builder := c.Delete().Where(discorddirectmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordDirectMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordDirectMessage.
+func (c *DiscordDirectMessageClient) Query() *DiscordDirectMessageQuery {
+	return &DiscordDirectMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordDirectMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordDirectMessage entity by its id.
+func (c *DiscordDirectMessageClient) Get(ctx context.Context, id string) (*DiscordDirectMessage, error) {
+	return c.Query().Where(discorddirectmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordDirectMessageClient) GetX(ctx context.Context, id string) *DiscordDirectMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DiscordDirectMessage.
+func (c *DiscordDirectMessageClient) QueryUser(_m *DiscordDirectMessage) *DiscordUserQuery {
+	query := (&DiscordUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorddirectmessage.Table, discorddirectmessage.FieldID, id),
+			sqlgraph.To(discorduser.Table, discorduser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discorddirectmessage.UserTable, discorddirectmessage.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordDirectMessageClient) Hooks() []Hook {
+	return c.hooks.DiscordDirectMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordDirectMessageClient) Interceptors() []Interceptor {
+	return c.inters.DiscordDirectMessage
+}
+
+func (c *DiscordDirectMessageClient) mutate(ctx context.Context, m *DiscordDirectMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordDirectMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordDirectMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordDirectMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordDirectMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordDirectMessage mutation op: %q", m.Op())
+	}
+}
+
+// DiscordGuildMemberClient is a client for the DiscordGuildMember schema.
+type DiscordGuildMemberClient struct {
+	config
+}
+
+// NewDiscordGuildMemberClient returns a client for the DiscordGuildMember from the given config.
+func NewDiscordGuildMemberClient(c config) *DiscordGuildMemberClient {
+	return &DiscordGuildMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordguildmember.Hooks(f(g(h())))`.
+func (c *DiscordGuildMemberClient) Use(hooks ...Hook) {
+	c.hooks.DiscordGuildMember = append(c.hooks.DiscordGuildMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordguildmember.Intercept(f(g(h())))`.
+func (c *DiscordGuildMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordGuildMember = append(c.inters.DiscordGuildMember, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordGuildMember entity.
+func (c *DiscordGuildMemberClient) Create() *DiscordGuildMemberCreate {
+	mutation := newDiscordGuildMemberMutation(c.config, OpCreate)
+	return &DiscordGuildMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordGuildMember entities.
+func (c *DiscordGuildMemberClient) CreateBulk(builders ...*DiscordGuildMemberCreate) *DiscordGuildMemberCreateBulk {
+	return &DiscordGuildMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordGuildMemberClient) MapCreateBulk(slice any, setFunc func(*DiscordGuildMemberCreate, int)) *DiscordGuildMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordGuildMemberCreateBulk{err: fmt.Errorf("calling to DiscordGuildMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordGuildMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordGuildMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordGuildMember.
+func (c *DiscordGuildMemberClient) Update() *DiscordGuildMemberUpdate {
+	mutation := newDiscordGuildMemberMutation(c.config, OpUpdate)
+	return &DiscordGuildMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordGuildMemberClient) UpdateOne(_m *DiscordGuildMember) *DiscordGuildMemberUpdateOne {
+	mutation := newDiscordGuildMemberMutation(c.config, OpUpdateOne, withDiscordGuildMember(_m))
+	return &DiscordGuildMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordGuildMemberClient) UpdateOneID(id int) *DiscordGuildMemberUpdateOne {
+	mutation := newDiscordGuildMemberMutation(c.config, OpUpdateOne, withDiscordGuildMemberID(id))
+	return &DiscordGuildMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordGuildMember.
+func (c *DiscordGuildMemberClient) Delete() *DiscordGuildMemberDelete {
+	mutation := newDiscordGuildMemberMutation(c.config, OpDelete)
+	return &DiscordGuildMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordGuildMemberClient) DeleteOne(_m *DiscordGuildMember) *DiscordGuildMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordGuildMemberClient) DeleteOneID(id int) *DiscordGuildMemberDeleteOne {
+	builder := c.Delete().Where(discordguildmember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordGuildMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordGuildMember.
+func (c *DiscordGuildMemberClient) Query() *DiscordGuildMemberQuery {
+	return &DiscordGuildMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordGuildMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordGuildMember entity by its id.
+func (c *DiscordGuildMemberClient) Get(ctx context.Context, id int) (*DiscordGuildMember, error) {
+	return c.Query().Where(discordguildmember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordGuildMemberClient) GetX(ctx context.Context, id int) *DiscordGuildMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DiscordGuildMember.
+func (c *DiscordGuildMemberClient) QueryUser(_m *DiscordGuildMember) *DiscordUserQuery {
+	query := (&DiscordUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordguildmember.Table, discordguildmember.FieldID, id),
+			sqlgraph.To(discorduser.Table, discorduser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordguildmember.UserTable, discordguildmember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordGuildMemberClient) Hooks() []Hook {
+	return c.hooks.DiscordGuildMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordGuildMemberClient) Interceptors() []Interceptor {
+	return c.inters.DiscordGuildMember
+}
+
+func (c *DiscordGuildMemberClient) mutate(ctx context.Context, m *DiscordGuildMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordGuildMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordGuildMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordGuildMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordGuildMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordGuildMember mutation op: %q", m.Op())
+	}
+}
+
+// DiscordGuildSettingClient is a client for the DiscordGuildSetting schema.
+type DiscordGuildSettingClient struct {
+	config
+}
+
+// NewDiscordGuildSettingClient returns a client for the DiscordGuildSetting from the given config.
+func NewDiscordGuildSettingClient(c config) *DiscordGuildSettingClient {
+	return &DiscordGuildSettingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordguildsetting.Hooks(f(g(h())))`.
+func (c *DiscordGuildSettingClient) Use(hooks ...Hook) {
+	c.hooks.DiscordGuildSetting = append(c.hooks.DiscordGuildSetting, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordguildsetting.Intercept(f(g(h())))`.
+func (c *DiscordGuildSettingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordGuildSetting = append(c.inters.DiscordGuildSetting, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordGuildSetting entity.
+func (c *DiscordGuildSettingClient) Create() *DiscordGuildSettingCreate {
+	mutation := newDiscordGuildSettingMutation(c.config, OpCreate)
+	return &DiscordGuildSettingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordGuildSetting entities.
+func (c *DiscordGuildSettingClient) CreateBulk(builders ...*DiscordGuildSettingCreate) *DiscordGuildSettingCreateBulk {
+	return &DiscordGuildSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordGuildSettingClient) MapCreateBulk(slice any, setFunc func(*DiscordGuildSettingCreate, int)) *DiscordGuildSettingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordGuildSettingCreateBulk{err: fmt.Errorf("calling to DiscordGuildSettingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordGuildSettingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordGuildSettingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordGuildSetting.
+func (c *DiscordGuildSettingClient) Update() *DiscordGuildSettingUpdate {
+	mutation := newDiscordGuildSettingMutation(c.config, OpUpdate)
+	return &DiscordGuildSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordGuildSettingClient) UpdateOne(_m *DiscordGuildSetting) *DiscordGuildSettingUpdateOne {
+	mutation := newDiscordGuildSettingMutation(c.config, OpUpdateOne, withDiscordGuildSetting(_m))
+	return &DiscordGuildSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordGuildSettingClient) UpdateOneID(id string) *DiscordGuildSettingUpdateOne {
+	mutation := newDiscordGuildSettingMutation(c.config, OpUpdateOne, withDiscordGuildSettingID(id))
+	return &DiscordGuildSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordGuildSetting.
+func (c *DiscordGuildSettingClient) Delete() *DiscordGuildSettingDelete {
+	mutation := newDiscordGuildSettingMutation(c.config, OpDelete)
+	return &DiscordGuildSettingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordGuildSettingClient) DeleteOne(_m *DiscordGuildSetting) *DiscordGuildSettingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordGuildSettingClient) DeleteOneID(id string) *DiscordGuildSettingDeleteOne {
+	builder := c.Delete().Where(discordguildsetting.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordGuildSettingDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordGuildSetting.
+func (c *DiscordGuildSettingClient) Query() *DiscordGuildSettingQuery {
+	return &DiscordGuildSettingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordGuildSetting},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordGuildSetting entity by its id.
+func (c *DiscordGuildSettingClient) Get(ctx context.Context, id string) (*DiscordGuildSetting, error) {
+	return c.Query().Where(discordguildsetting.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordGuildSettingClient) GetX(ctx context.Context, id string) *DiscordGuildSetting {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordGuildSettingClient) Hooks() []Hook {
+	return c.hooks.DiscordGuildSetting
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordGuildSettingClient) Interceptors() []Interceptor {
+	return c.inters.DiscordGuildSetting
+}
+
+func (c *DiscordGuildSettingClient) mutate(ctx context.Context, m *DiscordGuildSettingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordGuildSettingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordGuildSettingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordGuildSettingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordGuildSettingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordGuildSetting mutation op: %q", m.Op())
+	}
+}
+
+// DiscordMessageClient is a client for the DiscordMessage schema.
+type DiscordMessageClient struct {
+	config
+}
+
+// NewDiscordMessageClient returns a client for the DiscordMessage from the given config.
+func NewDiscordMessageClient(c config) *DiscordMessageClient {
+	return &DiscordMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordmessage.Hooks(f(g(h())))`.
+func (c *DiscordMessageClient) Use(hooks ...Hook) {
+	c.hooks.DiscordMessage = append(c.hooks.DiscordMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordmessage.Intercept(f(g(h())))`.
+func (c *DiscordMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordMessage = append(c.inters.DiscordMessage, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordMessage entity.
+func (c *DiscordMessageClient) Create() *DiscordMessageCreate {
+	mutation := newDiscordMessageMutation(c.config, OpCreate)
+	return &DiscordMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordMessage entities.
+func (c *DiscordMessageClient) CreateBulk(builders ...*DiscordMessageCreate) *DiscordMessageCreateBulk {
+	return &DiscordMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordMessageClient) MapCreateBulk(slice any, setFunc func(*DiscordMessageCreate, int)) *DiscordMessageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordMessageCreateBulk{err: fmt.Errorf("calling to DiscordMessageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordMessageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordMessage.
+func (c *DiscordMessageClient) Update() *DiscordMessageUpdate {
+	mutation := newDiscordMessageMutation(c.config, OpUpdate)
+	return &DiscordMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordMessageClient) UpdateOne(_m *DiscordMessage) *DiscordMessageUpdateOne {
+	mutation := newDiscordMessageMutation(c.config, OpUpdateOne, withDiscordMessage(_m))
+	return &DiscordMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordMessageClient) UpdateOneID(id string) *DiscordMessageUpdateOne {
+	mutation := newDiscordMessageMutation(c.config, OpUpdateOne, withDiscordMessageID(id))
+	return &DiscordMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordMessage.
+func (c *DiscordMessageClient) Delete() *DiscordMessageDelete {
+	mutation := newDiscordMessageMutation(c.config, OpDelete)
+	return &DiscordMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordMessageClient) DeleteOne(_m *DiscordMessage) *DiscordMessageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordMessageClient) DeleteOneID(id string) *DiscordMessageDeleteOne {
+	builder := c.Delete().Where(discordmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordMessage.
+func (c *DiscordMessageClient) Query() *DiscordMessageQuery {
+	return &DiscordMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordMessage entity by its id.
+func (c *DiscordMessageClient) Get(ctx context.Context, id string) (*DiscordMessage, error) {
+	return c.Query().Where(discordmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordMessageClient) GetX(ctx context.Context, id string) *DiscordMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryUser(_m *DiscordMessage) *DiscordUserQuery {
+	query := (&DiscordUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discorduser.Table, discorduser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordmessage.UserTable, discordmessage.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmbeddings queries the embeddings edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryEmbeddings(_m *DiscordMessage) *DiscordMessageEmbeddingQuery {
+	query := (&DiscordMessageEmbeddingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordmessageembedding.Table, discordmessageembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessage.EmbeddingsTable, discordmessage.EmbeddingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReactions queries the reactions edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryReactions(_m *DiscordMessage) *DiscordReactionQuery {
+	query := (&DiscordReactionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordreaction.Table, discordreaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessage.ReactionsTable, discordmessage.ReactionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplyTo queries the reply_to edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryReplyTo(_m *DiscordMessage) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordmessage.ReplyToTable, discordmessage.ReplyToColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReplies queries the replies edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryReplies(_m *DiscordMessage) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessage.RepliesTable, discordmessage.RepliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentionedUsers queries the mentioned_users edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryMentionedUsers(_m *DiscordMessage) *DiscordUserQuery {
+	query := (&DiscordUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discorduser.Table, discorduser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessage.MentionedUsersTable, discordmessage.MentionedUsersPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentionedRoles queries the mentioned_roles edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryMentionedRoles(_m *DiscordMessage) *DiscordRoleQuery {
+	query := (&DiscordRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordrole.Table, discordrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessage.MentionedRolesTable, discordmessage.MentionedRolesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentionedChannels queries the mentioned_channels edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryMentionedChannels(_m *DiscordMessage) *DiscordChannelQuery {
+	query := (&DiscordChannelClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordchannel.Table, discordchannel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessage.MentionedChannelsTable, discordmessage.MentionedChannelsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChunks queries the chunks edge of a DiscordMessage.
+func (c *DiscordMessageClient) QueryChunks(_m *DiscordMessage) *DiscordMessageChunkQuery {
+	query := (&DiscordMessageChunkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, id),
+			sqlgraph.To(discordmessagechunk.Table, discordmessagechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, discordmessage.ChunksTable, discordmessage.ChunksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordMessageClient) Hooks() []Hook {
+	return c.hooks.DiscordMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordMessageClient) Interceptors() []Interceptor {
+	return c.inters.DiscordMessage
+}
+
+func (c *DiscordMessageClient) mutate(ctx context.Context, m *DiscordMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordMessage mutation op: %q", m.Op())
+	}
+}
+
+// DiscordMessageChunkClient is a client for the DiscordMessageChunk schema.
+type DiscordMessageChunkClient struct {
+	config
+}
+
+// NewDiscordMessageChunkClient returns a client for the DiscordMessageChunk from the given config.
+func NewDiscordMessageChunkClient(c config) *DiscordMessageChunkClient {
+	return &DiscordMessageChunkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordmessagechunk.Hooks(f(g(h())))`.
+func (c *DiscordMessageChunkClient) Use(hooks ...Hook) {
+	c.hooks.DiscordMessageChunk = append(c.hooks.DiscordMessageChunk, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordmessagechunk.Intercept(f(g(h())))`.
+func (c *DiscordMessageChunkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordMessageChunk = append(c.inters.DiscordMessageChunk, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordMessageChunk entity.
+func (c *DiscordMessageChunkClient) Create() *DiscordMessageChunkCreate {
+	mutation := newDiscordMessageChunkMutation(c.config, OpCreate)
+	return &DiscordMessageChunkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordMessageChunk entities.
+func (c *DiscordMessageChunkClient) CreateBulk(builders ...*DiscordMessageChunkCreate) *DiscordMessageChunkCreateBulk {
+	return &DiscordMessageChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordMessageChunkClient) MapCreateBulk(slice any, setFunc func(*DiscordMessageChunkCreate, int)) *DiscordMessageChunkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordMessageChunkCreateBulk{err: fmt.Errorf("calling to DiscordMessageChunkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordMessageChunkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordMessageChunkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordMessageChunk.
+func (c *DiscordMessageChunkClient) Update() *DiscordMessageChunkUpdate {
+	mutation := newDiscordMessageChunkMutation(c.config, OpUpdate)
+	return &DiscordMessageChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordMessageChunkClient) UpdateOne(_m *DiscordMessageChunk) *DiscordMessageChunkUpdateOne {
+	mutation := newDiscordMessageChunkMutation(c.config, OpUpdateOne, withDiscordMessageChunk(_m))
+	return &DiscordMessageChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordMessageChunkClient) UpdateOneID(id string) *DiscordMessageChunkUpdateOne {
+	mutation := newDiscordMessageChunkMutation(c.config, OpUpdateOne, withDiscordMessageChunkID(id))
+	return &DiscordMessageChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordMessageChunk.
+func (c *DiscordMessageChunkClient) Delete() *DiscordMessageChunkDelete {
+	mutation := newDiscordMessageChunkMutation(c.config, OpDelete)
+	return &DiscordMessageChunkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordMessageChunkClient) DeleteOne(_m *DiscordMessageChunk) *DiscordMessageChunkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordMessageChunkClient) DeleteOneID(id string) *DiscordMessageChunkDeleteOne {
+	builder := c.Delete().Where(discordmessagechunk.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordMessageChunkDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordMessageChunk.
+func (c *DiscordMessageChunkClient) Query() *DiscordMessageChunkQuery {
+	return &DiscordMessageChunkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordMessageChunk},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordMessageChunk entity by its id.
+func (c *DiscordMessageChunkClient) Get(ctx context.Context, id string) (*DiscordMessageChunk, error) {
+	return c.Query().Where(discordmessagechunk.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordMessageChunkClient) GetX(ctx context.Context, id string) *DiscordMessageChunk {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessages queries the messages edge of a DiscordMessageChunk.
+func (c *DiscordMessageChunkClient) QueryMessages(_m *DiscordMessageChunk) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessagechunk.Table, discordmessagechunk.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessagechunk.MessagesTable, discordmessagechunk.MessagesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEmbeddings queries the embeddings edge of a DiscordMessageChunk.
+func (c *DiscordMessageChunkClient) QueryEmbeddings(_m *DiscordMessageChunk) *DiscordChunkEmbeddingQuery {
+	query := (&DiscordChunkEmbeddingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessagechunk.Table, discordmessagechunk.FieldID, id),
+			sqlgraph.To(discordchunkembedding.Table, discordchunkembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessagechunk.EmbeddingsTable, discordmessagechunk.EmbeddingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordMessageChunkClient) Hooks() []Hook {
+	return c.hooks.DiscordMessageChunk
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordMessageChunkClient) Interceptors() []Interceptor {
+	return c.inters.DiscordMessageChunk
+}
+
+func (c *DiscordMessageChunkClient) mutate(ctx context.Context, m *DiscordMessageChunkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordMessageChunkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordMessageChunkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordMessageChunkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordMessageChunkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordMessageChunk mutation op: %q", m.Op())
+	}
+}
+
+// DiscordMessageEmbeddingClient is a client for the DiscordMessageEmbedding schema.
+type DiscordMessageEmbeddingClient struct {
+	config
+}
+
+// NewDiscordMessageEmbeddingClient returns a client for the DiscordMessageEmbedding from the given config.
+func NewDiscordMessageEmbeddingClient(c config) *DiscordMessageEmbeddingClient {
+	return &DiscordMessageEmbeddingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordmessageembedding.Hooks(f(g(h())))`.
+func (c *DiscordMessageEmbeddingClient) Use(hooks ...Hook) {
+	c.hooks.DiscordMessageEmbedding = append(c.hooks.DiscordMessageEmbedding, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordmessageembedding.Intercept(f(g(h())))`.
+func (c *DiscordMessageEmbeddingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordMessageEmbedding = append(c.inters.DiscordMessageEmbedding, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordMessageEmbedding entity.
+func (c *DiscordMessageEmbeddingClient) Create() *DiscordMessageEmbeddingCreate {
+	mutation := newDiscordMessageEmbeddingMutation(c.config, OpCreate)
+	return &DiscordMessageEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordMessageEmbedding entities.
+func (c *DiscordMessageEmbeddingClient) CreateBulk(builders ...*DiscordMessageEmbeddingCreate) *DiscordMessageEmbeddingCreateBulk {
+	return &DiscordMessageEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordMessageEmbeddingClient) MapCreateBulk(slice any, setFunc func(*DiscordMessageEmbeddingCreate, int)) *DiscordMessageEmbeddingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordMessageEmbeddingCreateBulk{err: fmt.Errorf("calling to DiscordMessageEmbeddingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordMessageEmbeddingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordMessageEmbeddingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordMessageEmbedding.
+func (c *DiscordMessageEmbeddingClient) Update() *DiscordMessageEmbeddingUpdate {
+	mutation := newDiscordMessageEmbeddingMutation(c.config, OpUpdate)
+	return &DiscordMessageEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordMessageEmbeddingClient) UpdateOne(_m *DiscordMessageEmbedding) *DiscordMessageEmbeddingUpdateOne {
+	mutation := newDiscordMessageEmbeddingMutation(c.config, OpUpdateOne, withDiscordMessageEmbedding(_m))
+	return &DiscordMessageEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordMessageEmbeddingClient) UpdateOneID(id string) *DiscordMessageEmbeddingUpdateOne {
+	mutation := newDiscordMessageEmbeddingMutation(c.config, OpUpdateOne, withDiscordMessageEmbeddingID(id))
+	return &DiscordMessageEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordMessageEmbedding.
+func (c *DiscordMessageEmbeddingClient) Delete() *DiscordMessageEmbeddingDelete {
+	mutation := newDiscordMessageEmbeddingMutation(c.config, OpDelete)
+	return &DiscordMessageEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordMessageEmbeddingClient) DeleteOne(_m *DiscordMessageEmbedding) *DiscordMessageEmbeddingDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordMessageEmbeddingClient) DeleteOneID(id string) *DiscordMessageEmbeddingDeleteOne {
+	builder := c.Delete().Where(discordmessageembedding.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordMessageEmbeddingDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordMessageEmbedding.
+func (c *DiscordMessageEmbeddingClient) Query() *DiscordMessageEmbeddingQuery {
+	return &DiscordMessageEmbeddingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordMessageEmbedding},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordMessageEmbedding entity by its id.
+func (c *DiscordMessageEmbeddingClient) Get(ctx context.Context, id string) (*DiscordMessageEmbedding, error) {
+	return c.Query().Where(discordmessageembedding.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordMessageEmbeddingClient) GetX(ctx context.Context, id string) *DiscordMessageEmbedding {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a DiscordMessageEmbedding.
+func (c *DiscordMessageEmbeddingClient) QueryMessage(_m *DiscordMessageEmbedding) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessageembedding.Table, discordmessageembedding.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordmessageembedding.MessageTable, discordmessageembedding.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordMessageEmbeddingClient) Hooks() []Hook {
+	return c.hooks.DiscordMessageEmbedding
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordMessageEmbeddingClient) Interceptors() []Interceptor {
+	return c.inters.DiscordMessageEmbedding
+}
+
+func (c *DiscordMessageEmbeddingClient) mutate(ctx context.Context, m *DiscordMessageEmbeddingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordMessageEmbeddingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordMessageEmbeddingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordMessageEmbeddingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordMessageEmbeddingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordMessageEmbedding mutation op: %q", m.Op())
+	}
+}
+
+// DiscordProfileChangeClient is a client for the DiscordProfileChange schema.
+type DiscordProfileChangeClient struct {
+	config
+}
+
+// NewDiscordProfileChangeClient returns a client for the DiscordProfileChange from the given config.
+func NewDiscordProfileChangeClient(c config) *DiscordProfileChangeClient {
+	return &DiscordProfileChangeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordprofilechange.Hooks(f(g(h())))`.
+func (c *DiscordProfileChangeClient) Use(hooks ...Hook) {
+	c.hooks.DiscordProfileChange = append(c.hooks.DiscordProfileChange, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordprofilechange.Intercept(f(g(h())))`.
+func (c *DiscordProfileChangeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordProfileChange = append(c.inters.DiscordProfileChange, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordProfileChange entity.
+func (c *DiscordProfileChangeClient) Create() *DiscordProfileChangeCreate {
+	mutation := newDiscordProfileChangeMutation(c.config, OpCreate)
+	return &DiscordProfileChangeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordProfileChange entities.
+func (c *DiscordProfileChangeClient) CreateBulk(builders ...*DiscordProfileChangeCreate) *DiscordProfileChangeCreateBulk {
+	return &DiscordProfileChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordProfileChangeClient) MapCreateBulk(slice any, setFunc func(*DiscordProfileChangeCreate, int)) *DiscordProfileChangeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordProfileChangeCreateBulk{err: fmt.Errorf("calling to DiscordProfileChangeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordProfileChangeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordProfileChangeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordProfileChange.
+func (c *DiscordProfileChangeClient) Update() *DiscordProfileChangeUpdate {
+	mutation := newDiscordProfileChangeMutation(c.config, OpUpdate)
+	return &DiscordProfileChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordProfileChangeClient) UpdateOne(_m *DiscordProfileChange) *DiscordProfileChangeUpdateOne {
+	mutation := newDiscordProfileChangeMutation(c.config, OpUpdateOne, withDiscordProfileChange(_m))
+	return &DiscordProfileChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordProfileChangeClient) UpdateOneID(id int) *DiscordProfileChangeUpdateOne {
+	mutation := newDiscordProfileChangeMutation(c.config, OpUpdateOne, withDiscordProfileChangeID(id))
+	return &DiscordProfileChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordProfileChange.
+func (c *DiscordProfileChangeClient) Delete() *DiscordProfileChangeDelete {
+	mutation := newDiscordProfileChangeMutation(c.config, OpDelete)
+	return &DiscordProfileChangeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordProfileChangeClient) DeleteOne(_m *DiscordProfileChange) *DiscordProfileChangeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordProfileChangeClient) DeleteOneID(id int) *DiscordProfileChangeDeleteOne {
+	builder := c.Delete().Where(discordprofilechange.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordProfileChangeDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordProfileChange.
+func (c *DiscordProfileChangeClient) Query() *DiscordProfileChangeQuery {
+	return &DiscordProfileChangeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordProfileChange},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordProfileChange entity by its id.
+func (c *DiscordProfileChangeClient) Get(ctx context.Context, id int) (*DiscordProfileChange, error) {
+	return c.Query().Where(discordprofilechange.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordProfileChangeClient) GetX(ctx context.Context, id int) *DiscordProfileChange {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a DiscordProfileChange.
+func (c *DiscordProfileChangeClient) QueryUser(_m *DiscordProfileChange) *DiscordUserQuery {
+	query := (&DiscordUserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordprofilechange.Table, discordprofilechange.FieldID, id),
+			sqlgraph.To(discorduser.Table, discorduser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordprofilechange.UserTable, discordprofilechange.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordProfileChangeClient) Hooks() []Hook {
+	return c.hooks.DiscordProfileChange
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordProfileChangeClient) Interceptors() []Interceptor {
+	return c.inters.DiscordProfileChange
+}
+
+func (c *DiscordProfileChangeClient) mutate(ctx context.Context, m *DiscordProfileChangeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordProfileChangeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordProfileChangeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordProfileChangeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordProfileChangeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordProfileChange mutation op: %q", m.Op())
+	}
+}
+
+// DiscordReactionClient is a client for the DiscordReaction schema.
+type DiscordReactionClient struct {
+	config
+}
+
+// NewDiscordReactionClient returns a client for the DiscordReaction from the given config.
+func NewDiscordReactionClient(c config) *DiscordReactionClient {
+	return &DiscordReactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordreaction.Hooks(f(g(h())))`.
+func (c *DiscordReactionClient) Use(hooks ...Hook) {
+	c.hooks.DiscordReaction = append(c.hooks.DiscordReaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordreaction.Intercept(f(g(h())))`.
+func (c *DiscordReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordReaction = append(c.inters.DiscordReaction, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordReaction entity.
+func (c *DiscordReactionClient) Create() *DiscordReactionCreate {
+	mutation := newDiscordReactionMutation(c.config, OpCreate)
+	return &DiscordReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordReaction entities.
+func (c *DiscordReactionClient) CreateBulk(builders ...*DiscordReactionCreate) *DiscordReactionCreateBulk {
+	return &DiscordReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordReactionClient) MapCreateBulk(slice any, setFunc func(*DiscordReactionCreate, int)) *DiscordReactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordReactionCreateBulk{err: fmt.Errorf("calling to DiscordReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordReactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordReaction.
+func (c *DiscordReactionClient) Update() *DiscordReactionUpdate {
+	mutation := newDiscordReactionMutation(c.config, OpUpdate)
+	return &DiscordReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordReactionClient) UpdateOne(_m *DiscordReaction) *DiscordReactionUpdateOne {
+	mutation := newDiscordReactionMutation(c.config, OpUpdateOne, withDiscordReaction(_m))
+	return &DiscordReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordReactionClient) UpdateOneID(id int) *DiscordReactionUpdateOne {
+	mutation := newDiscordReactionMutation(c.config, OpUpdateOne, withDiscordReactionID(id))
+	return &DiscordReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordReaction.
+func (c *DiscordReactionClient) Delete() *DiscordReactionDelete {
+	mutation := newDiscordReactionMutation(c.config, OpDelete)
+	return &DiscordReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordReactionClient) DeleteOne(_m *DiscordReaction) *DiscordReactionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordReactionClient) DeleteOneID(id int) *DiscordReactionDeleteOne {
+	builder := c.Delete().Where(discordreaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordReactionDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordReaction.
+func (c *DiscordReactionClient) Query() *DiscordReactionQuery {
+	return &DiscordReactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordReaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordReaction entity by its id.
+func (c *DiscordReactionClient) Get(ctx context.Context, id int) (*DiscordReaction, error) {
+	return c.Query().Where(discordreaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordReactionClient) GetX(ctx context.Context, id int) *DiscordReaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessage queries the message edge of a DiscordReaction.
+func (c *DiscordReactionClient) QueryMessage(_m *DiscordReaction) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordreaction.Table, discordreaction.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordreaction.MessageTable, discordreaction.MessageColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordReactionClient) Hooks() []Hook {
+	return c.hooks.DiscordReaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordReactionClient) Interceptors() []Interceptor {
+	return c.inters.DiscordReaction
+}
+
+func (c *DiscordReactionClient) mutate(ctx context.Context, m *DiscordReactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordReaction mutation op: %q", m.Op())
+	}
+}
+
+// DiscordRoleClient is a client for the DiscordRole schema.
+type DiscordRoleClient struct {
+	config
+}
+
+// NewDiscordRoleClient returns a client for the DiscordRole from the given config.
+func NewDiscordRoleClient(c config) *DiscordRoleClient {
+	return &DiscordRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discordrole.Hooks(f(g(h())))`.
+func (c *DiscordRoleClient) Use(hooks ...Hook) {
+	c.hooks.DiscordRole = append(c.hooks.DiscordRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discordrole.Intercept(f(g(h())))`.
+func (c *DiscordRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordRole = append(c.inters.DiscordRole, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordRole entity.
+func (c *DiscordRoleClient) Create() *DiscordRoleCreate {
+	mutation := newDiscordRoleMutation(c.config, OpCreate)
+	return &DiscordRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordRole entities.
+func (c *DiscordRoleClient) CreateBulk(builders ...*DiscordRoleCreate) *DiscordRoleCreateBulk {
+	return &DiscordRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordRoleClient) MapCreateBulk(slice any, setFunc func(*DiscordRoleCreate, int)) *DiscordRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordRoleCreateBulk{err: fmt.Errorf("calling to DiscordRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordRole.
+func (c *DiscordRoleClient) Update() *DiscordRoleUpdate {
+	mutation := newDiscordRoleMutation(c.config, OpUpdate)
+	return &DiscordRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordRoleClient) UpdateOne(_m *DiscordRole) *DiscordRoleUpdateOne {
+	mutation := newDiscordRoleMutation(c.config, OpUpdateOne, withDiscordRole(_m))
+	return &DiscordRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordRoleClient) UpdateOneID(id string) *DiscordRoleUpdateOne {
+	mutation := newDiscordRoleMutation(c.config, OpUpdateOne, withDiscordRoleID(id))
+	return &DiscordRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordRole.
+func (c *DiscordRoleClient) Delete() *DiscordRoleDelete {
+	mutation := newDiscordRoleMutation(c.config, OpDelete)
+	return &DiscordRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordRoleClient) DeleteOne(_m *DiscordRole) *DiscordRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordRoleClient) DeleteOneID(id string) *DiscordRoleDeleteOne {
+	builder := c.Delete().Where(discordrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordRole.
+func (c *DiscordRoleClient) Query() *DiscordRoleQuery {
+	return &DiscordRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordRole entity by its id.
+func (c *DiscordRoleClient) Get(ctx context.Context, id string) (*DiscordRole, error) {
+	return c.Query().Where(discordrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordRoleClient) GetX(ctx context.Context, id string) *DiscordRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMentionedIn queries the mentioned_in edge of a DiscordRole.
+func (c *DiscordRoleClient) QueryMentionedIn(_m *DiscordRole) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordrole.Table, discordrole.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, discordrole.MentionedInTable, discordrole.MentionedInPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordRoleClient) Hooks() []Hook {
+	return c.hooks.DiscordRole
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordRoleClient) Interceptors() []Interceptor {
+	return c.inters.DiscordRole
+}
+
+func (c *DiscordRoleClient) mutate(ctx context.Context, m *DiscordRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordRole mutation op: %q", m.Op())
+	}
+}
+
+// DiscordUserClient is a client for the DiscordUser schema.
+type DiscordUserClient struct {
+	config
+}
+
+// NewDiscordUserClient returns a client for the DiscordUser from the given config.
+func NewDiscordUserClient(c config) *DiscordUserClient {
+	return &DiscordUserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `discorduser.Hooks(f(g(h())))`.
+func (c *DiscordUserClient) Use(hooks ...Hook) {
+	c.hooks.DiscordUser = append(c.hooks.DiscordUser, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `discorduser.Intercept(f(g(h())))`.
+func (c *DiscordUserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DiscordUser = append(c.inters.DiscordUser, interceptors...)
+}
+
+// Create returns a builder for creating a DiscordUser entity.
+func (c *DiscordUserClient) Create() *DiscordUserCreate {
+	mutation := newDiscordUserMutation(c.config, OpCreate)
+	return &DiscordUserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DiscordUser entities.
+func (c *DiscordUserClient) CreateBulk(builders ...*DiscordUserCreate) *DiscordUserCreateBulk {
+	return &DiscordUserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DiscordUserClient) MapCreateBulk(slice any, setFunc func(*DiscordUserCreate, int)) *DiscordUserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DiscordUserCreateBulk{err: fmt.Errorf("calling to DiscordUserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DiscordUserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DiscordUserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DiscordUser.
+func (c *DiscordUserClient) Update() *DiscordUserUpdate {
+	mutation := newDiscordUserMutation(c.config, OpUpdate)
+	return &DiscordUserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DiscordUserClient) UpdateOne(_m *DiscordUser) *DiscordUserUpdateOne {
+	mutation := newDiscordUserMutation(c.config, OpUpdateOne, withDiscordUser(_m))
+	return &DiscordUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DiscordUserClient) UpdateOneID(id string) *DiscordUserUpdateOne {
+	mutation := newDiscordUserMutation(c.config, OpUpdateOne, withDiscordUserID(id))
+	return &DiscordUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DiscordUser.
+func (c *DiscordUserClient) Delete() *DiscordUserDelete {
+	mutation := newDiscordUserMutation(c.config, OpDelete)
+	return &DiscordUserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DiscordUserClient) DeleteOne(_m *DiscordUser) *DiscordUserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DiscordUserClient) DeleteOneID(id string) *DiscordUserDeleteOne {
+	builder := c.Delete().Where(discorduser.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DiscordUserDeleteOne{builder}
+}
+
+// Query returns a query builder for DiscordUser.
+func (c *DiscordUserClient) Query() *DiscordUserQuery {
+	return &DiscordUserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDiscordUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DiscordUser entity by its id.
+func (c *DiscordUserClient) Get(ctx context.Context, id string) (*DiscordUser, error) {
+	return c.Query().Where(discorduser.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DiscordUserClient) GetX(ctx context.Context, id string) *DiscordUser {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMessages queries the messages edge of a DiscordUser.
+func (c *DiscordUserClient) QueryMessages(_m *DiscordUser) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.MessagesTable, discorduser.MessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMentionedIn queries the mentioned_in edge of a DiscordUser.
+func (c *DiscordUserClient) QueryMentionedIn(_m *DiscordUser) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, id),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, discorduser.MentionedInTable, discorduser.MentionedInPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMemberships queries the memberships edge of a DiscordUser.
+func (c *DiscordUserClient) QueryMemberships(_m *DiscordUser) *DiscordGuildMemberQuery {
+	query := (&DiscordGuildMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, id),
+			sqlgraph.To(discordguildmember.Table, discordguildmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.MembershipsTable, discorduser.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryProfileChanges queries the profile_changes edge of a DiscordUser.
+func (c *DiscordUserClient) QueryProfileChanges(_m *DiscordUser) *DiscordProfileChangeQuery {
+	query := (&DiscordProfileChangeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, id),
+			sqlgraph.To(discordprofilechange.Table, discordprofilechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.ProfileChangesTable, discorduser.ProfileChangesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDirectMessages queries the direct_messages edge of a DiscordUser.
+func (c *DiscordUserClient) QueryDirectMessages(_m *DiscordUser) *DiscordDirectMessageQuery {
+	query := (&DiscordDirectMessageClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, id),
+			sqlgraph.To(discorddirectmessage.Table, discorddirectmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.DirectMessagesTable, discorduser.DirectMessagesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DiscordUserClient) Hooks() []Hook {
+	return c.hooks.DiscordUser
+}
+
+// Interceptors returns the client interceptors.
+func (c *DiscordUserClient) Interceptors() []Interceptor {
+	return c.inters.DiscordUser
+}
+
+func (c *DiscordUserClient) mutate(ctx context.Context, m *DiscordUserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DiscordUserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DiscordUserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DiscordUserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DiscordUserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DiscordUser mutation op: %q", m.Op())
+	}
+}
+
+// EmbeddingModelClient is a client for the EmbeddingModel schema.
+type EmbeddingModelClient struct {
+	config
+}
+
+// NewEmbeddingModelClient returns a client for the EmbeddingModel from the given config.
+func NewEmbeddingModelClient(c config) *EmbeddingModelClient {
+	return &EmbeddingModelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `embeddingmodel.Hooks(f(g(h())))`.
+func (c *EmbeddingModelClient) Use(hooks ...Hook) {
+	c.hooks.EmbeddingModel = append(c.hooks.EmbeddingModel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `embeddingmodel.Intercept(f(g(h())))`.
+func (c *EmbeddingModelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmbeddingModel = append(c.inters.EmbeddingModel, interceptors...)
+}
+
+// Create returns a builder for creating a EmbeddingModel entity.
+func (c *EmbeddingModelClient) Create() *EmbeddingModelCreate {
+	mutation := newEmbeddingModelMutation(c.config, OpCreate)
+	return &EmbeddingModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmbeddingModel entities.
+func (c *EmbeddingModelClient) CreateBulk(builders ...*EmbeddingModelCreate) *EmbeddingModelCreateBulk {
+	return &EmbeddingModelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmbeddingModelClient) MapCreateBulk(slice any, setFunc func(*EmbeddingModelCreate, int)) *EmbeddingModelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmbeddingModelCreateBulk{err: fmt.Errorf("calling to EmbeddingModelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmbeddingModelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmbeddingModelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmbeddingModel.
+func (c *EmbeddingModelClient) Update() *EmbeddingModelUpdate {
+	mutation := newEmbeddingModelMutation(c.config, OpUpdate)
+	return &EmbeddingModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmbeddingModelClient) UpdateOne(_m *EmbeddingModel) *EmbeddingModelUpdateOne {
+	mutation := newEmbeddingModelMutation(c.config, OpUpdateOne, withEmbeddingModel(_m))
+	return &EmbeddingModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmbeddingModelClient) UpdateOneID(id string) *EmbeddingModelUpdateOne {
+	mutation := newEmbeddingModelMutation(c.config, OpUpdateOne, withEmbeddingModelID(id))
+	return &EmbeddingModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmbeddingModel.
+func (c *EmbeddingModelClient) Delete() *EmbeddingModelDelete {
+	mutation := newEmbeddingModelMutation(c.config, OpDelete)
+	return &EmbeddingModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmbeddingModelClient) DeleteOne(_m *EmbeddingModel) *EmbeddingModelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmbeddingModelClient) DeleteOneID(id string) *EmbeddingModelDeleteOne {
+	builder := c.Delete().Where(embeddingmodel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmbeddingModelDeleteOne{builder}
+}
+
+// Query returns a query builder for EmbeddingModel.
+func (c *EmbeddingModelClient) Query() *EmbeddingModelQuery {
+	return &EmbeddingModelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmbeddingModel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmbeddingModel entity by its id.
+func (c *EmbeddingModelClient) Get(ctx context.Context, id string) (*EmbeddingModel, error) {
+	return c.Query().Where(embeddingmodel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmbeddingModelClient) GetX(ctx context.Context, id string) *EmbeddingModel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmbeddingModelClient) Hooks() []Hook {
+	return c.hooks.EmbeddingModel
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmbeddingModelClient) Interceptors() []Interceptor {
+	return c.inters.EmbeddingModel
+}
+
+func (c *EmbeddingModelClient) mutate(ctx context.Context, m *EmbeddingModelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmbeddingModelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmbeddingModelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmbeddingModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmbeddingModelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmbeddingModel mutation op: %q", m.Op())
+	}
+}
+
+// ModerationEventClient is a client for the ModerationEvent schema.
+type ModerationEventClient struct {
+	config
+}
+
+// NewModerationEventClient returns a client for the ModerationEvent from the given config.
+func NewModerationEventClient(c config) *ModerationEventClient {
+	return &ModerationEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `moderationevent.Hooks(f(g(h())))`.
+func (c *ModerationEventClient) Use(hooks ...Hook) {
+	c.hooks.ModerationEvent = append(c.hooks.ModerationEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `moderationevent.Intercept(f(g(h())))`.
+func (c *ModerationEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ModerationEvent = append(c.inters.ModerationEvent, interceptors...)
+}
+
+// Create returns a builder for creating a ModerationEvent entity.
+func (c *ModerationEventClient) Create() *ModerationEventCreate {
+	mutation := newModerationEventMutation(c.config, OpCreate)
+	return &ModerationEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ModerationEvent entities.
+func (c *ModerationEventClient) CreateBulk(builders ...*ModerationEventCreate) *ModerationEventCreateBulk {
+	return &ModerationEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ModerationEventClient) MapCreateBulk(slice any, setFunc func(*ModerationEventCreate, int)) *ModerationEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ModerationEventCreateBulk{err: fmt.Errorf("calling to ModerationEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ModerationEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ModerationEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ModerationEvent.
+func (c *ModerationEventClient) Update() *ModerationEventUpdate {
+	mutation := newModerationEventMutation(c.config, OpUpdate)
+	return &ModerationEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ModerationEventClient) UpdateOne(_m *ModerationEvent) *ModerationEventUpdateOne {
+	mutation := newModerationEventMutation(c.config, OpUpdateOne, withModerationEvent(_m))
+	return &ModerationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ModerationEventClient) UpdateOneID(id int) *ModerationEventUpdateOne {
+	mutation := newModerationEventMutation(c.config, OpUpdateOne, withModerationEventID(id))
+	return &ModerationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ModerationEvent.
+func (c *ModerationEventClient) Delete() *ModerationEventDelete {
+	mutation := newModerationEventMutation(c.config, OpDelete)
+	return &ModerationEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ModerationEventClient) DeleteOne(_m *ModerationEvent) *ModerationEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ModerationEventClient) DeleteOneID(id int) *ModerationEventDeleteOne {
+	builder := c.Delete().Where(moderationevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ModerationEventDeleteOne{builder}
+}
+
+// Query returns a query builder for ModerationEvent.
+func (c *ModerationEventClient) Query() *ModerationEventQuery {
+	return &ModerationEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeModerationEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ModerationEvent entity by its id.
+func (c *ModerationEventClient) Get(ctx context.Context, id int) (*ModerationEvent, error) {
+	return c.Query().Where(moderationevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ModerationEventClient) GetX(ctx context.Context, id int) *ModerationEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ModerationEventClient) Hooks() []Hook {
+	return c.hooks.ModerationEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *ModerationEventClient) Interceptors() []Interceptor {
+	return c.inters.ModerationEvent
+}
+
+func (c *ModerationEventClient) mutate(ctx context.Context, m *ModerationEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ModerationEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ModerationEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ModerationEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ModerationEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ModerationEvent mutation op: %q", m.Op())
+	}
+}
+
+// PrivacyEventClient is a client for the PrivacyEvent schema.
+type PrivacyEventClient struct {
+	config
+}
+
+// NewPrivacyEventClient returns a client for the PrivacyEvent from the given config.
+func NewPrivacyEventClient(c config) *PrivacyEventClient {
+	return &PrivacyEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `privacyevent.Hooks(f(g(h())))`.
+func (c *PrivacyEventClient) Use(hooks ...Hook) {
+	c.hooks.PrivacyEvent = append(c.hooks.PrivacyEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `privacyevent.Intercept(f(g(h())))`.
+func (c *PrivacyEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrivacyEvent = append(c.inters.PrivacyEvent, interceptors...)
+}
+
+// Create returns a builder for creating a PrivacyEvent entity.
+func (c *PrivacyEventClient) Create() *PrivacyEventCreate {
+	mutation := newPrivacyEventMutation(c.config, OpCreate)
+	return &PrivacyEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PrivacyEvent entities.
+func (c *PrivacyEventClient) CreateBulk(builders ...*PrivacyEventCreate) *PrivacyEventCreateBulk {
+	return &PrivacyEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrivacyEventClient) MapCreateBulk(slice any, setFunc func(*PrivacyEventCreate, int)) *PrivacyEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrivacyEventCreateBulk{err: fmt.Errorf("calling to PrivacyEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrivacyEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrivacyEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PrivacyEvent.
+func (c *PrivacyEventClient) Update() *PrivacyEventUpdate {
+	mutation := newPrivacyEventMutation(c.config, OpUpdate)
+	return &PrivacyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrivacyEventClient) UpdateOne(_m *PrivacyEvent) *PrivacyEventUpdateOne {
+	mutation := newPrivacyEventMutation(c.config, OpUpdateOne, withPrivacyEvent(_m))
+	return &PrivacyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrivacyEventClient) UpdateOneID(id int) *PrivacyEventUpdateOne {
+	mutation := newPrivacyEventMutation(c.config, OpUpdateOne, withPrivacyEventID(id))
+	return &PrivacyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PrivacyEvent.
+func (c *PrivacyEventClient) Delete() *PrivacyEventDelete {
+	mutation := newPrivacyEventMutation(c.config, OpDelete)
+	return &PrivacyEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrivacyEventClient) DeleteOne(_m *PrivacyEvent) *PrivacyEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrivacyEventClient) DeleteOneID(id int) *PrivacyEventDeleteOne {
+	builder := c.Delete().Where(privacyevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrivacyEventDeleteOne{builder}
+}
+
+// Query returns a query builder for PrivacyEvent.
+func (c *PrivacyEventClient) Query() *PrivacyEventQuery {
+	return &PrivacyEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrivacyEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PrivacyEvent entity by its id.
+func (c *PrivacyEventClient) Get(ctx context.Context, id int) (*PrivacyEvent, error) {
+	return c.Query().Where(privacyevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrivacyEventClient) GetX(ctx context.Context, id int) *PrivacyEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PrivacyEventClient) Hooks() []Hook {
+	return c.hooks.PrivacyEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrivacyEventClient) Interceptors() []Interceptor {
+	return c.inters.PrivacyEvent
+}
+
+func (c *PrivacyEventClient) mutate(ctx context.Context, m *PrivacyEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrivacyEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrivacyEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrivacyEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrivacyEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PrivacyEvent mutation op: %q", m.Op())
+	}
+}
+
+// PrivacyOptOutClient is a client for the PrivacyOptOut schema.
+type PrivacyOptOutClient struct {
+	config
+}
+
+// NewPrivacyOptOutClient returns a client for the PrivacyOptOut from the given config.
+func NewPrivacyOptOutClient(c config) *PrivacyOptOutClient {
+	return &PrivacyOptOutClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `privacyoptout.Hooks(f(g(h())))`.
+func (c *PrivacyOptOutClient) Use(hooks ...Hook) {
+	c.hooks.PrivacyOptOut = append(c.hooks.PrivacyOptOut, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `privacyoptout.Intercept(f(g(h())))`.
+func (c *PrivacyOptOutClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PrivacyOptOut = append(c.inters.PrivacyOptOut, interceptors...)
+}
+
+// Create returns a builder for creating a PrivacyOptOut entity.
+func (c *PrivacyOptOutClient) Create() *PrivacyOptOutCreate {
+	mutation := newPrivacyOptOutMutation(c.config, OpCreate)
+	return &PrivacyOptOutCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PrivacyOptOut entities.
+func (c *PrivacyOptOutClient) CreateBulk(builders ...*PrivacyOptOutCreate) *PrivacyOptOutCreateBulk {
+	return &PrivacyOptOutCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrivacyOptOutClient) MapCreateBulk(slice any, setFunc func(*PrivacyOptOutCreate, int)) *PrivacyOptOutCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrivacyOptOutCreateBulk{err: fmt.Errorf("calling to PrivacyOptOutClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrivacyOptOutCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrivacyOptOutCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PrivacyOptOut.
+func (c *PrivacyOptOutClient) Update() *PrivacyOptOutUpdate {
+	mutation := newPrivacyOptOutMutation(c.config, OpUpdate)
+	return &PrivacyOptOutUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrivacyOptOutClient) UpdateOne(_m *PrivacyOptOut) *PrivacyOptOutUpdateOne {
+	mutation := newPrivacyOptOutMutation(c.config, OpUpdateOne, withPrivacyOptOut(_m))
+	return &PrivacyOptOutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrivacyOptOutClient) UpdateOneID(id string) *PrivacyOptOutUpdateOne {
+	mutation := newPrivacyOptOutMutation(c.config, OpUpdateOne, withPrivacyOptOutID(id))
+	return &PrivacyOptOutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PrivacyOptOut.
+func (c *PrivacyOptOutClient) Delete() *PrivacyOptOutDelete {
+	mutation := newPrivacyOptOutMutation(c.config, OpDelete)
+	return &PrivacyOptOutDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrivacyOptOutClient) DeleteOne(_m *PrivacyOptOut) *PrivacyOptOutDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrivacyOptOutClient) DeleteOneID(id string) *PrivacyOptOutDeleteOne {
+	builder := c.Delete().Where(privacyoptout.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrivacyOptOutDeleteOne{builder}
+}
+
+// Query returns a query builder for PrivacyOptOut.
+func (c *PrivacyOptOutClient) Query() *PrivacyOptOutQuery {
+	return &PrivacyOptOutQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrivacyOptOut},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PrivacyOptOut entity by its id.
+func (c *PrivacyOptOutClient) Get(ctx context.Context, id string) (*PrivacyOptOut, error) {
+	return c.Query().Where(privacyoptout.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrivacyOptOutClient) GetX(ctx context.Context, id string) *PrivacyOptOut {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PrivacyOptOutClient) Hooks() []Hook {
+	return c.hooks.PrivacyOptOut
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrivacyOptOutClient) Interceptors() []Interceptor {
+	return c.inters.PrivacyOptOut
+}
+
+func (c *PrivacyOptOutClient) mutate(ctx context.Context, m *PrivacyOptOutMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrivacyOptOutCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrivacyOptOutUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrivacyOptOutUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrivacyOptOutDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PrivacyOptOut mutation op: %q", m.Op())
+	}
+}
+
+// hooks and interceptors per client, for fast access.
+type (
+	hooks struct {
+		DiscordChannel, DiscordChannelSetting, DiscordChimeIn, DiscordChunkEmbedding,
+		DiscordDirectMessage, DiscordGuildMember, DiscordGuildSetting, DiscordMessage,
+		DiscordMessageChunk, DiscordMessageEmbedding, DiscordProfileChange,
+		DiscordReaction, DiscordRole, DiscordUser, EmbeddingModel, ModerationEvent,
+		PrivacyEvent, PrivacyOptOut []ent.Hook
+	}
+	inters struct {
+		DiscordChannel, DiscordChannelSetting, DiscordChimeIn, DiscordChunkEmbedding,
+		DiscordDirectMessage, DiscordGuildMember, DiscordGuildSetting, DiscordMessage,
+		DiscordMessageChunk, DiscordMessageEmbedding, DiscordProfileChange,
+		DiscordReaction, DiscordRole, DiscordUser, EmbeddingModel, ModerationEvent,
+		PrivacyEvent, PrivacyOptOut []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,633 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordmessage"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChannelCreate is the builder for creating a DiscordChannel entity.
+type DiscordChannelCreate struct {
+	config
+	mutation *DiscordChannelMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordChannelCreate) SetGuildID(v string) *DiscordChannelCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DiscordChannelCreate) SetName(v string) *DiscordChannelCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *DiscordChannelCreate) SetParentID(v string) *DiscordChannelCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *DiscordChannelCreate) SetNillableParentID(v *string) *DiscordChannelCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordChannelCreate) SetID(v string) *DiscordChannelCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (_c *DiscordChannelCreate) AddMentionedInIDs(ids ...string) *DiscordChannelCreate {
+	_c.mutation.AddMentionedInIDs(ids...)
+	return _c
+}
+
+// AddMentionedIn adds the "mentioned_in" edges to the DiscordMessage entity.
+func (_c *DiscordChannelCreate) AddMentionedIn(v ...*DiscordMessage) *DiscordChannelCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionedInIDs(ids...)
+}
+
+// Mutation returns the DiscordChannelMutation object of the builder.
+func (_c *DiscordChannelCreate) Mutation() *DiscordChannelMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordChannel in the database.
+func (_c *DiscordChannelCreate) Save(ctx context.Context) (*DiscordChannel, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordChannelCreate) SaveX(ctx context.Context) *DiscordChannel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChannelCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChannelCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordChannelCreate) check() error {
+	if _, ok := _c.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "DiscordChannel.guild_id"`)}
+	}
+	if v, ok := _c.mutation.GuildID(); ok {
+		if err := discordchannel.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "DiscordChannel.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DiscordChannel.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := discordchannel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DiscordChannel.name": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordchannel.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordChannel.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscordChannelCreate) sqlSave(ctx context.Context) (*DiscordChannel, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordChannel.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordChannelCreate) createSpec() (*DiscordChannel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordChannel{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordchannel.Table, sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordchannel.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(discordchannel.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.ParentID(); ok {
+		_spec.SetField(discordchannel.FieldParentID, field.TypeString, value)
+		_node.ParentID = value
+	}
+	if nodes := _c.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordchannel.MentionedInTable,
+			Columns: discordchannel.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChannel.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChannelUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChannelCreate) OnConflict(opts ...sql.ConflictOption) *DiscordChannelUpsertOne {
+	_c.conflict = opts
+	return &DiscordChannelUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChannel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChannelCreate) OnConflictColumns(columns ...string) *DiscordChannelUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChannelUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordChannelUpsertOne is the builder for "upsert"-ing
+	//  one DiscordChannel node.
+	DiscordChannelUpsertOne struct {
+		create *DiscordChannelCreate
+	}
+
+	// DiscordChannelUpsert is the "OnConflict" setter.
+	DiscordChannelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *DiscordChannelUpsert) SetName(v string) *DiscordChannelUpsert {
+	u.Set(discordchannel.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DiscordChannelUpsert) UpdateName() *DiscordChannelUpsert {
+	u.SetExcluded(discordchannel.FieldName)
+	return u
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *DiscordChannelUpsert) SetParentID(v string) *DiscordChannelUpsert {
+	u.Set(discordchannel.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *DiscordChannelUpsert) UpdateParentID() *DiscordChannelUpsert {
+	u.SetExcluded(discordchannel.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *DiscordChannelUpsert) ClearParentID() *DiscordChannelUpsert {
+	u.SetNull(discordchannel.FieldParentID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChannel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchannel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChannelUpsertOne) UpdateNewValues() *DiscordChannelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordchannel.FieldID)
+		}
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(discordchannel.FieldGuildID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChannel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordChannelUpsertOne) Ignore() *DiscordChannelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChannelUpsertOne) DoNothing() *DiscordChannelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChannelCreate.OnConflict
+// documentation for more info.
+func (u *DiscordChannelUpsertOne) Update(set func(*DiscordChannelUpsert)) *DiscordChannelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChannelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DiscordChannelUpsertOne) SetName(v string) *DiscordChannelUpsertOne {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DiscordChannelUpsertOne) UpdateName() *DiscordChannelUpsertOne {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *DiscordChannelUpsertOne) SetParentID(v string) *DiscordChannelUpsertOne {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *DiscordChannelUpsertOne) UpdateParentID() *DiscordChannelUpsertOne {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *DiscordChannelUpsertOne) ClearParentID() *DiscordChannelUpsertOne {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChannelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChannelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChannelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordChannelUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordChannelUpsertOne.ID is not supported by MySQL driver. Use DiscordChannelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordChannelUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordChannelCreateBulk is the builder for creating many DiscordChannel entities in bulk.
+type DiscordChannelCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordChannelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordChannel entities in the database.
+func (_c *DiscordChannelCreateBulk) Save(ctx context.Context) ([]*DiscordChannel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordChannel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordChannelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordChannelCreateBulk) SaveX(ctx context.Context) []*DiscordChannel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChannelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChannelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChannel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChannelUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChannelCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordChannelUpsertBulk {
+	_c.conflict = opts
+	return &DiscordChannelUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChannel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChannelCreateBulk) OnConflictColumns(columns ...string) *DiscordChannelUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChannelUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordChannelUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordChannel nodes.
+type DiscordChannelUpsertBulk struct {
+	create *DiscordChannelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordChannel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchannel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChannelUpsertBulk) UpdateNewValues() *DiscordChannelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordchannel.FieldID)
+			}
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(discordchannel.FieldGuildID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChannel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordChannelUpsertBulk) Ignore() *DiscordChannelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChannelUpsertBulk) DoNothing() *DiscordChannelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChannelCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordChannelUpsertBulk) Update(set func(*DiscordChannelUpsert)) *DiscordChannelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChannelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DiscordChannelUpsertBulk) SetName(v string) *DiscordChannelUpsertBulk {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DiscordChannelUpsertBulk) UpdateName() *DiscordChannelUpsertBulk {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetParentID sets the "parent_id" field.
+func (u *DiscordChannelUpsertBulk) SetParentID(v string) *DiscordChannelUpsertBulk {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *DiscordChannelUpsertBulk) UpdateParentID() *DiscordChannelUpsertBulk {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *DiscordChannelUpsertBulk) ClearParentID() *DiscordChannelUpsertBulk {
+	return u.Update(func(s *DiscordChannelUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChannelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordChannelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChannelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChannelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,125 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sev0/ent/discordchannelsetting"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DiscordChannelSetting is the model entity for the DiscordChannelSetting schema.
+type DiscordChannelSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// MentionReplies holds the value of the "mention_replies" field.
+	MentionReplies bool `json:"mention_replies,omitempty"`
+	// ChimeIn holds the value of the "chime_in" field.
+	ChimeIn      bool `json:"chime_in,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordChannelSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discordchannelsetting.FieldMentionReplies, discordchannelsetting.FieldChimeIn:
+			values[i] = new(sql.NullBool)
+		case discordchannelsetting.FieldID, discordchannelsetting.FieldGuildID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordChannelSetting fields.
+func (_m *DiscordChannelSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discordchannelsetting.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case discordchannelsetting.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				_m.GuildID = value.String
+			}
+		case discordchannelsetting.FieldMentionReplies:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mention_replies", values[i])
+			} else if value.Valid {
+				_m.MentionReplies = value.Bool
+			}
+		case discordchannelsetting.FieldChimeIn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field chime_in", values[i])
+			} else if value.Valid {
+				_m.ChimeIn = value.Bool
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordChannelSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordChannelSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DiscordChannelSetting.
+// Note that you need to call DiscordChannelSetting.Unwrap() before calling this method if this DiscordChannelSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordChannelSetting) Update() *DiscordChannelSettingUpdateOne {
+	return NewDiscordChannelSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordChannelSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordChannelSetting) Unwrap() *DiscordChannelSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordChannelSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordChannelSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordChannelSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(_m.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("mention_replies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MentionReplies))
+	builder.WriteString(", ")
+	builder.WriteString("chime_in=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChimeIn))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordChannelSettings is a parsable slice of DiscordChannelSetting.
+type DiscordChannelSettings []*DiscordChannelSetting
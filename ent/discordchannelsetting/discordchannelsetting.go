@@ -0,0 +1,74 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordchannelsetting
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the discordchannelsetting type in the database.
+	Label = "discord_channel_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldMentionReplies holds the string denoting the mention_replies field in the database.
+	FieldMentionReplies = "mention_replies"
+	// FieldChimeIn holds the string denoting the chime_in field in the database.
+	FieldChimeIn = "chime_in"
+	// Table holds the table name of the discordchannelsetting in the database.
+	Table = "discord_channel_settings"
+)
+
+// Columns holds all SQL columns for discordchannelsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldMentionReplies,
+	FieldChimeIn,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	GuildIDValidator func(string) error
+	// DefaultMentionReplies holds the default value on creation for the "mention_replies" field.
+	DefaultMentionReplies bool
+	// DefaultChimeIn holds the default value on creation for the "chime_in" field.
+	DefaultChimeIn bool
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the DiscordChannelSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByMentionReplies orders the results by the mention_replies field.
+func ByMentionReplies(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMentionReplies, opts...).ToFunc()
+}
+
+// ByChimeIn orders the results by the chime_in field.
+func ByChimeIn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChimeIn, opts...).ToFunc()
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordchannelsetting
+
+import (
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldContainsFold(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldGuildID, v))
+}
+
+// MentionReplies applies equality check predicate on the "mention_replies" field. It's identical to MentionRepliesEQ.
+func MentionReplies(v bool) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldMentionReplies, v))
+}
+
+// ChimeIn applies equality check predicate on the "chime_in" field. It's identical to ChimeInEQ.
+func ChimeIn(v bool) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldChimeIn, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// MentionRepliesEQ applies the EQ predicate on the "mention_replies" field.
+func MentionRepliesEQ(v bool) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldMentionReplies, v))
+}
+
+// MentionRepliesNEQ applies the NEQ predicate on the "mention_replies" field.
+func MentionRepliesNEQ(v bool) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldNEQ(FieldMentionReplies, v))
+}
+
+// ChimeInEQ applies the EQ predicate on the "chime_in" field.
+func ChimeInEQ(v bool) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldEQ(FieldChimeIn, v))
+}
+
+// ChimeInNEQ applies the NEQ predicate on the "chime_in" field.
+func ChimeInNEQ(v bool) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.FieldNEQ(FieldChimeIn, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscordChannelSetting) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscordChannelSetting) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscordChannelSetting) predicate.DiscordChannelSetting {
+	return predicate.DiscordChannelSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,601 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchannelsetting"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChannelSettingCreate is the builder for creating a DiscordChannelSetting entity.
+type DiscordChannelSettingCreate struct {
+	config
+	mutation *DiscordChannelSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordChannelSettingCreate) SetGuildID(v string) *DiscordChannelSettingCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetMentionReplies sets the "mention_replies" field.
+func (_c *DiscordChannelSettingCreate) SetMentionReplies(v bool) *DiscordChannelSettingCreate {
+	_c.mutation.SetMentionReplies(v)
+	return _c
+}
+
+// SetNillableMentionReplies sets the "mention_replies" field if the given value is not nil.
+func (_c *DiscordChannelSettingCreate) SetNillableMentionReplies(v *bool) *DiscordChannelSettingCreate {
+	if v != nil {
+		_c.SetMentionReplies(*v)
+	}
+	return _c
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (_c *DiscordChannelSettingCreate) SetChimeIn(v bool) *DiscordChannelSettingCreate {
+	_c.mutation.SetChimeIn(v)
+	return _c
+}
+
+// SetNillableChimeIn sets the "chime_in" field if the given value is not nil.
+func (_c *DiscordChannelSettingCreate) SetNillableChimeIn(v *bool) *DiscordChannelSettingCreate {
+	if v != nil {
+		_c.SetChimeIn(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordChannelSettingCreate) SetID(v string) *DiscordChannelSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DiscordChannelSettingMutation object of the builder.
+func (_c *DiscordChannelSettingCreate) Mutation() *DiscordChannelSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordChannelSetting in the database.
+func (_c *DiscordChannelSettingCreate) Save(ctx context.Context) (*DiscordChannelSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordChannelSettingCreate) SaveX(ctx context.Context) *DiscordChannelSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChannelSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChannelSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordChannelSettingCreate) defaults() {
+	if _, ok := _c.mutation.MentionReplies(); !ok {
+		v := discordchannelsetting.DefaultMentionReplies
+		_c.mutation.SetMentionReplies(v)
+	}
+	if _, ok := _c.mutation.ChimeIn(); !ok {
+		v := discordchannelsetting.DefaultChimeIn
+		_c.mutation.SetChimeIn(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordChannelSettingCreate) check() error {
+	if _, ok := _c.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "DiscordChannelSetting.guild_id"`)}
+	}
+	if v, ok := _c.mutation.GuildID(); ok {
+		if err := discordchannelsetting.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "DiscordChannelSetting.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.MentionReplies(); !ok {
+		return &ValidationError{Name: "mention_replies", err: errors.New(`ent: missing required field "DiscordChannelSetting.mention_replies"`)}
+	}
+	if _, ok := _c.mutation.ChimeIn(); !ok {
+		return &ValidationError{Name: "chime_in", err: errors.New(`ent: missing required field "DiscordChannelSetting.chime_in"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordchannelsetting.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordChannelSetting.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscordChannelSettingCreate) sqlSave(ctx context.Context) (*DiscordChannelSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordChannelSetting.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordChannelSettingCreate) createSpec() (*DiscordChannelSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordChannelSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordchannelsetting.Table, sqlgraph.NewFieldSpec(discordchannelsetting.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordchannelsetting.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.MentionReplies(); ok {
+		_spec.SetField(discordchannelsetting.FieldMentionReplies, field.TypeBool, value)
+		_node.MentionReplies = value
+	}
+	if value, ok := _c.mutation.ChimeIn(); ok {
+		_spec.SetField(discordchannelsetting.FieldChimeIn, field.TypeBool, value)
+		_node.ChimeIn = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChannelSetting.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChannelSettingUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChannelSettingCreate) OnConflict(opts ...sql.ConflictOption) *DiscordChannelSettingUpsertOne {
+	_c.conflict = opts
+	return &DiscordChannelSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChannelSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChannelSettingCreate) OnConflictColumns(columns ...string) *DiscordChannelSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChannelSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordChannelSettingUpsertOne is the builder for "upsert"-ing
+	//  one DiscordChannelSetting node.
+	DiscordChannelSettingUpsertOne struct {
+		create *DiscordChannelSettingCreate
+	}
+
+	// DiscordChannelSettingUpsert is the "OnConflict" setter.
+	DiscordChannelSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetMentionReplies sets the "mention_replies" field.
+func (u *DiscordChannelSettingUpsert) SetMentionReplies(v bool) *DiscordChannelSettingUpsert {
+	u.Set(discordchannelsetting.FieldMentionReplies, v)
+	return u
+}
+
+// UpdateMentionReplies sets the "mention_replies" field to the value that was provided on create.
+func (u *DiscordChannelSettingUpsert) UpdateMentionReplies() *DiscordChannelSettingUpsert {
+	u.SetExcluded(discordchannelsetting.FieldMentionReplies)
+	return u
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (u *DiscordChannelSettingUpsert) SetChimeIn(v bool) *DiscordChannelSettingUpsert {
+	u.Set(discordchannelsetting.FieldChimeIn, v)
+	return u
+}
+
+// UpdateChimeIn sets the "chime_in" field to the value that was provided on create.
+func (u *DiscordChannelSettingUpsert) UpdateChimeIn() *DiscordChannelSettingUpsert {
+	u.SetExcluded(discordchannelsetting.FieldChimeIn)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChannelSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchannelsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChannelSettingUpsertOne) UpdateNewValues() *DiscordChannelSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordchannelsetting.FieldID)
+		}
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(discordchannelsetting.FieldGuildID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChannelSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordChannelSettingUpsertOne) Ignore() *DiscordChannelSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChannelSettingUpsertOne) DoNothing() *DiscordChannelSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChannelSettingCreate.OnConflict
+// documentation for more info.
+func (u *DiscordChannelSettingUpsertOne) Update(set func(*DiscordChannelSettingUpsert)) *DiscordChannelSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChannelSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMentionReplies sets the "mention_replies" field.
+func (u *DiscordChannelSettingUpsertOne) SetMentionReplies(v bool) *DiscordChannelSettingUpsertOne {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.SetMentionReplies(v)
+	})
+}
+
+// UpdateMentionReplies sets the "mention_replies" field to the value that was provided on create.
+func (u *DiscordChannelSettingUpsertOne) UpdateMentionReplies() *DiscordChannelSettingUpsertOne {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.UpdateMentionReplies()
+	})
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (u *DiscordChannelSettingUpsertOne) SetChimeIn(v bool) *DiscordChannelSettingUpsertOne {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.SetChimeIn(v)
+	})
+}
+
+// UpdateChimeIn sets the "chime_in" field to the value that was provided on create.
+func (u *DiscordChannelSettingUpsertOne) UpdateChimeIn() *DiscordChannelSettingUpsertOne {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.UpdateChimeIn()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChannelSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChannelSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChannelSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordChannelSettingUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordChannelSettingUpsertOne.ID is not supported by MySQL driver. Use DiscordChannelSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordChannelSettingUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordChannelSettingCreateBulk is the builder for creating many DiscordChannelSetting entities in bulk.
+type DiscordChannelSettingCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordChannelSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordChannelSetting entities in the database.
+func (_c *DiscordChannelSettingCreateBulk) Save(ctx context.Context) ([]*DiscordChannelSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordChannelSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordChannelSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordChannelSettingCreateBulk) SaveX(ctx context.Context) []*DiscordChannelSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChannelSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChannelSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChannelSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChannelSettingUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChannelSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordChannelSettingUpsertBulk {
+	_c.conflict = opts
+	return &DiscordChannelSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChannelSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChannelSettingCreateBulk) OnConflictColumns(columns ...string) *DiscordChannelSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChannelSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordChannelSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordChannelSetting nodes.
+type DiscordChannelSettingUpsertBulk struct {
+	create *DiscordChannelSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordChannelSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchannelsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChannelSettingUpsertBulk) UpdateNewValues() *DiscordChannelSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordchannelsetting.FieldID)
+			}
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(discordchannelsetting.FieldGuildID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChannelSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordChannelSettingUpsertBulk) Ignore() *DiscordChannelSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChannelSettingUpsertBulk) DoNothing() *DiscordChannelSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChannelSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordChannelSettingUpsertBulk) Update(set func(*DiscordChannelSettingUpsert)) *DiscordChannelSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChannelSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetMentionReplies sets the "mention_replies" field.
+func (u *DiscordChannelSettingUpsertBulk) SetMentionReplies(v bool) *DiscordChannelSettingUpsertBulk {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.SetMentionReplies(v)
+	})
+}
+
+// UpdateMentionReplies sets the "mention_replies" field to the value that was provided on create.
+func (u *DiscordChannelSettingUpsertBulk) UpdateMentionReplies() *DiscordChannelSettingUpsertBulk {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.UpdateMentionReplies()
+	})
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (u *DiscordChannelSettingUpsertBulk) SetChimeIn(v bool) *DiscordChannelSettingUpsertBulk {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.SetChimeIn(v)
+	})
+}
+
+// UpdateChimeIn sets the "chime_in" field to the value that was provided on create.
+func (u *DiscordChannelSettingUpsertBulk) UpdateChimeIn() *DiscordChannelSettingUpsertBulk {
+	return u.Update(func(s *DiscordChannelSettingUpsert) {
+		s.UpdateChimeIn()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChannelSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordChannelSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChannelSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChannelSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
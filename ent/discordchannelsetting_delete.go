@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChannelSettingDelete is the builder for deleting a DiscordChannelSetting entity.
+type DiscordChannelSettingDelete struct {
+	config
+	hooks    []Hook
+	mutation *DiscordChannelSettingMutation
+}
+
+// Where appends a list predicates to the DiscordChannelSettingDelete builder.
+func (_d *DiscordChannelSettingDelete) Where(ps ...predicate.DiscordChannelSetting) *DiscordChannelSettingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DiscordChannelSettingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscordChannelSettingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DiscordChannelSettingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(discordchannelsetting.Table, sqlgraph.NewFieldSpec(discordchannelsetting.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DiscordChannelSettingDeleteOne is the builder for deleting a single DiscordChannelSetting entity.
+type DiscordChannelSettingDeleteOne struct {
+	_d *DiscordChannelSettingDelete
+}
+
+// Where appends a list predicates to the DiscordChannelSettingDelete builder.
+func (_d *DiscordChannelSettingDeleteOne) Where(ps ...predicate.DiscordChannelSetting) *DiscordChannelSettingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DiscordChannelSettingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{discordchannelsetting.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscordChannelSettingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChannelSettingUpdate is the builder for updating DiscordChannelSetting entities.
+type DiscordChannelSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordChannelSettingMutation
+}
+
+// Where appends a list predicates to the DiscordChannelSettingUpdate builder.
+func (_u *DiscordChannelSettingUpdate) Where(ps ...predicate.DiscordChannelSetting) *DiscordChannelSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMentionReplies sets the "mention_replies" field.
+func (_u *DiscordChannelSettingUpdate) SetMentionReplies(v bool) *DiscordChannelSettingUpdate {
+	_u.mutation.SetMentionReplies(v)
+	return _u
+}
+
+// SetNillableMentionReplies sets the "mention_replies" field if the given value is not nil.
+func (_u *DiscordChannelSettingUpdate) SetNillableMentionReplies(v *bool) *DiscordChannelSettingUpdate {
+	if v != nil {
+		_u.SetMentionReplies(*v)
+	}
+	return _u
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (_u *DiscordChannelSettingUpdate) SetChimeIn(v bool) *DiscordChannelSettingUpdate {
+	_u.mutation.SetChimeIn(v)
+	return _u
+}
+
+// SetNillableChimeIn sets the "chime_in" field if the given value is not nil.
+func (_u *DiscordChannelSettingUpdate) SetNillableChimeIn(v *bool) *DiscordChannelSettingUpdate {
+	if v != nil {
+		_u.SetChimeIn(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscordChannelSettingMutation object of the builder.
+func (_u *DiscordChannelSettingUpdate) Mutation() *DiscordChannelSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordChannelSettingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordChannelSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordChannelSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordChannelSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscordChannelSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discordchannelsetting.Table, discordchannelsetting.Columns, sqlgraph.NewFieldSpec(discordchannelsetting.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MentionReplies(); ok {
+		_spec.SetField(discordchannelsetting.FieldMentionReplies, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ChimeIn(); ok {
+		_spec.SetField(discordchannelsetting.FieldChimeIn, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordchannelsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordChannelSettingUpdateOne is the builder for updating a single DiscordChannelSetting entity.
+type DiscordChannelSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordChannelSettingMutation
+}
+
+// SetMentionReplies sets the "mention_replies" field.
+func (_u *DiscordChannelSettingUpdateOne) SetMentionReplies(v bool) *DiscordChannelSettingUpdateOne {
+	_u.mutation.SetMentionReplies(v)
+	return _u
+}
+
+// SetNillableMentionReplies sets the "mention_replies" field if the given value is not nil.
+func (_u *DiscordChannelSettingUpdateOne) SetNillableMentionReplies(v *bool) *DiscordChannelSettingUpdateOne {
+	if v != nil {
+		_u.SetMentionReplies(*v)
+	}
+	return _u
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (_u *DiscordChannelSettingUpdateOne) SetChimeIn(v bool) *DiscordChannelSettingUpdateOne {
+	_u.mutation.SetChimeIn(v)
+	return _u
+}
+
+// SetNillableChimeIn sets the "chime_in" field if the given value is not nil.
+func (_u *DiscordChannelSettingUpdateOne) SetNillableChimeIn(v *bool) *DiscordChannelSettingUpdateOne {
+	if v != nil {
+		_u.SetChimeIn(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscordChannelSettingMutation object of the builder.
+func (_u *DiscordChannelSettingUpdateOne) Mutation() *DiscordChannelSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordChannelSettingUpdate builder.
+func (_u *DiscordChannelSettingUpdateOne) Where(ps ...predicate.DiscordChannelSetting) *DiscordChannelSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordChannelSettingUpdateOne) Select(field string, fields ...string) *DiscordChannelSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordChannelSetting entity.
+func (_u *DiscordChannelSettingUpdateOne) Save(ctx context.Context) (*DiscordChannelSetting, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordChannelSettingUpdateOne) SaveX(ctx context.Context) *DiscordChannelSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordChannelSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordChannelSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscordChannelSettingUpdateOne) sqlSave(ctx context.Context) (_node *DiscordChannelSetting, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discordchannelsetting.Table, discordchannelsetting.Columns, sqlgraph.NewFieldSpec(discordchannelsetting.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordChannelSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordchannelsetting.FieldID)
+		for _, f := range fields {
+			if !discordchannelsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordchannelsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.MentionReplies(); ok {
+		_spec.SetField(discordchannelsetting.FieldMentionReplies, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ChimeIn(); ok {
+		_spec.SetField(discordchannelsetting.FieldChimeIn, field.TypeBool, value)
+	}
+	_node = &DiscordChannelSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordchannelsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,112 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordchimein
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the discordchimein type in the database.
+	Label = "discord_chime_in"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldChannelID holds the string denoting the channel_id field in the database.
+	FieldChannelID = "channel_id"
+	// FieldTriggerMessageID holds the string denoting the trigger_message_id field in the database.
+	FieldTriggerMessageID = "trigger_message_id"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldReactions holds the string denoting the reactions field in the database.
+	FieldReactions = "reactions"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the discordchimein in the database.
+	Table = "discord_chime_ins"
+)
+
+// Columns holds all SQL columns for discordchimein fields.
+var Columns = []string{
+	FieldID,
+	FieldGuildID,
+	FieldChannelID,
+	FieldTriggerMessageID,
+	FieldScore,
+	FieldReason,
+	FieldReactions,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	GuildIDValidator func(string) error
+	// ChannelIDValidator is a validator for the "channel_id" field. It is called by the builders before save.
+	ChannelIDValidator func(string) error
+	// TriggerMessageIDValidator is a validator for the "trigger_message_id" field. It is called by the builders before save.
+	TriggerMessageIDValidator func(string) error
+	// DefaultReactions holds the default value on creation for the "reactions" field.
+	DefaultReactions int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the DiscordChimeIn queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByChannelID orders the results by the channel_id field.
+func ByChannelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannelID, opts...).ToFunc()
+}
+
+// ByTriggerMessageID orders the results by the trigger_message_id field.
+func ByTriggerMessageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerMessageID, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByReactions orders the results by the reactions field.
+func ByReactions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReactions, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
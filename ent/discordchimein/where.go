@@ -0,0 +1,505 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordchimein
+
+import (
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContainsFold(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldGuildID, v))
+}
+
+// ChannelID applies equality check predicate on the "channel_id" field. It's identical to ChannelIDEQ.
+func ChannelID(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldChannelID, v))
+}
+
+// TriggerMessageID applies equality check predicate on the "trigger_message_id" field. It's identical to TriggerMessageIDEQ.
+func TriggerMessageID(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldTriggerMessageID, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldScore, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldReason, v))
+}
+
+// Reactions applies equality check predicate on the "reactions" field. It's identical to ReactionsEQ.
+func Reactions(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldReactions, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// ChannelIDEQ applies the EQ predicate on the "channel_id" field.
+func ChannelIDEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ChannelIDNEQ applies the NEQ predicate on the "channel_id" field.
+func ChannelIDNEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldChannelID, v))
+}
+
+// ChannelIDIn applies the In predicate on the "channel_id" field.
+func ChannelIDIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldChannelID, vs...))
+}
+
+// ChannelIDNotIn applies the NotIn predicate on the "channel_id" field.
+func ChannelIDNotIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldChannelID, vs...))
+}
+
+// ChannelIDGT applies the GT predicate on the "channel_id" field.
+func ChannelIDGT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldChannelID, v))
+}
+
+// ChannelIDGTE applies the GTE predicate on the "channel_id" field.
+func ChannelIDGTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldChannelID, v))
+}
+
+// ChannelIDLT applies the LT predicate on the "channel_id" field.
+func ChannelIDLT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldChannelID, v))
+}
+
+// ChannelIDLTE applies the LTE predicate on the "channel_id" field.
+func ChannelIDLTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldChannelID, v))
+}
+
+// ChannelIDContains applies the Contains predicate on the "channel_id" field.
+func ChannelIDContains(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContains(FieldChannelID, v))
+}
+
+// ChannelIDHasPrefix applies the HasPrefix predicate on the "channel_id" field.
+func ChannelIDHasPrefix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasPrefix(FieldChannelID, v))
+}
+
+// ChannelIDHasSuffix applies the HasSuffix predicate on the "channel_id" field.
+func ChannelIDHasSuffix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasSuffix(FieldChannelID, v))
+}
+
+// ChannelIDEqualFold applies the EqualFold predicate on the "channel_id" field.
+func ChannelIDEqualFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEqualFold(FieldChannelID, v))
+}
+
+// ChannelIDContainsFold applies the ContainsFold predicate on the "channel_id" field.
+func ChannelIDContainsFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContainsFold(FieldChannelID, v))
+}
+
+// TriggerMessageIDEQ applies the EQ predicate on the "trigger_message_id" field.
+func TriggerMessageIDEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDNEQ applies the NEQ predicate on the "trigger_message_id" field.
+func TriggerMessageIDNEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDIn applies the In predicate on the "trigger_message_id" field.
+func TriggerMessageIDIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldTriggerMessageID, vs...))
+}
+
+// TriggerMessageIDNotIn applies the NotIn predicate on the "trigger_message_id" field.
+func TriggerMessageIDNotIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldTriggerMessageID, vs...))
+}
+
+// TriggerMessageIDGT applies the GT predicate on the "trigger_message_id" field.
+func TriggerMessageIDGT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDGTE applies the GTE predicate on the "trigger_message_id" field.
+func TriggerMessageIDGTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDLT applies the LT predicate on the "trigger_message_id" field.
+func TriggerMessageIDLT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDLTE applies the LTE predicate on the "trigger_message_id" field.
+func TriggerMessageIDLTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDContains applies the Contains predicate on the "trigger_message_id" field.
+func TriggerMessageIDContains(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContains(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDHasPrefix applies the HasPrefix predicate on the "trigger_message_id" field.
+func TriggerMessageIDHasPrefix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasPrefix(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDHasSuffix applies the HasSuffix predicate on the "trigger_message_id" field.
+func TriggerMessageIDHasSuffix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasSuffix(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDEqualFold applies the EqualFold predicate on the "trigger_message_id" field.
+func TriggerMessageIDEqualFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEqualFold(FieldTriggerMessageID, v))
+}
+
+// TriggerMessageIDContainsFold applies the ContainsFold predicate on the "trigger_message_id" field.
+func TriggerMessageIDContainsFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContainsFold(FieldTriggerMessageID, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldScore, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ReactionsEQ applies the EQ predicate on the "reactions" field.
+func ReactionsEQ(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldReactions, v))
+}
+
+// ReactionsNEQ applies the NEQ predicate on the "reactions" field.
+func ReactionsNEQ(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldReactions, v))
+}
+
+// ReactionsIn applies the In predicate on the "reactions" field.
+func ReactionsIn(vs ...int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldReactions, vs...))
+}
+
+// ReactionsNotIn applies the NotIn predicate on the "reactions" field.
+func ReactionsNotIn(vs ...int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldReactions, vs...))
+}
+
+// ReactionsGT applies the GT predicate on the "reactions" field.
+func ReactionsGT(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldReactions, v))
+}
+
+// ReactionsGTE applies the GTE predicate on the "reactions" field.
+func ReactionsGTE(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldReactions, v))
+}
+
+// ReactionsLT applies the LT predicate on the "reactions" field.
+func ReactionsLT(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldReactions, v))
+}
+
+// ReactionsLTE applies the LTE predicate on the "reactions" field.
+func ReactionsLTE(v int) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldReactions, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscordChimeIn) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscordChimeIn) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscordChimeIn) predicate.DiscordChimeIn {
+	return predicate.DiscordChimeIn(sql.NotPredicates(p))
+}
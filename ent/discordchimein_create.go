@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchimein"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChimeInCreate is the builder for creating a DiscordChimeIn entity.
+type DiscordChimeInCreate struct {
+	config
+	mutation *DiscordChimeInMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordChimeInCreate) SetGuildID(v string) *DiscordChimeInCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *DiscordChimeInCreate) SetChannelID(v string) *DiscordChimeInCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetTriggerMessageID sets the "trigger_message_id" field.
+func (_c *DiscordChimeInCreate) SetTriggerMessageID(v string) *DiscordChimeInCreate {
+	_c.mutation.SetTriggerMessageID(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *DiscordChimeInCreate) SetScore(v float64) *DiscordChimeInCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *DiscordChimeInCreate) SetReason(v string) *DiscordChimeInCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *DiscordChimeInCreate) SetNillableReason(v *string) *DiscordChimeInCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetReactions sets the "reactions" field.
+func (_c *DiscordChimeInCreate) SetReactions(v int) *DiscordChimeInCreate {
+	_c.mutation.SetReactions(v)
+	return _c
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_c *DiscordChimeInCreate) SetNillableReactions(v *int) *DiscordChimeInCreate {
+	if v != nil {
+		_c.SetReactions(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DiscordChimeInCreate) SetCreatedAt(v time.Time) *DiscordChimeInCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DiscordChimeInCreate) SetNillableCreatedAt(v *time.Time) *DiscordChimeInCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordChimeInCreate) SetID(v string) *DiscordChimeInCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DiscordChimeInMutation object of the builder.
+func (_c *DiscordChimeInCreate) Mutation() *DiscordChimeInMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordChimeIn in the database.
+func (_c *DiscordChimeInCreate) Save(ctx context.Context) (*DiscordChimeIn, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordChimeInCreate) SaveX(ctx context.Context) *DiscordChimeIn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChimeInCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChimeInCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordChimeInCreate) defaults() {
+	if _, ok := _c.mutation.Reactions(); !ok {
+		v := discordchimein.DefaultReactions
+		_c.mutation.SetReactions(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := discordchimein.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordChimeInCreate) check() error {
+	if _, ok := _c.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "DiscordChimeIn.guild_id"`)}
+	}
+	if v, ok := _c.mutation.GuildID(); ok {
+		if err := discordchimein.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "DiscordChimeIn.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "DiscordChimeIn.channel_id"`)}
+	}
+	if v, ok := _c.mutation.ChannelID(); ok {
+		if err := discordchimein.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "DiscordChimeIn.channel_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TriggerMessageID(); !ok {
+		return &ValidationError{Name: "trigger_message_id", err: errors.New(`ent: missing required field "DiscordChimeIn.trigger_message_id"`)}
+	}
+	if v, ok := _c.mutation.TriggerMessageID(); ok {
+		if err := discordchimein.TriggerMessageIDValidator(v); err != nil {
+			return &ValidationError{Name: "trigger_message_id", err: fmt.Errorf(`ent: validator failed for field "DiscordChimeIn.trigger_message_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "DiscordChimeIn.score"`)}
+	}
+	if _, ok := _c.mutation.Reactions(); !ok {
+		return &ValidationError{Name: "reactions", err: errors.New(`ent: missing required field "DiscordChimeIn.reactions"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DiscordChimeIn.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordchimein.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordChimeIn.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscordChimeInCreate) sqlSave(ctx context.Context) (*DiscordChimeIn, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordChimeIn.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordChimeInCreate) createSpec() (*DiscordChimeIn, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordChimeIn{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordchimein.Table, sqlgraph.NewFieldSpec(discordchimein.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordchimein.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(discordchimein.FieldChannelID, field.TypeString, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.TriggerMessageID(); ok {
+		_spec.SetField(discordchimein.FieldTriggerMessageID, field.TypeString, value)
+		_node.TriggerMessageID = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(discordchimein.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(discordchimein.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Reactions(); ok {
+		_spec.SetField(discordchimein.FieldReactions, field.TypeInt, value)
+		_node.Reactions = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(discordchimein.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChimeIn.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChimeInUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChimeInCreate) OnConflict(opts ...sql.ConflictOption) *DiscordChimeInUpsertOne {
+	_c.conflict = opts
+	return &DiscordChimeInUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChimeIn.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChimeInCreate) OnConflictColumns(columns ...string) *DiscordChimeInUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChimeInUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordChimeInUpsertOne is the builder for "upsert"-ing
+	//  one DiscordChimeIn node.
+	DiscordChimeInUpsertOne struct {
+		create *DiscordChimeInCreate
+	}
+
+	// DiscordChimeInUpsert is the "OnConflict" setter.
+	DiscordChimeInUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetReactions sets the "reactions" field.
+func (u *DiscordChimeInUpsert) SetReactions(v int) *DiscordChimeInUpsert {
+	u.Set(discordchimein.FieldReactions, v)
+	return u
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *DiscordChimeInUpsert) UpdateReactions() *DiscordChimeInUpsert {
+	u.SetExcluded(discordchimein.FieldReactions)
+	return u
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *DiscordChimeInUpsert) AddReactions(v int) *DiscordChimeInUpsert {
+	u.Add(discordchimein.FieldReactions, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChimeIn.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchimein.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChimeInUpsertOne) UpdateNewValues() *DiscordChimeInUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordchimein.FieldID)
+		}
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(discordchimein.FieldGuildID)
+		}
+		if _, exists := u.create.mutation.ChannelID(); exists {
+			s.SetIgnore(discordchimein.FieldChannelID)
+		}
+		if _, exists := u.create.mutation.TriggerMessageID(); exists {
+			s.SetIgnore(discordchimein.FieldTriggerMessageID)
+		}
+		if _, exists := u.create.mutation.Score(); exists {
+			s.SetIgnore(discordchimein.FieldScore)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(discordchimein.FieldReason)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(discordchimein.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChimeIn.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordChimeInUpsertOne) Ignore() *DiscordChimeInUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChimeInUpsertOne) DoNothing() *DiscordChimeInUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChimeInCreate.OnConflict
+// documentation for more info.
+func (u *DiscordChimeInUpsertOne) Update(set func(*DiscordChimeInUpsert)) *DiscordChimeInUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChimeInUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReactions sets the "reactions" field.
+func (u *DiscordChimeInUpsertOne) SetReactions(v int) *DiscordChimeInUpsertOne {
+	return u.Update(func(s *DiscordChimeInUpsert) {
+		s.SetReactions(v)
+	})
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *DiscordChimeInUpsertOne) AddReactions(v int) *DiscordChimeInUpsertOne {
+	return u.Update(func(s *DiscordChimeInUpsert) {
+		s.AddReactions(v)
+	})
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *DiscordChimeInUpsertOne) UpdateReactions() *DiscordChimeInUpsertOne {
+	return u.Update(func(s *DiscordChimeInUpsert) {
+		s.UpdateReactions()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChimeInUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChimeInCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChimeInUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordChimeInUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordChimeInUpsertOne.ID is not supported by MySQL driver. Use DiscordChimeInUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordChimeInUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordChimeInCreateBulk is the builder for creating many DiscordChimeIn entities in bulk.
+type DiscordChimeInCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordChimeInCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordChimeIn entities in the database.
+func (_c *DiscordChimeInCreateBulk) Save(ctx context.Context) ([]*DiscordChimeIn, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordChimeIn, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordChimeInMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordChimeInCreateBulk) SaveX(ctx context.Context) []*DiscordChimeIn {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChimeInCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChimeInCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChimeIn.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChimeInUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChimeInCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordChimeInUpsertBulk {
+	_c.conflict = opts
+	return &DiscordChimeInUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChimeIn.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChimeInCreateBulk) OnConflictColumns(columns ...string) *DiscordChimeInUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChimeInUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordChimeInUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordChimeIn nodes.
+type DiscordChimeInUpsertBulk struct {
+	create *DiscordChimeInCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordChimeIn.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchimein.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChimeInUpsertBulk) UpdateNewValues() *DiscordChimeInUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordchimein.FieldID)
+			}
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(discordchimein.FieldGuildID)
+			}
+			if _, exists := b.mutation.ChannelID(); exists {
+				s.SetIgnore(discordchimein.FieldChannelID)
+			}
+			if _, exists := b.mutation.TriggerMessageID(); exists {
+				s.SetIgnore(discordchimein.FieldTriggerMessageID)
+			}
+			if _, exists := b.mutation.Score(); exists {
+				s.SetIgnore(discordchimein.FieldScore)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(discordchimein.FieldReason)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(discordchimein.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChimeIn.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordChimeInUpsertBulk) Ignore() *DiscordChimeInUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChimeInUpsertBulk) DoNothing() *DiscordChimeInUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChimeInCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordChimeInUpsertBulk) Update(set func(*DiscordChimeInUpsert)) *DiscordChimeInUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChimeInUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetReactions sets the "reactions" field.
+func (u *DiscordChimeInUpsertBulk) SetReactions(v int) *DiscordChimeInUpsertBulk {
+	return u.Update(func(s *DiscordChimeInUpsert) {
+		s.SetReactions(v)
+	})
+}
+
+// AddReactions adds v to the "reactions" field.
+func (u *DiscordChimeInUpsertBulk) AddReactions(v int) *DiscordChimeInUpsertBulk {
+	return u.Update(func(s *DiscordChimeInUpsert) {
+		s.AddReactions(v)
+	})
+}
+
+// UpdateReactions sets the "reactions" field to the value that was provided on create.
+func (u *DiscordChimeInUpsertBulk) UpdateReactions() *DiscordChimeInUpsertBulk {
+	return u.Update(func(s *DiscordChimeInUpsert) {
+		s.UpdateReactions()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChimeInUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordChimeInCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChimeInCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChimeInUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchimein"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChimeInUpdate is the builder for updating DiscordChimeIn entities.
+type DiscordChimeInUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordChimeInMutation
+}
+
+// Where appends a list predicates to the DiscordChimeInUpdate builder.
+func (_u *DiscordChimeInUpdate) Where(ps ...predicate.DiscordChimeIn) *DiscordChimeInUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetReactions sets the "reactions" field.
+func (_u *DiscordChimeInUpdate) SetReactions(v int) *DiscordChimeInUpdate {
+	_u.mutation.ResetReactions()
+	_u.mutation.SetReactions(v)
+	return _u
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_u *DiscordChimeInUpdate) SetNillableReactions(v *int) *DiscordChimeInUpdate {
+	if v != nil {
+		_u.SetReactions(*v)
+	}
+	return _u
+}
+
+// AddReactions adds value to the "reactions" field.
+func (_u *DiscordChimeInUpdate) AddReactions(v int) *DiscordChimeInUpdate {
+	_u.mutation.AddReactions(v)
+	return _u
+}
+
+// Mutation returns the DiscordChimeInMutation object of the builder.
+func (_u *DiscordChimeInUpdate) Mutation() *DiscordChimeInMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordChimeInUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordChimeInUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordChimeInUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordChimeInUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscordChimeInUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discordchimein.Table, discordchimein.Columns, sqlgraph.NewFieldSpec(discordchimein.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(discordchimein.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reactions(); ok {
+		_spec.SetField(discordchimein.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReactions(); ok {
+		_spec.AddField(discordchimein.FieldReactions, field.TypeInt, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordchimein.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordChimeInUpdateOne is the builder for updating a single DiscordChimeIn entity.
+type DiscordChimeInUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordChimeInMutation
+}
+
+// SetReactions sets the "reactions" field.
+func (_u *DiscordChimeInUpdateOne) SetReactions(v int) *DiscordChimeInUpdateOne {
+	_u.mutation.ResetReactions()
+	_u.mutation.SetReactions(v)
+	return _u
+}
+
+// SetNillableReactions sets the "reactions" field if the given value is not nil.
+func (_u *DiscordChimeInUpdateOne) SetNillableReactions(v *int) *DiscordChimeInUpdateOne {
+	if v != nil {
+		_u.SetReactions(*v)
+	}
+	return _u
+}
+
+// AddReactions adds value to the "reactions" field.
+func (_u *DiscordChimeInUpdateOne) AddReactions(v int) *DiscordChimeInUpdateOne {
+	_u.mutation.AddReactions(v)
+	return _u
+}
+
+// Mutation returns the DiscordChimeInMutation object of the builder.
+func (_u *DiscordChimeInUpdateOne) Mutation() *DiscordChimeInMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordChimeInUpdate builder.
+func (_u *DiscordChimeInUpdateOne) Where(ps ...predicate.DiscordChimeIn) *DiscordChimeInUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordChimeInUpdateOne) Select(field string, fields ...string) *DiscordChimeInUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordChimeIn entity.
+func (_u *DiscordChimeInUpdateOne) Save(ctx context.Context) (*DiscordChimeIn, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordChimeInUpdateOne) SaveX(ctx context.Context) *DiscordChimeIn {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordChimeInUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordChimeInUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscordChimeInUpdateOne) sqlSave(ctx context.Context) (_node *DiscordChimeIn, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discordchimein.Table, discordchimein.Columns, sqlgraph.NewFieldSpec(discordchimein.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordChimeIn.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordchimein.FieldID)
+		for _, f := range fields {
+			if !discordchimein.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordchimein.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(discordchimein.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reactions(); ok {
+		_spec.SetField(discordchimein.FieldReactions, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReactions(); ok {
+		_spec.AddField(discordchimein.FieldReactions, field.TypeInt, value)
+	}
+	_node = &DiscordChimeIn{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordchimein.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,169 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessagechunk"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// DiscordChunkEmbedding is the model entity for the DiscordChunkEmbedding schema.
+type DiscordChunkEmbedding struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ChunkID holds the value of the "chunk_id" field.
+	ChunkID string `json:"chunk_id,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding pgvector.Vector `json:"embedding,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiscordChunkEmbeddingQuery when eager-loading is set.
+	Edges        DiscordChunkEmbeddingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DiscordChunkEmbeddingEdges holds the relations/edges for other nodes in the graph.
+type DiscordChunkEmbeddingEdges struct {
+	// Chunk holds the value of the chunk edge.
+	Chunk *DiscordMessageChunk `json:"chunk,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ChunkOrErr returns the Chunk value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DiscordChunkEmbeddingEdges) ChunkOrErr() (*DiscordMessageChunk, error) {
+	if e.Chunk != nil {
+		return e.Chunk, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: discordmessagechunk.Label}
+	}
+	return nil, &NotLoadedError{edge: "chunk"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordChunkEmbedding) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discordchunkembedding.FieldEmbedding:
+			values[i] = new(pgvector.Vector)
+		case discordchunkembedding.FieldID, discordchunkembedding.FieldChunkID, discordchunkembedding.FieldModel:
+			values[i] = new(sql.NullString)
+		case discordchunkembedding.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordChunkEmbedding fields.
+func (_m *DiscordChunkEmbedding) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discordchunkembedding.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case discordchunkembedding.FieldChunkID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field chunk_id", values[i])
+			} else if value.Valid {
+				_m.ChunkID = value.String
+			}
+		case discordchunkembedding.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				_m.Model = value.String
+			}
+		case discordchunkembedding.FieldEmbedding:
+			if value, ok := values[i].(*pgvector.Vector); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value != nil {
+				_m.Embedding = *value
+			}
+		case discordchunkembedding.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordChunkEmbedding.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordChunkEmbedding) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryChunk queries the "chunk" edge of the DiscordChunkEmbedding entity.
+func (_m *DiscordChunkEmbedding) QueryChunk() *DiscordMessageChunkQuery {
+	return NewDiscordChunkEmbeddingClient(_m.config).QueryChunk(_m)
+}
+
+// Update returns a builder for updating this DiscordChunkEmbedding.
+// Note that you need to call DiscordChunkEmbedding.Unwrap() before calling this method if this DiscordChunkEmbedding
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordChunkEmbedding) Update() *DiscordChunkEmbeddingUpdateOne {
+	return NewDiscordChunkEmbeddingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordChunkEmbedding entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordChunkEmbedding) Unwrap() *DiscordChunkEmbedding {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordChunkEmbedding is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordChunkEmbedding) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordChunkEmbedding(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("chunk_id=")
+	builder.WriteString(_m.ChunkID)
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(_m.Model)
+	builder.WriteString(", ")
+	builder.WriteString("embedding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordChunkEmbeddings is a parsable slice of DiscordChunkEmbedding.
+type DiscordChunkEmbeddings []*DiscordChunkEmbedding
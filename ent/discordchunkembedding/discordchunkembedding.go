@@ -0,0 +1,108 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordchunkembedding
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the discordchunkembedding type in the database.
+	Label = "discord_chunk_embedding"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChunkID holds the string denoting the chunk_id field in the database.
+	FieldChunkID = "chunk_id"
+	// FieldModel holds the string denoting the model field in the database.
+	FieldModel = "model"
+	// FieldEmbedding holds the string denoting the embedding field in the database.
+	FieldEmbedding = "embedding"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeChunk holds the string denoting the chunk edge name in mutations.
+	EdgeChunk = "chunk"
+	// Table holds the table name of the discordchunkembedding in the database.
+	Table = "discord_chunk_embeddings"
+	// ChunkTable is the table that holds the chunk relation/edge.
+	ChunkTable = "discord_chunk_embeddings"
+	// ChunkInverseTable is the table name for the DiscordMessageChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "discordmessagechunk" package.
+	ChunkInverseTable = "discord_message_chunks"
+	// ChunkColumn is the table column denoting the chunk relation/edge.
+	ChunkColumn = "chunk_id"
+)
+
+// Columns holds all SQL columns for discordchunkembedding fields.
+var Columns = []string{
+	FieldID,
+	FieldChunkID,
+	FieldModel,
+	FieldEmbedding,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ChunkIDValidator is a validator for the "chunk_id" field. It is called by the builders before save.
+	ChunkIDValidator func(string) error
+	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	ModelValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the DiscordChunkEmbedding queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChunkID orders the results by the chunk_id field.
+func ByChunkID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChunkID, opts...).ToFunc()
+}
+
+// ByModel orders the results by the model field.
+func ByModel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModel, opts...).ToFunc()
+}
+
+// ByEmbedding orders the results by the embedding field.
+func ByEmbedding(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbedding, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByChunkField orders the results by chunk field.
+func ByChunkField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChunkStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newChunkStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChunkInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ChunkTable, ChunkColumn),
+	)
+}
@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordchunkembedding
+
+import (
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldContainsFold(FieldID, id))
+}
+
+// ChunkID applies equality check predicate on the "chunk_id" field. It's identical to ChunkIDEQ.
+func ChunkID(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldChunkID, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldModel, v))
+}
+
+// Embedding applies equality check predicate on the "embedding" field. It's identical to EmbeddingEQ.
+func Embedding(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChunkIDEQ applies the EQ predicate on the "chunk_id" field.
+func ChunkIDEQ(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldChunkID, v))
+}
+
+// ChunkIDNEQ applies the NEQ predicate on the "chunk_id" field.
+func ChunkIDNEQ(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNEQ(FieldChunkID, v))
+}
+
+// ChunkIDIn applies the In predicate on the "chunk_id" field.
+func ChunkIDIn(vs ...string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldIn(FieldChunkID, vs...))
+}
+
+// ChunkIDNotIn applies the NotIn predicate on the "chunk_id" field.
+func ChunkIDNotIn(vs ...string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNotIn(FieldChunkID, vs...))
+}
+
+// ChunkIDGT applies the GT predicate on the "chunk_id" field.
+func ChunkIDGT(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGT(FieldChunkID, v))
+}
+
+// ChunkIDGTE applies the GTE predicate on the "chunk_id" field.
+func ChunkIDGTE(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGTE(FieldChunkID, v))
+}
+
+// ChunkIDLT applies the LT predicate on the "chunk_id" field.
+func ChunkIDLT(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLT(FieldChunkID, v))
+}
+
+// ChunkIDLTE applies the LTE predicate on the "chunk_id" field.
+func ChunkIDLTE(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLTE(FieldChunkID, v))
+}
+
+// ChunkIDContains applies the Contains predicate on the "chunk_id" field.
+func ChunkIDContains(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldContains(FieldChunkID, v))
+}
+
+// ChunkIDHasPrefix applies the HasPrefix predicate on the "chunk_id" field.
+func ChunkIDHasPrefix(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldHasPrefix(FieldChunkID, v))
+}
+
+// ChunkIDHasSuffix applies the HasSuffix predicate on the "chunk_id" field.
+func ChunkIDHasSuffix(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldHasSuffix(FieldChunkID, v))
+}
+
+// ChunkIDEqualFold applies the EqualFold predicate on the "chunk_id" field.
+func ChunkIDEqualFold(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEqualFold(FieldChunkID, v))
+}
+
+// ChunkIDContainsFold applies the ContainsFold predicate on the "chunk_id" field.
+func ChunkIDContainsFold(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldContainsFold(FieldChunkID, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldContainsFold(FieldModel, v))
+}
+
+// EmbeddingEQ applies the EQ predicate on the "embedding" field.
+func EmbeddingEQ(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldEmbedding, v))
+}
+
+// EmbeddingNEQ applies the NEQ predicate on the "embedding" field.
+func EmbeddingNEQ(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNEQ(FieldEmbedding, v))
+}
+
+// EmbeddingIn applies the In predicate on the "embedding" field.
+func EmbeddingIn(vs ...pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingNotIn applies the NotIn predicate on the "embedding" field.
+func EmbeddingNotIn(vs ...pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNotIn(FieldEmbedding, vs...))
+}
+
+// EmbeddingGT applies the GT predicate on the "embedding" field.
+func EmbeddingGT(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGT(FieldEmbedding, v))
+}
+
+// EmbeddingGTE applies the GTE predicate on the "embedding" field.
+func EmbeddingGTE(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGTE(FieldEmbedding, v))
+}
+
+// EmbeddingLT applies the LT predicate on the "embedding" field.
+func EmbeddingLT(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLT(FieldEmbedding, v))
+}
+
+// EmbeddingLTE applies the LTE predicate on the "embedding" field.
+func EmbeddingLTE(v pgvector.Vector) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLTE(FieldEmbedding, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasChunk applies the HasEdge predicate on the "chunk" edge.
+func HasChunk() predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ChunkTable, ChunkColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChunkWith applies the HasEdge predicate on the "chunk" edge with a given conditions (other predicates).
+func HasChunkWith(preds ...predicate.DiscordMessageChunk) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(func(s *sql.Selector) {
+		step := newChunkStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscordChunkEmbedding) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscordChunkEmbedding) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscordChunkEmbedding) predicate.DiscordChunkEmbedding {
+	return predicate.DiscordChunkEmbedding(sql.NotPredicates(p))
+}
@@ -0,0 +1,603 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessagechunk"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// DiscordChunkEmbeddingCreate is the builder for creating a DiscordChunkEmbedding entity.
+type DiscordChunkEmbeddingCreate struct {
+	config
+	mutation *DiscordChunkEmbeddingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChunkID sets the "chunk_id" field.
+func (_c *DiscordChunkEmbeddingCreate) SetChunkID(v string) *DiscordChunkEmbeddingCreate {
+	_c.mutation.SetChunkID(v)
+	return _c
+}
+
+// SetModel sets the "model" field.
+func (_c *DiscordChunkEmbeddingCreate) SetModel(v string) *DiscordChunkEmbeddingCreate {
+	_c.mutation.SetModel(v)
+	return _c
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_c *DiscordChunkEmbeddingCreate) SetEmbedding(v pgvector.Vector) *DiscordChunkEmbeddingCreate {
+	_c.mutation.SetEmbedding(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *DiscordChunkEmbeddingCreate) SetCreatedAt(v time.Time) *DiscordChunkEmbeddingCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *DiscordChunkEmbeddingCreate) SetNillableCreatedAt(v *time.Time) *DiscordChunkEmbeddingCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordChunkEmbeddingCreate) SetID(v string) *DiscordChunkEmbeddingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetChunk sets the "chunk" edge to the DiscordMessageChunk entity.
+func (_c *DiscordChunkEmbeddingCreate) SetChunk(v *DiscordMessageChunk) *DiscordChunkEmbeddingCreate {
+	return _c.SetChunkID(v.ID)
+}
+
+// Mutation returns the DiscordChunkEmbeddingMutation object of the builder.
+func (_c *DiscordChunkEmbeddingCreate) Mutation() *DiscordChunkEmbeddingMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordChunkEmbedding in the database.
+func (_c *DiscordChunkEmbeddingCreate) Save(ctx context.Context) (*DiscordChunkEmbedding, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordChunkEmbeddingCreate) SaveX(ctx context.Context) *DiscordChunkEmbedding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChunkEmbeddingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChunkEmbeddingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordChunkEmbeddingCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := discordchunkembedding.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordChunkEmbeddingCreate) check() error {
+	if _, ok := _c.mutation.ChunkID(); !ok {
+		return &ValidationError{Name: "chunk_id", err: errors.New(`ent: missing required field "DiscordChunkEmbedding.chunk_id"`)}
+	}
+	if v, ok := _c.mutation.ChunkID(); ok {
+		if err := discordchunkembedding.ChunkIDValidator(v); err != nil {
+			return &ValidationError{Name: "chunk_id", err: fmt.Errorf(`ent: validator failed for field "DiscordChunkEmbedding.chunk_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "DiscordChunkEmbedding.model"`)}
+	}
+	if v, ok := _c.mutation.Model(); ok {
+		if err := discordchunkembedding.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "DiscordChunkEmbedding.model": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Embedding(); !ok {
+		return &ValidationError{Name: "embedding", err: errors.New(`ent: missing required field "DiscordChunkEmbedding.embedding"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DiscordChunkEmbedding.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordchunkembedding.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordChunkEmbedding.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.ChunkIDs()) == 0 {
+		return &ValidationError{Name: "chunk", err: errors.New(`ent: missing required edge "DiscordChunkEmbedding.chunk"`)}
+	}
+	return nil
+}
+
+func (_c *DiscordChunkEmbeddingCreate) sqlSave(ctx context.Context) (*DiscordChunkEmbedding, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordChunkEmbedding.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordChunkEmbeddingCreate) createSpec() (*DiscordChunkEmbedding, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordChunkEmbedding{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordchunkembedding.Table, sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Model(); ok {
+		_spec.SetField(discordchunkembedding.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := _c.mutation.Embedding(); ok {
+		_spec.SetField(discordchunkembedding.FieldEmbedding, field.TypeOther, value)
+		_node.Embedding = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(discordchunkembedding.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.ChunkIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordchunkembedding.ChunkTable,
+			Columns: []string{discordchunkembedding.ChunkColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ChunkID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChunkEmbedding.Create().
+//		SetChunkID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChunkEmbeddingUpsert) {
+//			SetChunkID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChunkEmbeddingCreate) OnConflict(opts ...sql.ConflictOption) *DiscordChunkEmbeddingUpsertOne {
+	_c.conflict = opts
+	return &DiscordChunkEmbeddingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChunkEmbedding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChunkEmbeddingCreate) OnConflictColumns(columns ...string) *DiscordChunkEmbeddingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChunkEmbeddingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordChunkEmbeddingUpsertOne is the builder for "upsert"-ing
+	//  one DiscordChunkEmbedding node.
+	DiscordChunkEmbeddingUpsertOne struct {
+		create *DiscordChunkEmbeddingCreate
+	}
+
+	// DiscordChunkEmbeddingUpsert is the "OnConflict" setter.
+	DiscordChunkEmbeddingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEmbedding sets the "embedding" field.
+func (u *DiscordChunkEmbeddingUpsert) SetEmbedding(v pgvector.Vector) *DiscordChunkEmbeddingUpsert {
+	u.Set(discordchunkembedding.FieldEmbedding, v)
+	return u
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *DiscordChunkEmbeddingUpsert) UpdateEmbedding() *DiscordChunkEmbeddingUpsert {
+	u.SetExcluded(discordchunkembedding.FieldEmbedding)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChunkEmbedding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchunkembedding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChunkEmbeddingUpsertOne) UpdateNewValues() *DiscordChunkEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordchunkembedding.FieldID)
+		}
+		if _, exists := u.create.mutation.ChunkID(); exists {
+			s.SetIgnore(discordchunkembedding.FieldChunkID)
+		}
+		if _, exists := u.create.mutation.Model(); exists {
+			s.SetIgnore(discordchunkembedding.FieldModel)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(discordchunkembedding.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChunkEmbedding.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordChunkEmbeddingUpsertOne) Ignore() *DiscordChunkEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChunkEmbeddingUpsertOne) DoNothing() *DiscordChunkEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChunkEmbeddingCreate.OnConflict
+// documentation for more info.
+func (u *DiscordChunkEmbeddingUpsertOne) Update(set func(*DiscordChunkEmbeddingUpsert)) *DiscordChunkEmbeddingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChunkEmbeddingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *DiscordChunkEmbeddingUpsertOne) SetEmbedding(v pgvector.Vector) *DiscordChunkEmbeddingUpsertOne {
+	return u.Update(func(s *DiscordChunkEmbeddingUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *DiscordChunkEmbeddingUpsertOne) UpdateEmbedding() *DiscordChunkEmbeddingUpsertOne {
+	return u.Update(func(s *DiscordChunkEmbeddingUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChunkEmbeddingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChunkEmbeddingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChunkEmbeddingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordChunkEmbeddingUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordChunkEmbeddingUpsertOne.ID is not supported by MySQL driver. Use DiscordChunkEmbeddingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordChunkEmbeddingUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordChunkEmbeddingCreateBulk is the builder for creating many DiscordChunkEmbedding entities in bulk.
+type DiscordChunkEmbeddingCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordChunkEmbeddingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordChunkEmbedding entities in the database.
+func (_c *DiscordChunkEmbeddingCreateBulk) Save(ctx context.Context) ([]*DiscordChunkEmbedding, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordChunkEmbedding, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordChunkEmbeddingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordChunkEmbeddingCreateBulk) SaveX(ctx context.Context) []*DiscordChunkEmbedding {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordChunkEmbeddingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordChunkEmbeddingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordChunkEmbedding.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordChunkEmbeddingUpsert) {
+//			SetChunkID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordChunkEmbeddingCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordChunkEmbeddingUpsertBulk {
+	_c.conflict = opts
+	return &DiscordChunkEmbeddingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordChunkEmbedding.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordChunkEmbeddingCreateBulk) OnConflictColumns(columns ...string) *DiscordChunkEmbeddingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordChunkEmbeddingUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordChunkEmbeddingUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordChunkEmbedding nodes.
+type DiscordChunkEmbeddingUpsertBulk struct {
+	create *DiscordChunkEmbeddingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordChunkEmbedding.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordchunkembedding.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordChunkEmbeddingUpsertBulk) UpdateNewValues() *DiscordChunkEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordchunkembedding.FieldID)
+			}
+			if _, exists := b.mutation.ChunkID(); exists {
+				s.SetIgnore(discordchunkembedding.FieldChunkID)
+			}
+			if _, exists := b.mutation.Model(); exists {
+				s.SetIgnore(discordchunkembedding.FieldModel)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(discordchunkembedding.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordChunkEmbedding.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordChunkEmbeddingUpsertBulk) Ignore() *DiscordChunkEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordChunkEmbeddingUpsertBulk) DoNothing() *DiscordChunkEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordChunkEmbeddingCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordChunkEmbeddingUpsertBulk) Update(set func(*DiscordChunkEmbeddingUpsert)) *DiscordChunkEmbeddingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordChunkEmbeddingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (u *DiscordChunkEmbeddingUpsertBulk) SetEmbedding(v pgvector.Vector) *DiscordChunkEmbeddingUpsertBulk {
+	return u.Update(func(s *DiscordChunkEmbeddingUpsert) {
+		s.SetEmbedding(v)
+	})
+}
+
+// UpdateEmbedding sets the "embedding" field to the value that was provided on create.
+func (u *DiscordChunkEmbeddingUpsertBulk) UpdateEmbedding() *DiscordChunkEmbeddingUpsertBulk {
+	return u.Update(func(s *DiscordChunkEmbeddingUpsert) {
+		s.UpdateEmbedding()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordChunkEmbeddingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordChunkEmbeddingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordChunkEmbeddingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordChunkEmbeddingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
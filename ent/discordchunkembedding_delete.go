@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChunkEmbeddingDelete is the builder for deleting a DiscordChunkEmbedding entity.
+type DiscordChunkEmbeddingDelete struct {
+	config
+	hooks    []Hook
+	mutation *DiscordChunkEmbeddingMutation
+}
+
+// Where appends a list predicates to the DiscordChunkEmbeddingDelete builder.
+func (_d *DiscordChunkEmbeddingDelete) Where(ps ...predicate.DiscordChunkEmbedding) *DiscordChunkEmbeddingDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DiscordChunkEmbeddingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscordChunkEmbeddingDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DiscordChunkEmbeddingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(discordchunkembedding.Table, sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DiscordChunkEmbeddingDeleteOne is the builder for deleting a single DiscordChunkEmbedding entity.
+type DiscordChunkEmbeddingDeleteOne struct {
+	_d *DiscordChunkEmbeddingDelete
+}
+
+// Where appends a list predicates to the DiscordChunkEmbeddingDelete builder.
+func (_d *DiscordChunkEmbeddingDeleteOne) Where(ps ...predicate.DiscordChunkEmbedding) *DiscordChunkEmbeddingDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DiscordChunkEmbeddingDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{discordchunkembedding.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DiscordChunkEmbeddingDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessagechunk"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChunkEmbeddingQuery is the builder for querying DiscordChunkEmbedding entities.
+type DiscordChunkEmbeddingQuery struct {
+	config
+	ctx        *QueryContext
+	order      []discordchunkembedding.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DiscordChunkEmbedding
+	withChunk  *DiscordMessageChunkQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DiscordChunkEmbeddingQuery builder.
+func (_q *DiscordChunkEmbeddingQuery) Where(ps ...predicate.DiscordChunkEmbedding) *DiscordChunkEmbeddingQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *DiscordChunkEmbeddingQuery) Limit(limit int) *DiscordChunkEmbeddingQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *DiscordChunkEmbeddingQuery) Offset(offset int) *DiscordChunkEmbeddingQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *DiscordChunkEmbeddingQuery) Unique(unique bool) *DiscordChunkEmbeddingQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *DiscordChunkEmbeddingQuery) Order(o ...discordchunkembedding.OrderOption) *DiscordChunkEmbeddingQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryChunk chains the current query on the "chunk" edge.
+func (_q *DiscordChunkEmbeddingQuery) QueryChunk() *DiscordMessageChunkQuery {
+	query := (&DiscordMessageChunkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordchunkembedding.Table, discordchunkembedding.FieldID, selector),
+			sqlgraph.To(discordmessagechunk.Table, discordmessagechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordchunkembedding.ChunkTable, discordchunkembedding.ChunkColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DiscordChunkEmbedding entity from the query.
+// Returns a *NotFoundError when no DiscordChunkEmbedding was found.
+func (_q *DiscordChunkEmbeddingQuery) First(ctx context.Context) (*DiscordChunkEmbedding, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{discordchunkembedding.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) FirstX(ctx context.Context) *DiscordChunkEmbedding {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DiscordChunkEmbedding ID from the query.
+// Returns a *NotFoundError when no DiscordChunkEmbedding ID was found.
+func (_q *DiscordChunkEmbeddingQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{discordchunkembedding.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DiscordChunkEmbedding entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DiscordChunkEmbedding entity is found.
+// Returns a *NotFoundError when no DiscordChunkEmbedding entities are found.
+func (_q *DiscordChunkEmbeddingQuery) Only(ctx context.Context) (*DiscordChunkEmbedding, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{discordchunkembedding.Label}
+	default:
+		return nil, &NotSingularError{discordchunkembedding.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) OnlyX(ctx context.Context) *DiscordChunkEmbedding {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DiscordChunkEmbedding ID in the query.
+// Returns a *NotSingularError when more than one DiscordChunkEmbedding ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *DiscordChunkEmbeddingQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{discordchunkembedding.Label}
+	default:
+		err = &NotSingularError{discordchunkembedding.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DiscordChunkEmbeddings.
+func (_q *DiscordChunkEmbeddingQuery) All(ctx context.Context) ([]*DiscordChunkEmbedding, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DiscordChunkEmbedding, *DiscordChunkEmbeddingQuery]()
+	return withInterceptors[[]*DiscordChunkEmbedding](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) AllX(ctx context.Context) []*DiscordChunkEmbedding {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DiscordChunkEmbedding IDs.
+func (_q *DiscordChunkEmbeddingQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(discordchunkembedding.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *DiscordChunkEmbeddingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*DiscordChunkEmbeddingQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *DiscordChunkEmbeddingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *DiscordChunkEmbeddingQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DiscordChunkEmbeddingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *DiscordChunkEmbeddingQuery) Clone() *DiscordChunkEmbeddingQuery {
+	if _q == nil {
+		return nil
+	}
+	return &DiscordChunkEmbeddingQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]discordchunkembedding.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.DiscordChunkEmbedding{}, _q.predicates...),
+		withChunk:  _q.withChunk.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithChunk tells the query-builder to eager-load the nodes that are connected to
+// the "chunk" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordChunkEmbeddingQuery) WithChunk(opts ...func(*DiscordMessageChunkQuery)) *DiscordChunkEmbeddingQuery {
+	query := (&DiscordMessageChunkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChunk = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ChunkID string `json:"chunk_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DiscordChunkEmbedding.Query().
+//		GroupBy(discordchunkembedding.FieldChunkID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *DiscordChunkEmbeddingQuery) GroupBy(field string, fields ...string) *DiscordChunkEmbeddingGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DiscordChunkEmbeddingGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = discordchunkembedding.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ChunkID string `json:"chunk_id,omitempty"`
+//	}
+//
+//	client.DiscordChunkEmbedding.Query().
+//		Select(discordchunkembedding.FieldChunkID).
+//		Scan(ctx, &v)
+func (_q *DiscordChunkEmbeddingQuery) Select(fields ...string) *DiscordChunkEmbeddingSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &DiscordChunkEmbeddingSelect{DiscordChunkEmbeddingQuery: _q}
+	sbuild.label = discordchunkembedding.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DiscordChunkEmbeddingSelect configured with the given aggregations.
+func (_q *DiscordChunkEmbeddingQuery) Aggregate(fns ...AggregateFunc) *DiscordChunkEmbeddingSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *DiscordChunkEmbeddingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !discordchunkembedding.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *DiscordChunkEmbeddingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DiscordChunkEmbedding, error) {
+	var (
+		nodes       = []*DiscordChunkEmbedding{}
+		_spec       = _q.querySpec()
+		loadedTypes = [1]bool{
+			_q.withChunk != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DiscordChunkEmbedding).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DiscordChunkEmbedding{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withChunk; query != nil {
+		if err := _q.loadChunk(ctx, query, nodes, nil,
+			func(n *DiscordChunkEmbedding, e *DiscordMessageChunk) { n.Edges.Chunk = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *DiscordChunkEmbeddingQuery) loadChunk(ctx context.Context, query *DiscordMessageChunkQuery, nodes []*DiscordChunkEmbedding, init func(*DiscordChunkEmbedding), assign func(*DiscordChunkEmbedding, *DiscordMessageChunk)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*DiscordChunkEmbedding)
+	for i := range nodes {
+		fk := nodes[i].ChunkID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(discordmessagechunk.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "chunk_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *DiscordChunkEmbeddingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *DiscordChunkEmbeddingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(discordchunkembedding.Table, discordchunkembedding.Columns, sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordchunkembedding.FieldID)
+		for i := range fields {
+			if fields[i] != discordchunkembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if _q.withChunk != nil {
+			_spec.Node.AddColumnOnce(discordchunkembedding.FieldChunkID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *DiscordChunkEmbeddingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(discordchunkembedding.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = discordchunkembedding.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DiscordChunkEmbeddingGroupBy is the group-by builder for DiscordChunkEmbedding entities.
+type DiscordChunkEmbeddingGroupBy struct {
+	selector
+	build *DiscordChunkEmbeddingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *DiscordChunkEmbeddingGroupBy) Aggregate(fns ...AggregateFunc) *DiscordChunkEmbeddingGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *DiscordChunkEmbeddingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DiscordChunkEmbeddingQuery, *DiscordChunkEmbeddingGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *DiscordChunkEmbeddingGroupBy) sqlScan(ctx context.Context, root *DiscordChunkEmbeddingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DiscordChunkEmbeddingSelect is the builder for selecting fields of DiscordChunkEmbedding entities.
+type DiscordChunkEmbeddingSelect struct {
+	*DiscordChunkEmbeddingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *DiscordChunkEmbeddingSelect) Aggregate(fns ...AggregateFunc) *DiscordChunkEmbeddingSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *DiscordChunkEmbeddingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DiscordChunkEmbeddingQuery, *DiscordChunkEmbeddingSelect](ctx, _s.DiscordChunkEmbeddingQuery, _s, _s.inters, v)
+}
+
+func (_s *DiscordChunkEmbeddingSelect) sqlScan(ctx context.Context, root *DiscordChunkEmbeddingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
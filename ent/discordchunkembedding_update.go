@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	pgvector "github.com/pgvector/pgvector-go"
+)
+
+// DiscordChunkEmbeddingUpdate is the builder for updating DiscordChunkEmbedding entities.
+type DiscordChunkEmbeddingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordChunkEmbeddingMutation
+}
+
+// Where appends a list predicates to the DiscordChunkEmbeddingUpdate builder.
+func (_u *DiscordChunkEmbeddingUpdate) Where(ps ...predicate.DiscordChunkEmbedding) *DiscordChunkEmbeddingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_u *DiscordChunkEmbeddingUpdate) SetEmbedding(v pgvector.Vector) *DiscordChunkEmbeddingUpdate {
+	_u.mutation.SetEmbedding(v)
+	return _u
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (_u *DiscordChunkEmbeddingUpdate) SetNillableEmbedding(v *pgvector.Vector) *DiscordChunkEmbeddingUpdate {
+	if v != nil {
+		_u.SetEmbedding(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscordChunkEmbeddingMutation object of the builder.
+func (_u *DiscordChunkEmbeddingUpdate) Mutation() *DiscordChunkEmbeddingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordChunkEmbeddingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordChunkEmbeddingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordChunkEmbeddingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordChunkEmbeddingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordChunkEmbeddingUpdate) check() error {
+	if _u.mutation.ChunkCleared() && len(_u.mutation.ChunkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordChunkEmbedding.chunk"`)
+	}
+	return nil
+}
+
+func (_u *DiscordChunkEmbeddingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordchunkembedding.Table, discordchunkembedding.Columns, sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Embedding(); ok {
+		_spec.SetField(discordchunkembedding.FieldEmbedding, field.TypeOther, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordchunkembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordChunkEmbeddingUpdateOne is the builder for updating a single DiscordChunkEmbedding entity.
+type DiscordChunkEmbeddingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordChunkEmbeddingMutation
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_u *DiscordChunkEmbeddingUpdateOne) SetEmbedding(v pgvector.Vector) *DiscordChunkEmbeddingUpdateOne {
+	_u.mutation.SetEmbedding(v)
+	return _u
+}
+
+// SetNillableEmbedding sets the "embedding" field if the given value is not nil.
+func (_u *DiscordChunkEmbeddingUpdateOne) SetNillableEmbedding(v *pgvector.Vector) *DiscordChunkEmbeddingUpdateOne {
+	if v != nil {
+		_u.SetEmbedding(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscordChunkEmbeddingMutation object of the builder.
+func (_u *DiscordChunkEmbeddingUpdateOne) Mutation() *DiscordChunkEmbeddingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordChunkEmbeddingUpdate builder.
+func (_u *DiscordChunkEmbeddingUpdateOne) Where(ps ...predicate.DiscordChunkEmbedding) *DiscordChunkEmbeddingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordChunkEmbeddingUpdateOne) Select(field string, fields ...string) *DiscordChunkEmbeddingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordChunkEmbedding entity.
+func (_u *DiscordChunkEmbeddingUpdateOne) Save(ctx context.Context) (*DiscordChunkEmbedding, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordChunkEmbeddingUpdateOne) SaveX(ctx context.Context) *DiscordChunkEmbedding {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordChunkEmbeddingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordChunkEmbeddingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordChunkEmbeddingUpdateOne) check() error {
+	if _u.mutation.ChunkCleared() && len(_u.mutation.ChunkIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordChunkEmbedding.chunk"`)
+	}
+	return nil
+}
+
+func (_u *DiscordChunkEmbeddingUpdateOne) sqlSave(ctx context.Context) (_node *DiscordChunkEmbedding, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordchunkembedding.Table, discordchunkembedding.Columns, sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordChunkEmbedding.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordchunkembedding.FieldID)
+		for _, f := range fields {
+			if !discordchunkembedding.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordchunkembedding.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Embedding(); ok {
+		_spec.SetField(discordchunkembedding.FieldEmbedding, field.TypeOther, value)
+	}
+	_node = &DiscordChunkEmbedding{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordchunkembedding.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discorduser"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DiscordDirectMessage is the model entity for the DiscordDirectMessage schema.
+type DiscordDirectMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// ChannelID holds the value of the "channel_id" field.
+	ChannelID string `json:"channel_id,omitempty"`
+	// FromBot holds the value of the "from_bot" field.
+	FromBot bool `json:"from_bot,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiscordDirectMessageQuery when eager-loading is set.
+	Edges        DiscordDirectMessageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DiscordDirectMessageEdges holds the relations/edges for other nodes in the graph.
+type DiscordDirectMessageEdges struct {
+	// User holds the value of the user edge.
+	User *DiscordUser `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DiscordDirectMessageEdges) UserOrErr() (*DiscordUser, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: discorduser.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordDirectMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discorddirectmessage.FieldFromBot:
+			values[i] = new(sql.NullBool)
+		case discorddirectmessage.FieldID, discorddirectmessage.FieldUserID, discorddirectmessage.FieldChannelID, discorddirectmessage.FieldContent:
+			values[i] = new(sql.NullString)
+		case discorddirectmessage.FieldTimestamp:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordDirectMessage fields.
+func (_m *DiscordDirectMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discorddirectmessage.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case discorddirectmessage.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = value.String
+			}
+		case discorddirectmessage.FieldChannelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_id", values[i])
+			} else if value.Valid {
+				_m.ChannelID = value.String
+			}
+		case discorddirectmessage.FieldFromBot:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field from_bot", values[i])
+			} else if value.Valid {
+				_m.FromBot = value.Bool
+			}
+		case discorddirectmessage.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		case discorddirectmessage.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordDirectMessage.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordDirectMessage) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the DiscordDirectMessage entity.
+func (_m *DiscordDirectMessage) QueryUser() *DiscordUserQuery {
+	return NewDiscordDirectMessageClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this DiscordDirectMessage.
+// Note that you need to call DiscordDirectMessage.Unwrap() before calling this method if this DiscordDirectMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordDirectMessage) Update() *DiscordDirectMessageUpdateOne {
+	return NewDiscordDirectMessageClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordDirectMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordDirectMessage) Unwrap() *DiscordDirectMessage {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordDirectMessage is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordDirectMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordDirectMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(_m.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("channel_id=")
+	builder.WriteString(_m.ChannelID)
+	builder.WriteString(", ")
+	builder.WriteString("from_bot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromBot))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordDirectMessages is a parsable slice of DiscordDirectMessage.
+type DiscordDirectMessages []*DiscordDirectMessage
@@ -0,0 +1,374 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discorddirectmessage
+
+import (
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContainsFold(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldUserID, v))
+}
+
+// ChannelID applies equality check predicate on the "channel_id" field. It's identical to ChannelIDEQ.
+func ChannelID(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldChannelID, v))
+}
+
+// FromBot applies equality check predicate on the "from_bot" field. It's identical to FromBotEQ.
+func FromBot(v bool) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldFromBot, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// ChannelIDEQ applies the EQ predicate on the "channel_id" field.
+func ChannelIDEQ(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ChannelIDNEQ applies the NEQ predicate on the "channel_id" field.
+func ChannelIDNEQ(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNEQ(FieldChannelID, v))
+}
+
+// ChannelIDIn applies the In predicate on the "channel_id" field.
+func ChannelIDIn(vs ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldIn(FieldChannelID, vs...))
+}
+
+// ChannelIDNotIn applies the NotIn predicate on the "channel_id" field.
+func ChannelIDNotIn(vs ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNotIn(FieldChannelID, vs...))
+}
+
+// ChannelIDGT applies the GT predicate on the "channel_id" field.
+func ChannelIDGT(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGT(FieldChannelID, v))
+}
+
+// ChannelIDGTE applies the GTE predicate on the "channel_id" field.
+func ChannelIDGTE(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGTE(FieldChannelID, v))
+}
+
+// ChannelIDLT applies the LT predicate on the "channel_id" field.
+func ChannelIDLT(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLT(FieldChannelID, v))
+}
+
+// ChannelIDLTE applies the LTE predicate on the "channel_id" field.
+func ChannelIDLTE(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLTE(FieldChannelID, v))
+}
+
+// ChannelIDContains applies the Contains predicate on the "channel_id" field.
+func ChannelIDContains(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContains(FieldChannelID, v))
+}
+
+// ChannelIDHasPrefix applies the HasPrefix predicate on the "channel_id" field.
+func ChannelIDHasPrefix(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldHasPrefix(FieldChannelID, v))
+}
+
+// ChannelIDHasSuffix applies the HasSuffix predicate on the "channel_id" field.
+func ChannelIDHasSuffix(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldHasSuffix(FieldChannelID, v))
+}
+
+// ChannelIDEqualFold applies the EqualFold predicate on the "channel_id" field.
+func ChannelIDEqualFold(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEqualFold(FieldChannelID, v))
+}
+
+// ChannelIDContainsFold applies the ContainsFold predicate on the "channel_id" field.
+func ChannelIDContainsFold(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContainsFold(FieldChannelID, v))
+}
+
+// FromBotEQ applies the EQ predicate on the "from_bot" field.
+func FromBotEQ(v bool) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldFromBot, v))
+}
+
+// FromBotNEQ applies the NEQ predicate on the "from_bot" field.
+func FromBotNEQ(v bool) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNEQ(FieldFromBot, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldContainsFold(FieldContent, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.DiscordUser) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscordDirectMessage) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscordDirectMessage) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscordDirectMessage) predicate.DiscordDirectMessage {
+	return predicate.DiscordDirectMessage(sql.NotPredicates(p))
+}
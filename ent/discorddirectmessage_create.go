@@ -0,0 +1,621 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discorduser"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordDirectMessageCreate is the builder for creating a DiscordDirectMessage entity.
+type DiscordDirectMessageCreate struct {
+	config
+	mutation *DiscordDirectMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DiscordDirectMessageCreate) SetUserID(v string) *DiscordDirectMessageCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *DiscordDirectMessageCreate) SetChannelID(v string) *DiscordDirectMessageCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetFromBot sets the "from_bot" field.
+func (_c *DiscordDirectMessageCreate) SetFromBot(v bool) *DiscordDirectMessageCreate {
+	_c.mutation.SetFromBot(v)
+	return _c
+}
+
+// SetNillableFromBot sets the "from_bot" field if the given value is not nil.
+func (_c *DiscordDirectMessageCreate) SetNillableFromBot(v *bool) *DiscordDirectMessageCreate {
+	if v != nil {
+		_c.SetFromBot(*v)
+	}
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *DiscordDirectMessageCreate) SetContent(v string) *DiscordDirectMessageCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *DiscordDirectMessageCreate) SetTimestamp(v time.Time) *DiscordDirectMessageCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordDirectMessageCreate) SetID(v string) *DiscordDirectMessageCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetUser sets the "user" edge to the DiscordUser entity.
+func (_c *DiscordDirectMessageCreate) SetUser(v *DiscordUser) *DiscordDirectMessageCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the DiscordDirectMessageMutation object of the builder.
+func (_c *DiscordDirectMessageCreate) Mutation() *DiscordDirectMessageMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordDirectMessage in the database.
+func (_c *DiscordDirectMessageCreate) Save(ctx context.Context) (*DiscordDirectMessage, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordDirectMessageCreate) SaveX(ctx context.Context) *DiscordDirectMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordDirectMessageCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordDirectMessageCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordDirectMessageCreate) defaults() {
+	if _, ok := _c.mutation.FromBot(); !ok {
+		v := discorddirectmessage.DefaultFromBot
+		_c.mutation.SetFromBot(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordDirectMessageCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DiscordDirectMessage.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := discorddirectmessage.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DiscordDirectMessage.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "DiscordDirectMessage.channel_id"`)}
+	}
+	if v, ok := _c.mutation.ChannelID(); ok {
+		if err := discorddirectmessage.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "DiscordDirectMessage.channel_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FromBot(); !ok {
+		return &ValidationError{Name: "from_bot", err: errors.New(`ent: missing required field "DiscordDirectMessage.from_bot"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "DiscordDirectMessage.content"`)}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "DiscordDirectMessage.timestamp"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discorddirectmessage.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordDirectMessage.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DiscordDirectMessage.user"`)}
+	}
+	return nil
+}
+
+func (_c *DiscordDirectMessageCreate) sqlSave(ctx context.Context) (*DiscordDirectMessage, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordDirectMessage.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordDirectMessageCreate) createSpec() (*DiscordDirectMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordDirectMessage{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discorddirectmessage.Table, sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(discorddirectmessage.FieldChannelID, field.TypeString, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.FromBot(); ok {
+		_spec.SetField(discorddirectmessage.FieldFromBot, field.TypeBool, value)
+		_node.FromBot = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(discorddirectmessage.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(discorddirectmessage.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discorddirectmessage.UserTable,
+			Columns: []string{discorddirectmessage.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordDirectMessage.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordDirectMessageUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordDirectMessageCreate) OnConflict(opts ...sql.ConflictOption) *DiscordDirectMessageUpsertOne {
+	_c.conflict = opts
+	return &DiscordDirectMessageUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordDirectMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordDirectMessageCreate) OnConflictColumns(columns ...string) *DiscordDirectMessageUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordDirectMessageUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordDirectMessageUpsertOne is the builder for "upsert"-ing
+	//  one DiscordDirectMessage node.
+	DiscordDirectMessageUpsertOne struct {
+		create *DiscordDirectMessageCreate
+	}
+
+	// DiscordDirectMessageUpsert is the "OnConflict" setter.
+	DiscordDirectMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetContent sets the "content" field.
+func (u *DiscordDirectMessageUpsert) SetContent(v string) *DiscordDirectMessageUpsert {
+	u.Set(discorddirectmessage.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *DiscordDirectMessageUpsert) UpdateContent() *DiscordDirectMessageUpsert {
+	u.SetExcluded(discorddirectmessage.FieldContent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordDirectMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discorddirectmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordDirectMessageUpsertOne) UpdateNewValues() *DiscordDirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discorddirectmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(discorddirectmessage.FieldUserID)
+		}
+		if _, exists := u.create.mutation.ChannelID(); exists {
+			s.SetIgnore(discorddirectmessage.FieldChannelID)
+		}
+		if _, exists := u.create.mutation.FromBot(); exists {
+			s.SetIgnore(discorddirectmessage.FieldFromBot)
+		}
+		if _, exists := u.create.mutation.Timestamp(); exists {
+			s.SetIgnore(discorddirectmessage.FieldTimestamp)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordDirectMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordDirectMessageUpsertOne) Ignore() *DiscordDirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordDirectMessageUpsertOne) DoNothing() *DiscordDirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordDirectMessageCreate.OnConflict
+// documentation for more info.
+func (u *DiscordDirectMessageUpsertOne) Update(set func(*DiscordDirectMessageUpsert)) *DiscordDirectMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordDirectMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *DiscordDirectMessageUpsertOne) SetContent(v string) *DiscordDirectMessageUpsertOne {
+	return u.Update(func(s *DiscordDirectMessageUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *DiscordDirectMessageUpsertOne) UpdateContent() *DiscordDirectMessageUpsertOne {
+	return u.Update(func(s *DiscordDirectMessageUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordDirectMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordDirectMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordDirectMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordDirectMessageUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordDirectMessageUpsertOne.ID is not supported by MySQL driver. Use DiscordDirectMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordDirectMessageUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordDirectMessageCreateBulk is the builder for creating many DiscordDirectMessage entities in bulk.
+type DiscordDirectMessageCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordDirectMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordDirectMessage entities in the database.
+func (_c *DiscordDirectMessageCreateBulk) Save(ctx context.Context) ([]*DiscordDirectMessage, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordDirectMessage, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordDirectMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordDirectMessageCreateBulk) SaveX(ctx context.Context) []*DiscordDirectMessage {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordDirectMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordDirectMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordDirectMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordDirectMessageUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordDirectMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordDirectMessageUpsertBulk {
+	_c.conflict = opts
+	return &DiscordDirectMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordDirectMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordDirectMessageCreateBulk) OnConflictColumns(columns ...string) *DiscordDirectMessageUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordDirectMessageUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordDirectMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordDirectMessage nodes.
+type DiscordDirectMessageUpsertBulk struct {
+	create *DiscordDirectMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordDirectMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discorddirectmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordDirectMessageUpsertBulk) UpdateNewValues() *DiscordDirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discorddirectmessage.FieldID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(discorddirectmessage.FieldUserID)
+			}
+			if _, exists := b.mutation.ChannelID(); exists {
+				s.SetIgnore(discorddirectmessage.FieldChannelID)
+			}
+			if _, exists := b.mutation.FromBot(); exists {
+				s.SetIgnore(discorddirectmessage.FieldFromBot)
+			}
+			if _, exists := b.mutation.Timestamp(); exists {
+				s.SetIgnore(discorddirectmessage.FieldTimestamp)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordDirectMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordDirectMessageUpsertBulk) Ignore() *DiscordDirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordDirectMessageUpsertBulk) DoNothing() *DiscordDirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordDirectMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordDirectMessageUpsertBulk) Update(set func(*DiscordDirectMessageUpsert)) *DiscordDirectMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordDirectMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *DiscordDirectMessageUpsertBulk) SetContent(v string) *DiscordDirectMessageUpsertBulk {
+	return u.Update(func(s *DiscordDirectMessageUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *DiscordDirectMessageUpsertBulk) UpdateContent() *DiscordDirectMessageUpsertBulk {
+	return u.Update(func(s *DiscordDirectMessageUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordDirectMessageUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordDirectMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordDirectMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordDirectMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
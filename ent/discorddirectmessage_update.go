@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordDirectMessageUpdate is the builder for updating DiscordDirectMessage entities.
+type DiscordDirectMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordDirectMessageMutation
+}
+
+// Where appends a list predicates to the DiscordDirectMessageUpdate builder.
+func (_u *DiscordDirectMessageUpdate) Where(ps ...predicate.DiscordDirectMessage) *DiscordDirectMessageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *DiscordDirectMessageUpdate) SetContent(v string) *DiscordDirectMessageUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *DiscordDirectMessageUpdate) SetNillableContent(v *string) *DiscordDirectMessageUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscordDirectMessageMutation object of the builder.
+func (_u *DiscordDirectMessageUpdate) Mutation() *DiscordDirectMessageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordDirectMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordDirectMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordDirectMessageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordDirectMessageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordDirectMessageUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordDirectMessage.user"`)
+	}
+	return nil
+}
+
+func (_u *DiscordDirectMessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discorddirectmessage.Table, discorddirectmessage.Columns, sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(discorddirectmessage.FieldContent, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discorddirectmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordDirectMessageUpdateOne is the builder for updating a single DiscordDirectMessage entity.
+type DiscordDirectMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordDirectMessageMutation
+}
+
+// SetContent sets the "content" field.
+func (_u *DiscordDirectMessageUpdateOne) SetContent(v string) *DiscordDirectMessageUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *DiscordDirectMessageUpdateOne) SetNillableContent(v *string) *DiscordDirectMessageUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DiscordDirectMessageMutation object of the builder.
+func (_u *DiscordDirectMessageUpdateOne) Mutation() *DiscordDirectMessageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordDirectMessageUpdate builder.
+func (_u *DiscordDirectMessageUpdateOne) Where(ps ...predicate.DiscordDirectMessage) *DiscordDirectMessageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordDirectMessageUpdateOne) Select(field string, fields ...string) *DiscordDirectMessageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordDirectMessage entity.
+func (_u *DiscordDirectMessageUpdateOne) Save(ctx context.Context) (*DiscordDirectMessage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordDirectMessageUpdateOne) SaveX(ctx context.Context) *DiscordDirectMessage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordDirectMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordDirectMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordDirectMessageUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordDirectMessage.user"`)
+	}
+	return nil
+}
+
+func (_u *DiscordDirectMessageUpdateOne) sqlSave(ctx context.Context) (_node *DiscordDirectMessage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discorddirectmessage.Table, discorddirectmessage.Columns, sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordDirectMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discorddirectmessage.FieldID)
+		for _, f := range fields {
+			if !discorddirectmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discorddirectmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(discorddirectmessage.FieldContent, field.TypeString, value)
+	}
+	_node = &DiscordDirectMessage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discorddirectmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
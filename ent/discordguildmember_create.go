@@ -0,0 +1,782 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discorduser"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordGuildMemberCreate is the builder for creating a DiscordGuildMember entity.
+type DiscordGuildMemberCreate struct {
+	config
+	mutation *DiscordGuildMemberMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordGuildMemberCreate) SetGuildID(v string) *DiscordGuildMemberCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DiscordGuildMemberCreate) SetUserID(v string) *DiscordGuildMemberCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNickname sets the "nickname" field.
+func (_c *DiscordGuildMemberCreate) SetNickname(v string) *DiscordGuildMemberCreate {
+	_c.mutation.SetNickname(v)
+	return _c
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (_c *DiscordGuildMemberCreate) SetNillableNickname(v *string) *DiscordGuildMemberCreate {
+	if v != nil {
+		_c.SetNickname(*v)
+	}
+	return _c
+}
+
+// SetRoles sets the "roles" field.
+func (_c *DiscordGuildMemberCreate) SetRoles(v []string) *DiscordGuildMemberCreate {
+	_c.mutation.SetRoles(v)
+	return _c
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_c *DiscordGuildMemberCreate) SetJoinedAt(v time.Time) *DiscordGuildMemberCreate {
+	_c.mutation.SetJoinedAt(v)
+	return _c
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_c *DiscordGuildMemberCreate) SetNillableJoinedAt(v *time.Time) *DiscordGuildMemberCreate {
+	if v != nil {
+		_c.SetJoinedAt(*v)
+	}
+	return _c
+}
+
+// SetLeftAt sets the "left_at" field.
+func (_c *DiscordGuildMemberCreate) SetLeftAt(v time.Time) *DiscordGuildMemberCreate {
+	_c.mutation.SetLeftAt(v)
+	return _c
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (_c *DiscordGuildMemberCreate) SetNillableLeftAt(v *time.Time) *DiscordGuildMemberCreate {
+	if v != nil {
+		_c.SetLeftAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the DiscordUser entity.
+func (_c *DiscordGuildMemberCreate) SetUser(v *DiscordUser) *DiscordGuildMemberCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the DiscordGuildMemberMutation object of the builder.
+func (_c *DiscordGuildMemberCreate) Mutation() *DiscordGuildMemberMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordGuildMember in the database.
+func (_c *DiscordGuildMemberCreate) Save(ctx context.Context) (*DiscordGuildMember, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordGuildMemberCreate) SaveX(ctx context.Context) *DiscordGuildMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordGuildMemberCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordGuildMemberCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordGuildMemberCreate) check() error {
+	if _, ok := _c.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "DiscordGuildMember.guild_id"`)}
+	}
+	if v, ok := _c.mutation.GuildID(); ok {
+		if err := discordguildmember.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildMember.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DiscordGuildMember.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := discordguildmember.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildMember.user_id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DiscordGuildMember.user"`)}
+	}
+	return nil
+}
+
+func (_c *DiscordGuildMemberCreate) sqlSave(ctx context.Context) (*DiscordGuildMember, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordGuildMemberCreate) createSpec() (*DiscordGuildMember, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordGuildMember{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordguildmember.Table, sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordguildmember.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.Nickname(); ok {
+		_spec.SetField(discordguildmember.FieldNickname, field.TypeString, value)
+		_node.Nickname = value
+	}
+	if value, ok := _c.mutation.Roles(); ok {
+		_spec.SetField(discordguildmember.FieldRoles, field.TypeJSON, value)
+		_node.Roles = value
+	}
+	if value, ok := _c.mutation.JoinedAt(); ok {
+		_spec.SetField(discordguildmember.FieldJoinedAt, field.TypeTime, value)
+		_node.JoinedAt = value
+	}
+	if value, ok := _c.mutation.LeftAt(); ok {
+		_spec.SetField(discordguildmember.FieldLeftAt, field.TypeTime, value)
+		_node.LeftAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordguildmember.UserTable,
+			Columns: []string{discordguildmember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordGuildMember.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordGuildMemberUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordGuildMemberCreate) OnConflict(opts ...sql.ConflictOption) *DiscordGuildMemberUpsertOne {
+	_c.conflict = opts
+	return &DiscordGuildMemberUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordGuildMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordGuildMemberCreate) OnConflictColumns(columns ...string) *DiscordGuildMemberUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordGuildMemberUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordGuildMemberUpsertOne is the builder for "upsert"-ing
+	//  one DiscordGuildMember node.
+	DiscordGuildMemberUpsertOne struct {
+		create *DiscordGuildMemberCreate
+	}
+
+	// DiscordGuildMemberUpsert is the "OnConflict" setter.
+	DiscordGuildMemberUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetNickname sets the "nickname" field.
+func (u *DiscordGuildMemberUpsert) SetNickname(v string) *DiscordGuildMemberUpsert {
+	u.Set(discordguildmember.FieldNickname, v)
+	return u
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsert) UpdateNickname() *DiscordGuildMemberUpsert {
+	u.SetExcluded(discordguildmember.FieldNickname)
+	return u
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *DiscordGuildMemberUpsert) ClearNickname() *DiscordGuildMemberUpsert {
+	u.SetNull(discordguildmember.FieldNickname)
+	return u
+}
+
+// SetRoles sets the "roles" field.
+func (u *DiscordGuildMemberUpsert) SetRoles(v []string) *DiscordGuildMemberUpsert {
+	u.Set(discordguildmember.FieldRoles, v)
+	return u
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsert) UpdateRoles() *DiscordGuildMemberUpsert {
+	u.SetExcluded(discordguildmember.FieldRoles)
+	return u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *DiscordGuildMemberUpsert) ClearRoles() *DiscordGuildMemberUpsert {
+	u.SetNull(discordguildmember.FieldRoles)
+	return u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *DiscordGuildMemberUpsert) SetJoinedAt(v time.Time) *DiscordGuildMemberUpsert {
+	u.Set(discordguildmember.FieldJoinedAt, v)
+	return u
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsert) UpdateJoinedAt() *DiscordGuildMemberUpsert {
+	u.SetExcluded(discordguildmember.FieldJoinedAt)
+	return u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *DiscordGuildMemberUpsert) ClearJoinedAt() *DiscordGuildMemberUpsert {
+	u.SetNull(discordguildmember.FieldJoinedAt)
+	return u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *DiscordGuildMemberUpsert) SetLeftAt(v time.Time) *DiscordGuildMemberUpsert {
+	u.Set(discordguildmember.FieldLeftAt, v)
+	return u
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsert) UpdateLeftAt() *DiscordGuildMemberUpsert {
+	u.SetExcluded(discordguildmember.FieldLeftAt)
+	return u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *DiscordGuildMemberUpsert) ClearLeftAt() *DiscordGuildMemberUpsert {
+	u.SetNull(discordguildmember.FieldLeftAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DiscordGuildMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DiscordGuildMemberUpsertOne) UpdateNewValues() *DiscordGuildMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(discordguildmember.FieldGuildID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(discordguildmember.FieldUserID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordGuildMember.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordGuildMemberUpsertOne) Ignore() *DiscordGuildMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordGuildMemberUpsertOne) DoNothing() *DiscordGuildMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordGuildMemberCreate.OnConflict
+// documentation for more info.
+func (u *DiscordGuildMemberUpsertOne) Update(set func(*DiscordGuildMemberUpsert)) *DiscordGuildMemberUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordGuildMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNickname sets the "nickname" field.
+func (u *DiscordGuildMemberUpsertOne) SetNickname(v string) *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertOne) UpdateNickname() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *DiscordGuildMemberUpsertOne) ClearNickname() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearNickname()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *DiscordGuildMemberUpsertOne) SetRoles(v []string) *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertOne) UpdateRoles() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *DiscordGuildMemberUpsertOne) ClearRoles() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearRoles()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *DiscordGuildMemberUpsertOne) SetJoinedAt(v time.Time) *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertOne) UpdateJoinedAt() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *DiscordGuildMemberUpsertOne) ClearJoinedAt() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *DiscordGuildMemberUpsertOne) SetLeftAt(v time.Time) *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetLeftAt(v)
+	})
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertOne) UpdateLeftAt() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateLeftAt()
+	})
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *DiscordGuildMemberUpsertOne) ClearLeftAt() *DiscordGuildMemberUpsertOne {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearLeftAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordGuildMemberUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordGuildMemberCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordGuildMemberUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordGuildMemberUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordGuildMemberUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordGuildMemberCreateBulk is the builder for creating many DiscordGuildMember entities in bulk.
+type DiscordGuildMemberCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordGuildMemberCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordGuildMember entities in the database.
+func (_c *DiscordGuildMemberCreateBulk) Save(ctx context.Context) ([]*DiscordGuildMember, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordGuildMember, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordGuildMemberMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordGuildMemberCreateBulk) SaveX(ctx context.Context) []*DiscordGuildMember {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordGuildMemberCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordGuildMemberCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordGuildMember.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordGuildMemberUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordGuildMemberCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordGuildMemberUpsertBulk {
+	_c.conflict = opts
+	return &DiscordGuildMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordGuildMember.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordGuildMemberCreateBulk) OnConflictColumns(columns ...string) *DiscordGuildMemberUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordGuildMemberUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordGuildMemberUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordGuildMember nodes.
+type DiscordGuildMemberUpsertBulk struct {
+	create *DiscordGuildMemberCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordGuildMember.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DiscordGuildMemberUpsertBulk) UpdateNewValues() *DiscordGuildMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(discordguildmember.FieldGuildID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(discordguildmember.FieldUserID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordGuildMember.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordGuildMemberUpsertBulk) Ignore() *DiscordGuildMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordGuildMemberUpsertBulk) DoNothing() *DiscordGuildMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordGuildMemberCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordGuildMemberUpsertBulk) Update(set func(*DiscordGuildMemberUpsert)) *DiscordGuildMemberUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordGuildMemberUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetNickname sets the "nickname" field.
+func (u *DiscordGuildMemberUpsertBulk) SetNickname(v string) *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetNickname(v)
+	})
+}
+
+// UpdateNickname sets the "nickname" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertBulk) UpdateNickname() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateNickname()
+	})
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (u *DiscordGuildMemberUpsertBulk) ClearNickname() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearNickname()
+	})
+}
+
+// SetRoles sets the "roles" field.
+func (u *DiscordGuildMemberUpsertBulk) SetRoles(v []string) *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetRoles(v)
+	})
+}
+
+// UpdateRoles sets the "roles" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertBulk) UpdateRoles() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateRoles()
+	})
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (u *DiscordGuildMemberUpsertBulk) ClearRoles() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearRoles()
+	})
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (u *DiscordGuildMemberUpsertBulk) SetJoinedAt(v time.Time) *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetJoinedAt(v)
+	})
+}
+
+// UpdateJoinedAt sets the "joined_at" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertBulk) UpdateJoinedAt() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateJoinedAt()
+	})
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (u *DiscordGuildMemberUpsertBulk) ClearJoinedAt() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearJoinedAt()
+	})
+}
+
+// SetLeftAt sets the "left_at" field.
+func (u *DiscordGuildMemberUpsertBulk) SetLeftAt(v time.Time) *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.SetLeftAt(v)
+	})
+}
+
+// UpdateLeftAt sets the "left_at" field to the value that was provided on create.
+func (u *DiscordGuildMemberUpsertBulk) UpdateLeftAt() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.UpdateLeftAt()
+	})
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (u *DiscordGuildMemberUpsertBulk) ClearLeftAt() *DiscordGuildMemberUpsertBulk {
+	return u.Update(func(s *DiscordGuildMemberUpsert) {
+		s.ClearLeftAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordGuildMemberUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordGuildMemberCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordGuildMemberCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordGuildMemberUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,413 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordGuildMemberUpdate is the builder for updating DiscordGuildMember entities.
+type DiscordGuildMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordGuildMemberMutation
+}
+
+// Where appends a list predicates to the DiscordGuildMemberUpdate builder.
+func (_u *DiscordGuildMemberUpdate) Where(ps ...predicate.DiscordGuildMember) *DiscordGuildMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetNickname sets the "nickname" field.
+func (_u *DiscordGuildMemberUpdate) SetNickname(v string) *DiscordGuildMemberUpdate {
+	_u.mutation.SetNickname(v)
+	return _u
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (_u *DiscordGuildMemberUpdate) SetNillableNickname(v *string) *DiscordGuildMemberUpdate {
+	if v != nil {
+		_u.SetNickname(*v)
+	}
+	return _u
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (_u *DiscordGuildMemberUpdate) ClearNickname() *DiscordGuildMemberUpdate {
+	_u.mutation.ClearNickname()
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *DiscordGuildMemberUpdate) SetRoles(v []string) *DiscordGuildMemberUpdate {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *DiscordGuildMemberUpdate) AppendRoles(v []string) *DiscordGuildMemberUpdate {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (_u *DiscordGuildMemberUpdate) ClearRoles() *DiscordGuildMemberUpdate {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *DiscordGuildMemberUpdate) SetJoinedAt(v time.Time) *DiscordGuildMemberUpdate {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *DiscordGuildMemberUpdate) SetNillableJoinedAt(v *time.Time) *DiscordGuildMemberUpdate {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (_u *DiscordGuildMemberUpdate) ClearJoinedAt() *DiscordGuildMemberUpdate {
+	_u.mutation.ClearJoinedAt()
+	return _u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (_u *DiscordGuildMemberUpdate) SetLeftAt(v time.Time) *DiscordGuildMemberUpdate {
+	_u.mutation.SetLeftAt(v)
+	return _u
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (_u *DiscordGuildMemberUpdate) SetNillableLeftAt(v *time.Time) *DiscordGuildMemberUpdate {
+	if v != nil {
+		_u.SetLeftAt(*v)
+	}
+	return _u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (_u *DiscordGuildMemberUpdate) ClearLeftAt() *DiscordGuildMemberUpdate {
+	_u.mutation.ClearLeftAt()
+	return _u
+}
+
+// Mutation returns the DiscordGuildMemberMutation object of the builder.
+func (_u *DiscordGuildMemberUpdate) Mutation() *DiscordGuildMemberMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordGuildMemberUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordGuildMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordGuildMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordGuildMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordGuildMemberUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordGuildMember.user"`)
+	}
+	return nil
+}
+
+func (_u *DiscordGuildMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordguildmember.Table, discordguildmember.Columns, sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Nickname(); ok {
+		_spec.SetField(discordguildmember.FieldNickname, field.TypeString, value)
+	}
+	if _u.mutation.NicknameCleared() {
+		_spec.ClearField(discordguildmember.FieldNickname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(discordguildmember.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildmember.FieldRoles, value)
+		})
+	}
+	if _u.mutation.RolesCleared() {
+		_spec.ClearField(discordguildmember.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(discordguildmember.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JoinedAtCleared() {
+		_spec.ClearField(discordguildmember.FieldJoinedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LeftAt(); ok {
+		_spec.SetField(discordguildmember.FieldLeftAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeftAtCleared() {
+		_spec.ClearField(discordguildmember.FieldLeftAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordguildmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordGuildMemberUpdateOne is the builder for updating a single DiscordGuildMember entity.
+type DiscordGuildMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordGuildMemberMutation
+}
+
+// SetNickname sets the "nickname" field.
+func (_u *DiscordGuildMemberUpdateOne) SetNickname(v string) *DiscordGuildMemberUpdateOne {
+	_u.mutation.SetNickname(v)
+	return _u
+}
+
+// SetNillableNickname sets the "nickname" field if the given value is not nil.
+func (_u *DiscordGuildMemberUpdateOne) SetNillableNickname(v *string) *DiscordGuildMemberUpdateOne {
+	if v != nil {
+		_u.SetNickname(*v)
+	}
+	return _u
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (_u *DiscordGuildMemberUpdateOne) ClearNickname() *DiscordGuildMemberUpdateOne {
+	_u.mutation.ClearNickname()
+	return _u
+}
+
+// SetRoles sets the "roles" field.
+func (_u *DiscordGuildMemberUpdateOne) SetRoles(v []string) *DiscordGuildMemberUpdateOne {
+	_u.mutation.SetRoles(v)
+	return _u
+}
+
+// AppendRoles appends value to the "roles" field.
+func (_u *DiscordGuildMemberUpdateOne) AppendRoles(v []string) *DiscordGuildMemberUpdateOne {
+	_u.mutation.AppendRoles(v)
+	return _u
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (_u *DiscordGuildMemberUpdateOne) ClearRoles() *DiscordGuildMemberUpdateOne {
+	_u.mutation.ClearRoles()
+	return _u
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (_u *DiscordGuildMemberUpdateOne) SetJoinedAt(v time.Time) *DiscordGuildMemberUpdateOne {
+	_u.mutation.SetJoinedAt(v)
+	return _u
+}
+
+// SetNillableJoinedAt sets the "joined_at" field if the given value is not nil.
+func (_u *DiscordGuildMemberUpdateOne) SetNillableJoinedAt(v *time.Time) *DiscordGuildMemberUpdateOne {
+	if v != nil {
+		_u.SetJoinedAt(*v)
+	}
+	return _u
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (_u *DiscordGuildMemberUpdateOne) ClearJoinedAt() *DiscordGuildMemberUpdateOne {
+	_u.mutation.ClearJoinedAt()
+	return _u
+}
+
+// SetLeftAt sets the "left_at" field.
+func (_u *DiscordGuildMemberUpdateOne) SetLeftAt(v time.Time) *DiscordGuildMemberUpdateOne {
+	_u.mutation.SetLeftAt(v)
+	return _u
+}
+
+// SetNillableLeftAt sets the "left_at" field if the given value is not nil.
+func (_u *DiscordGuildMemberUpdateOne) SetNillableLeftAt(v *time.Time) *DiscordGuildMemberUpdateOne {
+	if v != nil {
+		_u.SetLeftAt(*v)
+	}
+	return _u
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (_u *DiscordGuildMemberUpdateOne) ClearLeftAt() *DiscordGuildMemberUpdateOne {
+	_u.mutation.ClearLeftAt()
+	return _u
+}
+
+// Mutation returns the DiscordGuildMemberMutation object of the builder.
+func (_u *DiscordGuildMemberUpdateOne) Mutation() *DiscordGuildMemberMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordGuildMemberUpdate builder.
+func (_u *DiscordGuildMemberUpdateOne) Where(ps ...predicate.DiscordGuildMember) *DiscordGuildMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordGuildMemberUpdateOne) Select(field string, fields ...string) *DiscordGuildMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordGuildMember entity.
+func (_u *DiscordGuildMemberUpdateOne) Save(ctx context.Context) (*DiscordGuildMember, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordGuildMemberUpdateOne) SaveX(ctx context.Context) *DiscordGuildMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordGuildMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordGuildMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordGuildMemberUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordGuildMember.user"`)
+	}
+	return nil
+}
+
+func (_u *DiscordGuildMemberUpdateOne) sqlSave(ctx context.Context) (_node *DiscordGuildMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordguildmember.Table, discordguildmember.Columns, sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordGuildMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordguildmember.FieldID)
+		for _, f := range fields {
+			if !discordguildmember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordguildmember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Nickname(); ok {
+		_spec.SetField(discordguildmember.FieldNickname, field.TypeString, value)
+	}
+	if _u.mutation.NicknameCleared() {
+		_spec.ClearField(discordguildmember.FieldNickname, field.TypeString)
+	}
+	if value, ok := _u.mutation.Roles(); ok {
+		_spec.SetField(discordguildmember.FieldRoles, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRoles(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildmember.FieldRoles, value)
+		})
+	}
+	if _u.mutation.RolesCleared() {
+		_spec.ClearField(discordguildmember.FieldRoles, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.JoinedAt(); ok {
+		_spec.SetField(discordguildmember.FieldJoinedAt, field.TypeTime, value)
+	}
+	if _u.mutation.JoinedAtCleared() {
+		_spec.ClearField(discordguildmember.FieldJoinedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LeftAt(); ok {
+		_spec.SetField(discordguildmember.FieldLeftAt, field.TypeTime, value)
+	}
+	if _u.mutation.LeftAtCleared() {
+		_spec.ClearField(discordguildmember.FieldLeftAt, field.TypeTime)
+	}
+	_node = &DiscordGuildMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordguildmember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
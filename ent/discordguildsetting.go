@@ -0,0 +1,263 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sev0/ent/discordguildsetting"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DiscordGuildSetting is the model entity for the DiscordGuildSetting schema.
+type DiscordGuildSetting struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ChimeIn holds the value of the "chime_in" field.
+	ChimeIn bool `json:"chime_in,omitempty"`
+	// ChimeInProbability holds the value of the "chime_in_probability" field.
+	ChimeInProbability float64 `json:"chime_in_probability,omitempty"`
+	// ChimeInThreshold holds the value of the "chime_in_threshold" field.
+	ChimeInThreshold float64 `json:"chime_in_threshold,omitempty"`
+	// ChimeInCooldown holds the value of the "chime_in_cooldown" field.
+	ChimeInCooldown int `json:"chime_in_cooldown,omitempty"`
+	// ModerationDenylist holds the value of the "moderation_denylist" field.
+	ModerationDenylist []string `json:"moderation_denylist,omitempty"`
+	// ModerationDenylistAction holds the value of the "moderation_denylist_action" field.
+	ModerationDenylistAction discordguildsetting.ModerationDenylistAction `json:"moderation_denylist_action,omitempty"`
+	// ModerationRedact holds the value of the "moderation_redact" field.
+	ModerationRedact bool `json:"moderation_redact,omitempty"`
+	// ModerationClassifier holds the value of the "moderation_classifier" field.
+	ModerationClassifier bool `json:"moderation_classifier,omitempty"`
+	// ModerationFlagThreshold holds the value of the "moderation_flag_threshold" field.
+	ModerationFlagThreshold float64 `json:"moderation_flag_threshold,omitempty"`
+	// ModerationBlockThreshold holds the value of the "moderation_block_threshold" field.
+	ModerationBlockThreshold float64 `json:"moderation_block_threshold,omitempty"`
+	// IngestAllowlist holds the value of the "ingest_allowlist" field.
+	IngestAllowlist []string `json:"ingest_allowlist,omitempty"`
+	// IngestDenylist holds the value of the "ingest_denylist" field.
+	IngestDenylist []string `json:"ingest_denylist,omitempty"`
+	// RetrievalDenylist holds the value of the "retrieval_denylist" field.
+	RetrievalDenylist []string `json:"retrieval_denylist,omitempty"`
+	// RestrictedChannels holds the value of the "restricted_channels" field.
+	RestrictedChannels []string `json:"restricted_channels,omitempty"`
+	selectValues       sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordGuildSetting) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discordguildsetting.FieldModerationDenylist, discordguildsetting.FieldIngestAllowlist, discordguildsetting.FieldIngestDenylist, discordguildsetting.FieldRetrievalDenylist, discordguildsetting.FieldRestrictedChannels:
+			values[i] = new([]byte)
+		case discordguildsetting.FieldChimeIn, discordguildsetting.FieldModerationRedact, discordguildsetting.FieldModerationClassifier:
+			values[i] = new(sql.NullBool)
+		case discordguildsetting.FieldChimeInProbability, discordguildsetting.FieldChimeInThreshold, discordguildsetting.FieldModerationFlagThreshold, discordguildsetting.FieldModerationBlockThreshold:
+			values[i] = new(sql.NullFloat64)
+		case discordguildsetting.FieldChimeInCooldown:
+			values[i] = new(sql.NullInt64)
+		case discordguildsetting.FieldID, discordguildsetting.FieldModerationDenylistAction:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordGuildSetting fields.
+func (_m *DiscordGuildSetting) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discordguildsetting.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case discordguildsetting.FieldChimeIn:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field chime_in", values[i])
+			} else if value.Valid {
+				_m.ChimeIn = value.Bool
+			}
+		case discordguildsetting.FieldChimeInProbability:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field chime_in_probability", values[i])
+			} else if value.Valid {
+				_m.ChimeInProbability = value.Float64
+			}
+		case discordguildsetting.FieldChimeInThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field chime_in_threshold", values[i])
+			} else if value.Valid {
+				_m.ChimeInThreshold = value.Float64
+			}
+		case discordguildsetting.FieldChimeInCooldown:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field chime_in_cooldown", values[i])
+			} else if value.Valid {
+				_m.ChimeInCooldown = int(value.Int64)
+			}
+		case discordguildsetting.FieldModerationDenylist:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_denylist", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.ModerationDenylist); err != nil {
+					return fmt.Errorf("unmarshal field moderation_denylist: %w", err)
+				}
+			}
+		case discordguildsetting.FieldModerationDenylistAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_denylist_action", values[i])
+			} else if value.Valid {
+				_m.ModerationDenylistAction = discordguildsetting.ModerationDenylistAction(value.String)
+			}
+		case discordguildsetting.FieldModerationRedact:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_redact", values[i])
+			} else if value.Valid {
+				_m.ModerationRedact = value.Bool
+			}
+		case discordguildsetting.FieldModerationClassifier:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_classifier", values[i])
+			} else if value.Valid {
+				_m.ModerationClassifier = value.Bool
+			}
+		case discordguildsetting.FieldModerationFlagThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_flag_threshold", values[i])
+			} else if value.Valid {
+				_m.ModerationFlagThreshold = value.Float64
+			}
+		case discordguildsetting.FieldModerationBlockThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field moderation_block_threshold", values[i])
+			} else if value.Valid {
+				_m.ModerationBlockThreshold = value.Float64
+			}
+		case discordguildsetting.FieldIngestAllowlist:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ingest_allowlist", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IngestAllowlist); err != nil {
+					return fmt.Errorf("unmarshal field ingest_allowlist: %w", err)
+				}
+			}
+		case discordguildsetting.FieldIngestDenylist:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field ingest_denylist", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.IngestDenylist); err != nil {
+					return fmt.Errorf("unmarshal field ingest_denylist: %w", err)
+				}
+			}
+		case discordguildsetting.FieldRetrievalDenylist:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field retrieval_denylist", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RetrievalDenylist); err != nil {
+					return fmt.Errorf("unmarshal field retrieval_denylist: %w", err)
+				}
+			}
+		case discordguildsetting.FieldRestrictedChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field restricted_channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.RestrictedChannels); err != nil {
+					return fmt.Errorf("unmarshal field restricted_channels: %w", err)
+				}
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordGuildSetting.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordGuildSetting) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DiscordGuildSetting.
+// Note that you need to call DiscordGuildSetting.Unwrap() before calling this method if this DiscordGuildSetting
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordGuildSetting) Update() *DiscordGuildSettingUpdateOne {
+	return NewDiscordGuildSettingClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordGuildSetting entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordGuildSetting) Unwrap() *DiscordGuildSetting {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordGuildSetting is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordGuildSetting) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordGuildSetting(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("chime_in=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChimeIn))
+	builder.WriteString(", ")
+	builder.WriteString("chime_in_probability=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChimeInProbability))
+	builder.WriteString(", ")
+	builder.WriteString("chime_in_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChimeInThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("chime_in_cooldown=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChimeInCooldown))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_denylist=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationDenylist))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_denylist_action=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationDenylistAction))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_redact=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationRedact))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_classifier=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationClassifier))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_flag_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationFlagThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("moderation_block_threshold=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ModerationBlockThreshold))
+	builder.WriteString(", ")
+	builder.WriteString("ingest_allowlist=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IngestAllowlist))
+	builder.WriteString(", ")
+	builder.WriteString("ingest_denylist=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IngestDenylist))
+	builder.WriteString(", ")
+	builder.WriteString("retrieval_denylist=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RetrievalDenylist))
+	builder.WriteString(", ")
+	builder.WriteString("restricted_channels=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RestrictedChannels))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordGuildSettings is a parsable slice of DiscordGuildSetting.
+type DiscordGuildSettings []*DiscordGuildSetting
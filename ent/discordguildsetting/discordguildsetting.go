@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordguildsetting
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the discordguildsetting type in the database.
+	Label = "discord_guild_setting"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChimeIn holds the string denoting the chime_in field in the database.
+	FieldChimeIn = "chime_in"
+	// FieldChimeInProbability holds the string denoting the chime_in_probability field in the database.
+	FieldChimeInProbability = "chime_in_probability"
+	// FieldChimeInThreshold holds the string denoting the chime_in_threshold field in the database.
+	FieldChimeInThreshold = "chime_in_threshold"
+	// FieldChimeInCooldown holds the string denoting the chime_in_cooldown field in the database.
+	FieldChimeInCooldown = "chime_in_cooldown"
+	// FieldModerationDenylist holds the string denoting the moderation_denylist field in the database.
+	FieldModerationDenylist = "moderation_denylist"
+	// FieldModerationDenylistAction holds the string denoting the moderation_denylist_action field in the database.
+	FieldModerationDenylistAction = "moderation_denylist_action"
+	// FieldModerationRedact holds the string denoting the moderation_redact field in the database.
+	FieldModerationRedact = "moderation_redact"
+	// FieldModerationClassifier holds the string denoting the moderation_classifier field in the database.
+	FieldModerationClassifier = "moderation_classifier"
+	// FieldModerationFlagThreshold holds the string denoting the moderation_flag_threshold field in the database.
+	FieldModerationFlagThreshold = "moderation_flag_threshold"
+	// FieldModerationBlockThreshold holds the string denoting the moderation_block_threshold field in the database.
+	FieldModerationBlockThreshold = "moderation_block_threshold"
+	// FieldIngestAllowlist holds the string denoting the ingest_allowlist field in the database.
+	FieldIngestAllowlist = "ingest_allowlist"
+	// FieldIngestDenylist holds the string denoting the ingest_denylist field in the database.
+	FieldIngestDenylist = "ingest_denylist"
+	// FieldRetrievalDenylist holds the string denoting the retrieval_denylist field in the database.
+	FieldRetrievalDenylist = "retrieval_denylist"
+	// FieldRestrictedChannels holds the string denoting the restricted_channels field in the database.
+	FieldRestrictedChannels = "restricted_channels"
+	// Table holds the table name of the discordguildsetting in the database.
+	Table = "discord_guild_settings"
+)
+
+// Columns holds all SQL columns for discordguildsetting fields.
+var Columns = []string{
+	FieldID,
+	FieldChimeIn,
+	FieldChimeInProbability,
+	FieldChimeInThreshold,
+	FieldChimeInCooldown,
+	FieldModerationDenylist,
+	FieldModerationDenylistAction,
+	FieldModerationRedact,
+	FieldModerationClassifier,
+	FieldModerationFlagThreshold,
+	FieldModerationBlockThreshold,
+	FieldIngestAllowlist,
+	FieldIngestDenylist,
+	FieldRetrievalDenylist,
+	FieldRestrictedChannels,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChimeIn holds the default value on creation for the "chime_in" field.
+	DefaultChimeIn bool
+	// DefaultChimeInProbability holds the default value on creation for the "chime_in_probability" field.
+	DefaultChimeInProbability float64
+	// ChimeInProbabilityValidator is a validator for the "chime_in_probability" field. It is called by the builders before save.
+	ChimeInProbabilityValidator func(float64) error
+	// DefaultChimeInThreshold holds the default value on creation for the "chime_in_threshold" field.
+	DefaultChimeInThreshold float64
+	// ChimeInThresholdValidator is a validator for the "chime_in_threshold" field. It is called by the builders before save.
+	ChimeInThresholdValidator func(float64) error
+	// DefaultChimeInCooldown holds the default value on creation for the "chime_in_cooldown" field.
+	DefaultChimeInCooldown int
+	// ChimeInCooldownValidator is a validator for the "chime_in_cooldown" field. It is called by the builders before save.
+	ChimeInCooldownValidator func(int) error
+	// DefaultModerationRedact holds the default value on creation for the "moderation_redact" field.
+	DefaultModerationRedact bool
+	// DefaultModerationClassifier holds the default value on creation for the "moderation_classifier" field.
+	DefaultModerationClassifier bool
+	// DefaultModerationFlagThreshold holds the default value on creation for the "moderation_flag_threshold" field.
+	DefaultModerationFlagThreshold float64
+	// ModerationFlagThresholdValidator is a validator for the "moderation_flag_threshold" field. It is called by the builders before save.
+	ModerationFlagThresholdValidator func(float64) error
+	// DefaultModerationBlockThreshold holds the default value on creation for the "moderation_block_threshold" field.
+	DefaultModerationBlockThreshold float64
+	// ModerationBlockThresholdValidator is a validator for the "moderation_block_threshold" field. It is called by the builders before save.
+	ModerationBlockThresholdValidator func(float64) error
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// ModerationDenylistAction defines the type for the "moderation_denylist_action" enum field.
+type ModerationDenylistAction string
+
+// ModerationDenylistActionMask is the default value of the ModerationDenylistAction enum.
+const DefaultModerationDenylistAction = ModerationDenylistActionMask
+
+// ModerationDenylistAction values.
+const (
+	ModerationDenylistActionMask  ModerationDenylistAction = "mask"
+	ModerationDenylistActionBlock ModerationDenylistAction = "block"
+)
+
+func (mda ModerationDenylistAction) String() string {
+	return string(mda)
+}
+
+// ModerationDenylistActionValidator is a validator for the "moderation_denylist_action" field enum values. It is called by the builders before save.
+func ModerationDenylistActionValidator(mda ModerationDenylistAction) error {
+	switch mda {
+	case ModerationDenylistActionMask, ModerationDenylistActionBlock:
+		return nil
+	default:
+		return fmt.Errorf("discordguildsetting: invalid enum value for moderation_denylist_action field: %q", mda)
+	}
+}
+
+// OrderOption defines the ordering options for the DiscordGuildSetting queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByChimeIn orders the results by the chime_in field.
+func ByChimeIn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChimeIn, opts...).ToFunc()
+}
+
+// ByChimeInProbability orders the results by the chime_in_probability field.
+func ByChimeInProbability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChimeInProbability, opts...).ToFunc()
+}
+
+// ByChimeInThreshold orders the results by the chime_in_threshold field.
+func ByChimeInThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChimeInThreshold, opts...).ToFunc()
+}
+
+// ByChimeInCooldown orders the results by the chime_in_cooldown field.
+func ByChimeInCooldown(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChimeInCooldown, opts...).ToFunc()
+}
+
+// ByModerationDenylistAction orders the results by the moderation_denylist_action field.
+func ByModerationDenylistAction(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationDenylistAction, opts...).ToFunc()
+}
+
+// ByModerationRedact orders the results by the moderation_redact field.
+func ByModerationRedact(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationRedact, opts...).ToFunc()
+}
+
+// ByModerationClassifier orders the results by the moderation_classifier field.
+func ByModerationClassifier(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationClassifier, opts...).ToFunc()
+}
+
+// ByModerationFlagThreshold orders the results by the moderation_flag_threshold field.
+func ByModerationFlagThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationFlagThreshold, opts...).ToFunc()
+}
+
+// ByModerationBlockThreshold orders the results by the moderation_block_threshold field.
+func ByModerationBlockThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModerationBlockThreshold, opts...).ToFunc()
+}
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package discordguildsetting
+
+import (
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldContainsFold(FieldID, id))
+}
+
+// ChimeIn applies equality check predicate on the "chime_in" field. It's identical to ChimeInEQ.
+func ChimeIn(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeIn, v))
+}
+
+// ChimeInProbability applies equality check predicate on the "chime_in_probability" field. It's identical to ChimeInProbabilityEQ.
+func ChimeInProbability(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeInProbability, v))
+}
+
+// ChimeInThreshold applies equality check predicate on the "chime_in_threshold" field. It's identical to ChimeInThresholdEQ.
+func ChimeInThreshold(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeInThreshold, v))
+}
+
+// ChimeInCooldown applies equality check predicate on the "chime_in_cooldown" field. It's identical to ChimeInCooldownEQ.
+func ChimeInCooldown(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeInCooldown, v))
+}
+
+// ModerationRedact applies equality check predicate on the "moderation_redact" field. It's identical to ModerationRedactEQ.
+func ModerationRedact(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationRedact, v))
+}
+
+// ModerationClassifier applies equality check predicate on the "moderation_classifier" field. It's identical to ModerationClassifierEQ.
+func ModerationClassifier(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationClassifier, v))
+}
+
+// ModerationFlagThreshold applies equality check predicate on the "moderation_flag_threshold" field. It's identical to ModerationFlagThresholdEQ.
+func ModerationFlagThreshold(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationFlagThreshold, v))
+}
+
+// ModerationBlockThreshold applies equality check predicate on the "moderation_block_threshold" field. It's identical to ModerationBlockThresholdEQ.
+func ModerationBlockThreshold(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationBlockThreshold, v))
+}
+
+// ChimeInEQ applies the EQ predicate on the "chime_in" field.
+func ChimeInEQ(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeIn, v))
+}
+
+// ChimeInNEQ applies the NEQ predicate on the "chime_in" field.
+func ChimeInNEQ(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldChimeIn, v))
+}
+
+// ChimeInProbabilityEQ applies the EQ predicate on the "chime_in_probability" field.
+func ChimeInProbabilityEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeInProbability, v))
+}
+
+// ChimeInProbabilityNEQ applies the NEQ predicate on the "chime_in_probability" field.
+func ChimeInProbabilityNEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldChimeInProbability, v))
+}
+
+// ChimeInProbabilityIn applies the In predicate on the "chime_in_probability" field.
+func ChimeInProbabilityIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldChimeInProbability, vs...))
+}
+
+// ChimeInProbabilityNotIn applies the NotIn predicate on the "chime_in_probability" field.
+func ChimeInProbabilityNotIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldChimeInProbability, vs...))
+}
+
+// ChimeInProbabilityGT applies the GT predicate on the "chime_in_probability" field.
+func ChimeInProbabilityGT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGT(FieldChimeInProbability, v))
+}
+
+// ChimeInProbabilityGTE applies the GTE predicate on the "chime_in_probability" field.
+func ChimeInProbabilityGTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGTE(FieldChimeInProbability, v))
+}
+
+// ChimeInProbabilityLT applies the LT predicate on the "chime_in_probability" field.
+func ChimeInProbabilityLT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLT(FieldChimeInProbability, v))
+}
+
+// ChimeInProbabilityLTE applies the LTE predicate on the "chime_in_probability" field.
+func ChimeInProbabilityLTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLTE(FieldChimeInProbability, v))
+}
+
+// ChimeInThresholdEQ applies the EQ predicate on the "chime_in_threshold" field.
+func ChimeInThresholdEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeInThreshold, v))
+}
+
+// ChimeInThresholdNEQ applies the NEQ predicate on the "chime_in_threshold" field.
+func ChimeInThresholdNEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldChimeInThreshold, v))
+}
+
+// ChimeInThresholdIn applies the In predicate on the "chime_in_threshold" field.
+func ChimeInThresholdIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldChimeInThreshold, vs...))
+}
+
+// ChimeInThresholdNotIn applies the NotIn predicate on the "chime_in_threshold" field.
+func ChimeInThresholdNotIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldChimeInThreshold, vs...))
+}
+
+// ChimeInThresholdGT applies the GT predicate on the "chime_in_threshold" field.
+func ChimeInThresholdGT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGT(FieldChimeInThreshold, v))
+}
+
+// ChimeInThresholdGTE applies the GTE predicate on the "chime_in_threshold" field.
+func ChimeInThresholdGTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGTE(FieldChimeInThreshold, v))
+}
+
+// ChimeInThresholdLT applies the LT predicate on the "chime_in_threshold" field.
+func ChimeInThresholdLT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLT(FieldChimeInThreshold, v))
+}
+
+// ChimeInThresholdLTE applies the LTE predicate on the "chime_in_threshold" field.
+func ChimeInThresholdLTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLTE(FieldChimeInThreshold, v))
+}
+
+// ChimeInCooldownEQ applies the EQ predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownEQ(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldChimeInCooldown, v))
+}
+
+// ChimeInCooldownNEQ applies the NEQ predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownNEQ(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldChimeInCooldown, v))
+}
+
+// ChimeInCooldownIn applies the In predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownIn(vs ...int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldChimeInCooldown, vs...))
+}
+
+// ChimeInCooldownNotIn applies the NotIn predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownNotIn(vs ...int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldChimeInCooldown, vs...))
+}
+
+// ChimeInCooldownGT applies the GT predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownGT(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGT(FieldChimeInCooldown, v))
+}
+
+// ChimeInCooldownGTE applies the GTE predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownGTE(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGTE(FieldChimeInCooldown, v))
+}
+
+// ChimeInCooldownLT applies the LT predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownLT(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLT(FieldChimeInCooldown, v))
+}
+
+// ChimeInCooldownLTE applies the LTE predicate on the "chime_in_cooldown" field.
+func ChimeInCooldownLTE(v int) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLTE(FieldChimeInCooldown, v))
+}
+
+// ModerationDenylistIsNil applies the IsNil predicate on the "moderation_denylist" field.
+func ModerationDenylistIsNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIsNull(FieldModerationDenylist))
+}
+
+// ModerationDenylistNotNil applies the NotNil predicate on the "moderation_denylist" field.
+func ModerationDenylistNotNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotNull(FieldModerationDenylist))
+}
+
+// ModerationDenylistActionEQ applies the EQ predicate on the "moderation_denylist_action" field.
+func ModerationDenylistActionEQ(v ModerationDenylistAction) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationDenylistAction, v))
+}
+
+// ModerationDenylistActionNEQ applies the NEQ predicate on the "moderation_denylist_action" field.
+func ModerationDenylistActionNEQ(v ModerationDenylistAction) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldModerationDenylistAction, v))
+}
+
+// ModerationDenylistActionIn applies the In predicate on the "moderation_denylist_action" field.
+func ModerationDenylistActionIn(vs ...ModerationDenylistAction) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldModerationDenylistAction, vs...))
+}
+
+// ModerationDenylistActionNotIn applies the NotIn predicate on the "moderation_denylist_action" field.
+func ModerationDenylistActionNotIn(vs ...ModerationDenylistAction) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldModerationDenylistAction, vs...))
+}
+
+// ModerationRedactEQ applies the EQ predicate on the "moderation_redact" field.
+func ModerationRedactEQ(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationRedact, v))
+}
+
+// ModerationRedactNEQ applies the NEQ predicate on the "moderation_redact" field.
+func ModerationRedactNEQ(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldModerationRedact, v))
+}
+
+// ModerationClassifierEQ applies the EQ predicate on the "moderation_classifier" field.
+func ModerationClassifierEQ(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationClassifier, v))
+}
+
+// ModerationClassifierNEQ applies the NEQ predicate on the "moderation_classifier" field.
+func ModerationClassifierNEQ(v bool) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldModerationClassifier, v))
+}
+
+// ModerationFlagThresholdEQ applies the EQ predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationFlagThreshold, v))
+}
+
+// ModerationFlagThresholdNEQ applies the NEQ predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdNEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldModerationFlagThreshold, v))
+}
+
+// ModerationFlagThresholdIn applies the In predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldModerationFlagThreshold, vs...))
+}
+
+// ModerationFlagThresholdNotIn applies the NotIn predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdNotIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldModerationFlagThreshold, vs...))
+}
+
+// ModerationFlagThresholdGT applies the GT predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdGT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGT(FieldModerationFlagThreshold, v))
+}
+
+// ModerationFlagThresholdGTE applies the GTE predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdGTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGTE(FieldModerationFlagThreshold, v))
+}
+
+// ModerationFlagThresholdLT applies the LT predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdLT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLT(FieldModerationFlagThreshold, v))
+}
+
+// ModerationFlagThresholdLTE applies the LTE predicate on the "moderation_flag_threshold" field.
+func ModerationFlagThresholdLTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLTE(FieldModerationFlagThreshold, v))
+}
+
+// ModerationBlockThresholdEQ applies the EQ predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldEQ(FieldModerationBlockThreshold, v))
+}
+
+// ModerationBlockThresholdNEQ applies the NEQ predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdNEQ(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNEQ(FieldModerationBlockThreshold, v))
+}
+
+// ModerationBlockThresholdIn applies the In predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIn(FieldModerationBlockThreshold, vs...))
+}
+
+// ModerationBlockThresholdNotIn applies the NotIn predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdNotIn(vs ...float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotIn(FieldModerationBlockThreshold, vs...))
+}
+
+// ModerationBlockThresholdGT applies the GT predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdGT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGT(FieldModerationBlockThreshold, v))
+}
+
+// ModerationBlockThresholdGTE applies the GTE predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdGTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldGTE(FieldModerationBlockThreshold, v))
+}
+
+// ModerationBlockThresholdLT applies the LT predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdLT(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLT(FieldModerationBlockThreshold, v))
+}
+
+// ModerationBlockThresholdLTE applies the LTE predicate on the "moderation_block_threshold" field.
+func ModerationBlockThresholdLTE(v float64) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldLTE(FieldModerationBlockThreshold, v))
+}
+
+// IngestAllowlistIsNil applies the IsNil predicate on the "ingest_allowlist" field.
+func IngestAllowlistIsNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIsNull(FieldIngestAllowlist))
+}
+
+// IngestAllowlistNotNil applies the NotNil predicate on the "ingest_allowlist" field.
+func IngestAllowlistNotNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotNull(FieldIngestAllowlist))
+}
+
+// IngestDenylistIsNil applies the IsNil predicate on the "ingest_denylist" field.
+func IngestDenylistIsNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIsNull(FieldIngestDenylist))
+}
+
+// IngestDenylistNotNil applies the NotNil predicate on the "ingest_denylist" field.
+func IngestDenylistNotNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotNull(FieldIngestDenylist))
+}
+
+// RetrievalDenylistIsNil applies the IsNil predicate on the "retrieval_denylist" field.
+func RetrievalDenylistIsNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIsNull(FieldRetrievalDenylist))
+}
+
+// RetrievalDenylistNotNil applies the NotNil predicate on the "retrieval_denylist" field.
+func RetrievalDenylistNotNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotNull(FieldRetrievalDenylist))
+}
+
+// RestrictedChannelsIsNil applies the IsNil predicate on the "restricted_channels" field.
+func RestrictedChannelsIsNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldIsNull(FieldRestrictedChannels))
+}
+
+// RestrictedChannelsNotNil applies the NotNil predicate on the "restricted_channels" field.
+func RestrictedChannelsNotNil() predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.FieldNotNull(FieldRestrictedChannels))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DiscordGuildSetting) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DiscordGuildSetting) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DiscordGuildSetting) predicate.DiscordGuildSetting {
+	return predicate.DiscordGuildSetting(sql.NotPredicates(p))
+}
@@ -0,0 +1,1512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordguildsetting"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordGuildSettingCreate is the builder for creating a DiscordGuildSetting entity.
+type DiscordGuildSettingCreate struct {
+	config
+	mutation *DiscordGuildSettingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (_c *DiscordGuildSettingCreate) SetChimeIn(v bool) *DiscordGuildSettingCreate {
+	_c.mutation.SetChimeIn(v)
+	return _c
+}
+
+// SetNillableChimeIn sets the "chime_in" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableChimeIn(v *bool) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetChimeIn(*v)
+	}
+	return _c
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (_c *DiscordGuildSettingCreate) SetChimeInProbability(v float64) *DiscordGuildSettingCreate {
+	_c.mutation.SetChimeInProbability(v)
+	return _c
+}
+
+// SetNillableChimeInProbability sets the "chime_in_probability" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableChimeInProbability(v *float64) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetChimeInProbability(*v)
+	}
+	return _c
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (_c *DiscordGuildSettingCreate) SetChimeInThreshold(v float64) *DiscordGuildSettingCreate {
+	_c.mutation.SetChimeInThreshold(v)
+	return _c
+}
+
+// SetNillableChimeInThreshold sets the "chime_in_threshold" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableChimeInThreshold(v *float64) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetChimeInThreshold(*v)
+	}
+	return _c
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (_c *DiscordGuildSettingCreate) SetChimeInCooldown(v int) *DiscordGuildSettingCreate {
+	_c.mutation.SetChimeInCooldown(v)
+	return _c
+}
+
+// SetNillableChimeInCooldown sets the "chime_in_cooldown" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableChimeInCooldown(v *int) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetChimeInCooldown(*v)
+	}
+	return _c
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (_c *DiscordGuildSettingCreate) SetModerationDenylist(v []string) *DiscordGuildSettingCreate {
+	_c.mutation.SetModerationDenylist(v)
+	return _c
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (_c *DiscordGuildSettingCreate) SetModerationDenylistAction(v discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingCreate {
+	_c.mutation.SetModerationDenylistAction(v)
+	return _c
+}
+
+// SetNillableModerationDenylistAction sets the "moderation_denylist_action" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableModerationDenylistAction(v *discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetModerationDenylistAction(*v)
+	}
+	return _c
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (_c *DiscordGuildSettingCreate) SetModerationRedact(v bool) *DiscordGuildSettingCreate {
+	_c.mutation.SetModerationRedact(v)
+	return _c
+}
+
+// SetNillableModerationRedact sets the "moderation_redact" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableModerationRedact(v *bool) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetModerationRedact(*v)
+	}
+	return _c
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (_c *DiscordGuildSettingCreate) SetModerationClassifier(v bool) *DiscordGuildSettingCreate {
+	_c.mutation.SetModerationClassifier(v)
+	return _c
+}
+
+// SetNillableModerationClassifier sets the "moderation_classifier" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableModerationClassifier(v *bool) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetModerationClassifier(*v)
+	}
+	return _c
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (_c *DiscordGuildSettingCreate) SetModerationFlagThreshold(v float64) *DiscordGuildSettingCreate {
+	_c.mutation.SetModerationFlagThreshold(v)
+	return _c
+}
+
+// SetNillableModerationFlagThreshold sets the "moderation_flag_threshold" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableModerationFlagThreshold(v *float64) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetModerationFlagThreshold(*v)
+	}
+	return _c
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (_c *DiscordGuildSettingCreate) SetModerationBlockThreshold(v float64) *DiscordGuildSettingCreate {
+	_c.mutation.SetModerationBlockThreshold(v)
+	return _c
+}
+
+// SetNillableModerationBlockThreshold sets the "moderation_block_threshold" field if the given value is not nil.
+func (_c *DiscordGuildSettingCreate) SetNillableModerationBlockThreshold(v *float64) *DiscordGuildSettingCreate {
+	if v != nil {
+		_c.SetModerationBlockThreshold(*v)
+	}
+	return _c
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (_c *DiscordGuildSettingCreate) SetIngestAllowlist(v []string) *DiscordGuildSettingCreate {
+	_c.mutation.SetIngestAllowlist(v)
+	return _c
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (_c *DiscordGuildSettingCreate) SetIngestDenylist(v []string) *DiscordGuildSettingCreate {
+	_c.mutation.SetIngestDenylist(v)
+	return _c
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (_c *DiscordGuildSettingCreate) SetRetrievalDenylist(v []string) *DiscordGuildSettingCreate {
+	_c.mutation.SetRetrievalDenylist(v)
+	return _c
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (_c *DiscordGuildSettingCreate) SetRestrictedChannels(v []string) *DiscordGuildSettingCreate {
+	_c.mutation.SetRestrictedChannels(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordGuildSettingCreate) SetID(v string) *DiscordGuildSettingCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the DiscordGuildSettingMutation object of the builder.
+func (_c *DiscordGuildSettingCreate) Mutation() *DiscordGuildSettingMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordGuildSetting in the database.
+func (_c *DiscordGuildSettingCreate) Save(ctx context.Context) (*DiscordGuildSetting, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordGuildSettingCreate) SaveX(ctx context.Context) *DiscordGuildSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordGuildSettingCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordGuildSettingCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordGuildSettingCreate) defaults() {
+	if _, ok := _c.mutation.ChimeIn(); !ok {
+		v := discordguildsetting.DefaultChimeIn
+		_c.mutation.SetChimeIn(v)
+	}
+	if _, ok := _c.mutation.ChimeInProbability(); !ok {
+		v := discordguildsetting.DefaultChimeInProbability
+		_c.mutation.SetChimeInProbability(v)
+	}
+	if _, ok := _c.mutation.ChimeInThreshold(); !ok {
+		v := discordguildsetting.DefaultChimeInThreshold
+		_c.mutation.SetChimeInThreshold(v)
+	}
+	if _, ok := _c.mutation.ChimeInCooldown(); !ok {
+		v := discordguildsetting.DefaultChimeInCooldown
+		_c.mutation.SetChimeInCooldown(v)
+	}
+	if _, ok := _c.mutation.ModerationDenylistAction(); !ok {
+		v := discordguildsetting.DefaultModerationDenylistAction
+		_c.mutation.SetModerationDenylistAction(v)
+	}
+	if _, ok := _c.mutation.ModerationRedact(); !ok {
+		v := discordguildsetting.DefaultModerationRedact
+		_c.mutation.SetModerationRedact(v)
+	}
+	if _, ok := _c.mutation.ModerationClassifier(); !ok {
+		v := discordguildsetting.DefaultModerationClassifier
+		_c.mutation.SetModerationClassifier(v)
+	}
+	if _, ok := _c.mutation.ModerationFlagThreshold(); !ok {
+		v := discordguildsetting.DefaultModerationFlagThreshold
+		_c.mutation.SetModerationFlagThreshold(v)
+	}
+	if _, ok := _c.mutation.ModerationBlockThreshold(); !ok {
+		v := discordguildsetting.DefaultModerationBlockThreshold
+		_c.mutation.SetModerationBlockThreshold(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordGuildSettingCreate) check() error {
+	if _, ok := _c.mutation.ChimeIn(); !ok {
+		return &ValidationError{Name: "chime_in", err: errors.New(`ent: missing required field "DiscordGuildSetting.chime_in"`)}
+	}
+	if _, ok := _c.mutation.ChimeInProbability(); !ok {
+		return &ValidationError{Name: "chime_in_probability", err: errors.New(`ent: missing required field "DiscordGuildSetting.chime_in_probability"`)}
+	}
+	if v, ok := _c.mutation.ChimeInProbability(); ok {
+		if err := discordguildsetting.ChimeInProbabilityValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_probability", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_probability": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChimeInThreshold(); !ok {
+		return &ValidationError{Name: "chime_in_threshold", err: errors.New(`ent: missing required field "DiscordGuildSetting.chime_in_threshold"`)}
+	}
+	if v, ok := _c.mutation.ChimeInThreshold(); ok {
+		if err := discordguildsetting.ChimeInThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChimeInCooldown(); !ok {
+		return &ValidationError{Name: "chime_in_cooldown", err: errors.New(`ent: missing required field "DiscordGuildSetting.chime_in_cooldown"`)}
+	}
+	if v, ok := _c.mutation.ChimeInCooldown(); ok {
+		if err := discordguildsetting.ChimeInCooldownValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_cooldown", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_cooldown": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModerationDenylistAction(); !ok {
+		return &ValidationError{Name: "moderation_denylist_action", err: errors.New(`ent: missing required field "DiscordGuildSetting.moderation_denylist_action"`)}
+	}
+	if v, ok := _c.mutation.ModerationDenylistAction(); ok {
+		if err := discordguildsetting.ModerationDenylistActionValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_denylist_action", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_denylist_action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModerationRedact(); !ok {
+		return &ValidationError{Name: "moderation_redact", err: errors.New(`ent: missing required field "DiscordGuildSetting.moderation_redact"`)}
+	}
+	if _, ok := _c.mutation.ModerationClassifier(); !ok {
+		return &ValidationError{Name: "moderation_classifier", err: errors.New(`ent: missing required field "DiscordGuildSetting.moderation_classifier"`)}
+	}
+	if _, ok := _c.mutation.ModerationFlagThreshold(); !ok {
+		return &ValidationError{Name: "moderation_flag_threshold", err: errors.New(`ent: missing required field "DiscordGuildSetting.moderation_flag_threshold"`)}
+	}
+	if v, ok := _c.mutation.ModerationFlagThreshold(); ok {
+		if err := discordguildsetting.ModerationFlagThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_flag_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_flag_threshold": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ModerationBlockThreshold(); !ok {
+		return &ValidationError{Name: "moderation_block_threshold", err: errors.New(`ent: missing required field "DiscordGuildSetting.moderation_block_threshold"`)}
+	}
+	if v, ok := _c.mutation.ModerationBlockThreshold(); ok {
+		if err := discordguildsetting.ModerationBlockThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_block_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_block_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordguildsetting.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscordGuildSettingCreate) sqlSave(ctx context.Context) (*DiscordGuildSetting, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordGuildSetting.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordGuildSettingCreate) createSpec() (*DiscordGuildSetting, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordGuildSetting{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordguildsetting.Table, sqlgraph.NewFieldSpec(discordguildsetting.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.ChimeIn(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeIn, field.TypeBool, value)
+		_node.ChimeIn = value
+	}
+	if value, ok := _c.mutation.ChimeInProbability(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInProbability, field.TypeFloat64, value)
+		_node.ChimeInProbability = value
+	}
+	if value, ok := _c.mutation.ChimeInThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInThreshold, field.TypeFloat64, value)
+		_node.ChimeInThreshold = value
+	}
+	if value, ok := _c.mutation.ChimeInCooldown(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInCooldown, field.TypeInt, value)
+		_node.ChimeInCooldown = value
+	}
+	if value, ok := _c.mutation.ModerationDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationDenylist, field.TypeJSON, value)
+		_node.ModerationDenylist = value
+	}
+	if value, ok := _c.mutation.ModerationDenylistAction(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationDenylistAction, field.TypeEnum, value)
+		_node.ModerationDenylistAction = value
+	}
+	if value, ok := _c.mutation.ModerationRedact(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationRedact, field.TypeBool, value)
+		_node.ModerationRedact = value
+	}
+	if value, ok := _c.mutation.ModerationClassifier(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationClassifier, field.TypeBool, value)
+		_node.ModerationClassifier = value
+	}
+	if value, ok := _c.mutation.ModerationFlagThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationFlagThreshold, field.TypeFloat64, value)
+		_node.ModerationFlagThreshold = value
+	}
+	if value, ok := _c.mutation.ModerationBlockThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationBlockThreshold, field.TypeFloat64, value)
+		_node.ModerationBlockThreshold = value
+	}
+	if value, ok := _c.mutation.IngestAllowlist(); ok {
+		_spec.SetField(discordguildsetting.FieldIngestAllowlist, field.TypeJSON, value)
+		_node.IngestAllowlist = value
+	}
+	if value, ok := _c.mutation.IngestDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldIngestDenylist, field.TypeJSON, value)
+		_node.IngestDenylist = value
+	}
+	if value, ok := _c.mutation.RetrievalDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldRetrievalDenylist, field.TypeJSON, value)
+		_node.RetrievalDenylist = value
+	}
+	if value, ok := _c.mutation.RestrictedChannels(); ok {
+		_spec.SetField(discordguildsetting.FieldRestrictedChannels, field.TypeJSON, value)
+		_node.RestrictedChannels = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordGuildSetting.Create().
+//		SetChimeIn(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordGuildSettingUpsert) {
+//			SetChimeIn(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordGuildSettingCreate) OnConflict(opts ...sql.ConflictOption) *DiscordGuildSettingUpsertOne {
+	_c.conflict = opts
+	return &DiscordGuildSettingUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordGuildSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordGuildSettingCreate) OnConflictColumns(columns ...string) *DiscordGuildSettingUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordGuildSettingUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordGuildSettingUpsertOne is the builder for "upsert"-ing
+	//  one DiscordGuildSetting node.
+	DiscordGuildSettingUpsertOne struct {
+		create *DiscordGuildSettingCreate
+	}
+
+	// DiscordGuildSettingUpsert is the "OnConflict" setter.
+	DiscordGuildSettingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetChimeIn sets the "chime_in" field.
+func (u *DiscordGuildSettingUpsert) SetChimeIn(v bool) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldChimeIn, v)
+	return u
+}
+
+// UpdateChimeIn sets the "chime_in" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateChimeIn() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldChimeIn)
+	return u
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (u *DiscordGuildSettingUpsert) SetChimeInProbability(v float64) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldChimeInProbability, v)
+	return u
+}
+
+// UpdateChimeInProbability sets the "chime_in_probability" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateChimeInProbability() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldChimeInProbability)
+	return u
+}
+
+// AddChimeInProbability adds v to the "chime_in_probability" field.
+func (u *DiscordGuildSettingUpsert) AddChimeInProbability(v float64) *DiscordGuildSettingUpsert {
+	u.Add(discordguildsetting.FieldChimeInProbability, v)
+	return u
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (u *DiscordGuildSettingUpsert) SetChimeInThreshold(v float64) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldChimeInThreshold, v)
+	return u
+}
+
+// UpdateChimeInThreshold sets the "chime_in_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateChimeInThreshold() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldChimeInThreshold)
+	return u
+}
+
+// AddChimeInThreshold adds v to the "chime_in_threshold" field.
+func (u *DiscordGuildSettingUpsert) AddChimeInThreshold(v float64) *DiscordGuildSettingUpsert {
+	u.Add(discordguildsetting.FieldChimeInThreshold, v)
+	return u
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (u *DiscordGuildSettingUpsert) SetChimeInCooldown(v int) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldChimeInCooldown, v)
+	return u
+}
+
+// UpdateChimeInCooldown sets the "chime_in_cooldown" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateChimeInCooldown() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldChimeInCooldown)
+	return u
+}
+
+// AddChimeInCooldown adds v to the "chime_in_cooldown" field.
+func (u *DiscordGuildSettingUpsert) AddChimeInCooldown(v int) *DiscordGuildSettingUpsert {
+	u.Add(discordguildsetting.FieldChimeInCooldown, v)
+	return u
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (u *DiscordGuildSettingUpsert) SetModerationDenylist(v []string) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldModerationDenylist, v)
+	return u
+}
+
+// UpdateModerationDenylist sets the "moderation_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateModerationDenylist() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldModerationDenylist)
+	return u
+}
+
+// ClearModerationDenylist clears the value of the "moderation_denylist" field.
+func (u *DiscordGuildSettingUpsert) ClearModerationDenylist() *DiscordGuildSettingUpsert {
+	u.SetNull(discordguildsetting.FieldModerationDenylist)
+	return u
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (u *DiscordGuildSettingUpsert) SetModerationDenylistAction(v discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldModerationDenylistAction, v)
+	return u
+}
+
+// UpdateModerationDenylistAction sets the "moderation_denylist_action" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateModerationDenylistAction() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldModerationDenylistAction)
+	return u
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (u *DiscordGuildSettingUpsert) SetModerationRedact(v bool) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldModerationRedact, v)
+	return u
+}
+
+// UpdateModerationRedact sets the "moderation_redact" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateModerationRedact() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldModerationRedact)
+	return u
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (u *DiscordGuildSettingUpsert) SetModerationClassifier(v bool) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldModerationClassifier, v)
+	return u
+}
+
+// UpdateModerationClassifier sets the "moderation_classifier" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateModerationClassifier() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldModerationClassifier)
+	return u
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (u *DiscordGuildSettingUpsert) SetModerationFlagThreshold(v float64) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldModerationFlagThreshold, v)
+	return u
+}
+
+// UpdateModerationFlagThreshold sets the "moderation_flag_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateModerationFlagThreshold() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldModerationFlagThreshold)
+	return u
+}
+
+// AddModerationFlagThreshold adds v to the "moderation_flag_threshold" field.
+func (u *DiscordGuildSettingUpsert) AddModerationFlagThreshold(v float64) *DiscordGuildSettingUpsert {
+	u.Add(discordguildsetting.FieldModerationFlagThreshold, v)
+	return u
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (u *DiscordGuildSettingUpsert) SetModerationBlockThreshold(v float64) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldModerationBlockThreshold, v)
+	return u
+}
+
+// UpdateModerationBlockThreshold sets the "moderation_block_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateModerationBlockThreshold() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldModerationBlockThreshold)
+	return u
+}
+
+// AddModerationBlockThreshold adds v to the "moderation_block_threshold" field.
+func (u *DiscordGuildSettingUpsert) AddModerationBlockThreshold(v float64) *DiscordGuildSettingUpsert {
+	u.Add(discordguildsetting.FieldModerationBlockThreshold, v)
+	return u
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (u *DiscordGuildSettingUpsert) SetIngestAllowlist(v []string) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldIngestAllowlist, v)
+	return u
+}
+
+// UpdateIngestAllowlist sets the "ingest_allowlist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateIngestAllowlist() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldIngestAllowlist)
+	return u
+}
+
+// ClearIngestAllowlist clears the value of the "ingest_allowlist" field.
+func (u *DiscordGuildSettingUpsert) ClearIngestAllowlist() *DiscordGuildSettingUpsert {
+	u.SetNull(discordguildsetting.FieldIngestAllowlist)
+	return u
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (u *DiscordGuildSettingUpsert) SetIngestDenylist(v []string) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldIngestDenylist, v)
+	return u
+}
+
+// UpdateIngestDenylist sets the "ingest_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateIngestDenylist() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldIngestDenylist)
+	return u
+}
+
+// ClearIngestDenylist clears the value of the "ingest_denylist" field.
+func (u *DiscordGuildSettingUpsert) ClearIngestDenylist() *DiscordGuildSettingUpsert {
+	u.SetNull(discordguildsetting.FieldIngestDenylist)
+	return u
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (u *DiscordGuildSettingUpsert) SetRetrievalDenylist(v []string) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldRetrievalDenylist, v)
+	return u
+}
+
+// UpdateRetrievalDenylist sets the "retrieval_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateRetrievalDenylist() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldRetrievalDenylist)
+	return u
+}
+
+// ClearRetrievalDenylist clears the value of the "retrieval_denylist" field.
+func (u *DiscordGuildSettingUpsert) ClearRetrievalDenylist() *DiscordGuildSettingUpsert {
+	u.SetNull(discordguildsetting.FieldRetrievalDenylist)
+	return u
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (u *DiscordGuildSettingUpsert) SetRestrictedChannels(v []string) *DiscordGuildSettingUpsert {
+	u.Set(discordguildsetting.FieldRestrictedChannels, v)
+	return u
+}
+
+// UpdateRestrictedChannels sets the "restricted_channels" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsert) UpdateRestrictedChannels() *DiscordGuildSettingUpsert {
+	u.SetExcluded(discordguildsetting.FieldRestrictedChannels)
+	return u
+}
+
+// ClearRestrictedChannels clears the value of the "restricted_channels" field.
+func (u *DiscordGuildSettingUpsert) ClearRestrictedChannels() *DiscordGuildSettingUpsert {
+	u.SetNull(discordguildsetting.FieldRestrictedChannels)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordGuildSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordguildsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordGuildSettingUpsertOne) UpdateNewValues() *DiscordGuildSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordguildsetting.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordGuildSetting.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordGuildSettingUpsertOne) Ignore() *DiscordGuildSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordGuildSettingUpsertOne) DoNothing() *DiscordGuildSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordGuildSettingCreate.OnConflict
+// documentation for more info.
+func (u *DiscordGuildSettingUpsertOne) Update(set func(*DiscordGuildSettingUpsert)) *DiscordGuildSettingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordGuildSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (u *DiscordGuildSettingUpsertOne) SetChimeIn(v bool) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeIn(v)
+	})
+}
+
+// UpdateChimeIn sets the "chime_in" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateChimeIn() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeIn()
+	})
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (u *DiscordGuildSettingUpsertOne) SetChimeInProbability(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeInProbability(v)
+	})
+}
+
+// AddChimeInProbability adds v to the "chime_in_probability" field.
+func (u *DiscordGuildSettingUpsertOne) AddChimeInProbability(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddChimeInProbability(v)
+	})
+}
+
+// UpdateChimeInProbability sets the "chime_in_probability" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateChimeInProbability() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeInProbability()
+	})
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (u *DiscordGuildSettingUpsertOne) SetChimeInThreshold(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeInThreshold(v)
+	})
+}
+
+// AddChimeInThreshold adds v to the "chime_in_threshold" field.
+func (u *DiscordGuildSettingUpsertOne) AddChimeInThreshold(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddChimeInThreshold(v)
+	})
+}
+
+// UpdateChimeInThreshold sets the "chime_in_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateChimeInThreshold() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeInThreshold()
+	})
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (u *DiscordGuildSettingUpsertOne) SetChimeInCooldown(v int) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeInCooldown(v)
+	})
+}
+
+// AddChimeInCooldown adds v to the "chime_in_cooldown" field.
+func (u *DiscordGuildSettingUpsertOne) AddChimeInCooldown(v int) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddChimeInCooldown(v)
+	})
+}
+
+// UpdateChimeInCooldown sets the "chime_in_cooldown" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateChimeInCooldown() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeInCooldown()
+	})
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (u *DiscordGuildSettingUpsertOne) SetModerationDenylist(v []string) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationDenylist(v)
+	})
+}
+
+// UpdateModerationDenylist sets the "moderation_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateModerationDenylist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationDenylist()
+	})
+}
+
+// ClearModerationDenylist clears the value of the "moderation_denylist" field.
+func (u *DiscordGuildSettingUpsertOne) ClearModerationDenylist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearModerationDenylist()
+	})
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (u *DiscordGuildSettingUpsertOne) SetModerationDenylistAction(v discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationDenylistAction(v)
+	})
+}
+
+// UpdateModerationDenylistAction sets the "moderation_denylist_action" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateModerationDenylistAction() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationDenylistAction()
+	})
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (u *DiscordGuildSettingUpsertOne) SetModerationRedact(v bool) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationRedact(v)
+	})
+}
+
+// UpdateModerationRedact sets the "moderation_redact" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateModerationRedact() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationRedact()
+	})
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (u *DiscordGuildSettingUpsertOne) SetModerationClassifier(v bool) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationClassifier(v)
+	})
+}
+
+// UpdateModerationClassifier sets the "moderation_classifier" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateModerationClassifier() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationClassifier()
+	})
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (u *DiscordGuildSettingUpsertOne) SetModerationFlagThreshold(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationFlagThreshold(v)
+	})
+}
+
+// AddModerationFlagThreshold adds v to the "moderation_flag_threshold" field.
+func (u *DiscordGuildSettingUpsertOne) AddModerationFlagThreshold(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddModerationFlagThreshold(v)
+	})
+}
+
+// UpdateModerationFlagThreshold sets the "moderation_flag_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateModerationFlagThreshold() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationFlagThreshold()
+	})
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (u *DiscordGuildSettingUpsertOne) SetModerationBlockThreshold(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationBlockThreshold(v)
+	})
+}
+
+// AddModerationBlockThreshold adds v to the "moderation_block_threshold" field.
+func (u *DiscordGuildSettingUpsertOne) AddModerationBlockThreshold(v float64) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddModerationBlockThreshold(v)
+	})
+}
+
+// UpdateModerationBlockThreshold sets the "moderation_block_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateModerationBlockThreshold() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationBlockThreshold()
+	})
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (u *DiscordGuildSettingUpsertOne) SetIngestAllowlist(v []string) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetIngestAllowlist(v)
+	})
+}
+
+// UpdateIngestAllowlist sets the "ingest_allowlist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateIngestAllowlist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateIngestAllowlist()
+	})
+}
+
+// ClearIngestAllowlist clears the value of the "ingest_allowlist" field.
+func (u *DiscordGuildSettingUpsertOne) ClearIngestAllowlist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearIngestAllowlist()
+	})
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (u *DiscordGuildSettingUpsertOne) SetIngestDenylist(v []string) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetIngestDenylist(v)
+	})
+}
+
+// UpdateIngestDenylist sets the "ingest_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateIngestDenylist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateIngestDenylist()
+	})
+}
+
+// ClearIngestDenylist clears the value of the "ingest_denylist" field.
+func (u *DiscordGuildSettingUpsertOne) ClearIngestDenylist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearIngestDenylist()
+	})
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (u *DiscordGuildSettingUpsertOne) SetRetrievalDenylist(v []string) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetRetrievalDenylist(v)
+	})
+}
+
+// UpdateRetrievalDenylist sets the "retrieval_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateRetrievalDenylist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateRetrievalDenylist()
+	})
+}
+
+// ClearRetrievalDenylist clears the value of the "retrieval_denylist" field.
+func (u *DiscordGuildSettingUpsertOne) ClearRetrievalDenylist() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearRetrievalDenylist()
+	})
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (u *DiscordGuildSettingUpsertOne) SetRestrictedChannels(v []string) *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetRestrictedChannels(v)
+	})
+}
+
+// UpdateRestrictedChannels sets the "restricted_channels" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertOne) UpdateRestrictedChannels() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateRestrictedChannels()
+	})
+}
+
+// ClearRestrictedChannels clears the value of the "restricted_channels" field.
+func (u *DiscordGuildSettingUpsertOne) ClearRestrictedChannels() *DiscordGuildSettingUpsertOne {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearRestrictedChannels()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordGuildSettingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordGuildSettingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordGuildSettingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordGuildSettingUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordGuildSettingUpsertOne.ID is not supported by MySQL driver. Use DiscordGuildSettingUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordGuildSettingUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordGuildSettingCreateBulk is the builder for creating many DiscordGuildSetting entities in bulk.
+type DiscordGuildSettingCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordGuildSettingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordGuildSetting entities in the database.
+func (_c *DiscordGuildSettingCreateBulk) Save(ctx context.Context) ([]*DiscordGuildSetting, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordGuildSetting, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordGuildSettingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordGuildSettingCreateBulk) SaveX(ctx context.Context) []*DiscordGuildSetting {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordGuildSettingCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordGuildSettingCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordGuildSetting.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordGuildSettingUpsert) {
+//			SetChimeIn(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordGuildSettingCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordGuildSettingUpsertBulk {
+	_c.conflict = opts
+	return &DiscordGuildSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordGuildSetting.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordGuildSettingCreateBulk) OnConflictColumns(columns ...string) *DiscordGuildSettingUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordGuildSettingUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordGuildSettingUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordGuildSetting nodes.
+type DiscordGuildSettingUpsertBulk struct {
+	create *DiscordGuildSettingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordGuildSetting.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordguildsetting.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordGuildSettingUpsertBulk) UpdateNewValues() *DiscordGuildSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordguildsetting.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordGuildSetting.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordGuildSettingUpsertBulk) Ignore() *DiscordGuildSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordGuildSettingUpsertBulk) DoNothing() *DiscordGuildSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordGuildSettingCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordGuildSettingUpsertBulk) Update(set func(*DiscordGuildSettingUpsert)) *DiscordGuildSettingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordGuildSettingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (u *DiscordGuildSettingUpsertBulk) SetChimeIn(v bool) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeIn(v)
+	})
+}
+
+// UpdateChimeIn sets the "chime_in" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateChimeIn() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeIn()
+	})
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (u *DiscordGuildSettingUpsertBulk) SetChimeInProbability(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeInProbability(v)
+	})
+}
+
+// AddChimeInProbability adds v to the "chime_in_probability" field.
+func (u *DiscordGuildSettingUpsertBulk) AddChimeInProbability(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddChimeInProbability(v)
+	})
+}
+
+// UpdateChimeInProbability sets the "chime_in_probability" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateChimeInProbability() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeInProbability()
+	})
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (u *DiscordGuildSettingUpsertBulk) SetChimeInThreshold(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeInThreshold(v)
+	})
+}
+
+// AddChimeInThreshold adds v to the "chime_in_threshold" field.
+func (u *DiscordGuildSettingUpsertBulk) AddChimeInThreshold(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddChimeInThreshold(v)
+	})
+}
+
+// UpdateChimeInThreshold sets the "chime_in_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateChimeInThreshold() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeInThreshold()
+	})
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (u *DiscordGuildSettingUpsertBulk) SetChimeInCooldown(v int) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetChimeInCooldown(v)
+	})
+}
+
+// AddChimeInCooldown adds v to the "chime_in_cooldown" field.
+func (u *DiscordGuildSettingUpsertBulk) AddChimeInCooldown(v int) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddChimeInCooldown(v)
+	})
+}
+
+// UpdateChimeInCooldown sets the "chime_in_cooldown" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateChimeInCooldown() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateChimeInCooldown()
+	})
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (u *DiscordGuildSettingUpsertBulk) SetModerationDenylist(v []string) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationDenylist(v)
+	})
+}
+
+// UpdateModerationDenylist sets the "moderation_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateModerationDenylist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationDenylist()
+	})
+}
+
+// ClearModerationDenylist clears the value of the "moderation_denylist" field.
+func (u *DiscordGuildSettingUpsertBulk) ClearModerationDenylist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearModerationDenylist()
+	})
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (u *DiscordGuildSettingUpsertBulk) SetModerationDenylistAction(v discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationDenylistAction(v)
+	})
+}
+
+// UpdateModerationDenylistAction sets the "moderation_denylist_action" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateModerationDenylistAction() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationDenylistAction()
+	})
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (u *DiscordGuildSettingUpsertBulk) SetModerationRedact(v bool) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationRedact(v)
+	})
+}
+
+// UpdateModerationRedact sets the "moderation_redact" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateModerationRedact() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationRedact()
+	})
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (u *DiscordGuildSettingUpsertBulk) SetModerationClassifier(v bool) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationClassifier(v)
+	})
+}
+
+// UpdateModerationClassifier sets the "moderation_classifier" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateModerationClassifier() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationClassifier()
+	})
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (u *DiscordGuildSettingUpsertBulk) SetModerationFlagThreshold(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationFlagThreshold(v)
+	})
+}
+
+// AddModerationFlagThreshold adds v to the "moderation_flag_threshold" field.
+func (u *DiscordGuildSettingUpsertBulk) AddModerationFlagThreshold(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddModerationFlagThreshold(v)
+	})
+}
+
+// UpdateModerationFlagThreshold sets the "moderation_flag_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateModerationFlagThreshold() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationFlagThreshold()
+	})
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (u *DiscordGuildSettingUpsertBulk) SetModerationBlockThreshold(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetModerationBlockThreshold(v)
+	})
+}
+
+// AddModerationBlockThreshold adds v to the "moderation_block_threshold" field.
+func (u *DiscordGuildSettingUpsertBulk) AddModerationBlockThreshold(v float64) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.AddModerationBlockThreshold(v)
+	})
+}
+
+// UpdateModerationBlockThreshold sets the "moderation_block_threshold" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateModerationBlockThreshold() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateModerationBlockThreshold()
+	})
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (u *DiscordGuildSettingUpsertBulk) SetIngestAllowlist(v []string) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetIngestAllowlist(v)
+	})
+}
+
+// UpdateIngestAllowlist sets the "ingest_allowlist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateIngestAllowlist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateIngestAllowlist()
+	})
+}
+
+// ClearIngestAllowlist clears the value of the "ingest_allowlist" field.
+func (u *DiscordGuildSettingUpsertBulk) ClearIngestAllowlist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearIngestAllowlist()
+	})
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (u *DiscordGuildSettingUpsertBulk) SetIngestDenylist(v []string) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetIngestDenylist(v)
+	})
+}
+
+// UpdateIngestDenylist sets the "ingest_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateIngestDenylist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateIngestDenylist()
+	})
+}
+
+// ClearIngestDenylist clears the value of the "ingest_denylist" field.
+func (u *DiscordGuildSettingUpsertBulk) ClearIngestDenylist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearIngestDenylist()
+	})
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (u *DiscordGuildSettingUpsertBulk) SetRetrievalDenylist(v []string) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetRetrievalDenylist(v)
+	})
+}
+
+// UpdateRetrievalDenylist sets the "retrieval_denylist" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateRetrievalDenylist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateRetrievalDenylist()
+	})
+}
+
+// ClearRetrievalDenylist clears the value of the "retrieval_denylist" field.
+func (u *DiscordGuildSettingUpsertBulk) ClearRetrievalDenylist() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearRetrievalDenylist()
+	})
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (u *DiscordGuildSettingUpsertBulk) SetRestrictedChannels(v []string) *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.SetRestrictedChannels(v)
+	})
+}
+
+// UpdateRestrictedChannels sets the "restricted_channels" field to the value that was provided on create.
+func (u *DiscordGuildSettingUpsertBulk) UpdateRestrictedChannels() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.UpdateRestrictedChannels()
+	})
+}
+
+// ClearRestrictedChannels clears the value of the "restricted_channels" field.
+func (u *DiscordGuildSettingUpsertBulk) ClearRestrictedChannels() *DiscordGuildSettingUpsertBulk {
+	return u.Update(func(s *DiscordGuildSettingUpsert) {
+		s.ClearRestrictedChannels()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordGuildSettingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordGuildSettingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordGuildSettingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordGuildSettingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,948 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordguildsetting"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordGuildSettingUpdate is the builder for updating DiscordGuildSetting entities.
+type DiscordGuildSettingUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordGuildSettingMutation
+}
+
+// Where appends a list predicates to the DiscordGuildSettingUpdate builder.
+func (_u *DiscordGuildSettingUpdate) Where(ps ...predicate.DiscordGuildSetting) *DiscordGuildSettingUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (_u *DiscordGuildSettingUpdate) SetChimeIn(v bool) *DiscordGuildSettingUpdate {
+	_u.mutation.SetChimeIn(v)
+	return _u
+}
+
+// SetNillableChimeIn sets the "chime_in" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableChimeIn(v *bool) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetChimeIn(*v)
+	}
+	return _u
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (_u *DiscordGuildSettingUpdate) SetChimeInProbability(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.ResetChimeInProbability()
+	_u.mutation.SetChimeInProbability(v)
+	return _u
+}
+
+// SetNillableChimeInProbability sets the "chime_in_probability" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableChimeInProbability(v *float64) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetChimeInProbability(*v)
+	}
+	return _u
+}
+
+// AddChimeInProbability adds value to the "chime_in_probability" field.
+func (_u *DiscordGuildSettingUpdate) AddChimeInProbability(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.AddChimeInProbability(v)
+	return _u
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (_u *DiscordGuildSettingUpdate) SetChimeInThreshold(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.ResetChimeInThreshold()
+	_u.mutation.SetChimeInThreshold(v)
+	return _u
+}
+
+// SetNillableChimeInThreshold sets the "chime_in_threshold" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableChimeInThreshold(v *float64) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetChimeInThreshold(*v)
+	}
+	return _u
+}
+
+// AddChimeInThreshold adds value to the "chime_in_threshold" field.
+func (_u *DiscordGuildSettingUpdate) AddChimeInThreshold(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.AddChimeInThreshold(v)
+	return _u
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (_u *DiscordGuildSettingUpdate) SetChimeInCooldown(v int) *DiscordGuildSettingUpdate {
+	_u.mutation.ResetChimeInCooldown()
+	_u.mutation.SetChimeInCooldown(v)
+	return _u
+}
+
+// SetNillableChimeInCooldown sets the "chime_in_cooldown" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableChimeInCooldown(v *int) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetChimeInCooldown(*v)
+	}
+	return _u
+}
+
+// AddChimeInCooldown adds value to the "chime_in_cooldown" field.
+func (_u *DiscordGuildSettingUpdate) AddChimeInCooldown(v int) *DiscordGuildSettingUpdate {
+	_u.mutation.AddChimeInCooldown(v)
+	return _u
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (_u *DiscordGuildSettingUpdate) SetModerationDenylist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.SetModerationDenylist(v)
+	return _u
+}
+
+// AppendModerationDenylist appends value to the "moderation_denylist" field.
+func (_u *DiscordGuildSettingUpdate) AppendModerationDenylist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.AppendModerationDenylist(v)
+	return _u
+}
+
+// ClearModerationDenylist clears the value of the "moderation_denylist" field.
+func (_u *DiscordGuildSettingUpdate) ClearModerationDenylist() *DiscordGuildSettingUpdate {
+	_u.mutation.ClearModerationDenylist()
+	return _u
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (_u *DiscordGuildSettingUpdate) SetModerationDenylistAction(v discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpdate {
+	_u.mutation.SetModerationDenylistAction(v)
+	return _u
+}
+
+// SetNillableModerationDenylistAction sets the "moderation_denylist_action" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableModerationDenylistAction(v *discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetModerationDenylistAction(*v)
+	}
+	return _u
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (_u *DiscordGuildSettingUpdate) SetModerationRedact(v bool) *DiscordGuildSettingUpdate {
+	_u.mutation.SetModerationRedact(v)
+	return _u
+}
+
+// SetNillableModerationRedact sets the "moderation_redact" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableModerationRedact(v *bool) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetModerationRedact(*v)
+	}
+	return _u
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (_u *DiscordGuildSettingUpdate) SetModerationClassifier(v bool) *DiscordGuildSettingUpdate {
+	_u.mutation.SetModerationClassifier(v)
+	return _u
+}
+
+// SetNillableModerationClassifier sets the "moderation_classifier" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableModerationClassifier(v *bool) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetModerationClassifier(*v)
+	}
+	return _u
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (_u *DiscordGuildSettingUpdate) SetModerationFlagThreshold(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.ResetModerationFlagThreshold()
+	_u.mutation.SetModerationFlagThreshold(v)
+	return _u
+}
+
+// SetNillableModerationFlagThreshold sets the "moderation_flag_threshold" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableModerationFlagThreshold(v *float64) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetModerationFlagThreshold(*v)
+	}
+	return _u
+}
+
+// AddModerationFlagThreshold adds value to the "moderation_flag_threshold" field.
+func (_u *DiscordGuildSettingUpdate) AddModerationFlagThreshold(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.AddModerationFlagThreshold(v)
+	return _u
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (_u *DiscordGuildSettingUpdate) SetModerationBlockThreshold(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.ResetModerationBlockThreshold()
+	_u.mutation.SetModerationBlockThreshold(v)
+	return _u
+}
+
+// SetNillableModerationBlockThreshold sets the "moderation_block_threshold" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdate) SetNillableModerationBlockThreshold(v *float64) *DiscordGuildSettingUpdate {
+	if v != nil {
+		_u.SetModerationBlockThreshold(*v)
+	}
+	return _u
+}
+
+// AddModerationBlockThreshold adds value to the "moderation_block_threshold" field.
+func (_u *DiscordGuildSettingUpdate) AddModerationBlockThreshold(v float64) *DiscordGuildSettingUpdate {
+	_u.mutation.AddModerationBlockThreshold(v)
+	return _u
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (_u *DiscordGuildSettingUpdate) SetIngestAllowlist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.SetIngestAllowlist(v)
+	return _u
+}
+
+// AppendIngestAllowlist appends value to the "ingest_allowlist" field.
+func (_u *DiscordGuildSettingUpdate) AppendIngestAllowlist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.AppendIngestAllowlist(v)
+	return _u
+}
+
+// ClearIngestAllowlist clears the value of the "ingest_allowlist" field.
+func (_u *DiscordGuildSettingUpdate) ClearIngestAllowlist() *DiscordGuildSettingUpdate {
+	_u.mutation.ClearIngestAllowlist()
+	return _u
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (_u *DiscordGuildSettingUpdate) SetIngestDenylist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.SetIngestDenylist(v)
+	return _u
+}
+
+// AppendIngestDenylist appends value to the "ingest_denylist" field.
+func (_u *DiscordGuildSettingUpdate) AppendIngestDenylist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.AppendIngestDenylist(v)
+	return _u
+}
+
+// ClearIngestDenylist clears the value of the "ingest_denylist" field.
+func (_u *DiscordGuildSettingUpdate) ClearIngestDenylist() *DiscordGuildSettingUpdate {
+	_u.mutation.ClearIngestDenylist()
+	return _u
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (_u *DiscordGuildSettingUpdate) SetRetrievalDenylist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.SetRetrievalDenylist(v)
+	return _u
+}
+
+// AppendRetrievalDenylist appends value to the "retrieval_denylist" field.
+func (_u *DiscordGuildSettingUpdate) AppendRetrievalDenylist(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.AppendRetrievalDenylist(v)
+	return _u
+}
+
+// ClearRetrievalDenylist clears the value of the "retrieval_denylist" field.
+func (_u *DiscordGuildSettingUpdate) ClearRetrievalDenylist() *DiscordGuildSettingUpdate {
+	_u.mutation.ClearRetrievalDenylist()
+	return _u
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (_u *DiscordGuildSettingUpdate) SetRestrictedChannels(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.SetRestrictedChannels(v)
+	return _u
+}
+
+// AppendRestrictedChannels appends value to the "restricted_channels" field.
+func (_u *DiscordGuildSettingUpdate) AppendRestrictedChannels(v []string) *DiscordGuildSettingUpdate {
+	_u.mutation.AppendRestrictedChannels(v)
+	return _u
+}
+
+// ClearRestrictedChannels clears the value of the "restricted_channels" field.
+func (_u *DiscordGuildSettingUpdate) ClearRestrictedChannels() *DiscordGuildSettingUpdate {
+	_u.mutation.ClearRestrictedChannels()
+	return _u
+}
+
+// Mutation returns the DiscordGuildSettingMutation object of the builder.
+func (_u *DiscordGuildSettingUpdate) Mutation() *DiscordGuildSettingMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordGuildSettingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordGuildSettingUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordGuildSettingUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordGuildSettingUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordGuildSettingUpdate) check() error {
+	if v, ok := _u.mutation.ChimeInProbability(); ok {
+		if err := discordguildsetting.ChimeInProbabilityValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_probability", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_probability": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChimeInThreshold(); ok {
+		if err := discordguildsetting.ChimeInThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChimeInCooldown(); ok {
+		if err := discordguildsetting.ChimeInCooldownValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_cooldown", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_cooldown": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationDenylistAction(); ok {
+		if err := discordguildsetting.ModerationDenylistActionValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_denylist_action", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_denylist_action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationFlagThreshold(); ok {
+		if err := discordguildsetting.ModerationFlagThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_flag_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_flag_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationBlockThreshold(); ok {
+		if err := discordguildsetting.ModerationBlockThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_block_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_block_threshold": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DiscordGuildSettingUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordguildsetting.Table, discordguildsetting.Columns, sqlgraph.NewFieldSpec(discordguildsetting.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChimeIn(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeIn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ChimeInProbability(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInProbability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChimeInProbability(); ok {
+		_spec.AddField(discordguildsetting.FieldChimeInProbability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ChimeInThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChimeInThreshold(); ok {
+		_spec.AddField(discordguildsetting.FieldChimeInThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ChimeInCooldown(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInCooldown, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChimeInCooldown(); ok {
+		_spec.AddField(discordguildsetting.FieldChimeInCooldown, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ModerationDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationDenylist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModerationDenylist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldModerationDenylist, value)
+		})
+	}
+	if _u.mutation.ModerationDenylistCleared() {
+		_spec.ClearField(discordguildsetting.FieldModerationDenylist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModerationDenylistAction(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationDenylistAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ModerationRedact(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationRedact, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ModerationClassifier(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationClassifier, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ModerationFlagThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationFlagThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedModerationFlagThreshold(); ok {
+		_spec.AddField(discordguildsetting.FieldModerationFlagThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ModerationBlockThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationBlockThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedModerationBlockThreshold(); ok {
+		_spec.AddField(discordguildsetting.FieldModerationBlockThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.IngestAllowlist(); ok {
+		_spec.SetField(discordguildsetting.FieldIngestAllowlist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngestAllowlist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldIngestAllowlist, value)
+		})
+	}
+	if _u.mutation.IngestAllowlistCleared() {
+		_spec.ClearField(discordguildsetting.FieldIngestAllowlist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IngestDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldIngestDenylist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngestDenylist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldIngestDenylist, value)
+		})
+	}
+	if _u.mutation.IngestDenylistCleared() {
+		_spec.ClearField(discordguildsetting.FieldIngestDenylist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RetrievalDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldRetrievalDenylist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRetrievalDenylist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldRetrievalDenylist, value)
+		})
+	}
+	if _u.mutation.RetrievalDenylistCleared() {
+		_spec.ClearField(discordguildsetting.FieldRetrievalDenylist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RestrictedChannels(); ok {
+		_spec.SetField(discordguildsetting.FieldRestrictedChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRestrictedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldRestrictedChannels, value)
+		})
+	}
+	if _u.mutation.RestrictedChannelsCleared() {
+		_spec.ClearField(discordguildsetting.FieldRestrictedChannels, field.TypeJSON)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordguildsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordGuildSettingUpdateOne is the builder for updating a single DiscordGuildSetting entity.
+type DiscordGuildSettingUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordGuildSettingMutation
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (_u *DiscordGuildSettingUpdateOne) SetChimeIn(v bool) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetChimeIn(v)
+	return _u
+}
+
+// SetNillableChimeIn sets the "chime_in" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableChimeIn(v *bool) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetChimeIn(*v)
+	}
+	return _u
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (_u *DiscordGuildSettingUpdateOne) SetChimeInProbability(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.ResetChimeInProbability()
+	_u.mutation.SetChimeInProbability(v)
+	return _u
+}
+
+// SetNillableChimeInProbability sets the "chime_in_probability" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableChimeInProbability(v *float64) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetChimeInProbability(*v)
+	}
+	return _u
+}
+
+// AddChimeInProbability adds value to the "chime_in_probability" field.
+func (_u *DiscordGuildSettingUpdateOne) AddChimeInProbability(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AddChimeInProbability(v)
+	return _u
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (_u *DiscordGuildSettingUpdateOne) SetChimeInThreshold(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.ResetChimeInThreshold()
+	_u.mutation.SetChimeInThreshold(v)
+	return _u
+}
+
+// SetNillableChimeInThreshold sets the "chime_in_threshold" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableChimeInThreshold(v *float64) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetChimeInThreshold(*v)
+	}
+	return _u
+}
+
+// AddChimeInThreshold adds value to the "chime_in_threshold" field.
+func (_u *DiscordGuildSettingUpdateOne) AddChimeInThreshold(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AddChimeInThreshold(v)
+	return _u
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (_u *DiscordGuildSettingUpdateOne) SetChimeInCooldown(v int) *DiscordGuildSettingUpdateOne {
+	_u.mutation.ResetChimeInCooldown()
+	_u.mutation.SetChimeInCooldown(v)
+	return _u
+}
+
+// SetNillableChimeInCooldown sets the "chime_in_cooldown" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableChimeInCooldown(v *int) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetChimeInCooldown(*v)
+	}
+	return _u
+}
+
+// AddChimeInCooldown adds value to the "chime_in_cooldown" field.
+func (_u *DiscordGuildSettingUpdateOne) AddChimeInCooldown(v int) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AddChimeInCooldown(v)
+	return _u
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) SetModerationDenylist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetModerationDenylist(v)
+	return _u
+}
+
+// AppendModerationDenylist appends value to the "moderation_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) AppendModerationDenylist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AppendModerationDenylist(v)
+	return _u
+}
+
+// ClearModerationDenylist clears the value of the "moderation_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) ClearModerationDenylist() *DiscordGuildSettingUpdateOne {
+	_u.mutation.ClearModerationDenylist()
+	return _u
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (_u *DiscordGuildSettingUpdateOne) SetModerationDenylistAction(v discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetModerationDenylistAction(v)
+	return _u
+}
+
+// SetNillableModerationDenylistAction sets the "moderation_denylist_action" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableModerationDenylistAction(v *discordguildsetting.ModerationDenylistAction) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetModerationDenylistAction(*v)
+	}
+	return _u
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (_u *DiscordGuildSettingUpdateOne) SetModerationRedact(v bool) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetModerationRedact(v)
+	return _u
+}
+
+// SetNillableModerationRedact sets the "moderation_redact" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableModerationRedact(v *bool) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetModerationRedact(*v)
+	}
+	return _u
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (_u *DiscordGuildSettingUpdateOne) SetModerationClassifier(v bool) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetModerationClassifier(v)
+	return _u
+}
+
+// SetNillableModerationClassifier sets the "moderation_classifier" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableModerationClassifier(v *bool) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetModerationClassifier(*v)
+	}
+	return _u
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (_u *DiscordGuildSettingUpdateOne) SetModerationFlagThreshold(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.ResetModerationFlagThreshold()
+	_u.mutation.SetModerationFlagThreshold(v)
+	return _u
+}
+
+// SetNillableModerationFlagThreshold sets the "moderation_flag_threshold" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableModerationFlagThreshold(v *float64) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetModerationFlagThreshold(*v)
+	}
+	return _u
+}
+
+// AddModerationFlagThreshold adds value to the "moderation_flag_threshold" field.
+func (_u *DiscordGuildSettingUpdateOne) AddModerationFlagThreshold(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AddModerationFlagThreshold(v)
+	return _u
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (_u *DiscordGuildSettingUpdateOne) SetModerationBlockThreshold(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.ResetModerationBlockThreshold()
+	_u.mutation.SetModerationBlockThreshold(v)
+	return _u
+}
+
+// SetNillableModerationBlockThreshold sets the "moderation_block_threshold" field if the given value is not nil.
+func (_u *DiscordGuildSettingUpdateOne) SetNillableModerationBlockThreshold(v *float64) *DiscordGuildSettingUpdateOne {
+	if v != nil {
+		_u.SetModerationBlockThreshold(*v)
+	}
+	return _u
+}
+
+// AddModerationBlockThreshold adds value to the "moderation_block_threshold" field.
+func (_u *DiscordGuildSettingUpdateOne) AddModerationBlockThreshold(v float64) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AddModerationBlockThreshold(v)
+	return _u
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (_u *DiscordGuildSettingUpdateOne) SetIngestAllowlist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetIngestAllowlist(v)
+	return _u
+}
+
+// AppendIngestAllowlist appends value to the "ingest_allowlist" field.
+func (_u *DiscordGuildSettingUpdateOne) AppendIngestAllowlist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AppendIngestAllowlist(v)
+	return _u
+}
+
+// ClearIngestAllowlist clears the value of the "ingest_allowlist" field.
+func (_u *DiscordGuildSettingUpdateOne) ClearIngestAllowlist() *DiscordGuildSettingUpdateOne {
+	_u.mutation.ClearIngestAllowlist()
+	return _u
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) SetIngestDenylist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetIngestDenylist(v)
+	return _u
+}
+
+// AppendIngestDenylist appends value to the "ingest_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) AppendIngestDenylist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AppendIngestDenylist(v)
+	return _u
+}
+
+// ClearIngestDenylist clears the value of the "ingest_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) ClearIngestDenylist() *DiscordGuildSettingUpdateOne {
+	_u.mutation.ClearIngestDenylist()
+	return _u
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) SetRetrievalDenylist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetRetrievalDenylist(v)
+	return _u
+}
+
+// AppendRetrievalDenylist appends value to the "retrieval_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) AppendRetrievalDenylist(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AppendRetrievalDenylist(v)
+	return _u
+}
+
+// ClearRetrievalDenylist clears the value of the "retrieval_denylist" field.
+func (_u *DiscordGuildSettingUpdateOne) ClearRetrievalDenylist() *DiscordGuildSettingUpdateOne {
+	_u.mutation.ClearRetrievalDenylist()
+	return _u
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (_u *DiscordGuildSettingUpdateOne) SetRestrictedChannels(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.SetRestrictedChannels(v)
+	return _u
+}
+
+// AppendRestrictedChannels appends value to the "restricted_channels" field.
+func (_u *DiscordGuildSettingUpdateOne) AppendRestrictedChannels(v []string) *DiscordGuildSettingUpdateOne {
+	_u.mutation.AppendRestrictedChannels(v)
+	return _u
+}
+
+// ClearRestrictedChannels clears the value of the "restricted_channels" field.
+func (_u *DiscordGuildSettingUpdateOne) ClearRestrictedChannels() *DiscordGuildSettingUpdateOne {
+	_u.mutation.ClearRestrictedChannels()
+	return _u
+}
+
+// Mutation returns the DiscordGuildSettingMutation object of the builder.
+func (_u *DiscordGuildSettingUpdateOne) Mutation() *DiscordGuildSettingMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordGuildSettingUpdate builder.
+func (_u *DiscordGuildSettingUpdateOne) Where(ps ...predicate.DiscordGuildSetting) *DiscordGuildSettingUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordGuildSettingUpdateOne) Select(field string, fields ...string) *DiscordGuildSettingUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordGuildSetting entity.
+func (_u *DiscordGuildSettingUpdateOne) Save(ctx context.Context) (*DiscordGuildSetting, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordGuildSettingUpdateOne) SaveX(ctx context.Context) *DiscordGuildSetting {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordGuildSettingUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordGuildSettingUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordGuildSettingUpdateOne) check() error {
+	if v, ok := _u.mutation.ChimeInProbability(); ok {
+		if err := discordguildsetting.ChimeInProbabilityValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_probability", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_probability": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChimeInThreshold(); ok {
+		if err := discordguildsetting.ChimeInThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ChimeInCooldown(); ok {
+		if err := discordguildsetting.ChimeInCooldownValidator(v); err != nil {
+			return &ValidationError{Name: "chime_in_cooldown", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.chime_in_cooldown": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationDenylistAction(); ok {
+		if err := discordguildsetting.ModerationDenylistActionValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_denylist_action", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_denylist_action": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationFlagThreshold(); ok {
+		if err := discordguildsetting.ModerationFlagThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_flag_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_flag_threshold": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ModerationBlockThreshold(); ok {
+		if err := discordguildsetting.ModerationBlockThresholdValidator(v); err != nil {
+			return &ValidationError{Name: "moderation_block_threshold", err: fmt.Errorf(`ent: validator failed for field "DiscordGuildSetting.moderation_block_threshold": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DiscordGuildSettingUpdateOne) sqlSave(ctx context.Context) (_node *DiscordGuildSetting, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordguildsetting.Table, discordguildsetting.Columns, sqlgraph.NewFieldSpec(discordguildsetting.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordGuildSetting.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordguildsetting.FieldID)
+		for _, f := range fields {
+			if !discordguildsetting.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordguildsetting.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ChimeIn(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeIn, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ChimeInProbability(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInProbability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChimeInProbability(); ok {
+		_spec.AddField(discordguildsetting.FieldChimeInProbability, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ChimeInThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedChimeInThreshold(); ok {
+		_spec.AddField(discordguildsetting.FieldChimeInThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ChimeInCooldown(); ok {
+		_spec.SetField(discordguildsetting.FieldChimeInCooldown, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChimeInCooldown(); ok {
+		_spec.AddField(discordguildsetting.FieldChimeInCooldown, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ModerationDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationDenylist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedModerationDenylist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldModerationDenylist, value)
+		})
+	}
+	if _u.mutation.ModerationDenylistCleared() {
+		_spec.ClearField(discordguildsetting.FieldModerationDenylist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ModerationDenylistAction(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationDenylistAction, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ModerationRedact(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationRedact, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ModerationClassifier(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationClassifier, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ModerationFlagThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationFlagThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedModerationFlagThreshold(); ok {
+		_spec.AddField(discordguildsetting.FieldModerationFlagThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ModerationBlockThreshold(); ok {
+		_spec.SetField(discordguildsetting.FieldModerationBlockThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedModerationBlockThreshold(); ok {
+		_spec.AddField(discordguildsetting.FieldModerationBlockThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.IngestAllowlist(); ok {
+		_spec.SetField(discordguildsetting.FieldIngestAllowlist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngestAllowlist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldIngestAllowlist, value)
+		})
+	}
+	if _u.mutation.IngestAllowlistCleared() {
+		_spec.ClearField(discordguildsetting.FieldIngestAllowlist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.IngestDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldIngestDenylist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedIngestDenylist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldIngestDenylist, value)
+		})
+	}
+	if _u.mutation.IngestDenylistCleared() {
+		_spec.ClearField(discordguildsetting.FieldIngestDenylist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RetrievalDenylist(); ok {
+		_spec.SetField(discordguildsetting.FieldRetrievalDenylist, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRetrievalDenylist(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldRetrievalDenylist, value)
+		})
+	}
+	if _u.mutation.RetrievalDenylistCleared() {
+		_spec.ClearField(discordguildsetting.FieldRetrievalDenylist, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.RestrictedChannels(); ok {
+		_spec.SetField(discordguildsetting.FieldRestrictedChannels, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedRestrictedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, discordguildsetting.FieldRestrictedChannels, value)
+		})
+	}
+	if _u.mutation.RestrictedChannelsCleared() {
+		_spec.ClearField(discordguildsetting.FieldRestrictedChannels, field.TypeJSON)
+	}
+	_node = &DiscordGuildSetting{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordguildsetting.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -22,10 +22,18 @@ type DiscordMessage struct {
 	Content string `json:"content,omitempty"`
 	// AuthorID holds the value of the "author_id" field.
 	AuthorID string `json:"author_id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// ChannelID holds the value of the "channel_id" field.
+	ChannelID string `json:"channel_id,omitempty"`
+	// ReplyToID holds the value of the "reply_to_id" field.
+	ReplyToID string `json:"reply_to_id,omitempty"`
 	// Timestamp holds the value of the "timestamp" field.
 	Timestamp time.Time `json:"timestamp,omitempty"`
 	// EditedTimestamp holds the value of the "edited_timestamp" field.
 	EditedTimestamp time.Time `json:"edited_timestamp,omitempty"`
+	// SuspectedInjection holds the value of the "suspected_injection" field.
+	SuspectedInjection bool `json:"suspected_injection,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DiscordMessageQuery when eager-loading is set.
 	Edges        DiscordMessageEdges `json:"edges"`
@@ -38,9 +46,23 @@ type DiscordMessageEdges struct {
 	User *DiscordUser `json:"user,omitempty"`
 	// Embeddings holds the value of the embeddings edge.
 	Embeddings []*DiscordMessageEmbedding `json:"embeddings,omitempty"`
+	// Reactions holds the value of the reactions edge.
+	Reactions []*DiscordReaction `json:"reactions,omitempty"`
+	// ReplyTo holds the value of the reply_to edge.
+	ReplyTo *DiscordMessage `json:"reply_to,omitempty"`
+	// Replies holds the value of the replies edge.
+	Replies []*DiscordMessage `json:"replies,omitempty"`
+	// MentionedUsers holds the value of the mentioned_users edge.
+	MentionedUsers []*DiscordUser `json:"mentioned_users,omitempty"`
+	// MentionedRoles holds the value of the mentioned_roles edge.
+	MentionedRoles []*DiscordRole `json:"mentioned_roles,omitempty"`
+	// MentionedChannels holds the value of the mentioned_channels edge.
+	MentionedChannels []*DiscordChannel `json:"mentioned_channels,omitempty"`
+	// Chunks holds the value of the chunks edge.
+	Chunks []*DiscordMessageChunk `json:"chunks,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -63,12 +85,79 @@ func (e DiscordMessageEdges) EmbeddingsOrErr() ([]*DiscordMessageEmbedding, erro
 	return nil, &NotLoadedError{edge: "embeddings"}
 }
 
+// ReactionsOrErr returns the Reactions value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageEdges) ReactionsOrErr() ([]*DiscordReaction, error) {
+	if e.loadedTypes[2] {
+		return e.Reactions, nil
+	}
+	return nil, &NotLoadedError{edge: "reactions"}
+}
+
+// ReplyToOrErr returns the ReplyTo value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DiscordMessageEdges) ReplyToOrErr() (*DiscordMessage, error) {
+	if e.ReplyTo != nil {
+		return e.ReplyTo, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: discordmessage.Label}
+	}
+	return nil, &NotLoadedError{edge: "reply_to"}
+}
+
+// RepliesOrErr returns the Replies value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageEdges) RepliesOrErr() ([]*DiscordMessage, error) {
+	if e.loadedTypes[4] {
+		return e.Replies, nil
+	}
+	return nil, &NotLoadedError{edge: "replies"}
+}
+
+// MentionedUsersOrErr returns the MentionedUsers value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageEdges) MentionedUsersOrErr() ([]*DiscordUser, error) {
+	if e.loadedTypes[5] {
+		return e.MentionedUsers, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_users"}
+}
+
+// MentionedRolesOrErr returns the MentionedRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageEdges) MentionedRolesOrErr() ([]*DiscordRole, error) {
+	if e.loadedTypes[6] {
+		return e.MentionedRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_roles"}
+}
+
+// MentionedChannelsOrErr returns the MentionedChannels value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageEdges) MentionedChannelsOrErr() ([]*DiscordChannel, error) {
+	if e.loadedTypes[7] {
+		return e.MentionedChannels, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_channels"}
+}
+
+// ChunksOrErr returns the Chunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageEdges) ChunksOrErr() ([]*DiscordMessageChunk, error) {
+	if e.loadedTypes[8] {
+		return e.Chunks, nil
+	}
+	return nil, &NotLoadedError{edge: "chunks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DiscordMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case discordmessage.FieldID, discordmessage.FieldContent, discordmessage.FieldAuthorID:
+		case discordmessage.FieldSuspectedInjection:
+			values[i] = new(sql.NullBool)
+		case discordmessage.FieldID, discordmessage.FieldContent, discordmessage.FieldAuthorID, discordmessage.FieldGuildID, discordmessage.FieldChannelID, discordmessage.FieldReplyToID:
 			values[i] = new(sql.NullString)
 		case discordmessage.FieldTimestamp, discordmessage.FieldEditedTimestamp:
 			values[i] = new(sql.NullTime)
@@ -105,6 +194,24 @@ func (_m *DiscordMessage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AuthorID = value.String
 			}
+		case discordmessage.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				_m.GuildID = value.String
+			}
+		case discordmessage.FieldChannelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_id", values[i])
+			} else if value.Valid {
+				_m.ChannelID = value.String
+			}
+		case discordmessage.FieldReplyToID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reply_to_id", values[i])
+			} else if value.Valid {
+				_m.ReplyToID = value.String
+			}
 		case discordmessage.FieldTimestamp:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
@@ -117,6 +224,12 @@ func (_m *DiscordMessage) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.EditedTimestamp = value.Time
 			}
+		case discordmessage.FieldSuspectedInjection:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field suspected_injection", values[i])
+			} else if value.Valid {
+				_m.SuspectedInjection = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -140,6 +253,41 @@ func (_m *DiscordMessage) QueryEmbeddings() *DiscordMessageEmbeddingQuery {
 	return NewDiscordMessageClient(_m.config).QueryEmbeddings(_m)
 }
 
+// QueryReactions queries the "reactions" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryReactions() *DiscordReactionQuery {
+	return NewDiscordMessageClient(_m.config).QueryReactions(_m)
+}
+
+// QueryReplyTo queries the "reply_to" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryReplyTo() *DiscordMessageQuery {
+	return NewDiscordMessageClient(_m.config).QueryReplyTo(_m)
+}
+
+// QueryReplies queries the "replies" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryReplies() *DiscordMessageQuery {
+	return NewDiscordMessageClient(_m.config).QueryReplies(_m)
+}
+
+// QueryMentionedUsers queries the "mentioned_users" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryMentionedUsers() *DiscordUserQuery {
+	return NewDiscordMessageClient(_m.config).QueryMentionedUsers(_m)
+}
+
+// QueryMentionedRoles queries the "mentioned_roles" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryMentionedRoles() *DiscordRoleQuery {
+	return NewDiscordMessageClient(_m.config).QueryMentionedRoles(_m)
+}
+
+// QueryMentionedChannels queries the "mentioned_channels" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryMentionedChannels() *DiscordChannelQuery {
+	return NewDiscordMessageClient(_m.config).QueryMentionedChannels(_m)
+}
+
+// QueryChunks queries the "chunks" edge of the DiscordMessage entity.
+func (_m *DiscordMessage) QueryChunks() *DiscordMessageChunkQuery {
+	return NewDiscordMessageClient(_m.config).QueryChunks(_m)
+}
+
 // Update returns a builder for updating this DiscordMessage.
 // Note that you need to call DiscordMessage.Unwrap() before calling this method if this DiscordMessage
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -169,11 +317,23 @@ func (_m *DiscordMessage) String() string {
 	builder.WriteString("author_id=")
 	builder.WriteString(_m.AuthorID)
 	builder.WriteString(", ")
+	builder.WriteString("guild_id=")
+	builder.WriteString(_m.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("channel_id=")
+	builder.WriteString(_m.ChannelID)
+	builder.WriteString(", ")
+	builder.WriteString("reply_to_id=")
+	builder.WriteString(_m.ReplyToID)
+	builder.WriteString(", ")
 	builder.WriteString("timestamp=")
 	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("edited_timestamp=")
 	builder.WriteString(_m.EditedTimestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("suspected_injection=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SuspectedInjection))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -16,14 +16,36 @@ const (
 	FieldContent = "content"
 	// FieldAuthorID holds the string denoting the author_id field in the database.
 	FieldAuthorID = "author_id"
+	// FieldGuildID holds the string denoting the guild_id field in the database.
+	FieldGuildID = "guild_id"
+	// FieldChannelID holds the string denoting the channel_id field in the database.
+	FieldChannelID = "channel_id"
+	// FieldReplyToID holds the string denoting the reply_to_id field in the database.
+	FieldReplyToID = "reply_to_id"
 	// FieldTimestamp holds the string denoting the timestamp field in the database.
 	FieldTimestamp = "timestamp"
 	// FieldEditedTimestamp holds the string denoting the edited_timestamp field in the database.
 	FieldEditedTimestamp = "edited_timestamp"
+	// FieldSuspectedInjection holds the string denoting the suspected_injection field in the database.
+	FieldSuspectedInjection = "suspected_injection"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeEmbeddings holds the string denoting the embeddings edge name in mutations.
 	EdgeEmbeddings = "embeddings"
+	// EdgeReactions holds the string denoting the reactions edge name in mutations.
+	EdgeReactions = "reactions"
+	// EdgeReplyTo holds the string denoting the reply_to edge name in mutations.
+	EdgeReplyTo = "reply_to"
+	// EdgeReplies holds the string denoting the replies edge name in mutations.
+	EdgeReplies = "replies"
+	// EdgeMentionedUsers holds the string denoting the mentioned_users edge name in mutations.
+	EdgeMentionedUsers = "mentioned_users"
+	// EdgeMentionedRoles holds the string denoting the mentioned_roles edge name in mutations.
+	EdgeMentionedRoles = "mentioned_roles"
+	// EdgeMentionedChannels holds the string denoting the mentioned_channels edge name in mutations.
+	EdgeMentionedChannels = "mentioned_channels"
+	// EdgeChunks holds the string denoting the chunks edge name in mutations.
+	EdgeChunks = "chunks"
 	// Table holds the table name of the discordmessage in the database.
 	Table = "discord_messages"
 	// UserTable is the table that holds the user relation/edge.
@@ -40,6 +62,41 @@ const (
 	EmbeddingsInverseTable = "discord_message_embeddings"
 	// EmbeddingsColumn is the table column denoting the embeddings relation/edge.
 	EmbeddingsColumn = "message_id"
+	// ReactionsTable is the table that holds the reactions relation/edge.
+	ReactionsTable = "discord_reactions"
+	// ReactionsInverseTable is the table name for the DiscordReaction entity.
+	// It exists in this package in order to avoid circular dependency with the "discordreaction" package.
+	ReactionsInverseTable = "discord_reactions"
+	// ReactionsColumn is the table column denoting the reactions relation/edge.
+	ReactionsColumn = "message_id"
+	// ReplyToTable is the table that holds the reply_to relation/edge.
+	ReplyToTable = "discord_messages"
+	// ReplyToColumn is the table column denoting the reply_to relation/edge.
+	ReplyToColumn = "reply_to_id"
+	// RepliesTable is the table that holds the replies relation/edge.
+	RepliesTable = "discord_messages"
+	// RepliesColumn is the table column denoting the replies relation/edge.
+	RepliesColumn = "reply_to_id"
+	// MentionedUsersTable is the table that holds the mentioned_users relation/edge. The primary key declared below.
+	MentionedUsersTable = "discord_message_mentioned_users"
+	// MentionedUsersInverseTable is the table name for the DiscordUser entity.
+	// It exists in this package in order to avoid circular dependency with the "discorduser" package.
+	MentionedUsersInverseTable = "discord_users"
+	// MentionedRolesTable is the table that holds the mentioned_roles relation/edge. The primary key declared below.
+	MentionedRolesTable = "discord_message_mentioned_roles"
+	// MentionedRolesInverseTable is the table name for the DiscordRole entity.
+	// It exists in this package in order to avoid circular dependency with the "discordrole" package.
+	MentionedRolesInverseTable = "discord_roles"
+	// MentionedChannelsTable is the table that holds the mentioned_channels relation/edge. The primary key declared below.
+	MentionedChannelsTable = "discord_message_mentioned_channels"
+	// MentionedChannelsInverseTable is the table name for the DiscordChannel entity.
+	// It exists in this package in order to avoid circular dependency with the "discordchannel" package.
+	MentionedChannelsInverseTable = "discord_channels"
+	// ChunksTable is the table that holds the chunks relation/edge. The primary key declared below.
+	ChunksTable = "discord_message_chunk_messages"
+	// ChunksInverseTable is the table name for the DiscordMessageChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "discordmessagechunk" package.
+	ChunksInverseTable = "discord_message_chunks"
 )
 
 // Columns holds all SQL columns for discordmessage fields.
@@ -47,10 +104,29 @@ var Columns = []string{
 	FieldID,
 	FieldContent,
 	FieldAuthorID,
+	FieldGuildID,
+	FieldChannelID,
+	FieldReplyToID,
 	FieldTimestamp,
 	FieldEditedTimestamp,
+	FieldSuspectedInjection,
 }
 
+var (
+	// MentionedUsersPrimaryKey and MentionedUsersColumn2 are the table columns denoting the
+	// primary key for the mentioned_users relation (M2M).
+	MentionedUsersPrimaryKey = []string{"discord_message_id", "discord_user_id"}
+	// MentionedRolesPrimaryKey and MentionedRolesColumn2 are the table columns denoting the
+	// primary key for the mentioned_roles relation (M2M).
+	MentionedRolesPrimaryKey = []string{"discord_message_id", "discord_role_id"}
+	// MentionedChannelsPrimaryKey and MentionedChannelsColumn2 are the table columns denoting the
+	// primary key for the mentioned_channels relation (M2M).
+	MentionedChannelsPrimaryKey = []string{"discord_message_id", "discord_channel_id"}
+	// ChunksPrimaryKey and ChunksColumn2 are the table columns denoting the
+	// primary key for the chunks relation (M2M).
+	ChunksPrimaryKey = []string{"discord_message_chunk_id", "discord_message_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -64,6 +140,8 @@ func ValidColumn(column string) bool {
 var (
 	// AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
 	AuthorIDValidator func(string) error
+	// DefaultSuspectedInjection holds the default value on creation for the "suspected_injection" field.
+	DefaultSuspectedInjection bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -86,6 +164,21 @@ func ByAuthorID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAuthorID, opts...).ToFunc()
 }
 
+// ByGuildID orders the results by the guild_id field.
+func ByGuildID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuildID, opts...).ToFunc()
+}
+
+// ByChannelID orders the results by the channel_id field.
+func ByChannelID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannelID, opts...).ToFunc()
+}
+
+// ByReplyToID orders the results by the reply_to_id field.
+func ByReplyToID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReplyToID, opts...).ToFunc()
+}
+
 // ByTimestamp orders the results by the timestamp field.
 func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
@@ -96,6 +189,11 @@ func ByEditedTimestamp(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldEditedTimestamp, opts...).ToFunc()
 }
 
+// BySuspectedInjection orders the results by the suspected_injection field.
+func BySuspectedInjection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSuspectedInjection, opts...).ToFunc()
+}
+
 // ByUserField orders the results by user field.
 func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -116,6 +214,97 @@ func ByEmbeddings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newEmbeddingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReactionsCount orders the results by reactions count.
+func ByReactionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReactionsStep(), opts...)
+	}
+}
+
+// ByReactions orders the results by reactions terms.
+func ByReactions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReactionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReplyToField orders the results by reply_to field.
+func ByReplyToField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReplyToStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByRepliesCount orders the results by replies count.
+func ByRepliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRepliesStep(), opts...)
+	}
+}
+
+// ByReplies orders the results by replies terms.
+func ByReplies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRepliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionedUsersCount orders the results by mentioned_users count.
+func ByMentionedUsersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedUsersStep(), opts...)
+	}
+}
+
+// ByMentionedUsers orders the results by mentioned_users terms.
+func ByMentionedUsers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedUsersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionedRolesCount orders the results by mentioned_roles count.
+func ByMentionedRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedRolesStep(), opts...)
+	}
+}
+
+// ByMentionedRoles orders the results by mentioned_roles terms.
+func ByMentionedRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMentionedChannelsCount orders the results by mentioned_channels count.
+func ByMentionedChannelsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedChannelsStep(), opts...)
+	}
+}
+
+// ByMentionedChannels orders the results by mentioned_channels terms.
+func ByMentionedChannels(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedChannelsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByChunksCount orders the results by chunks count.
+func ByChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChunksStep(), opts...)
+	}
+}
+
+// ByChunks orders the results by chunks terms.
+func ByChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -130,3 +319,52 @@ func newEmbeddingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, EmbeddingsTable, EmbeddingsColumn),
 	)
 }
+func newReactionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReactionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+	)
+}
+func newReplyToStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReplyToTable, ReplyToColumn),
+	)
+}
+func newRepliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+	)
+}
+func newMentionedUsersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedUsersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MentionedUsersTable, MentionedUsersPrimaryKey...),
+	)
+}
+func newMentionedRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MentionedRolesTable, MentionedRolesPrimaryKey...),
+	)
+}
+func newMentionedChannelsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedChannelsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, MentionedChannelsTable, MentionedChannelsPrimaryKey...),
+	)
+}
+func newChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ChunksTable, ChunksPrimaryKey...),
+	)
+}
@@ -75,6 +75,21 @@ func AuthorID(v string) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldEQ(FieldAuthorID, v))
 }
 
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldGuildID, v))
+}
+
+// ChannelID applies equality check predicate on the "channel_id" field. It's identical to ChannelIDEQ.
+func ChannelID(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ReplyToID applies equality check predicate on the "reply_to_id" field. It's identical to ReplyToIDEQ.
+func ReplyToID(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldReplyToID, v))
+}
+
 // Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
 func Timestamp(v time.Time) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldEQ(FieldTimestamp, v))
@@ -85,6 +100,11 @@ func EditedTimestamp(v time.Time) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldEQ(FieldEditedTimestamp, v))
 }
 
+// SuspectedInjection applies equality check predicate on the "suspected_injection" field. It's identical to SuspectedInjectionEQ.
+func SuspectedInjection(v bool) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldSuspectedInjection, v))
+}
+
 // ContentEQ applies the EQ predicate on the "content" field.
 func ContentEQ(v string) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldEQ(FieldContent, v))
@@ -215,6 +235,231 @@ func AuthorIDContainsFold(v string) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldContainsFold(FieldAuthorID, v))
 }
 
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDIsNil applies the IsNil predicate on the "guild_id" field.
+func GuildIDIsNil() predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldIsNull(FieldGuildID))
+}
+
+// GuildIDNotNil applies the NotNil predicate on the "guild_id" field.
+func GuildIDNotNil() predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNotNull(FieldGuildID))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// ChannelIDEQ applies the EQ predicate on the "channel_id" field.
+func ChannelIDEQ(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ChannelIDNEQ applies the NEQ predicate on the "channel_id" field.
+func ChannelIDNEQ(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNEQ(FieldChannelID, v))
+}
+
+// ChannelIDIn applies the In predicate on the "channel_id" field.
+func ChannelIDIn(vs ...string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldIn(FieldChannelID, vs...))
+}
+
+// ChannelIDNotIn applies the NotIn predicate on the "channel_id" field.
+func ChannelIDNotIn(vs ...string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNotIn(FieldChannelID, vs...))
+}
+
+// ChannelIDGT applies the GT predicate on the "channel_id" field.
+func ChannelIDGT(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldGT(FieldChannelID, v))
+}
+
+// ChannelIDGTE applies the GTE predicate on the "channel_id" field.
+func ChannelIDGTE(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldGTE(FieldChannelID, v))
+}
+
+// ChannelIDLT applies the LT predicate on the "channel_id" field.
+func ChannelIDLT(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldLT(FieldChannelID, v))
+}
+
+// ChannelIDLTE applies the LTE predicate on the "channel_id" field.
+func ChannelIDLTE(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldLTE(FieldChannelID, v))
+}
+
+// ChannelIDContains applies the Contains predicate on the "channel_id" field.
+func ChannelIDContains(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldContains(FieldChannelID, v))
+}
+
+// ChannelIDHasPrefix applies the HasPrefix predicate on the "channel_id" field.
+func ChannelIDHasPrefix(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldHasPrefix(FieldChannelID, v))
+}
+
+// ChannelIDHasSuffix applies the HasSuffix predicate on the "channel_id" field.
+func ChannelIDHasSuffix(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldHasSuffix(FieldChannelID, v))
+}
+
+// ChannelIDIsNil applies the IsNil predicate on the "channel_id" field.
+func ChannelIDIsNil() predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldIsNull(FieldChannelID))
+}
+
+// ChannelIDNotNil applies the NotNil predicate on the "channel_id" field.
+func ChannelIDNotNil() predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNotNull(FieldChannelID))
+}
+
+// ChannelIDEqualFold applies the EqualFold predicate on the "channel_id" field.
+func ChannelIDEqualFold(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEqualFold(FieldChannelID, v))
+}
+
+// ChannelIDContainsFold applies the ContainsFold predicate on the "channel_id" field.
+func ChannelIDContainsFold(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldContainsFold(FieldChannelID, v))
+}
+
+// ReplyToIDEQ applies the EQ predicate on the "reply_to_id" field.
+func ReplyToIDEQ(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldReplyToID, v))
+}
+
+// ReplyToIDNEQ applies the NEQ predicate on the "reply_to_id" field.
+func ReplyToIDNEQ(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNEQ(FieldReplyToID, v))
+}
+
+// ReplyToIDIn applies the In predicate on the "reply_to_id" field.
+func ReplyToIDIn(vs ...string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldIn(FieldReplyToID, vs...))
+}
+
+// ReplyToIDNotIn applies the NotIn predicate on the "reply_to_id" field.
+func ReplyToIDNotIn(vs ...string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNotIn(FieldReplyToID, vs...))
+}
+
+// ReplyToIDGT applies the GT predicate on the "reply_to_id" field.
+func ReplyToIDGT(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldGT(FieldReplyToID, v))
+}
+
+// ReplyToIDGTE applies the GTE predicate on the "reply_to_id" field.
+func ReplyToIDGTE(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldGTE(FieldReplyToID, v))
+}
+
+// ReplyToIDLT applies the LT predicate on the "reply_to_id" field.
+func ReplyToIDLT(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldLT(FieldReplyToID, v))
+}
+
+// ReplyToIDLTE applies the LTE predicate on the "reply_to_id" field.
+func ReplyToIDLTE(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldLTE(FieldReplyToID, v))
+}
+
+// ReplyToIDContains applies the Contains predicate on the "reply_to_id" field.
+func ReplyToIDContains(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldContains(FieldReplyToID, v))
+}
+
+// ReplyToIDHasPrefix applies the HasPrefix predicate on the "reply_to_id" field.
+func ReplyToIDHasPrefix(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldHasPrefix(FieldReplyToID, v))
+}
+
+// ReplyToIDHasSuffix applies the HasSuffix predicate on the "reply_to_id" field.
+func ReplyToIDHasSuffix(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldHasSuffix(FieldReplyToID, v))
+}
+
+// ReplyToIDIsNil applies the IsNil predicate on the "reply_to_id" field.
+func ReplyToIDIsNil() predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldIsNull(FieldReplyToID))
+}
+
+// ReplyToIDNotNil applies the NotNil predicate on the "reply_to_id" field.
+func ReplyToIDNotNil() predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNotNull(FieldReplyToID))
+}
+
+// ReplyToIDEqualFold applies the EqualFold predicate on the "reply_to_id" field.
+func ReplyToIDEqualFold(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEqualFold(FieldReplyToID, v))
+}
+
+// ReplyToIDContainsFold applies the ContainsFold predicate on the "reply_to_id" field.
+func ReplyToIDContainsFold(v string) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldContainsFold(FieldReplyToID, v))
+}
+
 // TimestampEQ applies the EQ predicate on the "timestamp" field.
 func TimestampEQ(v time.Time) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldEQ(FieldTimestamp, v))
@@ -305,6 +550,16 @@ func EditedTimestampNotNil() predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.FieldNotNull(FieldEditedTimestamp))
 }
 
+// SuspectedInjectionEQ applies the EQ predicate on the "suspected_injection" field.
+func SuspectedInjectionEQ(v bool) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldEQ(FieldSuspectedInjection, v))
+}
+
+// SuspectedInjectionNEQ applies the NEQ predicate on the "suspected_injection" field.
+func SuspectedInjectionNEQ(v bool) predicate.DiscordMessage {
+	return predicate.DiscordMessage(sql.FieldNEQ(FieldSuspectedInjection, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.DiscordMessage {
 	return predicate.DiscordMessage(func(s *sql.Selector) {
@@ -351,6 +606,167 @@ func HasEmbeddingsWith(preds ...predicate.DiscordMessageEmbedding) predicate.Dis
 	})
 }
 
+// HasReactions applies the HasEdge predicate on the "reactions" edge.
+func HasReactions() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReactionsTable, ReactionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReactionsWith applies the HasEdge predicate on the "reactions" edge with a given conditions (other predicates).
+func HasReactionsWith(preds ...predicate.DiscordReaction) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newReactionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplyTo applies the HasEdge predicate on the "reply_to" edge.
+func HasReplyTo() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReplyToTable, ReplyToColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReplyToWith applies the HasEdge predicate on the "reply_to" edge with a given conditions (other predicates).
+func HasReplyToWith(preds ...predicate.DiscordMessage) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newReplyToStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReplies applies the HasEdge predicate on the "replies" edge.
+func HasReplies() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RepliesTable, RepliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRepliesWith applies the HasEdge predicate on the "replies" edge with a given conditions (other predicates).
+func HasRepliesWith(preds ...predicate.DiscordMessage) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newRepliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentionedUsers applies the HasEdge predicate on the "mentioned_users" edge.
+func HasMentionedUsers() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, MentionedUsersTable, MentionedUsersPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionedUsersWith applies the HasEdge predicate on the "mentioned_users" edge with a given conditions (other predicates).
+func HasMentionedUsersWith(preds ...predicate.DiscordUser) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newMentionedUsersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentionedRoles applies the HasEdge predicate on the "mentioned_roles" edge.
+func HasMentionedRoles() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, MentionedRolesTable, MentionedRolesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionedRolesWith applies the HasEdge predicate on the "mentioned_roles" edge with a given conditions (other predicates).
+func HasMentionedRolesWith(preds ...predicate.DiscordRole) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newMentionedRolesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMentionedChannels applies the HasEdge predicate on the "mentioned_channels" edge.
+func HasMentionedChannels() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, MentionedChannelsTable, MentionedChannelsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionedChannelsWith applies the HasEdge predicate on the "mentioned_channels" edge with a given conditions (other predicates).
+func HasMentionedChannelsWith(preds ...predicate.DiscordChannel) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newMentionedChannelsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChunks applies the HasEdge predicate on the "chunks" edge.
+func HasChunks() predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ChunksTable, ChunksPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChunksWith applies the HasEdge predicate on the "chunks" edge with a given conditions (other predicates).
+func HasChunksWith(preds ...predicate.DiscordMessageChunk) predicate.DiscordMessage {
+	return predicate.DiscordMessage(func(s *sql.Selector) {
+		step := newChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DiscordMessage) predicate.DiscordMessage {
 	return predicate.DiscordMessage(sql.AndPredicates(predicates...))
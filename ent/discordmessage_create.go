@@ -6,8 +6,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sev0/ent/discordchannel"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
 	"sev0/ent/discorduser"
 	"time"
 
@@ -37,6 +41,48 @@ func (_c *DiscordMessageCreate) SetAuthorID(v string) *DiscordMessageCreate {
 	return _c
 }
 
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordMessageCreate) SetGuildID(v string) *DiscordMessageCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (_c *DiscordMessageCreate) SetNillableGuildID(v *string) *DiscordMessageCreate {
+	if v != nil {
+		_c.SetGuildID(*v)
+	}
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *DiscordMessageCreate) SetChannelID(v string) *DiscordMessageCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_c *DiscordMessageCreate) SetNillableChannelID(v *string) *DiscordMessageCreate {
+	if v != nil {
+		_c.SetChannelID(*v)
+	}
+	return _c
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_c *DiscordMessageCreate) SetReplyToID(v string) *DiscordMessageCreate {
+	_c.mutation.SetReplyToID(v)
+	return _c
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_c *DiscordMessageCreate) SetNillableReplyToID(v *string) *DiscordMessageCreate {
+	if v != nil {
+		_c.SetReplyToID(*v)
+	}
+	return _c
+}
+
 // SetTimestamp sets the "timestamp" field.
 func (_c *DiscordMessageCreate) SetTimestamp(v time.Time) *DiscordMessageCreate {
 	_c.mutation.SetTimestamp(v)
@@ -57,6 +103,20 @@ func (_c *DiscordMessageCreate) SetNillableEditedTimestamp(v *time.Time) *Discor
 	return _c
 }
 
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (_c *DiscordMessageCreate) SetSuspectedInjection(v bool) *DiscordMessageCreate {
+	_c.mutation.SetSuspectedInjection(v)
+	return _c
+}
+
+// SetNillableSuspectedInjection sets the "suspected_injection" field if the given value is not nil.
+func (_c *DiscordMessageCreate) SetNillableSuspectedInjection(v *bool) *DiscordMessageCreate {
+	if v != nil {
+		_c.SetSuspectedInjection(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DiscordMessageCreate) SetID(v string) *DiscordMessageCreate {
 	_c.mutation.SetID(v)
@@ -89,6 +149,101 @@ func (_c *DiscordMessageCreate) AddEmbeddings(v ...*DiscordMessageEmbedding) *Di
 	return _c.AddEmbeddingIDs(ids...)
 }
 
+// AddReactionIDs adds the "reactions" edge to the DiscordReaction entity by IDs.
+func (_c *DiscordMessageCreate) AddReactionIDs(ids ...int) *DiscordMessageCreate {
+	_c.mutation.AddReactionIDs(ids...)
+	return _c
+}
+
+// AddReactions adds the "reactions" edges to the DiscordReaction entity.
+func (_c *DiscordMessageCreate) AddReactions(v ...*DiscordReaction) *DiscordMessageCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReactionIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the DiscordMessage entity.
+func (_c *DiscordMessageCreate) SetReplyTo(v *DiscordMessage) *DiscordMessageCreate {
+	return _c.SetReplyToID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the DiscordMessage entity by IDs.
+func (_c *DiscordMessageCreate) AddReplyIDs(ids ...string) *DiscordMessageCreate {
+	_c.mutation.AddReplyIDs(ids...)
+	return _c
+}
+
+// AddReplies adds the "replies" edges to the DiscordMessage entity.
+func (_c *DiscordMessageCreate) AddReplies(v ...*DiscordMessage) *DiscordMessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReplyIDs(ids...)
+}
+
+// AddMentionedUserIDs adds the "mentioned_users" edge to the DiscordUser entity by IDs.
+func (_c *DiscordMessageCreate) AddMentionedUserIDs(ids ...string) *DiscordMessageCreate {
+	_c.mutation.AddMentionedUserIDs(ids...)
+	return _c
+}
+
+// AddMentionedUsers adds the "mentioned_users" edges to the DiscordUser entity.
+func (_c *DiscordMessageCreate) AddMentionedUsers(v ...*DiscordUser) *DiscordMessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionedUserIDs(ids...)
+}
+
+// AddMentionedRoleIDs adds the "mentioned_roles" edge to the DiscordRole entity by IDs.
+func (_c *DiscordMessageCreate) AddMentionedRoleIDs(ids ...string) *DiscordMessageCreate {
+	_c.mutation.AddMentionedRoleIDs(ids...)
+	return _c
+}
+
+// AddMentionedRoles adds the "mentioned_roles" edges to the DiscordRole entity.
+func (_c *DiscordMessageCreate) AddMentionedRoles(v ...*DiscordRole) *DiscordMessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionedRoleIDs(ids...)
+}
+
+// AddMentionedChannelIDs adds the "mentioned_channels" edge to the DiscordChannel entity by IDs.
+func (_c *DiscordMessageCreate) AddMentionedChannelIDs(ids ...string) *DiscordMessageCreate {
+	_c.mutation.AddMentionedChannelIDs(ids...)
+	return _c
+}
+
+// AddMentionedChannels adds the "mentioned_channels" edges to the DiscordChannel entity.
+func (_c *DiscordMessageCreate) AddMentionedChannels(v ...*DiscordChannel) *DiscordMessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionedChannelIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the DiscordMessageChunk entity by IDs.
+func (_c *DiscordMessageCreate) AddChunkIDs(ids ...string) *DiscordMessageCreate {
+	_c.mutation.AddChunkIDs(ids...)
+	return _c
+}
+
+// AddChunks adds the "chunks" edges to the DiscordMessageChunk entity.
+func (_c *DiscordMessageCreate) AddChunks(v ...*DiscordMessageChunk) *DiscordMessageCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChunkIDs(ids...)
+}
+
 // Mutation returns the DiscordMessageMutation object of the builder.
 func (_c *DiscordMessageCreate) Mutation() *DiscordMessageMutation {
 	return _c.mutation
@@ -96,6 +251,7 @@ func (_c *DiscordMessageCreate) Mutation() *DiscordMessageMutation {
 
 // Save creates the DiscordMessage in the database.
 func (_c *DiscordMessageCreate) Save(ctx context.Context) (*DiscordMessage, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -121,6 +277,14 @@ func (_c *DiscordMessageCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *DiscordMessageCreate) defaults() {
+	if _, ok := _c.mutation.SuspectedInjection(); !ok {
+		v := discordmessage.DefaultSuspectedInjection
+		_c.mutation.SetSuspectedInjection(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *DiscordMessageCreate) check() error {
 	if _, ok := _c.mutation.Content(); !ok {
@@ -137,6 +301,9 @@ func (_c *DiscordMessageCreate) check() error {
 	if _, ok := _c.mutation.Timestamp(); !ok {
 		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "DiscordMessage.timestamp"`)}
 	}
+	if _, ok := _c.mutation.SuspectedInjection(); !ok {
+		return &ValidationError{Name: "suspected_injection", err: errors.New(`ent: missing required field "DiscordMessage.suspected_injection"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := discordmessage.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordMessage.id": %w`, err)}
@@ -185,6 +352,14 @@ func (_c *DiscordMessageCreate) createSpec() (*DiscordMessage, *sqlgraph.CreateS
 		_spec.SetField(discordmessage.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordmessage.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(discordmessage.FieldChannelID, field.TypeString, value)
+		_node.ChannelID = value
+	}
 	if value, ok := _c.mutation.Timestamp(); ok {
 		_spec.SetField(discordmessage.FieldTimestamp, field.TypeTime, value)
 		_node.Timestamp = value
@@ -193,6 +368,10 @@ func (_c *DiscordMessageCreate) createSpec() (*DiscordMessage, *sqlgraph.CreateS
 		_spec.SetField(discordmessage.FieldEditedTimestamp, field.TypeTime, value)
 		_node.EditedTimestamp = value
 	}
+	if value, ok := _c.mutation.SuspectedInjection(); ok {
+		_spec.SetField(discordmessage.FieldSuspectedInjection, field.TypeBool, value)
+		_node.SuspectedInjection = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -226,6 +405,119 @@ func (_c *DiscordMessageCreate) createSpec() (*DiscordMessage, *sqlgraph.CreateS
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordmessage.ReplyToTable,
+			Columns: []string{discordmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReplyToID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionedRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MentionedChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -290,6 +582,60 @@ func (u *DiscordMessageUpsert) UpdateContent() *DiscordMessageUpsert {
 	return u
 }
 
+// SetGuildID sets the "guild_id" field.
+func (u *DiscordMessageUpsert) SetGuildID(v string) *DiscordMessageUpsert {
+	u.Set(discordmessage.FieldGuildID, v)
+	return u
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsert) UpdateGuildID() *DiscordMessageUpsert {
+	u.SetExcluded(discordmessage.FieldGuildID)
+	return u
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (u *DiscordMessageUpsert) ClearGuildID() *DiscordMessageUpsert {
+	u.SetNull(discordmessage.FieldGuildID)
+	return u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (u *DiscordMessageUpsert) SetChannelID(v string) *DiscordMessageUpsert {
+	u.Set(discordmessage.FieldChannelID, v)
+	return u
+}
+
+// UpdateChannelID sets the "channel_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsert) UpdateChannelID() *DiscordMessageUpsert {
+	u.SetExcluded(discordmessage.FieldChannelID)
+	return u
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (u *DiscordMessageUpsert) ClearChannelID() *DiscordMessageUpsert {
+	u.SetNull(discordmessage.FieldChannelID)
+	return u
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (u *DiscordMessageUpsert) SetReplyToID(v string) *DiscordMessageUpsert {
+	u.Set(discordmessage.FieldReplyToID, v)
+	return u
+}
+
+// UpdateReplyToID sets the "reply_to_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsert) UpdateReplyToID() *DiscordMessageUpsert {
+	u.SetExcluded(discordmessage.FieldReplyToID)
+	return u
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (u *DiscordMessageUpsert) ClearReplyToID() *DiscordMessageUpsert {
+	u.SetNull(discordmessage.FieldReplyToID)
+	return u
+}
+
 // SetEditedTimestamp sets the "edited_timestamp" field.
 func (u *DiscordMessageUpsert) SetEditedTimestamp(v time.Time) *DiscordMessageUpsert {
 	u.Set(discordmessage.FieldEditedTimestamp, v)
@@ -308,6 +654,18 @@ func (u *DiscordMessageUpsert) ClearEditedTimestamp() *DiscordMessageUpsert {
 	return u
 }
 
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (u *DiscordMessageUpsert) SetSuspectedInjection(v bool) *DiscordMessageUpsert {
+	u.Set(discordmessage.FieldSuspectedInjection, v)
+	return u
+}
+
+// UpdateSuspectedInjection sets the "suspected_injection" field to the value that was provided on create.
+func (u *DiscordMessageUpsert) UpdateSuspectedInjection() *DiscordMessageUpsert {
+	u.SetExcluded(discordmessage.FieldSuspectedInjection)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -376,6 +734,69 @@ func (u *DiscordMessageUpsertOne) UpdateContent() *DiscordMessageUpsertOne {
 	})
 }
 
+// SetGuildID sets the "guild_id" field.
+func (u *DiscordMessageUpsertOne) SetGuildID(v string) *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsertOne) UpdateGuildID() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (u *DiscordMessageUpsertOne) ClearGuildID() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.ClearGuildID()
+	})
+}
+
+// SetChannelID sets the "channel_id" field.
+func (u *DiscordMessageUpsertOne) SetChannelID(v string) *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetChannelID(v)
+	})
+}
+
+// UpdateChannelID sets the "channel_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsertOne) UpdateChannelID() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateChannelID()
+	})
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (u *DiscordMessageUpsertOne) ClearChannelID() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.ClearChannelID()
+	})
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (u *DiscordMessageUpsertOne) SetReplyToID(v string) *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetReplyToID(v)
+	})
+}
+
+// UpdateReplyToID sets the "reply_to_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsertOne) UpdateReplyToID() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateReplyToID()
+	})
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (u *DiscordMessageUpsertOne) ClearReplyToID() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.ClearReplyToID()
+	})
+}
+
 // SetEditedTimestamp sets the "edited_timestamp" field.
 func (u *DiscordMessageUpsertOne) SetEditedTimestamp(v time.Time) *DiscordMessageUpsertOne {
 	return u.Update(func(s *DiscordMessageUpsert) {
@@ -397,6 +818,20 @@ func (u *DiscordMessageUpsertOne) ClearEditedTimestamp() *DiscordMessageUpsertOn
 	})
 }
 
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (u *DiscordMessageUpsertOne) SetSuspectedInjection(v bool) *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetSuspectedInjection(v)
+	})
+}
+
+// UpdateSuspectedInjection sets the "suspected_injection" field to the value that was provided on create.
+func (u *DiscordMessageUpsertOne) UpdateSuspectedInjection() *DiscordMessageUpsertOne {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateSuspectedInjection()
+	})
+}
+
 // Exec executes the query.
 func (u *DiscordMessageUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -454,6 +889,7 @@ func (_c *DiscordMessageCreateBulk) Save(ctx context.Context) ([]*DiscordMessage
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DiscordMessageMutation)
 				if !ok {
@@ -631,6 +1067,69 @@ func (u *DiscordMessageUpsertBulk) UpdateContent() *DiscordMessageUpsertBulk {
 	})
 }
 
+// SetGuildID sets the "guild_id" field.
+func (u *DiscordMessageUpsertBulk) SetGuildID(v string) *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetGuildID(v)
+	})
+}
+
+// UpdateGuildID sets the "guild_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsertBulk) UpdateGuildID() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateGuildID()
+	})
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (u *DiscordMessageUpsertBulk) ClearGuildID() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.ClearGuildID()
+	})
+}
+
+// SetChannelID sets the "channel_id" field.
+func (u *DiscordMessageUpsertBulk) SetChannelID(v string) *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetChannelID(v)
+	})
+}
+
+// UpdateChannelID sets the "channel_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsertBulk) UpdateChannelID() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateChannelID()
+	})
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (u *DiscordMessageUpsertBulk) ClearChannelID() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.ClearChannelID()
+	})
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (u *DiscordMessageUpsertBulk) SetReplyToID(v string) *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetReplyToID(v)
+	})
+}
+
+// UpdateReplyToID sets the "reply_to_id" field to the value that was provided on create.
+func (u *DiscordMessageUpsertBulk) UpdateReplyToID() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateReplyToID()
+	})
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (u *DiscordMessageUpsertBulk) ClearReplyToID() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.ClearReplyToID()
+	})
+}
+
 // SetEditedTimestamp sets the "edited_timestamp" field.
 func (u *DiscordMessageUpsertBulk) SetEditedTimestamp(v time.Time) *DiscordMessageUpsertBulk {
 	return u.Update(func(s *DiscordMessageUpsert) {
@@ -652,6 +1151,20 @@ func (u *DiscordMessageUpsertBulk) ClearEditedTimestamp() *DiscordMessageUpsertB
 	})
 }
 
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (u *DiscordMessageUpsertBulk) SetSuspectedInjection(v bool) *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.SetSuspectedInjection(v)
+	})
+}
+
+// UpdateSuspectedInjection sets the "suspected_injection" field to the value that was provided on create.
+func (u *DiscordMessageUpsertBulk) UpdateSuspectedInjection() *DiscordMessageUpsertBulk {
+	return u.Update(func(s *DiscordMessageUpsert) {
+		s.UpdateSuspectedInjection()
+	})
+}
+
 // Exec executes the query.
 func (u *DiscordMessageUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -7,8 +7,12 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sev0/ent/discordchannel"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
 	"sev0/ent/discorduser"
 	"sev0/ent/predicate"
 
@@ -21,12 +25,19 @@ import (
 // DiscordMessageQuery is the builder for querying DiscordMessage entities.
 type DiscordMessageQuery struct {
 	config
-	ctx            *QueryContext
-	order          []discordmessage.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.DiscordMessage
-	withUser       *DiscordUserQuery
-	withEmbeddings *DiscordMessageEmbeddingQuery
+	ctx                   *QueryContext
+	order                 []discordmessage.OrderOption
+	inters                []Interceptor
+	predicates            []predicate.DiscordMessage
+	withUser              *DiscordUserQuery
+	withEmbeddings        *DiscordMessageEmbeddingQuery
+	withReactions         *DiscordReactionQuery
+	withReplyTo           *DiscordMessageQuery
+	withReplies           *DiscordMessageQuery
+	withMentionedUsers    *DiscordUserQuery
+	withMentionedRoles    *DiscordRoleQuery
+	withMentionedChannels *DiscordChannelQuery
+	withChunks            *DiscordMessageChunkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +118,160 @@ func (_q *DiscordMessageQuery) QueryEmbeddings() *DiscordMessageEmbeddingQuery {
 	return query
 }
 
+// QueryReactions chains the current query on the "reactions" edge.
+func (_q *DiscordMessageQuery) QueryReactions() *DiscordReactionQuery {
+	query := (&DiscordReactionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discordreaction.Table, discordreaction.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessage.ReactionsTable, discordmessage.ReactionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplyTo chains the current query on the "reply_to" edge.
+func (_q *DiscordMessageQuery) QueryReplyTo() *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, discordmessage.ReplyToTable, discordmessage.ReplyToColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReplies chains the current query on the "replies" edge.
+func (_q *DiscordMessageQuery) QueryReplies() *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessage.RepliesTable, discordmessage.RepliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentionedUsers chains the current query on the "mentioned_users" edge.
+func (_q *DiscordMessageQuery) QueryMentionedUsers() *DiscordUserQuery {
+	query := (&DiscordUserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discorduser.Table, discorduser.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessage.MentionedUsersTable, discordmessage.MentionedUsersPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentionedRoles chains the current query on the "mentioned_roles" edge.
+func (_q *DiscordMessageQuery) QueryMentionedRoles() *DiscordRoleQuery {
+	query := (&DiscordRoleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discordrole.Table, discordrole.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessage.MentionedRolesTable, discordmessage.MentionedRolesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMentionedChannels chains the current query on the "mentioned_channels" edge.
+func (_q *DiscordMessageQuery) QueryMentionedChannels() *DiscordChannelQuery {
+	query := (&DiscordChannelClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discordchannel.Table, discordchannel.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessage.MentionedChannelsTable, discordmessage.MentionedChannelsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChunks chains the current query on the "chunks" edge.
+func (_q *DiscordMessageQuery) QueryChunks() *DiscordMessageChunkQuery {
+	query := (&DiscordMessageChunkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessage.Table, discordmessage.FieldID, selector),
+			sqlgraph.To(discordmessagechunk.Table, discordmessagechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, discordmessage.ChunksTable, discordmessage.ChunksPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DiscordMessage entity from the query.
 // Returns a *NotFoundError when no DiscordMessage was found.
 func (_q *DiscordMessageQuery) First(ctx context.Context) (*DiscordMessage, error) {
@@ -294,13 +459,20 @@ func (_q *DiscordMessageQuery) Clone() *DiscordMessageQuery {
 		return nil
 	}
 	return &DiscordMessageQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]discordmessage.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.DiscordMessage{}, _q.predicates...),
-		withUser:       _q.withUser.Clone(),
-		withEmbeddings: _q.withEmbeddings.Clone(),
+		config:                _q.config,
+		ctx:                   _q.ctx.Clone(),
+		order:                 append([]discordmessage.OrderOption{}, _q.order...),
+		inters:                append([]Interceptor{}, _q.inters...),
+		predicates:            append([]predicate.DiscordMessage{}, _q.predicates...),
+		withUser:              _q.withUser.Clone(),
+		withEmbeddings:        _q.withEmbeddings.Clone(),
+		withReactions:         _q.withReactions.Clone(),
+		withReplyTo:           _q.withReplyTo.Clone(),
+		withReplies:           _q.withReplies.Clone(),
+		withMentionedUsers:    _q.withMentionedUsers.Clone(),
+		withMentionedRoles:    _q.withMentionedRoles.Clone(),
+		withMentionedChannels: _q.withMentionedChannels.Clone(),
+		withChunks:            _q.withChunks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +501,83 @@ func (_q *DiscordMessageQuery) WithEmbeddings(opts ...func(*DiscordMessageEmbedd
 	return _q
 }
 
+// WithReactions tells the query-builder to eager-load the nodes that are connected to
+// the "reactions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithReactions(opts ...func(*DiscordReactionQuery)) *DiscordMessageQuery {
+	query := (&DiscordReactionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReactions = query
+	return _q
+}
+
+// WithReplyTo tells the query-builder to eager-load the nodes that are connected to
+// the "reply_to" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithReplyTo(opts ...func(*DiscordMessageQuery)) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReplyTo = query
+	return _q
+}
+
+// WithReplies tells the query-builder to eager-load the nodes that are connected to
+// the "replies" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithReplies(opts ...func(*DiscordMessageQuery)) *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReplies = query
+	return _q
+}
+
+// WithMentionedUsers tells the query-builder to eager-load the nodes that are connected to
+// the "mentioned_users" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithMentionedUsers(opts ...func(*DiscordUserQuery)) *DiscordMessageQuery {
+	query := (&DiscordUserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentionedUsers = query
+	return _q
+}
+
+// WithMentionedRoles tells the query-builder to eager-load the nodes that are connected to
+// the "mentioned_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithMentionedRoles(opts ...func(*DiscordRoleQuery)) *DiscordMessageQuery {
+	query := (&DiscordRoleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentionedRoles = query
+	return _q
+}
+
+// WithMentionedChannels tells the query-builder to eager-load the nodes that are connected to
+// the "mentioned_channels" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithMentionedChannels(opts ...func(*DiscordChannelQuery)) *DiscordMessageQuery {
+	query := (&DiscordChannelClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentionedChannels = query
+	return _q
+}
+
+// WithChunks tells the query-builder to eager-load the nodes that are connected to
+// the "chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageQuery) WithChunks(opts ...func(*DiscordMessageChunkQuery)) *DiscordMessageQuery {
+	query := (&DiscordMessageChunkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChunks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +656,16 @@ func (_q *DiscordMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*DiscordMessage{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [9]bool{
 			_q.withUser != nil,
 			_q.withEmbeddings != nil,
+			_q.withReactions != nil,
+			_q.withReplyTo != nil,
+			_q.withReplies != nil,
+			_q.withMentionedUsers != nil,
+			_q.withMentionedRoles != nil,
+			_q.withMentionedChannels != nil,
+			_q.withChunks != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -445,6 +701,56 @@ func (_q *DiscordMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := _q.withReactions; query != nil {
+		if err := _q.loadReactions(ctx, query, nodes,
+			func(n *DiscordMessage) { n.Edges.Reactions = []*DiscordReaction{} },
+			func(n *DiscordMessage, e *DiscordReaction) { n.Edges.Reactions = append(n.Edges.Reactions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReplyTo; query != nil {
+		if err := _q.loadReplyTo(ctx, query, nodes, nil,
+			func(n *DiscordMessage, e *DiscordMessage) { n.Edges.ReplyTo = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReplies; query != nil {
+		if err := _q.loadReplies(ctx, query, nodes,
+			func(n *DiscordMessage) { n.Edges.Replies = []*DiscordMessage{} },
+			func(n *DiscordMessage, e *DiscordMessage) { n.Edges.Replies = append(n.Edges.Replies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMentionedUsers; query != nil {
+		if err := _q.loadMentionedUsers(ctx, query, nodes,
+			func(n *DiscordMessage) { n.Edges.MentionedUsers = []*DiscordUser{} },
+			func(n *DiscordMessage, e *DiscordUser) { n.Edges.MentionedUsers = append(n.Edges.MentionedUsers, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMentionedRoles; query != nil {
+		if err := _q.loadMentionedRoles(ctx, query, nodes,
+			func(n *DiscordMessage) { n.Edges.MentionedRoles = []*DiscordRole{} },
+			func(n *DiscordMessage, e *DiscordRole) { n.Edges.MentionedRoles = append(n.Edges.MentionedRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMentionedChannels; query != nil {
+		if err := _q.loadMentionedChannels(ctx, query, nodes,
+			func(n *DiscordMessage) { n.Edges.MentionedChannels = []*DiscordChannel{} },
+			func(n *DiscordMessage, e *DiscordChannel) {
+				n.Edges.MentionedChannels = append(n.Edges.MentionedChannels, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChunks; query != nil {
+		if err := _q.loadChunks(ctx, query, nodes,
+			func(n *DiscordMessage) { n.Edges.Chunks = []*DiscordMessageChunk{} },
+			func(n *DiscordMessage, e *DiscordMessageChunk) { n.Edges.Chunks = append(n.Edges.Chunks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +813,339 @@ func (_q *DiscordMessageQuery) loadEmbeddings(ctx context.Context, query *Discor
 	}
 	return nil
 }
+func (_q *DiscordMessageQuery) loadReactions(ctx context.Context, query *DiscordReactionQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordReaction)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*DiscordMessage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discordreaction.FieldMessageID)
+	}
+	query.Where(predicate.DiscordReaction(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discordmessage.ReactionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.MessageID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DiscordMessageQuery) loadReplyTo(ctx context.Context, query *DiscordMessageQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordMessage)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*DiscordMessage)
+	for i := range nodes {
+		fk := nodes[i].ReplyToID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(discordmessage.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "reply_to_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *DiscordMessageQuery) loadReplies(ctx context.Context, query *DiscordMessageQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*DiscordMessage)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discordmessage.FieldReplyToID)
+	}
+	query.Where(predicate.DiscordMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discordmessage.RepliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReplyToID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "reply_to_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DiscordMessageQuery) loadMentionedUsers(ctx context.Context, query *DiscordUserQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordUser)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*DiscordMessage)
+	nids := make(map[string]map[*DiscordMessage]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discordmessage.MentionedUsersTable)
+		s.Join(joinT).On(s.C(discorduser.FieldID), joinT.C(discordmessage.MentionedUsersPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(discordmessage.MentionedUsersPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discordmessage.MentionedUsersPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DiscordMessage]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DiscordUser](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentioned_users" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *DiscordMessageQuery) loadMentionedRoles(ctx context.Context, query *DiscordRoleQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordRole)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*DiscordMessage)
+	nids := make(map[string]map[*DiscordMessage]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discordmessage.MentionedRolesTable)
+		s.Join(joinT).On(s.C(discordrole.FieldID), joinT.C(discordmessage.MentionedRolesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(discordmessage.MentionedRolesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discordmessage.MentionedRolesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DiscordMessage]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DiscordRole](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentioned_roles" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *DiscordMessageQuery) loadMentionedChannels(ctx context.Context, query *DiscordChannelQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordChannel)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*DiscordMessage)
+	nids := make(map[string]map[*DiscordMessage]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discordmessage.MentionedChannelsTable)
+		s.Join(joinT).On(s.C(discordchannel.FieldID), joinT.C(discordmessage.MentionedChannelsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(discordmessage.MentionedChannelsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discordmessage.MentionedChannelsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DiscordMessage]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DiscordChannel](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentioned_channels" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *DiscordMessageQuery) loadChunks(ctx context.Context, query *DiscordMessageChunkQuery, nodes []*DiscordMessage, init func(*DiscordMessage), assign func(*DiscordMessage, *DiscordMessageChunk)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*DiscordMessage)
+	nids := make(map[string]map[*DiscordMessage]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discordmessage.ChunksTable)
+		s.Join(joinT).On(s.C(discordmessagechunk.FieldID), joinT.C(discordmessage.ChunksPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(discordmessage.ChunksPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discordmessage.ChunksPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DiscordMessage]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DiscordMessageChunk](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "chunks" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *DiscordMessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -536,6 +1175,9 @@ func (_q *DiscordMessageQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withUser != nil {
 			_spec.Node.AddColumnOnce(discordmessage.FieldAuthorID)
 		}
+		if _q.withReplyTo != nil {
+			_spec.Node.AddColumnOnce(discordmessage.FieldReplyToID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
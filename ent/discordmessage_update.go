@@ -6,8 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sev0/ent/discordchannel"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
+	"sev0/ent/discorduser"
 	"sev0/ent/predicate"
 	"time"
 
@@ -43,6 +48,66 @@ func (_u *DiscordMessageUpdate) SetNillableContent(v *string) *DiscordMessageUpd
 	return _u
 }
 
+// SetGuildID sets the "guild_id" field.
+func (_u *DiscordMessageUpdate) SetGuildID(v string) *DiscordMessageUpdate {
+	_u.mutation.SetGuildID(v)
+	return _u
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (_u *DiscordMessageUpdate) SetNillableGuildID(v *string) *DiscordMessageUpdate {
+	if v != nil {
+		_u.SetGuildID(*v)
+	}
+	return _u
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (_u *DiscordMessageUpdate) ClearGuildID() *DiscordMessageUpdate {
+	_u.mutation.ClearGuildID()
+	return _u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_u *DiscordMessageUpdate) SetChannelID(v string) *DiscordMessageUpdate {
+	_u.mutation.SetChannelID(v)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_u *DiscordMessageUpdate) SetNillableChannelID(v *string) *DiscordMessageUpdate {
+	if v != nil {
+		_u.SetChannelID(*v)
+	}
+	return _u
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (_u *DiscordMessageUpdate) ClearChannelID() *DiscordMessageUpdate {
+	_u.mutation.ClearChannelID()
+	return _u
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_u *DiscordMessageUpdate) SetReplyToID(v string) *DiscordMessageUpdate {
+	_u.mutation.SetReplyToID(v)
+	return _u
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_u *DiscordMessageUpdate) SetNillableReplyToID(v *string) *DiscordMessageUpdate {
+	if v != nil {
+		_u.SetReplyToID(*v)
+	}
+	return _u
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (_u *DiscordMessageUpdate) ClearReplyToID() *DiscordMessageUpdate {
+	_u.mutation.ClearReplyToID()
+	return _u
+}
+
 // SetEditedTimestamp sets the "edited_timestamp" field.
 func (_u *DiscordMessageUpdate) SetEditedTimestamp(v time.Time) *DiscordMessageUpdate {
 	_u.mutation.SetEditedTimestamp(v)
@@ -63,6 +128,20 @@ func (_u *DiscordMessageUpdate) ClearEditedTimestamp() *DiscordMessageUpdate {
 	return _u
 }
 
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (_u *DiscordMessageUpdate) SetSuspectedInjection(v bool) *DiscordMessageUpdate {
+	_u.mutation.SetSuspectedInjection(v)
+	return _u
+}
+
+// SetNillableSuspectedInjection sets the "suspected_injection" field if the given value is not nil.
+func (_u *DiscordMessageUpdate) SetNillableSuspectedInjection(v *bool) *DiscordMessageUpdate {
+	if v != nil {
+		_u.SetSuspectedInjection(*v)
+	}
+	return _u
+}
+
 // AddEmbeddingIDs adds the "embeddings" edge to the DiscordMessageEmbedding entity by IDs.
 func (_u *DiscordMessageUpdate) AddEmbeddingIDs(ids ...string) *DiscordMessageUpdate {
 	_u.mutation.AddEmbeddingIDs(ids...)
@@ -78,6 +157,101 @@ func (_u *DiscordMessageUpdate) AddEmbeddings(v ...*DiscordMessageEmbedding) *Di
 	return _u.AddEmbeddingIDs(ids...)
 }
 
+// AddReactionIDs adds the "reactions" edge to the DiscordReaction entity by IDs.
+func (_u *DiscordMessageUpdate) AddReactionIDs(ids ...int) *DiscordMessageUpdate {
+	_u.mutation.AddReactionIDs(ids...)
+	return _u
+}
+
+// AddReactions adds the "reactions" edges to the DiscordReaction entity.
+func (_u *DiscordMessageUpdate) AddReactions(v ...*DiscordReaction) *DiscordMessageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactionIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the DiscordMessage entity.
+func (_u *DiscordMessageUpdate) SetReplyTo(v *DiscordMessage) *DiscordMessageUpdate {
+	return _u.SetReplyToID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the DiscordMessage entity by IDs.
+func (_u *DiscordMessageUpdate) AddReplyIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the DiscordMessage entity.
+func (_u *DiscordMessageUpdate) AddReplies(v ...*DiscordMessage) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
+// AddMentionedUserIDs adds the "mentioned_users" edge to the DiscordUser entity by IDs.
+func (_u *DiscordMessageUpdate) AddMentionedUserIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.AddMentionedUserIDs(ids...)
+	return _u
+}
+
+// AddMentionedUsers adds the "mentioned_users" edges to the DiscordUser entity.
+func (_u *DiscordMessageUpdate) AddMentionedUsers(v ...*DiscordUser) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedUserIDs(ids...)
+}
+
+// AddMentionedRoleIDs adds the "mentioned_roles" edge to the DiscordRole entity by IDs.
+func (_u *DiscordMessageUpdate) AddMentionedRoleIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.AddMentionedRoleIDs(ids...)
+	return _u
+}
+
+// AddMentionedRoles adds the "mentioned_roles" edges to the DiscordRole entity.
+func (_u *DiscordMessageUpdate) AddMentionedRoles(v ...*DiscordRole) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedRoleIDs(ids...)
+}
+
+// AddMentionedChannelIDs adds the "mentioned_channels" edge to the DiscordChannel entity by IDs.
+func (_u *DiscordMessageUpdate) AddMentionedChannelIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.AddMentionedChannelIDs(ids...)
+	return _u
+}
+
+// AddMentionedChannels adds the "mentioned_channels" edges to the DiscordChannel entity.
+func (_u *DiscordMessageUpdate) AddMentionedChannels(v ...*DiscordChannel) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedChannelIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the DiscordMessageChunk entity by IDs.
+func (_u *DiscordMessageUpdate) AddChunkIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.AddChunkIDs(ids...)
+	return _u
+}
+
+// AddChunks adds the "chunks" edges to the DiscordMessageChunk entity.
+func (_u *DiscordMessageUpdate) AddChunks(v ...*DiscordMessageChunk) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChunkIDs(ids...)
+}
+
 // Mutation returns the DiscordMessageMutation object of the builder.
 func (_u *DiscordMessageUpdate) Mutation() *DiscordMessageMutation {
 	return _u.mutation
@@ -104,6 +278,138 @@ func (_u *DiscordMessageUpdate) RemoveEmbeddings(v ...*DiscordMessageEmbedding)
 	return _u.RemoveEmbeddingIDs(ids...)
 }
 
+// ClearReactions clears all "reactions" edges to the DiscordReaction entity.
+func (_u *DiscordMessageUpdate) ClearReactions() *DiscordMessageUpdate {
+	_u.mutation.ClearReactions()
+	return _u
+}
+
+// RemoveReactionIDs removes the "reactions" edge to DiscordReaction entities by IDs.
+func (_u *DiscordMessageUpdate) RemoveReactionIDs(ids ...int) *DiscordMessageUpdate {
+	_u.mutation.RemoveReactionIDs(ids...)
+	return _u
+}
+
+// RemoveReactions removes "reactions" edges to DiscordReaction entities.
+func (_u *DiscordMessageUpdate) RemoveReactions(v ...*DiscordReaction) *DiscordMessageUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReactionIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the DiscordMessage entity.
+func (_u *DiscordMessageUpdate) ClearReplyTo() *DiscordMessageUpdate {
+	_u.mutation.ClearReplyTo()
+	return _u
+}
+
+// ClearReplies clears all "replies" edges to the DiscordMessage entity.
+func (_u *DiscordMessageUpdate) ClearReplies() *DiscordMessageUpdate {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to DiscordMessage entities by IDs.
+func (_u *DiscordMessageUpdate) RemoveReplyIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to DiscordMessage entities.
+func (_u *DiscordMessageUpdate) RemoveReplies(v ...*DiscordMessage) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReplyIDs(ids...)
+}
+
+// ClearMentionedUsers clears all "mentioned_users" edges to the DiscordUser entity.
+func (_u *DiscordMessageUpdate) ClearMentionedUsers() *DiscordMessageUpdate {
+	_u.mutation.ClearMentionedUsers()
+	return _u
+}
+
+// RemoveMentionedUserIDs removes the "mentioned_users" edge to DiscordUser entities by IDs.
+func (_u *DiscordMessageUpdate) RemoveMentionedUserIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.RemoveMentionedUserIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedUsers removes "mentioned_users" edges to DiscordUser entities.
+func (_u *DiscordMessageUpdate) RemoveMentionedUsers(v ...*DiscordUser) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedUserIDs(ids...)
+}
+
+// ClearMentionedRoles clears all "mentioned_roles" edges to the DiscordRole entity.
+func (_u *DiscordMessageUpdate) ClearMentionedRoles() *DiscordMessageUpdate {
+	_u.mutation.ClearMentionedRoles()
+	return _u
+}
+
+// RemoveMentionedRoleIDs removes the "mentioned_roles" edge to DiscordRole entities by IDs.
+func (_u *DiscordMessageUpdate) RemoveMentionedRoleIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.RemoveMentionedRoleIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedRoles removes "mentioned_roles" edges to DiscordRole entities.
+func (_u *DiscordMessageUpdate) RemoveMentionedRoles(v ...*DiscordRole) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedRoleIDs(ids...)
+}
+
+// ClearMentionedChannels clears all "mentioned_channels" edges to the DiscordChannel entity.
+func (_u *DiscordMessageUpdate) ClearMentionedChannels() *DiscordMessageUpdate {
+	_u.mutation.ClearMentionedChannels()
+	return _u
+}
+
+// RemoveMentionedChannelIDs removes the "mentioned_channels" edge to DiscordChannel entities by IDs.
+func (_u *DiscordMessageUpdate) RemoveMentionedChannelIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.RemoveMentionedChannelIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedChannels removes "mentioned_channels" edges to DiscordChannel entities.
+func (_u *DiscordMessageUpdate) RemoveMentionedChannels(v ...*DiscordChannel) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedChannelIDs(ids...)
+}
+
+// ClearChunks clears all "chunks" edges to the DiscordMessageChunk entity.
+func (_u *DiscordMessageUpdate) ClearChunks() *DiscordMessageUpdate {
+	_u.mutation.ClearChunks()
+	return _u
+}
+
+// RemoveChunkIDs removes the "chunks" edge to DiscordMessageChunk entities by IDs.
+func (_u *DiscordMessageUpdate) RemoveChunkIDs(ids ...string) *DiscordMessageUpdate {
+	_u.mutation.RemoveChunkIDs(ids...)
+	return _u
+}
+
+// RemoveChunks removes "chunks" edges to DiscordMessageChunk entities.
+func (_u *DiscordMessageUpdate) RemoveChunks(v ...*DiscordMessageChunk) *DiscordMessageUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChunkIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DiscordMessageUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -154,12 +460,27 @@ func (_u *DiscordMessageUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(discordmessage.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.GuildID(); ok {
+		_spec.SetField(discordmessage.FieldGuildID, field.TypeString, value)
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(discordmessage.FieldGuildID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChannelID(); ok {
+		_spec.SetField(discordmessage.FieldChannelID, field.TypeString, value)
+	}
+	if _u.mutation.ChannelIDCleared() {
+		_spec.ClearField(discordmessage.FieldChannelID, field.TypeString)
+	}
 	if value, ok := _u.mutation.EditedTimestamp(); ok {
 		_spec.SetField(discordmessage.FieldEditedTimestamp, field.TypeTime, value)
 	}
 	if _u.mutation.EditedTimestampCleared() {
 		_spec.ClearField(discordmessage.FieldEditedTimestamp, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SuspectedInjection(); ok {
+		_spec.SetField(discordmessage.FieldSuspectedInjection, field.TypeBool, value)
+	}
 	if _u.mutation.EmbeddingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -205,99 +526,699 @@ func (_u *DiscordMessageUpdate) sqlSave(ctx context.Context) (_node int, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{discordmessage.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// DiscordMessageUpdateOne is the builder for updating a single DiscordMessage entity.
-type DiscordMessageUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *DiscordMessageMutation
-}
-
-// SetContent sets the "content" field.
-func (_u *DiscordMessageUpdateOne) SetContent(v string) *DiscordMessageUpdateOne {
-	_u.mutation.SetContent(v)
-	return _u
-}
-
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (_u *DiscordMessageUpdateOne) SetNillableContent(v *string) *DiscordMessageUpdateOne {
-	if v != nil {
-		_u.SetContent(*v)
+	if nodes := _u.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !_u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetEditedTimestamp sets the "edited_timestamp" field.
-func (_u *DiscordMessageUpdateOne) SetEditedTimestamp(v time.Time) *DiscordMessageUpdateOne {
-	_u.mutation.SetEditedTimestamp(v)
-	return _u
-}
-
-// SetNillableEditedTimestamp sets the "edited_timestamp" field if the given value is not nil.
-func (_u *DiscordMessageUpdateOne) SetNillableEditedTimestamp(v *time.Time) *DiscordMessageUpdateOne {
-	if v != nil {
-		_u.SetEditedTimestamp(*v)
+	if nodes := _u.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// ClearEditedTimestamp clears the value of the "edited_timestamp" field.
-func (_u *DiscordMessageUpdateOne) ClearEditedTimestamp() *DiscordMessageUpdateOne {
-	_u.mutation.ClearEditedTimestamp()
-	return _u
-}
-
-// AddEmbeddingIDs adds the "embeddings" edge to the DiscordMessageEmbedding entity by IDs.
-func (_u *DiscordMessageUpdateOne) AddEmbeddingIDs(ids ...string) *DiscordMessageUpdateOne {
+	if _u.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordmessage.ReplyToTable,
+			Columns: []string{discordmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordmessage.ReplyToTable,
+			Columns: []string{discordmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !_u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedUsersIDs(); len(nodes) > 0 && !_u.mutation.MentionedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionedRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedRolesIDs(); len(nodes) > 0 && !_u.mutation.MentionedRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedChannelsIDs(); len(nodes) > 0 && !_u.mutation.MentionedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChunksIDs(); len(nodes) > 0 && !_u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordMessageUpdateOne is the builder for updating a single DiscordMessage entity.
+type DiscordMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordMessageMutation
+}
+
+// SetContent sets the "content" field.
+func (_u *DiscordMessageUpdateOne) SetContent(v string) *DiscordMessageUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *DiscordMessageUpdateOne) SetNillableContent(v *string) *DiscordMessageUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_u *DiscordMessageUpdateOne) SetGuildID(v string) *DiscordMessageUpdateOne {
+	_u.mutation.SetGuildID(v)
+	return _u
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (_u *DiscordMessageUpdateOne) SetNillableGuildID(v *string) *DiscordMessageUpdateOne {
+	if v != nil {
+		_u.SetGuildID(*v)
+	}
+	return _u
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (_u *DiscordMessageUpdateOne) ClearGuildID() *DiscordMessageUpdateOne {
+	_u.mutation.ClearGuildID()
+	return _u
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_u *DiscordMessageUpdateOne) SetChannelID(v string) *DiscordMessageUpdateOne {
+	_u.mutation.SetChannelID(v)
+	return _u
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_u *DiscordMessageUpdateOne) SetNillableChannelID(v *string) *DiscordMessageUpdateOne {
+	if v != nil {
+		_u.SetChannelID(*v)
+	}
+	return _u
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (_u *DiscordMessageUpdateOne) ClearChannelID() *DiscordMessageUpdateOne {
+	_u.mutation.ClearChannelID()
+	return _u
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (_u *DiscordMessageUpdateOne) SetReplyToID(v string) *DiscordMessageUpdateOne {
+	_u.mutation.SetReplyToID(v)
+	return _u
+}
+
+// SetNillableReplyToID sets the "reply_to_id" field if the given value is not nil.
+func (_u *DiscordMessageUpdateOne) SetNillableReplyToID(v *string) *DiscordMessageUpdateOne {
+	if v != nil {
+		_u.SetReplyToID(*v)
+	}
+	return _u
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (_u *DiscordMessageUpdateOne) ClearReplyToID() *DiscordMessageUpdateOne {
+	_u.mutation.ClearReplyToID()
+	return _u
+}
+
+// SetEditedTimestamp sets the "edited_timestamp" field.
+func (_u *DiscordMessageUpdateOne) SetEditedTimestamp(v time.Time) *DiscordMessageUpdateOne {
+	_u.mutation.SetEditedTimestamp(v)
+	return _u
+}
+
+// SetNillableEditedTimestamp sets the "edited_timestamp" field if the given value is not nil.
+func (_u *DiscordMessageUpdateOne) SetNillableEditedTimestamp(v *time.Time) *DiscordMessageUpdateOne {
+	if v != nil {
+		_u.SetEditedTimestamp(*v)
+	}
+	return _u
+}
+
+// ClearEditedTimestamp clears the value of the "edited_timestamp" field.
+func (_u *DiscordMessageUpdateOne) ClearEditedTimestamp() *DiscordMessageUpdateOne {
+	_u.mutation.ClearEditedTimestamp()
+	return _u
+}
+
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (_u *DiscordMessageUpdateOne) SetSuspectedInjection(v bool) *DiscordMessageUpdateOne {
+	_u.mutation.SetSuspectedInjection(v)
+	return _u
+}
+
+// SetNillableSuspectedInjection sets the "suspected_injection" field if the given value is not nil.
+func (_u *DiscordMessageUpdateOne) SetNillableSuspectedInjection(v *bool) *DiscordMessageUpdateOne {
+	if v != nil {
+		_u.SetSuspectedInjection(*v)
+	}
+	return _u
+}
+
+// AddEmbeddingIDs adds the "embeddings" edge to the DiscordMessageEmbedding entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddEmbeddingIDs(ids ...string) *DiscordMessageUpdateOne {
 	_u.mutation.AddEmbeddingIDs(ids...)
 	return _u
 }
 
-// AddEmbeddings adds the "embeddings" edges to the DiscordMessageEmbedding entity.
-func (_u *DiscordMessageUpdateOne) AddEmbeddings(v ...*DiscordMessageEmbedding) *DiscordMessageUpdateOne {
+// AddEmbeddings adds the "embeddings" edges to the DiscordMessageEmbedding entity.
+func (_u *DiscordMessageUpdateOne) AddEmbeddings(v ...*DiscordMessageEmbedding) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmbeddingIDs(ids...)
+}
+
+// AddReactionIDs adds the "reactions" edge to the DiscordReaction entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddReactionIDs(ids ...int) *DiscordMessageUpdateOne {
+	_u.mutation.AddReactionIDs(ids...)
+	return _u
+}
+
+// AddReactions adds the "reactions" edges to the DiscordReaction entity.
+func (_u *DiscordMessageUpdateOne) AddReactions(v ...*DiscordReaction) *DiscordMessageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReactionIDs(ids...)
+}
+
+// SetReplyTo sets the "reply_to" edge to the DiscordMessage entity.
+func (_u *DiscordMessageUpdateOne) SetReplyTo(v *DiscordMessage) *DiscordMessageUpdateOne {
+	return _u.SetReplyToID(v.ID)
+}
+
+// AddReplyIDs adds the "replies" edge to the DiscordMessage entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddReplyIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.AddReplyIDs(ids...)
+	return _u
+}
+
+// AddReplies adds the "replies" edges to the DiscordMessage entity.
+func (_u *DiscordMessageUpdateOne) AddReplies(v ...*DiscordMessage) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReplyIDs(ids...)
+}
+
+// AddMentionedUserIDs adds the "mentioned_users" edge to the DiscordUser entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddMentionedUserIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.AddMentionedUserIDs(ids...)
+	return _u
+}
+
+// AddMentionedUsers adds the "mentioned_users" edges to the DiscordUser entity.
+func (_u *DiscordMessageUpdateOne) AddMentionedUsers(v ...*DiscordUser) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedUserIDs(ids...)
+}
+
+// AddMentionedRoleIDs adds the "mentioned_roles" edge to the DiscordRole entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddMentionedRoleIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.AddMentionedRoleIDs(ids...)
+	return _u
+}
+
+// AddMentionedRoles adds the "mentioned_roles" edges to the DiscordRole entity.
+func (_u *DiscordMessageUpdateOne) AddMentionedRoles(v ...*DiscordRole) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedRoleIDs(ids...)
+}
+
+// AddMentionedChannelIDs adds the "mentioned_channels" edge to the DiscordChannel entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddMentionedChannelIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.AddMentionedChannelIDs(ids...)
+	return _u
+}
+
+// AddMentionedChannels adds the "mentioned_channels" edges to the DiscordChannel entity.
+func (_u *DiscordMessageUpdateOne) AddMentionedChannels(v ...*DiscordChannel) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedChannelIDs(ids...)
+}
+
+// AddChunkIDs adds the "chunks" edge to the DiscordMessageChunk entity by IDs.
+func (_u *DiscordMessageUpdateOne) AddChunkIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.AddChunkIDs(ids...)
+	return _u
+}
+
+// AddChunks adds the "chunks" edges to the DiscordMessageChunk entity.
+func (_u *DiscordMessageUpdateOne) AddChunks(v ...*DiscordMessageChunk) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChunkIDs(ids...)
+}
+
+// Mutation returns the DiscordMessageMutation object of the builder.
+func (_u *DiscordMessageUpdateOne) Mutation() *DiscordMessageMutation {
+	return _u.mutation
+}
+
+// ClearEmbeddings clears all "embeddings" edges to the DiscordMessageEmbedding entity.
+func (_u *DiscordMessageUpdateOne) ClearEmbeddings() *DiscordMessageUpdateOne {
+	_u.mutation.ClearEmbeddings()
+	return _u
+}
+
+// RemoveEmbeddingIDs removes the "embeddings" edge to DiscordMessageEmbedding entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveEmbeddingIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveEmbeddingIDs(ids...)
+	return _u
+}
+
+// RemoveEmbeddings removes "embeddings" edges to DiscordMessageEmbedding entities.
+func (_u *DiscordMessageUpdateOne) RemoveEmbeddings(v ...*DiscordMessageEmbedding) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmbeddingIDs(ids...)
+}
+
+// ClearReactions clears all "reactions" edges to the DiscordReaction entity.
+func (_u *DiscordMessageUpdateOne) ClearReactions() *DiscordMessageUpdateOne {
+	_u.mutation.ClearReactions()
+	return _u
+}
+
+// RemoveReactionIDs removes the "reactions" edge to DiscordReaction entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveReactionIDs(ids ...int) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveReactionIDs(ids...)
+	return _u
+}
+
+// RemoveReactions removes "reactions" edges to DiscordReaction entities.
+func (_u *DiscordMessageUpdateOne) RemoveReactions(v ...*DiscordReaction) *DiscordMessageUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReactionIDs(ids...)
+}
+
+// ClearReplyTo clears the "reply_to" edge to the DiscordMessage entity.
+func (_u *DiscordMessageUpdateOne) ClearReplyTo() *DiscordMessageUpdateOne {
+	_u.mutation.ClearReplyTo()
+	return _u
+}
+
+// ClearReplies clears all "replies" edges to the DiscordMessage entity.
+func (_u *DiscordMessageUpdateOne) ClearReplies() *DiscordMessageUpdateOne {
+	_u.mutation.ClearReplies()
+	return _u
+}
+
+// RemoveReplyIDs removes the "replies" edge to DiscordMessage entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveReplyIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveReplyIDs(ids...)
+	return _u
+}
+
+// RemoveReplies removes "replies" edges to DiscordMessage entities.
+func (_u *DiscordMessageUpdateOne) RemoveReplies(v ...*DiscordMessage) *DiscordMessageUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.AddEmbeddingIDs(ids...)
+	return _u.RemoveReplyIDs(ids...)
 }
 
-// Mutation returns the DiscordMessageMutation object of the builder.
-func (_u *DiscordMessageUpdateOne) Mutation() *DiscordMessageMutation {
-	return _u.mutation
+// ClearMentionedUsers clears all "mentioned_users" edges to the DiscordUser entity.
+func (_u *DiscordMessageUpdateOne) ClearMentionedUsers() *DiscordMessageUpdateOne {
+	_u.mutation.ClearMentionedUsers()
+	return _u
 }
 
-// ClearEmbeddings clears all "embeddings" edges to the DiscordMessageEmbedding entity.
-func (_u *DiscordMessageUpdateOne) ClearEmbeddings() *DiscordMessageUpdateOne {
-	_u.mutation.ClearEmbeddings()
+// RemoveMentionedUserIDs removes the "mentioned_users" edge to DiscordUser entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveMentionedUserIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveMentionedUserIDs(ids...)
 	return _u
 }
 
-// RemoveEmbeddingIDs removes the "embeddings" edge to DiscordMessageEmbedding entities by IDs.
-func (_u *DiscordMessageUpdateOne) RemoveEmbeddingIDs(ids ...string) *DiscordMessageUpdateOne {
-	_u.mutation.RemoveEmbeddingIDs(ids...)
+// RemoveMentionedUsers removes "mentioned_users" edges to DiscordUser entities.
+func (_u *DiscordMessageUpdateOne) RemoveMentionedUsers(v ...*DiscordUser) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedUserIDs(ids...)
+}
+
+// ClearMentionedRoles clears all "mentioned_roles" edges to the DiscordRole entity.
+func (_u *DiscordMessageUpdateOne) ClearMentionedRoles() *DiscordMessageUpdateOne {
+	_u.mutation.ClearMentionedRoles()
 	return _u
 }
 
-// RemoveEmbeddings removes "embeddings" edges to DiscordMessageEmbedding entities.
-func (_u *DiscordMessageUpdateOne) RemoveEmbeddings(v ...*DiscordMessageEmbedding) *DiscordMessageUpdateOne {
+// RemoveMentionedRoleIDs removes the "mentioned_roles" edge to DiscordRole entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveMentionedRoleIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveMentionedRoleIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedRoles removes "mentioned_roles" edges to DiscordRole entities.
+func (_u *DiscordMessageUpdateOne) RemoveMentionedRoles(v ...*DiscordRole) *DiscordMessageUpdateOne {
 	ids := make([]string, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveEmbeddingIDs(ids...)
+	return _u.RemoveMentionedRoleIDs(ids...)
+}
+
+// ClearMentionedChannels clears all "mentioned_channels" edges to the DiscordChannel entity.
+func (_u *DiscordMessageUpdateOne) ClearMentionedChannels() *DiscordMessageUpdateOne {
+	_u.mutation.ClearMentionedChannels()
+	return _u
+}
+
+// RemoveMentionedChannelIDs removes the "mentioned_channels" edge to DiscordChannel entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveMentionedChannelIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveMentionedChannelIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedChannels removes "mentioned_channels" edges to DiscordChannel entities.
+func (_u *DiscordMessageUpdateOne) RemoveMentionedChannels(v ...*DiscordChannel) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedChannelIDs(ids...)
+}
+
+// ClearChunks clears all "chunks" edges to the DiscordMessageChunk entity.
+func (_u *DiscordMessageUpdateOne) ClearChunks() *DiscordMessageUpdateOne {
+	_u.mutation.ClearChunks()
+	return _u
+}
+
+// RemoveChunkIDs removes the "chunks" edge to DiscordMessageChunk entities by IDs.
+func (_u *DiscordMessageUpdateOne) RemoveChunkIDs(ids ...string) *DiscordMessageUpdateOne {
+	_u.mutation.RemoveChunkIDs(ids...)
+	return _u
+}
+
+// RemoveChunks removes "chunks" edges to DiscordMessageChunk entities.
+func (_u *DiscordMessageUpdateOne) RemoveChunks(v ...*DiscordMessageChunk) *DiscordMessageUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChunkIDs(ids...)
 }
 
 // Where appends a list predicates to the DiscordMessageUpdate builder.
@@ -380,12 +1301,27 @@ func (_u *DiscordMessageUpdateOne) sqlSave(ctx context.Context) (_node *DiscordM
 	if value, ok := _u.mutation.Content(); ok {
 		_spec.SetField(discordmessage.FieldContent, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.GuildID(); ok {
+		_spec.SetField(discordmessage.FieldGuildID, field.TypeString, value)
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(discordmessage.FieldGuildID, field.TypeString)
+	}
+	if value, ok := _u.mutation.ChannelID(); ok {
+		_spec.SetField(discordmessage.FieldChannelID, field.TypeString, value)
+	}
+	if _u.mutation.ChannelIDCleared() {
+		_spec.ClearField(discordmessage.FieldChannelID, field.TypeString)
+	}
 	if value, ok := _u.mutation.EditedTimestamp(); ok {
 		_spec.SetField(discordmessage.FieldEditedTimestamp, field.TypeTime, value)
 	}
 	if _u.mutation.EditedTimestampCleared() {
 		_spec.ClearField(discordmessage.FieldEditedTimestamp, field.TypeTime)
 	}
+	if value, ok := _u.mutation.SuspectedInjection(); ok {
+		_spec.SetField(discordmessage.FieldSuspectedInjection, field.TypeBool, value)
+	}
 	if _u.mutation.EmbeddingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -431,6 +1367,305 @@ func (_u *DiscordMessageUpdateOne) sqlSave(ctx context.Context) (_node *DiscordM
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReactionsIDs(); len(nodes) > 0 && !_u.mutation.ReactionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReactionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.ReactionsTable,
+			Columns: []string{discordmessage.ReactionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordreaction.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReplyToCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordmessage.ReplyToTable,
+			Columns: []string{discordmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReplyToIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordmessage.ReplyToTable,
+			Columns: []string{discordmessage.ReplyToColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRepliesIDs(); len(nodes) > 0 && !_u.mutation.RepliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RepliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessage.RepliesTable,
+			Columns: []string{discordmessage.RepliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedUsersIDs(); len(nodes) > 0 && !_u.mutation.MentionedUsersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedUsersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedUsersTable,
+			Columns: discordmessage.MentionedUsersPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionedRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedRolesIDs(); len(nodes) > 0 && !_u.mutation.MentionedRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedRolesTable,
+			Columns: discordmessage.MentionedRolesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordrole.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MentionedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedChannelsIDs(); len(nodes) > 0 && !_u.mutation.MentionedChannelsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedChannelsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessage.MentionedChannelsTable,
+			Columns: discordmessage.MentionedChannelsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchannel.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChunksIDs(); len(nodes) > 0 && !_u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discordmessage.ChunksTable,
+			Columns: discordmessage.ChunksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &DiscordMessage{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sev0/ent/discordmessagechunk"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DiscordMessageChunk is the model entity for the DiscordMessageChunk schema.
+type DiscordMessageChunk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// ChannelID holds the value of the "channel_id" field.
+	ChannelID string `json:"channel_id,omitempty"`
+	// StartAt holds the value of the "start_at" field.
+	StartAt time.Time `json:"start_at,omitempty"`
+	// EndAt holds the value of the "end_at" field.
+	EndAt time.Time `json:"end_at,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiscordMessageChunkQuery when eager-loading is set.
+	Edges        DiscordMessageChunkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DiscordMessageChunkEdges holds the relations/edges for other nodes in the graph.
+type DiscordMessageChunkEdges struct {
+	// Messages holds the value of the messages edge.
+	Messages []*DiscordMessage `json:"messages,omitempty"`
+	// Embeddings holds the value of the embeddings edge.
+	Embeddings []*DiscordChunkEmbedding `json:"embeddings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// MessagesOrErr returns the Messages value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageChunkEdges) MessagesOrErr() ([]*DiscordMessage, error) {
+	if e.loadedTypes[0] {
+		return e.Messages, nil
+	}
+	return nil, &NotLoadedError{edge: "messages"}
+}
+
+// EmbeddingsOrErr returns the Embeddings value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordMessageChunkEdges) EmbeddingsOrErr() ([]*DiscordChunkEmbedding, error) {
+	if e.loadedTypes[1] {
+		return e.Embeddings, nil
+	}
+	return nil, &NotLoadedError{edge: "embeddings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordMessageChunk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discordmessagechunk.FieldID, discordmessagechunk.FieldGuildID, discordmessagechunk.FieldChannelID, discordmessagechunk.FieldContent:
+			values[i] = new(sql.NullString)
+		case discordmessagechunk.FieldStartAt, discordmessagechunk.FieldEndAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordMessageChunk fields.
+func (_m *DiscordMessageChunk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discordmessagechunk.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case discordmessagechunk.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				_m.GuildID = value.String
+			}
+		case discordmessagechunk.FieldChannelID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel_id", values[i])
+			} else if value.Valid {
+				_m.ChannelID = value.String
+			}
+		case discordmessagechunk.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case discordmessagechunk.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = value.Time
+			}
+		case discordmessagechunk.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				_m.Content = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordMessageChunk.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordMessageChunk) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMessages queries the "messages" edge of the DiscordMessageChunk entity.
+func (_m *DiscordMessageChunk) QueryMessages() *DiscordMessageQuery {
+	return NewDiscordMessageChunkClient(_m.config).QueryMessages(_m)
+}
+
+// QueryEmbeddings queries the "embeddings" edge of the DiscordMessageChunk entity.
+func (_m *DiscordMessageChunk) QueryEmbeddings() *DiscordChunkEmbeddingQuery {
+	return NewDiscordMessageChunkClient(_m.config).QueryEmbeddings(_m)
+}
+
+// Update returns a builder for updating this DiscordMessageChunk.
+// Note that you need to call DiscordMessageChunk.Unwrap() before calling this method if this DiscordMessageChunk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordMessageChunk) Update() *DiscordMessageChunkUpdateOne {
+	return NewDiscordMessageChunkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordMessageChunk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordMessageChunk) Unwrap() *DiscordMessageChunk {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordMessageChunk is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordMessageChunk) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordMessageChunk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(_m.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("channel_id=")
+	builder.WriteString(_m.ChannelID)
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_at=")
+	builder.WriteString(_m.EndAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(_m.Content)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordMessageChunks is a parsable slice of DiscordMessageChunk.
+type DiscordMessageChunks []*DiscordMessageChunk
@@ -0,0 +1,679 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordMessageChunkCreate is the builder for creating a DiscordMessageChunk entity.
+type DiscordMessageChunkCreate struct {
+	config
+	mutation *DiscordMessageChunkMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordMessageChunkCreate) SetGuildID(v string) *DiscordMessageChunkCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *DiscordMessageChunkCreate) SetChannelID(v string) *DiscordMessageChunkCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *DiscordMessageChunkCreate) SetStartAt(v time.Time) *DiscordMessageChunkCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *DiscordMessageChunkCreate) SetEndAt(v time.Time) *DiscordMessageChunkCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetContent sets the "content" field.
+func (_c *DiscordMessageChunkCreate) SetContent(v string) *DiscordMessageChunkCreate {
+	_c.mutation.SetContent(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DiscordMessageChunkCreate) SetID(v string) *DiscordMessageChunkCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// AddMessageIDs adds the "messages" edge to the DiscordMessage entity by IDs.
+func (_c *DiscordMessageChunkCreate) AddMessageIDs(ids ...string) *DiscordMessageChunkCreate {
+	_c.mutation.AddMessageIDs(ids...)
+	return _c
+}
+
+// AddMessages adds the "messages" edges to the DiscordMessage entity.
+func (_c *DiscordMessageChunkCreate) AddMessages(v ...*DiscordMessage) *DiscordMessageChunkCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMessageIDs(ids...)
+}
+
+// AddEmbeddingIDs adds the "embeddings" edge to the DiscordChunkEmbedding entity by IDs.
+func (_c *DiscordMessageChunkCreate) AddEmbeddingIDs(ids ...string) *DiscordMessageChunkCreate {
+	_c.mutation.AddEmbeddingIDs(ids...)
+	return _c
+}
+
+// AddEmbeddings adds the "embeddings" edges to the DiscordChunkEmbedding entity.
+func (_c *DiscordMessageChunkCreate) AddEmbeddings(v ...*DiscordChunkEmbedding) *DiscordMessageChunkCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEmbeddingIDs(ids...)
+}
+
+// Mutation returns the DiscordMessageChunkMutation object of the builder.
+func (_c *DiscordMessageChunkCreate) Mutation() *DiscordMessageChunkMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordMessageChunk in the database.
+func (_c *DiscordMessageChunkCreate) Save(ctx context.Context) (*DiscordMessageChunk, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordMessageChunkCreate) SaveX(ctx context.Context) *DiscordMessageChunk {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordMessageChunkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordMessageChunkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordMessageChunkCreate) check() error {
+	if _, ok := _c.mutation.GuildID(); !ok {
+		return &ValidationError{Name: "guild_id", err: errors.New(`ent: missing required field "DiscordMessageChunk.guild_id"`)}
+	}
+	if v, ok := _c.mutation.GuildID(); ok {
+		if err := discordmessagechunk.GuildIDValidator(v); err != nil {
+			return &ValidationError{Name: "guild_id", err: fmt.Errorf(`ent: validator failed for field "DiscordMessageChunk.guild_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChannelID(); !ok {
+		return &ValidationError{Name: "channel_id", err: errors.New(`ent: missing required field "DiscordMessageChunk.channel_id"`)}
+	}
+	if v, ok := _c.mutation.ChannelID(); ok {
+		if err := discordmessagechunk.ChannelIDValidator(v); err != nil {
+			return &ValidationError{Name: "channel_id", err: fmt.Errorf(`ent: validator failed for field "DiscordMessageChunk.channel_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "DiscordMessageChunk.start_at"`)}
+	}
+	if _, ok := _c.mutation.EndAt(); !ok {
+		return &ValidationError{Name: "end_at", err: errors.New(`ent: missing required field "DiscordMessageChunk.end_at"`)}
+	}
+	if _, ok := _c.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "DiscordMessageChunk.content"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := discordmessagechunk.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordMessageChunk.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DiscordMessageChunkCreate) sqlSave(ctx context.Context) (*DiscordMessageChunk, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DiscordMessageChunk.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordMessageChunkCreate) createSpec() (*DiscordMessageChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordMessageChunk{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordmessagechunk.Table, sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordmessagechunk.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(discordmessagechunk.FieldChannelID, field.TypeString, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(discordmessagechunk.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(discordmessagechunk.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = value
+	}
+	if value, ok := _c.mutation.Content(); ok {
+		_spec.SetField(discordmessagechunk.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if nodes := _c.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordMessageChunk.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordMessageChunkUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordMessageChunkCreate) OnConflict(opts ...sql.ConflictOption) *DiscordMessageChunkUpsertOne {
+	_c.conflict = opts
+	return &DiscordMessageChunkUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordMessageChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordMessageChunkCreate) OnConflictColumns(columns ...string) *DiscordMessageChunkUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordMessageChunkUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordMessageChunkUpsertOne is the builder for "upsert"-ing
+	//  one DiscordMessageChunk node.
+	DiscordMessageChunkUpsertOne struct {
+		create *DiscordMessageChunkCreate
+	}
+
+	// DiscordMessageChunkUpsert is the "OnConflict" setter.
+	DiscordMessageChunkUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetEndAt sets the "end_at" field.
+func (u *DiscordMessageChunkUpsert) SetEndAt(v time.Time) *DiscordMessageChunkUpsert {
+	u.Set(discordmessagechunk.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *DiscordMessageChunkUpsert) UpdateEndAt() *DiscordMessageChunkUpsert {
+	u.SetExcluded(discordmessagechunk.FieldEndAt)
+	return u
+}
+
+// SetContent sets the "content" field.
+func (u *DiscordMessageChunkUpsert) SetContent(v string) *DiscordMessageChunkUpsert {
+	u.Set(discordmessagechunk.FieldContent, v)
+	return u
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *DiscordMessageChunkUpsert) UpdateContent() *DiscordMessageChunkUpsert {
+	u.SetExcluded(discordmessagechunk.FieldContent)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DiscordMessageChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordmessagechunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordMessageChunkUpsertOne) UpdateNewValues() *DiscordMessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(discordmessagechunk.FieldID)
+		}
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(discordmessagechunk.FieldGuildID)
+		}
+		if _, exists := u.create.mutation.ChannelID(); exists {
+			s.SetIgnore(discordmessagechunk.FieldChannelID)
+		}
+		if _, exists := u.create.mutation.StartAt(); exists {
+			s.SetIgnore(discordmessagechunk.FieldStartAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordMessageChunk.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordMessageChunkUpsertOne) Ignore() *DiscordMessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordMessageChunkUpsertOne) DoNothing() *DiscordMessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordMessageChunkCreate.OnConflict
+// documentation for more info.
+func (u *DiscordMessageChunkUpsertOne) Update(set func(*DiscordMessageChunkUpsert)) *DiscordMessageChunkUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordMessageChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *DiscordMessageChunkUpsertOne) SetEndAt(v time.Time) *DiscordMessageChunkUpsertOne {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *DiscordMessageChunkUpsertOne) UpdateEndAt() *DiscordMessageChunkUpsertOne {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *DiscordMessageChunkUpsertOne) SetContent(v string) *DiscordMessageChunkUpsertOne {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *DiscordMessageChunkUpsertOne) UpdateContent() *DiscordMessageChunkUpsertOne {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordMessageChunkUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordMessageChunkCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordMessageChunkUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordMessageChunkUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DiscordMessageChunkUpsertOne.ID is not supported by MySQL driver. Use DiscordMessageChunkUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordMessageChunkUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordMessageChunkCreateBulk is the builder for creating many DiscordMessageChunk entities in bulk.
+type DiscordMessageChunkCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordMessageChunkCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordMessageChunk entities in the database.
+func (_c *DiscordMessageChunkCreateBulk) Save(ctx context.Context) ([]*DiscordMessageChunk, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordMessageChunk, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordMessageChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordMessageChunkCreateBulk) SaveX(ctx context.Context) []*DiscordMessageChunk {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordMessageChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordMessageChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordMessageChunk.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordMessageChunkUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordMessageChunkCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordMessageChunkUpsertBulk {
+	_c.conflict = opts
+	return &DiscordMessageChunkUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordMessageChunk.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordMessageChunkCreateBulk) OnConflictColumns(columns ...string) *DiscordMessageChunkUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordMessageChunkUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordMessageChunkUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordMessageChunk nodes.
+type DiscordMessageChunkUpsertBulk struct {
+	create *DiscordMessageChunkCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordMessageChunk.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(discordmessagechunk.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DiscordMessageChunkUpsertBulk) UpdateNewValues() *DiscordMessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(discordmessagechunk.FieldID)
+			}
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(discordmessagechunk.FieldGuildID)
+			}
+			if _, exists := b.mutation.ChannelID(); exists {
+				s.SetIgnore(discordmessagechunk.FieldChannelID)
+			}
+			if _, exists := b.mutation.StartAt(); exists {
+				s.SetIgnore(discordmessagechunk.FieldStartAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordMessageChunk.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordMessageChunkUpsertBulk) Ignore() *DiscordMessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordMessageChunkUpsertBulk) DoNothing() *DiscordMessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordMessageChunkCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordMessageChunkUpsertBulk) Update(set func(*DiscordMessageChunkUpsert)) *DiscordMessageChunkUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordMessageChunkUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *DiscordMessageChunkUpsertBulk) SetEndAt(v time.Time) *DiscordMessageChunkUpsertBulk {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *DiscordMessageChunkUpsertBulk) UpdateEndAt() *DiscordMessageChunkUpsertBulk {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// SetContent sets the "content" field.
+func (u *DiscordMessageChunkUpsertBulk) SetContent(v string) *DiscordMessageChunkUpsertBulk {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.SetContent(v)
+	})
+}
+
+// UpdateContent sets the "content" field to the value that was provided on create.
+func (u *DiscordMessageChunkUpsertBulk) UpdateContent() *DiscordMessageChunkUpsertBulk {
+	return u.Update(func(s *DiscordMessageChunkUpsert) {
+		s.UpdateContent()
+	})
+}
+
+// Exec executes the query.
+func (u *DiscordMessageChunkUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordMessageChunkCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordMessageChunkCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordMessageChunkUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
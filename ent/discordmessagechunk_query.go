@@ -0,0 +1,713 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordMessageChunkQuery is the builder for querying DiscordMessageChunk entities.
+type DiscordMessageChunkQuery struct {
+	config
+	ctx            *QueryContext
+	order          []discordmessagechunk.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.DiscordMessageChunk
+	withMessages   *DiscordMessageQuery
+	withEmbeddings *DiscordChunkEmbeddingQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DiscordMessageChunkQuery builder.
+func (_q *DiscordMessageChunkQuery) Where(ps ...predicate.DiscordMessageChunk) *DiscordMessageChunkQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *DiscordMessageChunkQuery) Limit(limit int) *DiscordMessageChunkQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *DiscordMessageChunkQuery) Offset(offset int) *DiscordMessageChunkQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *DiscordMessageChunkQuery) Unique(unique bool) *DiscordMessageChunkQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *DiscordMessageChunkQuery) Order(o ...discordmessagechunk.OrderOption) *DiscordMessageChunkQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryMessages chains the current query on the "messages" edge.
+func (_q *DiscordMessageChunkQuery) QueryMessages() *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessagechunk.Table, discordmessagechunk.FieldID, selector),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, discordmessagechunk.MessagesTable, discordmessagechunk.MessagesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEmbeddings chains the current query on the "embeddings" edge.
+func (_q *DiscordMessageChunkQuery) QueryEmbeddings() *DiscordChunkEmbeddingQuery {
+	query := (&DiscordChunkEmbeddingClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discordmessagechunk.Table, discordmessagechunk.FieldID, selector),
+			sqlgraph.To(discordchunkembedding.Table, discordchunkembedding.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discordmessagechunk.EmbeddingsTable, discordmessagechunk.EmbeddingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DiscordMessageChunk entity from the query.
+// Returns a *NotFoundError when no DiscordMessageChunk was found.
+func (_q *DiscordMessageChunkQuery) First(ctx context.Context) (*DiscordMessageChunk, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{discordmessagechunk.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) FirstX(ctx context.Context) *DiscordMessageChunk {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DiscordMessageChunk ID from the query.
+// Returns a *NotFoundError when no DiscordMessageChunk ID was found.
+func (_q *DiscordMessageChunkQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{discordmessagechunk.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) FirstIDX(ctx context.Context) string {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DiscordMessageChunk entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DiscordMessageChunk entity is found.
+// Returns a *NotFoundError when no DiscordMessageChunk entities are found.
+func (_q *DiscordMessageChunkQuery) Only(ctx context.Context) (*DiscordMessageChunk, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{discordmessagechunk.Label}
+	default:
+		return nil, &NotSingularError{discordmessagechunk.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) OnlyX(ctx context.Context) *DiscordMessageChunk {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DiscordMessageChunk ID in the query.
+// Returns a *NotSingularError when more than one DiscordMessageChunk ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *DiscordMessageChunkQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{discordmessagechunk.Label}
+	default:
+		err = &NotSingularError{discordmessagechunk.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) OnlyIDX(ctx context.Context) string {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DiscordMessageChunks.
+func (_q *DiscordMessageChunkQuery) All(ctx context.Context) ([]*DiscordMessageChunk, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DiscordMessageChunk, *DiscordMessageChunkQuery]()
+	return withInterceptors[[]*DiscordMessageChunk](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) AllX(ctx context.Context) []*DiscordMessageChunk {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DiscordMessageChunk IDs.
+func (_q *DiscordMessageChunkQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(discordmessagechunk.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) IDsX(ctx context.Context) []string {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *DiscordMessageChunkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*DiscordMessageChunkQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *DiscordMessageChunkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *DiscordMessageChunkQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DiscordMessageChunkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *DiscordMessageChunkQuery) Clone() *DiscordMessageChunkQuery {
+	if _q == nil {
+		return nil
+	}
+	return &DiscordMessageChunkQuery{
+		config:         _q.config,
+		ctx:            _q.ctx.Clone(),
+		order:          append([]discordmessagechunk.OrderOption{}, _q.order...),
+		inters:         append([]Interceptor{}, _q.inters...),
+		predicates:     append([]predicate.DiscordMessageChunk{}, _q.predicates...),
+		withMessages:   _q.withMessages.Clone(),
+		withEmbeddings: _q.withEmbeddings.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithMessages tells the query-builder to eager-load the nodes that are connected to
+// the "messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageChunkQuery) WithMessages(opts ...func(*DiscordMessageQuery)) *DiscordMessageChunkQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMessages = query
+	return _q
+}
+
+// WithEmbeddings tells the query-builder to eager-load the nodes that are connected to
+// the "embeddings" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordMessageChunkQuery) WithEmbeddings(opts ...func(*DiscordChunkEmbeddingQuery)) *DiscordMessageChunkQuery {
+	query := (&DiscordChunkEmbeddingClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withEmbeddings = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DiscordMessageChunk.Query().
+//		GroupBy(discordmessagechunk.FieldGuildID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *DiscordMessageChunkQuery) GroupBy(field string, fields ...string) *DiscordMessageChunkGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DiscordMessageChunkGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = discordmessagechunk.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		GuildID string `json:"guild_id,omitempty"`
+//	}
+//
+//	client.DiscordMessageChunk.Query().
+//		Select(discordmessagechunk.FieldGuildID).
+//		Scan(ctx, &v)
+func (_q *DiscordMessageChunkQuery) Select(fields ...string) *DiscordMessageChunkSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &DiscordMessageChunkSelect{DiscordMessageChunkQuery: _q}
+	sbuild.label = discordmessagechunk.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DiscordMessageChunkSelect configured with the given aggregations.
+func (_q *DiscordMessageChunkQuery) Aggregate(fns ...AggregateFunc) *DiscordMessageChunkSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *DiscordMessageChunkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !discordmessagechunk.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *DiscordMessageChunkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DiscordMessageChunk, error) {
+	var (
+		nodes       = []*DiscordMessageChunk{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withMessages != nil,
+			_q.withEmbeddings != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DiscordMessageChunk).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DiscordMessageChunk{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withMessages; query != nil {
+		if err := _q.loadMessages(ctx, query, nodes,
+			func(n *DiscordMessageChunk) { n.Edges.Messages = []*DiscordMessage{} },
+			func(n *DiscordMessageChunk, e *DiscordMessage) { n.Edges.Messages = append(n.Edges.Messages, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withEmbeddings; query != nil {
+		if err := _q.loadEmbeddings(ctx, query, nodes,
+			func(n *DiscordMessageChunk) { n.Edges.Embeddings = []*DiscordChunkEmbedding{} },
+			func(n *DiscordMessageChunk, e *DiscordChunkEmbedding) {
+				n.Edges.Embeddings = append(n.Edges.Embeddings, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *DiscordMessageChunkQuery) loadMessages(ctx context.Context, query *DiscordMessageQuery, nodes []*DiscordMessageChunk, init func(*DiscordMessageChunk), assign func(*DiscordMessageChunk, *DiscordMessage)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*DiscordMessageChunk)
+	nids := make(map[string]map[*DiscordMessageChunk]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discordmessagechunk.MessagesTable)
+		s.Join(joinT).On(s.C(discordmessage.FieldID), joinT.C(discordmessagechunk.MessagesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(discordmessagechunk.MessagesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discordmessagechunk.MessagesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DiscordMessageChunk]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DiscordMessage](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "messages" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *DiscordMessageChunkQuery) loadEmbeddings(ctx context.Context, query *DiscordChunkEmbeddingQuery, nodes []*DiscordMessageChunk, init func(*DiscordMessageChunk), assign func(*DiscordMessageChunk, *DiscordChunkEmbedding)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*DiscordMessageChunk)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discordchunkembedding.FieldChunkID)
+	}
+	query.Where(predicate.DiscordChunkEmbedding(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discordmessagechunk.EmbeddingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ChunkID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "chunk_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (_q *DiscordMessageChunkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *DiscordMessageChunkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(discordmessagechunk.Table, discordmessagechunk.Columns, sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordmessagechunk.FieldID)
+		for i := range fields {
+			if fields[i] != discordmessagechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *DiscordMessageChunkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(discordmessagechunk.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = discordmessagechunk.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// DiscordMessageChunkGroupBy is the group-by builder for DiscordMessageChunk entities.
+type DiscordMessageChunkGroupBy struct {
+	selector
+	build *DiscordMessageChunkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *DiscordMessageChunkGroupBy) Aggregate(fns ...AggregateFunc) *DiscordMessageChunkGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *DiscordMessageChunkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DiscordMessageChunkQuery, *DiscordMessageChunkGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *DiscordMessageChunkGroupBy) sqlScan(ctx context.Context, root *DiscordMessageChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DiscordMessageChunkSelect is the builder for selecting fields of DiscordMessageChunk entities.
+type DiscordMessageChunkSelect struct {
+	*DiscordMessageChunkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *DiscordMessageChunkSelect) Aggregate(fns ...AggregateFunc) *DiscordMessageChunkSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *DiscordMessageChunkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DiscordMessageChunkQuery, *DiscordMessageChunkSelect](ctx, _s.DiscordMessageChunkQuery, _s, _s.inters, v)
+}
+
+func (_s *DiscordMessageChunkSelect) sqlScan(ctx context.Context, root *DiscordMessageChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordMessageChunkUpdate is the builder for updating DiscordMessageChunk entities.
+type DiscordMessageChunkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordMessageChunkMutation
+}
+
+// Where appends a list predicates to the DiscordMessageChunkUpdate builder.
+func (_u *DiscordMessageChunkUpdate) Where(ps ...predicate.DiscordMessageChunk) *DiscordMessageChunkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *DiscordMessageChunkUpdate) SetEndAt(v time.Time) *DiscordMessageChunkUpdate {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *DiscordMessageChunkUpdate) SetNillableEndAt(v *time.Time) *DiscordMessageChunkUpdate {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *DiscordMessageChunkUpdate) SetContent(v string) *DiscordMessageChunkUpdate {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *DiscordMessageChunkUpdate) SetNillableContent(v *string) *DiscordMessageChunkUpdate {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the DiscordMessage entity by IDs.
+func (_u *DiscordMessageChunkUpdate) AddMessageIDs(ids ...string) *DiscordMessageChunkUpdate {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the DiscordMessage entity.
+func (_u *DiscordMessageChunkUpdate) AddMessages(v ...*DiscordMessage) *DiscordMessageChunkUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// AddEmbeddingIDs adds the "embeddings" edge to the DiscordChunkEmbedding entity by IDs.
+func (_u *DiscordMessageChunkUpdate) AddEmbeddingIDs(ids ...string) *DiscordMessageChunkUpdate {
+	_u.mutation.AddEmbeddingIDs(ids...)
+	return _u
+}
+
+// AddEmbeddings adds the "embeddings" edges to the DiscordChunkEmbedding entity.
+func (_u *DiscordMessageChunkUpdate) AddEmbeddings(v ...*DiscordChunkEmbedding) *DiscordMessageChunkUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmbeddingIDs(ids...)
+}
+
+// Mutation returns the DiscordMessageChunkMutation object of the builder.
+func (_u *DiscordMessageChunkUpdate) Mutation() *DiscordMessageChunkMutation {
+	return _u.mutation
+}
+
+// ClearMessages clears all "messages" edges to the DiscordMessage entity.
+func (_u *DiscordMessageChunkUpdate) ClearMessages() *DiscordMessageChunkUpdate {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to DiscordMessage entities by IDs.
+func (_u *DiscordMessageChunkUpdate) RemoveMessageIDs(ids ...string) *DiscordMessageChunkUpdate {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to DiscordMessage entities.
+func (_u *DiscordMessageChunkUpdate) RemoveMessages(v ...*DiscordMessage) *DiscordMessageChunkUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// ClearEmbeddings clears all "embeddings" edges to the DiscordChunkEmbedding entity.
+func (_u *DiscordMessageChunkUpdate) ClearEmbeddings() *DiscordMessageChunkUpdate {
+	_u.mutation.ClearEmbeddings()
+	return _u
+}
+
+// RemoveEmbeddingIDs removes the "embeddings" edge to DiscordChunkEmbedding entities by IDs.
+func (_u *DiscordMessageChunkUpdate) RemoveEmbeddingIDs(ids ...string) *DiscordMessageChunkUpdate {
+	_u.mutation.RemoveEmbeddingIDs(ids...)
+	return _u
+}
+
+// RemoveEmbeddings removes "embeddings" edges to DiscordChunkEmbedding entities.
+func (_u *DiscordMessageChunkUpdate) RemoveEmbeddings(v ...*DiscordChunkEmbedding) *DiscordMessageChunkUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmbeddingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordMessageChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordMessageChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordMessageChunkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordMessageChunkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscordMessageChunkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discordmessagechunk.Table, discordmessagechunk.Columns, sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(discordmessagechunk.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(discordmessagechunk.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !_u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmbeddingsIDs(); len(nodes) > 0 && !_u.mutation.EmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordmessagechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordMessageChunkUpdateOne is the builder for updating a single DiscordMessageChunk entity.
+type DiscordMessageChunkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordMessageChunkMutation
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *DiscordMessageChunkUpdateOne) SetEndAt(v time.Time) *DiscordMessageChunkUpdateOne {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *DiscordMessageChunkUpdateOne) SetNillableEndAt(v *time.Time) *DiscordMessageChunkUpdateOne {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// SetContent sets the "content" field.
+func (_u *DiscordMessageChunkUpdateOne) SetContent(v string) *DiscordMessageChunkUpdateOne {
+	_u.mutation.SetContent(v)
+	return _u
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (_u *DiscordMessageChunkUpdateOne) SetNillableContent(v *string) *DiscordMessageChunkUpdateOne {
+	if v != nil {
+		_u.SetContent(*v)
+	}
+	return _u
+}
+
+// AddMessageIDs adds the "messages" edge to the DiscordMessage entity by IDs.
+func (_u *DiscordMessageChunkUpdateOne) AddMessageIDs(ids ...string) *DiscordMessageChunkUpdateOne {
+	_u.mutation.AddMessageIDs(ids...)
+	return _u
+}
+
+// AddMessages adds the "messages" edges to the DiscordMessage entity.
+func (_u *DiscordMessageChunkUpdateOne) AddMessages(v ...*DiscordMessage) *DiscordMessageChunkUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMessageIDs(ids...)
+}
+
+// AddEmbeddingIDs adds the "embeddings" edge to the DiscordChunkEmbedding entity by IDs.
+func (_u *DiscordMessageChunkUpdateOne) AddEmbeddingIDs(ids ...string) *DiscordMessageChunkUpdateOne {
+	_u.mutation.AddEmbeddingIDs(ids...)
+	return _u
+}
+
+// AddEmbeddings adds the "embeddings" edges to the DiscordChunkEmbedding entity.
+func (_u *DiscordMessageChunkUpdateOne) AddEmbeddings(v ...*DiscordChunkEmbedding) *DiscordMessageChunkUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEmbeddingIDs(ids...)
+}
+
+// Mutation returns the DiscordMessageChunkMutation object of the builder.
+func (_u *DiscordMessageChunkUpdateOne) Mutation() *DiscordMessageChunkMutation {
+	return _u.mutation
+}
+
+// ClearMessages clears all "messages" edges to the DiscordMessage entity.
+func (_u *DiscordMessageChunkUpdateOne) ClearMessages() *DiscordMessageChunkUpdateOne {
+	_u.mutation.ClearMessages()
+	return _u
+}
+
+// RemoveMessageIDs removes the "messages" edge to DiscordMessage entities by IDs.
+func (_u *DiscordMessageChunkUpdateOne) RemoveMessageIDs(ids ...string) *DiscordMessageChunkUpdateOne {
+	_u.mutation.RemoveMessageIDs(ids...)
+	return _u
+}
+
+// RemoveMessages removes "messages" edges to DiscordMessage entities.
+func (_u *DiscordMessageChunkUpdateOne) RemoveMessages(v ...*DiscordMessage) *DiscordMessageChunkUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMessageIDs(ids...)
+}
+
+// ClearEmbeddings clears all "embeddings" edges to the DiscordChunkEmbedding entity.
+func (_u *DiscordMessageChunkUpdateOne) ClearEmbeddings() *DiscordMessageChunkUpdateOne {
+	_u.mutation.ClearEmbeddings()
+	return _u
+}
+
+// RemoveEmbeddingIDs removes the "embeddings" edge to DiscordChunkEmbedding entities by IDs.
+func (_u *DiscordMessageChunkUpdateOne) RemoveEmbeddingIDs(ids ...string) *DiscordMessageChunkUpdateOne {
+	_u.mutation.RemoveEmbeddingIDs(ids...)
+	return _u
+}
+
+// RemoveEmbeddings removes "embeddings" edges to DiscordChunkEmbedding entities.
+func (_u *DiscordMessageChunkUpdateOne) RemoveEmbeddings(v ...*DiscordChunkEmbedding) *DiscordMessageChunkUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEmbeddingIDs(ids...)
+}
+
+// Where appends a list predicates to the DiscordMessageChunkUpdate builder.
+func (_u *DiscordMessageChunkUpdateOne) Where(ps ...predicate.DiscordMessageChunk) *DiscordMessageChunkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordMessageChunkUpdateOne) Select(field string, fields ...string) *DiscordMessageChunkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordMessageChunk entity.
+func (_u *DiscordMessageChunkUpdateOne) Save(ctx context.Context) (*DiscordMessageChunk, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordMessageChunkUpdateOne) SaveX(ctx context.Context) *DiscordMessageChunk {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordMessageChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordMessageChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DiscordMessageChunkUpdateOne) sqlSave(ctx context.Context) (_node *DiscordMessageChunk, err error) {
+	_spec := sqlgraph.NewUpdateSpec(discordmessagechunk.Table, discordmessagechunk.Columns, sqlgraph.NewFieldSpec(discordmessagechunk.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordMessageChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordmessagechunk.FieldID)
+		for _, f := range fields {
+			if !discordmessagechunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordmessagechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(discordmessagechunk.FieldEndAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Content(); ok {
+		_spec.SetField(discordmessagechunk.FieldContent, field.TypeString, value)
+	}
+	if _u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMessagesIDs(); len(nodes) > 0 && !_u.mutation.MessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   discordmessagechunk.MessagesTable,
+			Columns: discordmessagechunk.MessagesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEmbeddingsIDs(); len(nodes) > 0 && !_u.mutation.EmbeddingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EmbeddingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discordmessagechunk.EmbeddingsTable,
+			Columns: []string{discordmessagechunk.EmbeddingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordchunkembedding.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &DiscordMessageChunk{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordmessagechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,563 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discorduser"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordProfileChangeCreate is the builder for creating a DiscordProfileChange entity.
+type DiscordProfileChangeCreate struct {
+	config
+	mutation *DiscordProfileChangeMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *DiscordProfileChangeCreate) SetUserID(v string) *DiscordProfileChangeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *DiscordProfileChangeCreate) SetGuildID(v string) *DiscordProfileChangeCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (_c *DiscordProfileChangeCreate) SetNillableGuildID(v *string) *DiscordProfileChangeCreate {
+	if v != nil {
+		_c.SetGuildID(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *DiscordProfileChangeCreate) SetKind(v discordprofilechange.Kind) *DiscordProfileChangeCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *DiscordProfileChangeCreate) SetValue(v string) *DiscordProfileChangeCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_c *DiscordProfileChangeCreate) SetChangedAt(v time.Time) *DiscordProfileChangeCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *DiscordProfileChangeCreate) SetNillableChangedAt(v *time.Time) *DiscordProfileChangeCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the DiscordUser entity.
+func (_c *DiscordProfileChangeCreate) SetUser(v *DiscordUser) *DiscordProfileChangeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the DiscordProfileChangeMutation object of the builder.
+func (_c *DiscordProfileChangeCreate) Mutation() *DiscordProfileChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the DiscordProfileChange in the database.
+func (_c *DiscordProfileChangeCreate) Save(ctx context.Context) (*DiscordProfileChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DiscordProfileChangeCreate) SaveX(ctx context.Context) *DiscordProfileChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordProfileChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordProfileChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DiscordProfileChangeCreate) defaults() {
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		v := discordprofilechange.DefaultChangedAt()
+		_c.mutation.SetChangedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DiscordProfileChangeCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "DiscordProfileChange.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := discordprofilechange.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "DiscordProfileChange.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "DiscordProfileChange.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := discordprofilechange.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "DiscordProfileChange.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "DiscordProfileChange.value"`)}
+	}
+	if v, ok := _c.mutation.Value(); ok {
+		if err := discordprofilechange.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "DiscordProfileChange.value": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "DiscordProfileChange.changed_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "DiscordProfileChange.user"`)}
+	}
+	return nil
+}
+
+func (_c *DiscordProfileChangeCreate) sqlSave(ctx context.Context) (*DiscordProfileChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DiscordProfileChangeCreate) createSpec() (*DiscordProfileChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DiscordProfileChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(discordprofilechange.Table, sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(discordprofilechange.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(discordprofilechange.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(discordprofilechange.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(discordprofilechange.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   discordprofilechange.UserTable,
+			Columns: []string{discordprofilechange.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorduser.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordProfileChange.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordProfileChangeUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordProfileChangeCreate) OnConflict(opts ...sql.ConflictOption) *DiscordProfileChangeUpsertOne {
+	_c.conflict = opts
+	return &DiscordProfileChangeUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordProfileChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordProfileChangeCreate) OnConflictColumns(columns ...string) *DiscordProfileChangeUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordProfileChangeUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DiscordProfileChangeUpsertOne is the builder for "upsert"-ing
+	//  one DiscordProfileChange node.
+	DiscordProfileChangeUpsertOne struct {
+		create *DiscordProfileChangeCreate
+	}
+
+	// DiscordProfileChangeUpsert is the "OnConflict" setter.
+	DiscordProfileChangeUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DiscordProfileChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DiscordProfileChangeUpsertOne) UpdateNewValues() *DiscordProfileChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(discordprofilechange.FieldUserID)
+		}
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(discordprofilechange.FieldGuildID)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(discordprofilechange.FieldKind)
+		}
+		if _, exists := u.create.mutation.Value(); exists {
+			s.SetIgnore(discordprofilechange.FieldValue)
+		}
+		if _, exists := u.create.mutation.ChangedAt(); exists {
+			s.SetIgnore(discordprofilechange.FieldChangedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordProfileChange.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DiscordProfileChangeUpsertOne) Ignore() *DiscordProfileChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordProfileChangeUpsertOne) DoNothing() *DiscordProfileChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordProfileChangeCreate.OnConflict
+// documentation for more info.
+func (u *DiscordProfileChangeUpsertOne) Update(set func(*DiscordProfileChangeUpsert)) *DiscordProfileChangeUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordProfileChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DiscordProfileChangeUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordProfileChangeCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordProfileChangeUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DiscordProfileChangeUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DiscordProfileChangeUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DiscordProfileChangeCreateBulk is the builder for creating many DiscordProfileChange entities in bulk.
+type DiscordProfileChangeCreateBulk struct {
+	config
+	err      error
+	builders []*DiscordProfileChangeCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DiscordProfileChange entities in the database.
+func (_c *DiscordProfileChangeCreateBulk) Save(ctx context.Context) ([]*DiscordProfileChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DiscordProfileChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DiscordProfileChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DiscordProfileChangeCreateBulk) SaveX(ctx context.Context) []*DiscordProfileChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DiscordProfileChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DiscordProfileChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DiscordProfileChange.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DiscordProfileChangeUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DiscordProfileChangeCreateBulk) OnConflict(opts ...sql.ConflictOption) *DiscordProfileChangeUpsertBulk {
+	_c.conflict = opts
+	return &DiscordProfileChangeUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DiscordProfileChange.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DiscordProfileChangeCreateBulk) OnConflictColumns(columns ...string) *DiscordProfileChangeUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DiscordProfileChangeUpsertBulk{
+		create: _c,
+	}
+}
+
+// DiscordProfileChangeUpsertBulk is the builder for "upsert"-ing
+// a bulk of DiscordProfileChange nodes.
+type DiscordProfileChangeUpsertBulk struct {
+	create *DiscordProfileChangeCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DiscordProfileChange.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DiscordProfileChangeUpsertBulk) UpdateNewValues() *DiscordProfileChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(discordprofilechange.FieldUserID)
+			}
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(discordprofilechange.FieldGuildID)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(discordprofilechange.FieldKind)
+			}
+			if _, exists := b.mutation.Value(); exists {
+				s.SetIgnore(discordprofilechange.FieldValue)
+			}
+			if _, exists := b.mutation.ChangedAt(); exists {
+				s.SetIgnore(discordprofilechange.FieldChangedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DiscordProfileChange.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DiscordProfileChangeUpsertBulk) Ignore() *DiscordProfileChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DiscordProfileChangeUpsertBulk) DoNothing() *DiscordProfileChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DiscordProfileChangeCreateBulk.OnConflict
+// documentation for more info.
+func (u *DiscordProfileChangeUpsertBulk) Update(set func(*DiscordProfileChangeUpsert)) *DiscordProfileChangeUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DiscordProfileChangeUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DiscordProfileChangeUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DiscordProfileChangeCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DiscordProfileChangeCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DiscordProfileChangeUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
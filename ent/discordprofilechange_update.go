@@ -0,0 +1,203 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordProfileChangeUpdate is the builder for updating DiscordProfileChange entities.
+type DiscordProfileChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DiscordProfileChangeMutation
+}
+
+// Where appends a list predicates to the DiscordProfileChangeUpdate builder.
+func (_u *DiscordProfileChangeUpdate) Where(ps ...predicate.DiscordProfileChange) *DiscordProfileChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the DiscordProfileChangeMutation object of the builder.
+func (_u *DiscordProfileChangeUpdate) Mutation() *DiscordProfileChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DiscordProfileChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordProfileChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DiscordProfileChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordProfileChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordProfileChangeUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordProfileChange.user"`)
+	}
+	return nil
+}
+
+func (_u *DiscordProfileChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordprofilechange.Table, discordprofilechange.Columns, sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(discordprofilechange.FieldGuildID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordprofilechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DiscordProfileChangeUpdateOne is the builder for updating a single DiscordProfileChange entity.
+type DiscordProfileChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DiscordProfileChangeMutation
+}
+
+// Mutation returns the DiscordProfileChangeMutation object of the builder.
+func (_u *DiscordProfileChangeUpdateOne) Mutation() *DiscordProfileChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DiscordProfileChangeUpdate builder.
+func (_u *DiscordProfileChangeUpdateOne) Where(ps ...predicate.DiscordProfileChange) *DiscordProfileChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DiscordProfileChangeUpdateOne) Select(field string, fields ...string) *DiscordProfileChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DiscordProfileChange entity.
+func (_u *DiscordProfileChangeUpdateOne) Save(ctx context.Context) (*DiscordProfileChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DiscordProfileChangeUpdateOne) SaveX(ctx context.Context) *DiscordProfileChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DiscordProfileChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DiscordProfileChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DiscordProfileChangeUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DiscordProfileChange.user"`)
+	}
+	return nil
+}
+
+func (_u *DiscordProfileChangeUpdateOne) sqlSave(ctx context.Context) (_node *DiscordProfileChange, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(discordprofilechange.Table, discordprofilechange.Columns, sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DiscordProfileChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, discordprofilechange.FieldID)
+		for _, f := range fields {
+			if !discordprofilechange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != discordprofilechange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(discordprofilechange.FieldGuildID, field.TypeString)
+	}
+	_node = &DiscordProfileChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{discordprofilechange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sev0/ent/discordrole"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DiscordRole is the model entity for the DiscordRole schema.
+type DiscordRole struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// GuildID holds the value of the "guild_id" field.
+	GuildID string `json:"guild_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DiscordRoleQuery when eager-loading is set.
+	Edges        DiscordRoleEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DiscordRoleEdges holds the relations/edges for other nodes in the graph.
+type DiscordRoleEdges struct {
+	// MentionedIn holds the value of the mentioned_in edge.
+	MentionedIn []*DiscordMessage `json:"mentioned_in,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MentionedInOrErr returns the MentionedIn value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordRoleEdges) MentionedInOrErr() ([]*DiscordMessage, error) {
+	if e.loadedTypes[0] {
+		return e.MentionedIn, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DiscordRole) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case discordrole.FieldID, discordrole.FieldGuildID, discordrole.FieldName:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DiscordRole fields.
+func (_m *DiscordRole) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case discordrole.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case discordrole.FieldGuildID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guild_id", values[i])
+			} else if value.Valid {
+				_m.GuildID = value.String
+			}
+		case discordrole.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DiscordRole.
+// This includes values selected through modifiers, order, etc.
+func (_m *DiscordRole) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMentionedIn queries the "mentioned_in" edge of the DiscordRole entity.
+func (_m *DiscordRole) QueryMentionedIn() *DiscordMessageQuery {
+	return NewDiscordRoleClient(_m.config).QueryMentionedIn(_m)
+}
+
+// Update returns a builder for updating this DiscordRole.
+// Note that you need to call DiscordRole.Unwrap() before calling this method if this DiscordRole
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DiscordRole) Update() *DiscordRoleUpdateOne {
+	return NewDiscordRoleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DiscordRole entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DiscordRole) Unwrap() *DiscordRole {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DiscordRole is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DiscordRole) String() string {
+	var builder strings.Builder
+	builder.WriteString("DiscordRole(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("guild_id=")
+	builder.WriteString(_m.GuildID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DiscordRoles is a parsable slice of DiscordRole.
+type DiscordRoles []*DiscordRole
@@ -20,6 +20,12 @@ type DiscordUser struct {
 	Username string `json:"username,omitempty"`
 	// GlobalName holds the value of the "global_name" field.
 	GlobalName string `json:"global_name,omitempty"`
+	// Avatar holds the value of the "avatar" field.
+	Avatar string `json:"avatar,omitempty"`
+	// ProfilingOptOut holds the value of the "profiling_opt_out" field.
+	ProfilingOptOut bool `json:"profiling_opt_out,omitempty"`
+	// DmEnabled holds the value of the "dm_enabled" field.
+	DmEnabled bool `json:"dm_enabled,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the DiscordUserQuery when eager-loading is set.
 	Edges        DiscordUserEdges `json:"edges"`
@@ -30,9 +36,17 @@ type DiscordUser struct {
 type DiscordUserEdges struct {
 	// Messages holds the value of the messages edge.
 	Messages []*DiscordMessage `json:"messages,omitempty"`
+	// MentionedIn holds the value of the mentioned_in edge.
+	MentionedIn []*DiscordMessage `json:"mentioned_in,omitempty"`
+	// Memberships holds the value of the memberships edge.
+	Memberships []*DiscordGuildMember `json:"memberships,omitempty"`
+	// ProfileChanges holds the value of the profile_changes edge.
+	ProfileChanges []*DiscordProfileChange `json:"profile_changes,omitempty"`
+	// DirectMessages holds the value of the direct_messages edge.
+	DirectMessages []*DiscordDirectMessage `json:"direct_messages,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [5]bool
 }
 
 // MessagesOrErr returns the Messages value or an error if the edge
@@ -44,12 +58,50 @@ func (e DiscordUserEdges) MessagesOrErr() ([]*DiscordMessage, error) {
 	return nil, &NotLoadedError{edge: "messages"}
 }
 
+// MentionedInOrErr returns the MentionedIn value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordUserEdges) MentionedInOrErr() ([]*DiscordMessage, error) {
+	if e.loadedTypes[1] {
+		return e.MentionedIn, nil
+	}
+	return nil, &NotLoadedError{edge: "mentioned_in"}
+}
+
+// MembershipsOrErr returns the Memberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordUserEdges) MembershipsOrErr() ([]*DiscordGuildMember, error) {
+	if e.loadedTypes[2] {
+		return e.Memberships, nil
+	}
+	return nil, &NotLoadedError{edge: "memberships"}
+}
+
+// ProfileChangesOrErr returns the ProfileChanges value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordUserEdges) ProfileChangesOrErr() ([]*DiscordProfileChange, error) {
+	if e.loadedTypes[3] {
+		return e.ProfileChanges, nil
+	}
+	return nil, &NotLoadedError{edge: "profile_changes"}
+}
+
+// DirectMessagesOrErr returns the DirectMessages value or an error if the edge
+// was not loaded in eager-loading.
+func (e DiscordUserEdges) DirectMessagesOrErr() ([]*DiscordDirectMessage, error) {
+	if e.loadedTypes[4] {
+		return e.DirectMessages, nil
+	}
+	return nil, &NotLoadedError{edge: "direct_messages"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*DiscordUser) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case discorduser.FieldID, discorduser.FieldUsername, discorduser.FieldGlobalName:
+		case discorduser.FieldProfilingOptOut, discorduser.FieldDmEnabled:
+			values[i] = new(sql.NullBool)
+		case discorduser.FieldID, discorduser.FieldUsername, discorduser.FieldGlobalName, discorduser.FieldAvatar:
 			values[i] = new(sql.NullString)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -84,6 +136,24 @@ func (_m *DiscordUser) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.GlobalName = value.String
 			}
+		case discorduser.FieldAvatar:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar", values[i])
+			} else if value.Valid {
+				_m.Avatar = value.String
+			}
+		case discorduser.FieldProfilingOptOut:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field profiling_opt_out", values[i])
+			} else if value.Valid {
+				_m.ProfilingOptOut = value.Bool
+			}
+		case discorduser.FieldDmEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field dm_enabled", values[i])
+			} else if value.Valid {
+				_m.DmEnabled = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -102,6 +172,26 @@ func (_m *DiscordUser) QueryMessages() *DiscordMessageQuery {
 	return NewDiscordUserClient(_m.config).QueryMessages(_m)
 }
 
+// QueryMentionedIn queries the "mentioned_in" edge of the DiscordUser entity.
+func (_m *DiscordUser) QueryMentionedIn() *DiscordMessageQuery {
+	return NewDiscordUserClient(_m.config).QueryMentionedIn(_m)
+}
+
+// QueryMemberships queries the "memberships" edge of the DiscordUser entity.
+func (_m *DiscordUser) QueryMemberships() *DiscordGuildMemberQuery {
+	return NewDiscordUserClient(_m.config).QueryMemberships(_m)
+}
+
+// QueryProfileChanges queries the "profile_changes" edge of the DiscordUser entity.
+func (_m *DiscordUser) QueryProfileChanges() *DiscordProfileChangeQuery {
+	return NewDiscordUserClient(_m.config).QueryProfileChanges(_m)
+}
+
+// QueryDirectMessages queries the "direct_messages" edge of the DiscordUser entity.
+func (_m *DiscordUser) QueryDirectMessages() *DiscordDirectMessageQuery {
+	return NewDiscordUserClient(_m.config).QueryDirectMessages(_m)
+}
+
 // Update returns a builder for updating this DiscordUser.
 // Note that you need to call DiscordUser.Unwrap() before calling this method if this DiscordUser
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -130,6 +220,15 @@ func (_m *DiscordUser) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("global_name=")
 	builder.WriteString(_m.GlobalName)
+	builder.WriteString(", ")
+	builder.WriteString("avatar=")
+	builder.WriteString(_m.Avatar)
+	builder.WriteString(", ")
+	builder.WriteString("profiling_opt_out=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProfilingOptOut))
+	builder.WriteString(", ")
+	builder.WriteString("dm_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DmEnabled))
 	builder.WriteByte(')')
 	return builder.String()
 }
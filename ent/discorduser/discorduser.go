@@ -16,8 +16,22 @@ const (
 	FieldUsername = "username"
 	// FieldGlobalName holds the string denoting the global_name field in the database.
 	FieldGlobalName = "global_name"
+	// FieldAvatar holds the string denoting the avatar field in the database.
+	FieldAvatar = "avatar"
+	// FieldProfilingOptOut holds the string denoting the profiling_opt_out field in the database.
+	FieldProfilingOptOut = "profiling_opt_out"
+	// FieldDmEnabled holds the string denoting the dm_enabled field in the database.
+	FieldDmEnabled = "dm_enabled"
 	// EdgeMessages holds the string denoting the messages edge name in mutations.
 	EdgeMessages = "messages"
+	// EdgeMentionedIn holds the string denoting the mentioned_in edge name in mutations.
+	EdgeMentionedIn = "mentioned_in"
+	// EdgeMemberships holds the string denoting the memberships edge name in mutations.
+	EdgeMemberships = "memberships"
+	// EdgeProfileChanges holds the string denoting the profile_changes edge name in mutations.
+	EdgeProfileChanges = "profile_changes"
+	// EdgeDirectMessages holds the string denoting the direct_messages edge name in mutations.
+	EdgeDirectMessages = "direct_messages"
 	// Table holds the table name of the discorduser in the database.
 	Table = "discord_users"
 	// MessagesTable is the table that holds the messages relation/edge.
@@ -27,6 +41,32 @@ const (
 	MessagesInverseTable = "discord_messages"
 	// MessagesColumn is the table column denoting the messages relation/edge.
 	MessagesColumn = "author_id"
+	// MentionedInTable is the table that holds the mentioned_in relation/edge. The primary key declared below.
+	MentionedInTable = "discord_message_mentioned_users"
+	// MentionedInInverseTable is the table name for the DiscordMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "discordmessage" package.
+	MentionedInInverseTable = "discord_messages"
+	// MembershipsTable is the table that holds the memberships relation/edge.
+	MembershipsTable = "discord_guild_members"
+	// MembershipsInverseTable is the table name for the DiscordGuildMember entity.
+	// It exists in this package in order to avoid circular dependency with the "discordguildmember" package.
+	MembershipsInverseTable = "discord_guild_members"
+	// MembershipsColumn is the table column denoting the memberships relation/edge.
+	MembershipsColumn = "user_id"
+	// ProfileChangesTable is the table that holds the profile_changes relation/edge.
+	ProfileChangesTable = "discord_profile_changes"
+	// ProfileChangesInverseTable is the table name for the DiscordProfileChange entity.
+	// It exists in this package in order to avoid circular dependency with the "discordprofilechange" package.
+	ProfileChangesInverseTable = "discord_profile_changes"
+	// ProfileChangesColumn is the table column denoting the profile_changes relation/edge.
+	ProfileChangesColumn = "user_id"
+	// DirectMessagesTable is the table that holds the direct_messages relation/edge.
+	DirectMessagesTable = "discord_direct_messages"
+	// DirectMessagesInverseTable is the table name for the DiscordDirectMessage entity.
+	// It exists in this package in order to avoid circular dependency with the "discorddirectmessage" package.
+	DirectMessagesInverseTable = "discord_direct_messages"
+	// DirectMessagesColumn is the table column denoting the direct_messages relation/edge.
+	DirectMessagesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for discorduser fields.
@@ -34,8 +74,17 @@ var Columns = []string{
 	FieldID,
 	FieldUsername,
 	FieldGlobalName,
+	FieldAvatar,
+	FieldProfilingOptOut,
+	FieldDmEnabled,
 }
 
+var (
+	// MentionedInPrimaryKey and MentionedInColumn2 are the table columns denoting the
+	// primary key for the mentioned_in relation (M2M).
+	MentionedInPrimaryKey = []string{"discord_message_id", "discord_user_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -51,6 +100,10 @@ var (
 	UsernameValidator func(string) error
 	// GlobalNameValidator is a validator for the "global_name" field. It is called by the builders before save.
 	GlobalNameValidator func(string) error
+	// DefaultProfilingOptOut holds the default value on creation for the "profiling_opt_out" field.
+	DefaultProfilingOptOut bool
+	// DefaultDmEnabled holds the default value on creation for the "dm_enabled" field.
+	DefaultDmEnabled bool
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
 	IDValidator func(string) error
 )
@@ -73,6 +126,21 @@ func ByGlobalName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGlobalName, opts...).ToFunc()
 }
 
+// ByAvatar orders the results by the avatar field.
+func ByAvatar(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatar, opts...).ToFunc()
+}
+
+// ByProfilingOptOut orders the results by the profiling_opt_out field.
+func ByProfilingOptOut(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProfilingOptOut, opts...).ToFunc()
+}
+
+// ByDmEnabled orders the results by the dm_enabled field.
+func ByDmEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDmEnabled, opts...).ToFunc()
+}
+
 // ByMessagesCount orders the results by messages count.
 func ByMessagesCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -86,6 +154,62 @@ func ByMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByMentionedInCount orders the results by mentioned_in count.
+func ByMentionedInCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMentionedInStep(), opts...)
+	}
+}
+
+// ByMentionedIn orders the results by mentioned_in terms.
+func ByMentionedIn(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMentionedInStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMembershipsCount orders the results by memberships count.
+func ByMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMembershipsStep(), opts...)
+	}
+}
+
+// ByMemberships orders the results by memberships terms.
+func ByMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByProfileChangesCount orders the results by profile_changes count.
+func ByProfileChangesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newProfileChangesStep(), opts...)
+	}
+}
+
+// ByProfileChanges orders the results by profile_changes terms.
+func ByProfileChanges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newProfileChangesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByDirectMessagesCount orders the results by direct_messages count.
+func ByDirectMessagesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDirectMessagesStep(), opts...)
+	}
+}
+
+// ByDirectMessages orders the results by direct_messages terms.
+func ByDirectMessages(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDirectMessagesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newMessagesStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -93,3 +217,31 @@ func newMessagesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, MessagesTable, MessagesColumn),
 	)
 }
+func newMentionedInStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MentionedInInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, MentionedInTable, MentionedInPrimaryKey...),
+	)
+}
+func newMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+	)
+}
+func newProfileChangesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ProfileChangesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ProfileChangesTable, ProfileChangesColumn),
+	)
+}
+func newDirectMessagesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DirectMessagesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DirectMessagesTable, DirectMessagesColumn),
+	)
+}
@@ -74,6 +74,21 @@ func GlobalName(v string) predicate.DiscordUser {
 	return predicate.DiscordUser(sql.FieldEQ(FieldGlobalName, v))
 }
 
+// Avatar applies equality check predicate on the "avatar" field. It's identical to AvatarEQ.
+func Avatar(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEQ(FieldAvatar, v))
+}
+
+// ProfilingOptOut applies equality check predicate on the "profiling_opt_out" field. It's identical to ProfilingOptOutEQ.
+func ProfilingOptOut(v bool) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEQ(FieldProfilingOptOut, v))
+}
+
+// DmEnabled applies equality check predicate on the "dm_enabled" field. It's identical to DmEnabledEQ.
+func DmEnabled(v bool) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEQ(FieldDmEnabled, v))
+}
+
 // UsernameEQ applies the EQ predicate on the "username" field.
 func UsernameEQ(v string) predicate.DiscordUser {
 	return predicate.DiscordUser(sql.FieldEQ(FieldUsername, v))
@@ -204,6 +219,101 @@ func GlobalNameContainsFold(v string) predicate.DiscordUser {
 	return predicate.DiscordUser(sql.FieldContainsFold(FieldGlobalName, v))
 }
 
+// AvatarEQ applies the EQ predicate on the "avatar" field.
+func AvatarEQ(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEQ(FieldAvatar, v))
+}
+
+// AvatarNEQ applies the NEQ predicate on the "avatar" field.
+func AvatarNEQ(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldNEQ(FieldAvatar, v))
+}
+
+// AvatarIn applies the In predicate on the "avatar" field.
+func AvatarIn(vs ...string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldIn(FieldAvatar, vs...))
+}
+
+// AvatarNotIn applies the NotIn predicate on the "avatar" field.
+func AvatarNotIn(vs ...string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldNotIn(FieldAvatar, vs...))
+}
+
+// AvatarGT applies the GT predicate on the "avatar" field.
+func AvatarGT(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldGT(FieldAvatar, v))
+}
+
+// AvatarGTE applies the GTE predicate on the "avatar" field.
+func AvatarGTE(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldGTE(FieldAvatar, v))
+}
+
+// AvatarLT applies the LT predicate on the "avatar" field.
+func AvatarLT(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldLT(FieldAvatar, v))
+}
+
+// AvatarLTE applies the LTE predicate on the "avatar" field.
+func AvatarLTE(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldLTE(FieldAvatar, v))
+}
+
+// AvatarContains applies the Contains predicate on the "avatar" field.
+func AvatarContains(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldContains(FieldAvatar, v))
+}
+
+// AvatarHasPrefix applies the HasPrefix predicate on the "avatar" field.
+func AvatarHasPrefix(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldHasPrefix(FieldAvatar, v))
+}
+
+// AvatarHasSuffix applies the HasSuffix predicate on the "avatar" field.
+func AvatarHasSuffix(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldHasSuffix(FieldAvatar, v))
+}
+
+// AvatarIsNil applies the IsNil predicate on the "avatar" field.
+func AvatarIsNil() predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldIsNull(FieldAvatar))
+}
+
+// AvatarNotNil applies the NotNil predicate on the "avatar" field.
+func AvatarNotNil() predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldNotNull(FieldAvatar))
+}
+
+// AvatarEqualFold applies the EqualFold predicate on the "avatar" field.
+func AvatarEqualFold(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEqualFold(FieldAvatar, v))
+}
+
+// AvatarContainsFold applies the ContainsFold predicate on the "avatar" field.
+func AvatarContainsFold(v string) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldContainsFold(FieldAvatar, v))
+}
+
+// ProfilingOptOutEQ applies the EQ predicate on the "profiling_opt_out" field.
+func ProfilingOptOutEQ(v bool) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEQ(FieldProfilingOptOut, v))
+}
+
+// ProfilingOptOutNEQ applies the NEQ predicate on the "profiling_opt_out" field.
+func ProfilingOptOutNEQ(v bool) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldNEQ(FieldProfilingOptOut, v))
+}
+
+// DmEnabledEQ applies the EQ predicate on the "dm_enabled" field.
+func DmEnabledEQ(v bool) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldEQ(FieldDmEnabled, v))
+}
+
+// DmEnabledNEQ applies the NEQ predicate on the "dm_enabled" field.
+func DmEnabledNEQ(v bool) predicate.DiscordUser {
+	return predicate.DiscordUser(sql.FieldNEQ(FieldDmEnabled, v))
+}
+
 // HasMessages applies the HasEdge predicate on the "messages" edge.
 func HasMessages() predicate.DiscordUser {
 	return predicate.DiscordUser(func(s *sql.Selector) {
@@ -227,6 +337,98 @@ func HasMessagesWith(preds ...predicate.DiscordMessage) predicate.DiscordUser {
 	})
 }
 
+// HasMentionedIn applies the HasEdge predicate on the "mentioned_in" edge.
+func HasMentionedIn() predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, MentionedInTable, MentionedInPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMentionedInWith applies the HasEdge predicate on the "mentioned_in" edge with a given conditions (other predicates).
+func HasMentionedInWith(preds ...predicate.DiscordMessage) predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := newMentionedInStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMemberships applies the HasEdge predicate on the "memberships" edge.
+func HasMemberships() predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MembershipsTable, MembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMembershipsWith applies the HasEdge predicate on the "memberships" edge with a given conditions (other predicates).
+func HasMembershipsWith(preds ...predicate.DiscordGuildMember) predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := newMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasProfileChanges applies the HasEdge predicate on the "profile_changes" edge.
+func HasProfileChanges() predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ProfileChangesTable, ProfileChangesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProfileChangesWith applies the HasEdge predicate on the "profile_changes" edge with a given conditions (other predicates).
+func HasProfileChangesWith(preds ...predicate.DiscordProfileChange) predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := newProfileChangesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDirectMessages applies the HasEdge predicate on the "direct_messages" edge.
+func HasDirectMessages() predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DirectMessagesTable, DirectMessagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDirectMessagesWith applies the HasEdge predicate on the "direct_messages" edge with a given conditions (other predicates).
+func HasDirectMessagesWith(preds ...predicate.DiscordDirectMessage) predicate.DiscordUser {
+	return predicate.DiscordUser(func(s *sql.Selector) {
+		step := newDirectMessagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.DiscordUser) predicate.DiscordUser {
 	return predicate.DiscordUser(sql.AndPredicates(predicates...))
@@ -6,7 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordprofilechange"
 	"sev0/ent/discorduser"
 
 	"entgo.io/ent/dialect"
@@ -35,6 +38,48 @@ func (_c *DiscordUserCreate) SetGlobalName(v string) *DiscordUserCreate {
 	return _c
 }
 
+// SetAvatar sets the "avatar" field.
+func (_c *DiscordUserCreate) SetAvatar(v string) *DiscordUserCreate {
+	_c.mutation.SetAvatar(v)
+	return _c
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_c *DiscordUserCreate) SetNillableAvatar(v *string) *DiscordUserCreate {
+	if v != nil {
+		_c.SetAvatar(*v)
+	}
+	return _c
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (_c *DiscordUserCreate) SetProfilingOptOut(v bool) *DiscordUserCreate {
+	_c.mutation.SetProfilingOptOut(v)
+	return _c
+}
+
+// SetNillableProfilingOptOut sets the "profiling_opt_out" field if the given value is not nil.
+func (_c *DiscordUserCreate) SetNillableProfilingOptOut(v *bool) *DiscordUserCreate {
+	if v != nil {
+		_c.SetProfilingOptOut(*v)
+	}
+	return _c
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (_c *DiscordUserCreate) SetDmEnabled(v bool) *DiscordUserCreate {
+	_c.mutation.SetDmEnabled(v)
+	return _c
+}
+
+// SetNillableDmEnabled sets the "dm_enabled" field if the given value is not nil.
+func (_c *DiscordUserCreate) SetNillableDmEnabled(v *bool) *DiscordUserCreate {
+	if v != nil {
+		_c.SetDmEnabled(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *DiscordUserCreate) SetID(v string) *DiscordUserCreate {
 	_c.mutation.SetID(v)
@@ -56,6 +101,66 @@ func (_c *DiscordUserCreate) AddMessages(v ...*DiscordMessage) *DiscordUserCreat
 	return _c.AddMessageIDs(ids...)
 }
 
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (_c *DiscordUserCreate) AddMentionedInIDs(ids ...string) *DiscordUserCreate {
+	_c.mutation.AddMentionedInIDs(ids...)
+	return _c
+}
+
+// AddMentionedIn adds the "mentioned_in" edges to the DiscordMessage entity.
+func (_c *DiscordUserCreate) AddMentionedIn(v ...*DiscordMessage) *DiscordUserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMentionedInIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the DiscordGuildMember entity by IDs.
+func (_c *DiscordUserCreate) AddMembershipIDs(ids ...int) *DiscordUserCreate {
+	_c.mutation.AddMembershipIDs(ids...)
+	return _c
+}
+
+// AddMemberships adds the "memberships" edges to the DiscordGuildMember entity.
+func (_c *DiscordUserCreate) AddMemberships(v ...*DiscordGuildMember) *DiscordUserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMembershipIDs(ids...)
+}
+
+// AddProfileChangeIDs adds the "profile_changes" edge to the DiscordProfileChange entity by IDs.
+func (_c *DiscordUserCreate) AddProfileChangeIDs(ids ...int) *DiscordUserCreate {
+	_c.mutation.AddProfileChangeIDs(ids...)
+	return _c
+}
+
+// AddProfileChanges adds the "profile_changes" edges to the DiscordProfileChange entity.
+func (_c *DiscordUserCreate) AddProfileChanges(v ...*DiscordProfileChange) *DiscordUserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddProfileChangeIDs(ids...)
+}
+
+// AddDirectMessageIDs adds the "direct_messages" edge to the DiscordDirectMessage entity by IDs.
+func (_c *DiscordUserCreate) AddDirectMessageIDs(ids ...string) *DiscordUserCreate {
+	_c.mutation.AddDirectMessageIDs(ids...)
+	return _c
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DiscordDirectMessage entity.
+func (_c *DiscordUserCreate) AddDirectMessages(v ...*DiscordDirectMessage) *DiscordUserCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDirectMessageIDs(ids...)
+}
+
 // Mutation returns the DiscordUserMutation object of the builder.
 func (_c *DiscordUserCreate) Mutation() *DiscordUserMutation {
 	return _c.mutation
@@ -63,6 +168,7 @@ func (_c *DiscordUserCreate) Mutation() *DiscordUserMutation {
 
 // Save creates the DiscordUser in the database.
 func (_c *DiscordUserCreate) Save(ctx context.Context) (*DiscordUser, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -88,6 +194,18 @@ func (_c *DiscordUserCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *DiscordUserCreate) defaults() {
+	if _, ok := _c.mutation.ProfilingOptOut(); !ok {
+		v := discorduser.DefaultProfilingOptOut
+		_c.mutation.SetProfilingOptOut(v)
+	}
+	if _, ok := _c.mutation.DmEnabled(); !ok {
+		v := discorduser.DefaultDmEnabled
+		_c.mutation.SetDmEnabled(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *DiscordUserCreate) check() error {
 	if _, ok := _c.mutation.Username(); !ok {
@@ -106,6 +224,12 @@ func (_c *DiscordUserCreate) check() error {
 			return &ValidationError{Name: "global_name", err: fmt.Errorf(`ent: validator failed for field "DiscordUser.global_name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ProfilingOptOut(); !ok {
+		return &ValidationError{Name: "profiling_opt_out", err: errors.New(`ent: missing required field "DiscordUser.profiling_opt_out"`)}
+	}
+	if _, ok := _c.mutation.DmEnabled(); !ok {
+		return &ValidationError{Name: "dm_enabled", err: errors.New(`ent: missing required field "DiscordUser.dm_enabled"`)}
+	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := discorduser.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DiscordUser.id": %w`, err)}
@@ -155,6 +279,18 @@ func (_c *DiscordUserCreate) createSpec() (*DiscordUser, *sqlgraph.CreateSpec) {
 		_spec.SetField(discorduser.FieldGlobalName, field.TypeString, value)
 		_node.GlobalName = value
 	}
+	if value, ok := _c.mutation.Avatar(); ok {
+		_spec.SetField(discorduser.FieldAvatar, field.TypeString, value)
+		_node.Avatar = value
+	}
+	if value, ok := _c.mutation.ProfilingOptOut(); ok {
+		_spec.SetField(discorduser.FieldProfilingOptOut, field.TypeBool, value)
+		_node.ProfilingOptOut = value
+	}
+	if value, ok := _c.mutation.DmEnabled(); ok {
+		_spec.SetField(discorduser.FieldDmEnabled, field.TypeBool, value)
+		_node.DmEnabled = value
+	}
 	if nodes := _c.mutation.MessagesIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -171,6 +307,70 @@ func (_c *DiscordUserCreate) createSpec() (*DiscordUser, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ProfileChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -247,6 +447,48 @@ func (u *DiscordUserUpsert) UpdateGlobalName() *DiscordUserUpsert {
 	return u
 }
 
+// SetAvatar sets the "avatar" field.
+func (u *DiscordUserUpsert) SetAvatar(v string) *DiscordUserUpsert {
+	u.Set(discorduser.FieldAvatar, v)
+	return u
+}
+
+// UpdateAvatar sets the "avatar" field to the value that was provided on create.
+func (u *DiscordUserUpsert) UpdateAvatar() *DiscordUserUpsert {
+	u.SetExcluded(discorduser.FieldAvatar)
+	return u
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (u *DiscordUserUpsert) ClearAvatar() *DiscordUserUpsert {
+	u.SetNull(discorduser.FieldAvatar)
+	return u
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (u *DiscordUserUpsert) SetProfilingOptOut(v bool) *DiscordUserUpsert {
+	u.Set(discorduser.FieldProfilingOptOut, v)
+	return u
+}
+
+// UpdateProfilingOptOut sets the "profiling_opt_out" field to the value that was provided on create.
+func (u *DiscordUserUpsert) UpdateProfilingOptOut() *DiscordUserUpsert {
+	u.SetExcluded(discorduser.FieldProfilingOptOut)
+	return u
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (u *DiscordUserUpsert) SetDmEnabled(v bool) *DiscordUserUpsert {
+	u.Set(discorduser.FieldDmEnabled, v)
+	return u
+}
+
+// UpdateDmEnabled sets the "dm_enabled" field to the value that was provided on create.
+func (u *DiscordUserUpsert) UpdateDmEnabled() *DiscordUserUpsert {
+	u.SetExcluded(discorduser.FieldDmEnabled)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -323,6 +565,55 @@ func (u *DiscordUserUpsertOne) UpdateGlobalName() *DiscordUserUpsertOne {
 	})
 }
 
+// SetAvatar sets the "avatar" field.
+func (u *DiscordUserUpsertOne) SetAvatar(v string) *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.SetAvatar(v)
+	})
+}
+
+// UpdateAvatar sets the "avatar" field to the value that was provided on create.
+func (u *DiscordUserUpsertOne) UpdateAvatar() *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.UpdateAvatar()
+	})
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (u *DiscordUserUpsertOne) ClearAvatar() *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.ClearAvatar()
+	})
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (u *DiscordUserUpsertOne) SetProfilingOptOut(v bool) *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.SetProfilingOptOut(v)
+	})
+}
+
+// UpdateProfilingOptOut sets the "profiling_opt_out" field to the value that was provided on create.
+func (u *DiscordUserUpsertOne) UpdateProfilingOptOut() *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.UpdateProfilingOptOut()
+	})
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (u *DiscordUserUpsertOne) SetDmEnabled(v bool) *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.SetDmEnabled(v)
+	})
+}
+
+// UpdateDmEnabled sets the "dm_enabled" field to the value that was provided on create.
+func (u *DiscordUserUpsertOne) UpdateDmEnabled() *DiscordUserUpsertOne {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.UpdateDmEnabled()
+	})
+}
+
 // Exec executes the query.
 func (u *DiscordUserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -380,6 +671,7 @@ func (_c *DiscordUserCreateBulk) Save(ctx context.Context) ([]*DiscordUser, erro
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*DiscordUserMutation)
 				if !ok {
@@ -565,6 +857,55 @@ func (u *DiscordUserUpsertBulk) UpdateGlobalName() *DiscordUserUpsertBulk {
 	})
 }
 
+// SetAvatar sets the "avatar" field.
+func (u *DiscordUserUpsertBulk) SetAvatar(v string) *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.SetAvatar(v)
+	})
+}
+
+// UpdateAvatar sets the "avatar" field to the value that was provided on create.
+func (u *DiscordUserUpsertBulk) UpdateAvatar() *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.UpdateAvatar()
+	})
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (u *DiscordUserUpsertBulk) ClearAvatar() *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.ClearAvatar()
+	})
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (u *DiscordUserUpsertBulk) SetProfilingOptOut(v bool) *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.SetProfilingOptOut(v)
+	})
+}
+
+// UpdateProfilingOptOut sets the "profiling_opt_out" field to the value that was provided on create.
+func (u *DiscordUserUpsertBulk) UpdateProfilingOptOut() *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.UpdateProfilingOptOut()
+	})
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (u *DiscordUserUpsertBulk) SetDmEnabled(v bool) *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.SetDmEnabled(v)
+	})
+}
+
+// UpdateDmEnabled sets the "dm_enabled" field to the value that was provided on create.
+func (u *DiscordUserUpsertBulk) UpdateDmEnabled() *DiscordUserUpsertBulk {
+	return u.Update(func(s *DiscordUserUpsert) {
+		s.UpdateDmEnabled()
+	})
+}
+
 // Exec executes the query.
 func (u *DiscordUserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -7,7 +7,10 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordprofilechange"
 	"sev0/ent/discorduser"
 	"sev0/ent/predicate"
 
@@ -20,11 +23,15 @@ import (
 // DiscordUserQuery is the builder for querying DiscordUser entities.
 type DiscordUserQuery struct {
 	config
-	ctx          *QueryContext
-	order        []discorduser.OrderOption
-	inters       []Interceptor
-	predicates   []predicate.DiscordUser
-	withMessages *DiscordMessageQuery
+	ctx                *QueryContext
+	order              []discorduser.OrderOption
+	inters             []Interceptor
+	predicates         []predicate.DiscordUser
+	withMessages       *DiscordMessageQuery
+	withMentionedIn    *DiscordMessageQuery
+	withMemberships    *DiscordGuildMemberQuery
+	withProfileChanges *DiscordProfileChangeQuery
+	withDirectMessages *DiscordDirectMessageQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -83,6 +90,94 @@ func (_q *DiscordUserQuery) QueryMessages() *DiscordMessageQuery {
 	return query
 }
 
+// QueryMentionedIn chains the current query on the "mentioned_in" edge.
+func (_q *DiscordUserQuery) QueryMentionedIn() *DiscordMessageQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, selector),
+			sqlgraph.To(discordmessage.Table, discordmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, discorduser.MentionedInTable, discorduser.MentionedInPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMemberships chains the current query on the "memberships" edge.
+func (_q *DiscordUserQuery) QueryMemberships() *DiscordGuildMemberQuery {
+	query := (&DiscordGuildMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, selector),
+			sqlgraph.To(discordguildmember.Table, discordguildmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.MembershipsTable, discorduser.MembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryProfileChanges chains the current query on the "profile_changes" edge.
+func (_q *DiscordUserQuery) QueryProfileChanges() *DiscordProfileChangeQuery {
+	query := (&DiscordProfileChangeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, selector),
+			sqlgraph.To(discordprofilechange.Table, discordprofilechange.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.ProfileChangesTable, discorduser.ProfileChangesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDirectMessages chains the current query on the "direct_messages" edge.
+func (_q *DiscordUserQuery) QueryDirectMessages() *DiscordDirectMessageQuery {
+	query := (&DiscordDirectMessageClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(discorduser.Table, discorduser.FieldID, selector),
+			sqlgraph.To(discorddirectmessage.Table, discorddirectmessage.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, discorduser.DirectMessagesTable, discorduser.DirectMessagesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first DiscordUser entity from the query.
 // Returns a *NotFoundError when no DiscordUser was found.
 func (_q *DiscordUserQuery) First(ctx context.Context) (*DiscordUser, error) {
@@ -270,12 +365,16 @@ func (_q *DiscordUserQuery) Clone() *DiscordUserQuery {
 		return nil
 	}
 	return &DiscordUserQuery{
-		config:       _q.config,
-		ctx:          _q.ctx.Clone(),
-		order:        append([]discorduser.OrderOption{}, _q.order...),
-		inters:       append([]Interceptor{}, _q.inters...),
-		predicates:   append([]predicate.DiscordUser{}, _q.predicates...),
-		withMessages: _q.withMessages.Clone(),
+		config:             _q.config,
+		ctx:                _q.ctx.Clone(),
+		order:              append([]discorduser.OrderOption{}, _q.order...),
+		inters:             append([]Interceptor{}, _q.inters...),
+		predicates:         append([]predicate.DiscordUser{}, _q.predicates...),
+		withMessages:       _q.withMessages.Clone(),
+		withMentionedIn:    _q.withMentionedIn.Clone(),
+		withMemberships:    _q.withMemberships.Clone(),
+		withProfileChanges: _q.withProfileChanges.Clone(),
+		withDirectMessages: _q.withDirectMessages.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -293,6 +392,50 @@ func (_q *DiscordUserQuery) WithMessages(opts ...func(*DiscordMessageQuery)) *Di
 	return _q
 }
 
+// WithMentionedIn tells the query-builder to eager-load the nodes that are connected to
+// the "mentioned_in" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordUserQuery) WithMentionedIn(opts ...func(*DiscordMessageQuery)) *DiscordUserQuery {
+	query := (&DiscordMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMentionedIn = query
+	return _q
+}
+
+// WithMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordUserQuery) WithMemberships(opts ...func(*DiscordGuildMemberQuery)) *DiscordUserQuery {
+	query := (&DiscordGuildMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMemberships = query
+	return _q
+}
+
+// WithProfileChanges tells the query-builder to eager-load the nodes that are connected to
+// the "profile_changes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordUserQuery) WithProfileChanges(opts ...func(*DiscordProfileChangeQuery)) *DiscordUserQuery {
+	query := (&DiscordProfileChangeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withProfileChanges = query
+	return _q
+}
+
+// WithDirectMessages tells the query-builder to eager-load the nodes that are connected to
+// the "direct_messages" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *DiscordUserQuery) WithDirectMessages(opts ...func(*DiscordDirectMessageQuery)) *DiscordUserQuery {
+	query := (&DiscordDirectMessageClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDirectMessages = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -371,8 +514,12 @@ func (_q *DiscordUserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*DiscordUser{}
 		_spec       = _q.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [5]bool{
 			_q.withMessages != nil,
+			_q.withMentionedIn != nil,
+			_q.withMemberships != nil,
+			_q.withProfileChanges != nil,
+			_q.withDirectMessages != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -400,6 +547,38 @@ func (_q *DiscordUserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withMentionedIn; query != nil {
+		if err := _q.loadMentionedIn(ctx, query, nodes,
+			func(n *DiscordUser) { n.Edges.MentionedIn = []*DiscordMessage{} },
+			func(n *DiscordUser, e *DiscordMessage) { n.Edges.MentionedIn = append(n.Edges.MentionedIn, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMemberships; query != nil {
+		if err := _q.loadMemberships(ctx, query, nodes,
+			func(n *DiscordUser) { n.Edges.Memberships = []*DiscordGuildMember{} },
+			func(n *DiscordUser, e *DiscordGuildMember) { n.Edges.Memberships = append(n.Edges.Memberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withProfileChanges; query != nil {
+		if err := _q.loadProfileChanges(ctx, query, nodes,
+			func(n *DiscordUser) { n.Edges.ProfileChanges = []*DiscordProfileChange{} },
+			func(n *DiscordUser, e *DiscordProfileChange) {
+				n.Edges.ProfileChanges = append(n.Edges.ProfileChanges, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDirectMessages; query != nil {
+		if err := _q.loadDirectMessages(ctx, query, nodes,
+			func(n *DiscordUser) { n.Edges.DirectMessages = []*DiscordDirectMessage{} },
+			func(n *DiscordUser, e *DiscordDirectMessage) {
+				n.Edges.DirectMessages = append(n.Edges.DirectMessages, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -433,6 +612,157 @@ func (_q *DiscordUserQuery) loadMessages(ctx context.Context, query *DiscordMess
 	}
 	return nil
 }
+func (_q *DiscordUserQuery) loadMentionedIn(ctx context.Context, query *DiscordMessageQuery, nodes []*DiscordUser, init func(*DiscordUser), assign func(*DiscordUser, *DiscordMessage)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*DiscordUser)
+	nids := make(map[string]map[*DiscordUser]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(discorduser.MentionedInTable)
+		s.Join(joinT).On(s.C(discordmessage.FieldID), joinT.C(discorduser.MentionedInPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(discorduser.MentionedInPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(discorduser.MentionedInPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*DiscordUser]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*DiscordMessage](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "mentioned_in" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *DiscordUserQuery) loadMemberships(ctx context.Context, query *DiscordGuildMemberQuery, nodes []*DiscordUser, init func(*DiscordUser), assign func(*DiscordUser, *DiscordGuildMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*DiscordUser)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discordguildmember.FieldUserID)
+	}
+	query.Where(predicate.DiscordGuildMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discorduser.MembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DiscordUserQuery) loadProfileChanges(ctx context.Context, query *DiscordProfileChangeQuery, nodes []*DiscordUser, init func(*DiscordUser), assign func(*DiscordUser, *DiscordProfileChange)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*DiscordUser)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discordprofilechange.FieldUserID)
+	}
+	query.Where(predicate.DiscordProfileChange(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discorduser.ProfileChangesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *DiscordUserQuery) loadDirectMessages(ctx context.Context, query *DiscordDirectMessageQuery, nodes []*DiscordUser, init func(*DiscordUser), assign func(*DiscordUser, *DiscordDirectMessage)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*DiscordUser)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(discorddirectmessage.FieldUserID)
+	}
+	query.Where(predicate.DiscordDirectMessage(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(discorduser.DirectMessagesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *DiscordUserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
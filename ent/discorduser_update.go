@@ -6,7 +6,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordprofilechange"
 	"sev0/ent/discorduser"
 	"sev0/ent/predicate"
 
@@ -56,6 +59,54 @@ func (_u *DiscordUserUpdate) SetNillableGlobalName(v *string) *DiscordUserUpdate
 	return _u
 }
 
+// SetAvatar sets the "avatar" field.
+func (_u *DiscordUserUpdate) SetAvatar(v string) *DiscordUserUpdate {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_u *DiscordUserUpdate) SetNillableAvatar(v *string) *DiscordUserUpdate {
+	if v != nil {
+		_u.SetAvatar(*v)
+	}
+	return _u
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (_u *DiscordUserUpdate) ClearAvatar() *DiscordUserUpdate {
+	_u.mutation.ClearAvatar()
+	return _u
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (_u *DiscordUserUpdate) SetProfilingOptOut(v bool) *DiscordUserUpdate {
+	_u.mutation.SetProfilingOptOut(v)
+	return _u
+}
+
+// SetNillableProfilingOptOut sets the "profiling_opt_out" field if the given value is not nil.
+func (_u *DiscordUserUpdate) SetNillableProfilingOptOut(v *bool) *DiscordUserUpdate {
+	if v != nil {
+		_u.SetProfilingOptOut(*v)
+	}
+	return _u
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (_u *DiscordUserUpdate) SetDmEnabled(v bool) *DiscordUserUpdate {
+	_u.mutation.SetDmEnabled(v)
+	return _u
+}
+
+// SetNillableDmEnabled sets the "dm_enabled" field if the given value is not nil.
+func (_u *DiscordUserUpdate) SetNillableDmEnabled(v *bool) *DiscordUserUpdate {
+	if v != nil {
+		_u.SetDmEnabled(*v)
+	}
+	return _u
+}
+
 // AddMessageIDs adds the "messages" edge to the DiscordMessage entity by IDs.
 func (_u *DiscordUserUpdate) AddMessageIDs(ids ...string) *DiscordUserUpdate {
 	_u.mutation.AddMessageIDs(ids...)
@@ -71,6 +122,66 @@ func (_u *DiscordUserUpdate) AddMessages(v ...*DiscordMessage) *DiscordUserUpdat
 	return _u.AddMessageIDs(ids...)
 }
 
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (_u *DiscordUserUpdate) AddMentionedInIDs(ids ...string) *DiscordUserUpdate {
+	_u.mutation.AddMentionedInIDs(ids...)
+	return _u
+}
+
+// AddMentionedIn adds the "mentioned_in" edges to the DiscordMessage entity.
+func (_u *DiscordUserUpdate) AddMentionedIn(v ...*DiscordMessage) *DiscordUserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedInIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the DiscordGuildMember entity by IDs.
+func (_u *DiscordUserUpdate) AddMembershipIDs(ids ...int) *DiscordUserUpdate {
+	_u.mutation.AddMembershipIDs(ids...)
+	return _u
+}
+
+// AddMemberships adds the "memberships" edges to the DiscordGuildMember entity.
+func (_u *DiscordUserUpdate) AddMemberships(v ...*DiscordGuildMember) *DiscordUserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMembershipIDs(ids...)
+}
+
+// AddProfileChangeIDs adds the "profile_changes" edge to the DiscordProfileChange entity by IDs.
+func (_u *DiscordUserUpdate) AddProfileChangeIDs(ids ...int) *DiscordUserUpdate {
+	_u.mutation.AddProfileChangeIDs(ids...)
+	return _u
+}
+
+// AddProfileChanges adds the "profile_changes" edges to the DiscordProfileChange entity.
+func (_u *DiscordUserUpdate) AddProfileChanges(v ...*DiscordProfileChange) *DiscordUserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProfileChangeIDs(ids...)
+}
+
+// AddDirectMessageIDs adds the "direct_messages" edge to the DiscordDirectMessage entity by IDs.
+func (_u *DiscordUserUpdate) AddDirectMessageIDs(ids ...string) *DiscordUserUpdate {
+	_u.mutation.AddDirectMessageIDs(ids...)
+	return _u
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DiscordDirectMessage entity.
+func (_u *DiscordUserUpdate) AddDirectMessages(v ...*DiscordDirectMessage) *DiscordUserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDirectMessageIDs(ids...)
+}
+
 // Mutation returns the DiscordUserMutation object of the builder.
 func (_u *DiscordUserUpdate) Mutation() *DiscordUserMutation {
 	return _u.mutation
@@ -97,6 +208,90 @@ func (_u *DiscordUserUpdate) RemoveMessages(v ...*DiscordMessage) *DiscordUserUp
 	return _u.RemoveMessageIDs(ids...)
 }
 
+// ClearMentionedIn clears all "mentioned_in" edges to the DiscordMessage entity.
+func (_u *DiscordUserUpdate) ClearMentionedIn() *DiscordUserUpdate {
+	_u.mutation.ClearMentionedIn()
+	return _u
+}
+
+// RemoveMentionedInIDs removes the "mentioned_in" edge to DiscordMessage entities by IDs.
+func (_u *DiscordUserUpdate) RemoveMentionedInIDs(ids ...string) *DiscordUserUpdate {
+	_u.mutation.RemoveMentionedInIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedIn removes "mentioned_in" edges to DiscordMessage entities.
+func (_u *DiscordUserUpdate) RemoveMentionedIn(v ...*DiscordMessage) *DiscordUserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedInIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the DiscordGuildMember entity.
+func (_u *DiscordUserUpdate) ClearMemberships() *DiscordUserUpdate {
+	_u.mutation.ClearMemberships()
+	return _u
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to DiscordGuildMember entities by IDs.
+func (_u *DiscordUserUpdate) RemoveMembershipIDs(ids ...int) *DiscordUserUpdate {
+	_u.mutation.RemoveMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveMemberships removes "memberships" edges to DiscordGuildMember entities.
+func (_u *DiscordUserUpdate) RemoveMemberships(v ...*DiscordGuildMember) *DiscordUserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMembershipIDs(ids...)
+}
+
+// ClearProfileChanges clears all "profile_changes" edges to the DiscordProfileChange entity.
+func (_u *DiscordUserUpdate) ClearProfileChanges() *DiscordUserUpdate {
+	_u.mutation.ClearProfileChanges()
+	return _u
+}
+
+// RemoveProfileChangeIDs removes the "profile_changes" edge to DiscordProfileChange entities by IDs.
+func (_u *DiscordUserUpdate) RemoveProfileChangeIDs(ids ...int) *DiscordUserUpdate {
+	_u.mutation.RemoveProfileChangeIDs(ids...)
+	return _u
+}
+
+// RemoveProfileChanges removes "profile_changes" edges to DiscordProfileChange entities.
+func (_u *DiscordUserUpdate) RemoveProfileChanges(v ...*DiscordProfileChange) *DiscordUserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProfileChangeIDs(ids...)
+}
+
+// ClearDirectMessages clears all "direct_messages" edges to the DiscordDirectMessage entity.
+func (_u *DiscordUserUpdate) ClearDirectMessages() *DiscordUserUpdate {
+	_u.mutation.ClearDirectMessages()
+	return _u
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to DiscordDirectMessage entities by IDs.
+func (_u *DiscordUserUpdate) RemoveDirectMessageIDs(ids ...string) *DiscordUserUpdate {
+	_u.mutation.RemoveDirectMessageIDs(ids...)
+	return _u
+}
+
+// RemoveDirectMessages removes "direct_messages" edges to DiscordDirectMessage entities.
+func (_u *DiscordUserUpdate) RemoveDirectMessages(v ...*DiscordDirectMessage) *DiscordUserUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDirectMessageIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DiscordUserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -157,6 +352,18 @@ func (_u *DiscordUserUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.GlobalName(); ok {
 		_spec.SetField(discorduser.FieldGlobalName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Avatar(); ok {
+		_spec.SetField(discorduser.FieldAvatar, field.TypeString, value)
+	}
+	if _u.mutation.AvatarCleared() {
+		_spec.ClearField(discorduser.FieldAvatar, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProfilingOptOut(); ok {
+		_spec.SetField(discorduser.FieldProfilingOptOut, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DmEnabled(); ok {
+		_spec.SetField(discorduser.FieldDmEnabled, field.TypeBool, value)
+	}
 	if _u.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -202,6 +409,186 @@ func (_u *DiscordUserUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MentionedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedInIDs(); len(nodes) > 0 && !_u.mutation.MentionedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !_u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProfileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProfileChangesIDs(); len(nodes) > 0 && !_u.mutation.ProfileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDirectMessagesIDs(); len(nodes) > 0 && !_u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{discorduser.Label}
@@ -250,6 +637,54 @@ func (_u *DiscordUserUpdateOne) SetNillableGlobalName(v *string) *DiscordUserUpd
 	return _u
 }
 
+// SetAvatar sets the "avatar" field.
+func (_u *DiscordUserUpdateOne) SetAvatar(v string) *DiscordUserUpdateOne {
+	_u.mutation.SetAvatar(v)
+	return _u
+}
+
+// SetNillableAvatar sets the "avatar" field if the given value is not nil.
+func (_u *DiscordUserUpdateOne) SetNillableAvatar(v *string) *DiscordUserUpdateOne {
+	if v != nil {
+		_u.SetAvatar(*v)
+	}
+	return _u
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (_u *DiscordUserUpdateOne) ClearAvatar() *DiscordUserUpdateOne {
+	_u.mutation.ClearAvatar()
+	return _u
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (_u *DiscordUserUpdateOne) SetProfilingOptOut(v bool) *DiscordUserUpdateOne {
+	_u.mutation.SetProfilingOptOut(v)
+	return _u
+}
+
+// SetNillableProfilingOptOut sets the "profiling_opt_out" field if the given value is not nil.
+func (_u *DiscordUserUpdateOne) SetNillableProfilingOptOut(v *bool) *DiscordUserUpdateOne {
+	if v != nil {
+		_u.SetProfilingOptOut(*v)
+	}
+	return _u
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (_u *DiscordUserUpdateOne) SetDmEnabled(v bool) *DiscordUserUpdateOne {
+	_u.mutation.SetDmEnabled(v)
+	return _u
+}
+
+// SetNillableDmEnabled sets the "dm_enabled" field if the given value is not nil.
+func (_u *DiscordUserUpdateOne) SetNillableDmEnabled(v *bool) *DiscordUserUpdateOne {
+	if v != nil {
+		_u.SetDmEnabled(*v)
+	}
+	return _u
+}
+
 // AddMessageIDs adds the "messages" edge to the DiscordMessage entity by IDs.
 func (_u *DiscordUserUpdateOne) AddMessageIDs(ids ...string) *DiscordUserUpdateOne {
 	_u.mutation.AddMessageIDs(ids...)
@@ -265,6 +700,66 @@ func (_u *DiscordUserUpdateOne) AddMessages(v ...*DiscordMessage) *DiscordUserUp
 	return _u.AddMessageIDs(ids...)
 }
 
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (_u *DiscordUserUpdateOne) AddMentionedInIDs(ids ...string) *DiscordUserUpdateOne {
+	_u.mutation.AddMentionedInIDs(ids...)
+	return _u
+}
+
+// AddMentionedIn adds the "mentioned_in" edges to the DiscordMessage entity.
+func (_u *DiscordUserUpdateOne) AddMentionedIn(v ...*DiscordMessage) *DiscordUserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMentionedInIDs(ids...)
+}
+
+// AddMembershipIDs adds the "memberships" edge to the DiscordGuildMember entity by IDs.
+func (_u *DiscordUserUpdateOne) AddMembershipIDs(ids ...int) *DiscordUserUpdateOne {
+	_u.mutation.AddMembershipIDs(ids...)
+	return _u
+}
+
+// AddMemberships adds the "memberships" edges to the DiscordGuildMember entity.
+func (_u *DiscordUserUpdateOne) AddMemberships(v ...*DiscordGuildMember) *DiscordUserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMembershipIDs(ids...)
+}
+
+// AddProfileChangeIDs adds the "profile_changes" edge to the DiscordProfileChange entity by IDs.
+func (_u *DiscordUserUpdateOne) AddProfileChangeIDs(ids ...int) *DiscordUserUpdateOne {
+	_u.mutation.AddProfileChangeIDs(ids...)
+	return _u
+}
+
+// AddProfileChanges adds the "profile_changes" edges to the DiscordProfileChange entity.
+func (_u *DiscordUserUpdateOne) AddProfileChanges(v ...*DiscordProfileChange) *DiscordUserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddProfileChangeIDs(ids...)
+}
+
+// AddDirectMessageIDs adds the "direct_messages" edge to the DiscordDirectMessage entity by IDs.
+func (_u *DiscordUserUpdateOne) AddDirectMessageIDs(ids ...string) *DiscordUserUpdateOne {
+	_u.mutation.AddDirectMessageIDs(ids...)
+	return _u
+}
+
+// AddDirectMessages adds the "direct_messages" edges to the DiscordDirectMessage entity.
+func (_u *DiscordUserUpdateOne) AddDirectMessages(v ...*DiscordDirectMessage) *DiscordUserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDirectMessageIDs(ids...)
+}
+
 // Mutation returns the DiscordUserMutation object of the builder.
 func (_u *DiscordUserUpdateOne) Mutation() *DiscordUserMutation {
 	return _u.mutation
@@ -291,6 +786,90 @@ func (_u *DiscordUserUpdateOne) RemoveMessages(v ...*DiscordMessage) *DiscordUse
 	return _u.RemoveMessageIDs(ids...)
 }
 
+// ClearMentionedIn clears all "mentioned_in" edges to the DiscordMessage entity.
+func (_u *DiscordUserUpdateOne) ClearMentionedIn() *DiscordUserUpdateOne {
+	_u.mutation.ClearMentionedIn()
+	return _u
+}
+
+// RemoveMentionedInIDs removes the "mentioned_in" edge to DiscordMessage entities by IDs.
+func (_u *DiscordUserUpdateOne) RemoveMentionedInIDs(ids ...string) *DiscordUserUpdateOne {
+	_u.mutation.RemoveMentionedInIDs(ids...)
+	return _u
+}
+
+// RemoveMentionedIn removes "mentioned_in" edges to DiscordMessage entities.
+func (_u *DiscordUserUpdateOne) RemoveMentionedIn(v ...*DiscordMessage) *DiscordUserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMentionedInIDs(ids...)
+}
+
+// ClearMemberships clears all "memberships" edges to the DiscordGuildMember entity.
+func (_u *DiscordUserUpdateOne) ClearMemberships() *DiscordUserUpdateOne {
+	_u.mutation.ClearMemberships()
+	return _u
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to DiscordGuildMember entities by IDs.
+func (_u *DiscordUserUpdateOne) RemoveMembershipIDs(ids ...int) *DiscordUserUpdateOne {
+	_u.mutation.RemoveMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveMemberships removes "memberships" edges to DiscordGuildMember entities.
+func (_u *DiscordUserUpdateOne) RemoveMemberships(v ...*DiscordGuildMember) *DiscordUserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMembershipIDs(ids...)
+}
+
+// ClearProfileChanges clears all "profile_changes" edges to the DiscordProfileChange entity.
+func (_u *DiscordUserUpdateOne) ClearProfileChanges() *DiscordUserUpdateOne {
+	_u.mutation.ClearProfileChanges()
+	return _u
+}
+
+// RemoveProfileChangeIDs removes the "profile_changes" edge to DiscordProfileChange entities by IDs.
+func (_u *DiscordUserUpdateOne) RemoveProfileChangeIDs(ids ...int) *DiscordUserUpdateOne {
+	_u.mutation.RemoveProfileChangeIDs(ids...)
+	return _u
+}
+
+// RemoveProfileChanges removes "profile_changes" edges to DiscordProfileChange entities.
+func (_u *DiscordUserUpdateOne) RemoveProfileChanges(v ...*DiscordProfileChange) *DiscordUserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveProfileChangeIDs(ids...)
+}
+
+// ClearDirectMessages clears all "direct_messages" edges to the DiscordDirectMessage entity.
+func (_u *DiscordUserUpdateOne) ClearDirectMessages() *DiscordUserUpdateOne {
+	_u.mutation.ClearDirectMessages()
+	return _u
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to DiscordDirectMessage entities by IDs.
+func (_u *DiscordUserUpdateOne) RemoveDirectMessageIDs(ids ...string) *DiscordUserUpdateOne {
+	_u.mutation.RemoveDirectMessageIDs(ids...)
+	return _u
+}
+
+// RemoveDirectMessages removes "direct_messages" edges to DiscordDirectMessage entities.
+func (_u *DiscordUserUpdateOne) RemoveDirectMessages(v ...*DiscordDirectMessage) *DiscordUserUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDirectMessageIDs(ids...)
+}
+
 // Where appends a list predicates to the DiscordUserUpdate builder.
 func (_u *DiscordUserUpdateOne) Where(ps ...predicate.DiscordUser) *DiscordUserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -381,6 +960,18 @@ func (_u *DiscordUserUpdateOne) sqlSave(ctx context.Context) (_node *DiscordUser
 	if value, ok := _u.mutation.GlobalName(); ok {
 		_spec.SetField(discorduser.FieldGlobalName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Avatar(); ok {
+		_spec.SetField(discorduser.FieldAvatar, field.TypeString, value)
+	}
+	if _u.mutation.AvatarCleared() {
+		_spec.ClearField(discorduser.FieldAvatar, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProfilingOptOut(); ok {
+		_spec.SetField(discorduser.FieldProfilingOptOut, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.DmEnabled(); ok {
+		_spec.SetField(discorduser.FieldDmEnabled, field.TypeBool, value)
+	}
 	if _u.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -426,6 +1017,186 @@ func (_u *DiscordUserUpdateOne) sqlSave(ctx context.Context) (_node *DiscordUser
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.MentionedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMentionedInIDs(); len(nodes) > 0 && !_u.mutation.MentionedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MentionedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   discorduser.MentionedInTable,
+			Columns: discorduser.MentionedInPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMembershipsIDs(); len(nodes) > 0 && !_u.mutation.MembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.MembershipsTable,
+			Columns: []string{discorduser.MembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordguildmember.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ProfileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedProfileChangesIDs(); len(nodes) > 0 && !_u.mutation.ProfileChangesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProfileChangesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.ProfileChangesTable,
+			Columns: []string{discorduser.ProfileChangesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discordprofilechange.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDirectMessagesIDs(); len(nodes) > 0 && !_u.mutation.DirectMessagesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DirectMessagesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   discorduser.DirectMessagesTable,
+			Columns: []string{discorduser.DirectMessagesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(discorddirectmessage.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &DiscordUser{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package embeddingmodel
+
+import (
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldContainsFold(FieldID, id))
+}
+
+// Dimensions applies equality check predicate on the "dimensions" field. It's identical to DimensionsEQ.
+func Dimensions(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldDimensions, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldActive, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReadyAt applies equality check predicate on the "ready_at" field. It's identical to ReadyAtEQ.
+func ReadyAt(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldReadyAt, v))
+}
+
+// DimensionsEQ applies the EQ predicate on the "dimensions" field.
+func DimensionsEQ(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldDimensions, v))
+}
+
+// DimensionsNEQ applies the NEQ predicate on the "dimensions" field.
+func DimensionsNEQ(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNEQ(FieldDimensions, v))
+}
+
+// DimensionsIn applies the In predicate on the "dimensions" field.
+func DimensionsIn(vs ...int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldIn(FieldDimensions, vs...))
+}
+
+// DimensionsNotIn applies the NotIn predicate on the "dimensions" field.
+func DimensionsNotIn(vs ...int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNotIn(FieldDimensions, vs...))
+}
+
+// DimensionsGT applies the GT predicate on the "dimensions" field.
+func DimensionsGT(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGT(FieldDimensions, v))
+}
+
+// DimensionsGTE applies the GTE predicate on the "dimensions" field.
+func DimensionsGTE(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGTE(FieldDimensions, v))
+}
+
+// DimensionsLT applies the LT predicate on the "dimensions" field.
+func DimensionsLT(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLT(FieldDimensions, v))
+}
+
+// DimensionsLTE applies the LTE predicate on the "dimensions" field.
+func DimensionsLTE(v int) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLTE(FieldDimensions, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNEQ(FieldActive, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReadyAtEQ applies the EQ predicate on the "ready_at" field.
+func ReadyAtEQ(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldEQ(FieldReadyAt, v))
+}
+
+// ReadyAtNEQ applies the NEQ predicate on the "ready_at" field.
+func ReadyAtNEQ(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNEQ(FieldReadyAt, v))
+}
+
+// ReadyAtIn applies the In predicate on the "ready_at" field.
+func ReadyAtIn(vs ...time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldIn(FieldReadyAt, vs...))
+}
+
+// ReadyAtNotIn applies the NotIn predicate on the "ready_at" field.
+func ReadyAtNotIn(vs ...time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNotIn(FieldReadyAt, vs...))
+}
+
+// ReadyAtGT applies the GT predicate on the "ready_at" field.
+func ReadyAtGT(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGT(FieldReadyAt, v))
+}
+
+// ReadyAtGTE applies the GTE predicate on the "ready_at" field.
+func ReadyAtGTE(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldGTE(FieldReadyAt, v))
+}
+
+// ReadyAtLT applies the LT predicate on the "ready_at" field.
+func ReadyAtLT(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLT(FieldReadyAt, v))
+}
+
+// ReadyAtLTE applies the LTE predicate on the "ready_at" field.
+func ReadyAtLTE(v time.Time) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldLTE(FieldReadyAt, v))
+}
+
+// ReadyAtIsNil applies the IsNil predicate on the "ready_at" field.
+func ReadyAtIsNil() predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldIsNull(FieldReadyAt))
+}
+
+// ReadyAtNotNil applies the NotNil predicate on the "ready_at" field.
+func ReadyAtNotNil() predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.FieldNotNull(FieldReadyAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EmbeddingModel) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EmbeddingModel) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EmbeddingModel) predicate.EmbeddingModel {
+	return predicate.EmbeddingModel(sql.NotPredicates(p))
+}
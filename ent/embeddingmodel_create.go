@@ -0,0 +1,646 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/embeddingmodel"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmbeddingModelCreate is the builder for creating a EmbeddingModel entity.
+type EmbeddingModelCreate struct {
+	config
+	mutation *EmbeddingModelMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDimensions sets the "dimensions" field.
+func (_c *EmbeddingModelCreate) SetDimensions(v int) *EmbeddingModelCreate {
+	_c.mutation.SetDimensions(v)
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *EmbeddingModelCreate) SetActive(v bool) *EmbeddingModelCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *EmbeddingModelCreate) SetNillableActive(v *bool) *EmbeddingModelCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EmbeddingModelCreate) SetCreatedAt(v time.Time) *EmbeddingModelCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EmbeddingModelCreate) SetNillableCreatedAt(v *time.Time) *EmbeddingModelCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (_c *EmbeddingModelCreate) SetReadyAt(v time.Time) *EmbeddingModelCreate {
+	_c.mutation.SetReadyAt(v)
+	return _c
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (_c *EmbeddingModelCreate) SetNillableReadyAt(v *time.Time) *EmbeddingModelCreate {
+	if v != nil {
+		_c.SetReadyAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EmbeddingModelCreate) SetID(v string) *EmbeddingModelCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// Mutation returns the EmbeddingModelMutation object of the builder.
+func (_c *EmbeddingModelCreate) Mutation() *EmbeddingModelMutation {
+	return _c.mutation
+}
+
+// Save creates the EmbeddingModel in the database.
+func (_c *EmbeddingModelCreate) Save(ctx context.Context) (*EmbeddingModel, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EmbeddingModelCreate) SaveX(ctx context.Context) *EmbeddingModel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmbeddingModelCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmbeddingModelCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EmbeddingModelCreate) defaults() {
+	if _, ok := _c.mutation.Active(); !ok {
+		v := embeddingmodel.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := embeddingmodel.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EmbeddingModelCreate) check() error {
+	if _, ok := _c.mutation.Dimensions(); !ok {
+		return &ValidationError{Name: "dimensions", err: errors.New(`ent: missing required field "EmbeddingModel.dimensions"`)}
+	}
+	if v, ok := _c.mutation.Dimensions(); ok {
+		if err := embeddingmodel.DimensionsValidator(v); err != nil {
+			return &ValidationError{Name: "dimensions", err: fmt.Errorf(`ent: validator failed for field "EmbeddingModel.dimensions": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "EmbeddingModel.active"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EmbeddingModel.created_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := embeddingmodel.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "EmbeddingModel.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EmbeddingModelCreate) sqlSave(ctx context.Context) (*EmbeddingModel, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EmbeddingModel.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EmbeddingModelCreate) createSpec() (*EmbeddingModel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EmbeddingModel{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(embeddingmodel.Table, sqlgraph.NewFieldSpec(embeddingmodel.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.Dimensions(); ok {
+		_spec.SetField(embeddingmodel.FieldDimensions, field.TypeInt, value)
+		_node.Dimensions = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(embeddingmodel.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(embeddingmodel.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReadyAt(); ok {
+		_spec.SetField(embeddingmodel.FieldReadyAt, field.TypeTime, value)
+		_node.ReadyAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmbeddingModel.Create().
+//		SetDimensions(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmbeddingModelUpsert) {
+//			SetDimensions(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmbeddingModelCreate) OnConflict(opts ...sql.ConflictOption) *EmbeddingModelUpsertOne {
+	_c.conflict = opts
+	return &EmbeddingModelUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmbeddingModel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmbeddingModelCreate) OnConflictColumns(columns ...string) *EmbeddingModelUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmbeddingModelUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EmbeddingModelUpsertOne is the builder for "upsert"-ing
+	//  one EmbeddingModel node.
+	EmbeddingModelUpsertOne struct {
+		create *EmbeddingModelCreate
+	}
+
+	// EmbeddingModelUpsert is the "OnConflict" setter.
+	EmbeddingModelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetActive sets the "active" field.
+func (u *EmbeddingModelUpsert) SetActive(v bool) *EmbeddingModelUpsert {
+	u.Set(embeddingmodel.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *EmbeddingModelUpsert) UpdateActive() *EmbeddingModelUpsert {
+	u.SetExcluded(embeddingmodel.FieldActive)
+	return u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *EmbeddingModelUpsert) SetReadyAt(v time.Time) *EmbeddingModelUpsert {
+	u.Set(embeddingmodel.FieldReadyAt, v)
+	return u
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *EmbeddingModelUpsert) UpdateReadyAt() *EmbeddingModelUpsert {
+	u.SetExcluded(embeddingmodel.FieldReadyAt)
+	return u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *EmbeddingModelUpsert) ClearReadyAt() *EmbeddingModelUpsert {
+	u.SetNull(embeddingmodel.FieldReadyAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingModel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(embeddingmodel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmbeddingModelUpsertOne) UpdateNewValues() *EmbeddingModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(embeddingmodel.FieldID)
+		}
+		if _, exists := u.create.mutation.Dimensions(); exists {
+			s.SetIgnore(embeddingmodel.FieldDimensions)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(embeddingmodel.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingModel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EmbeddingModelUpsertOne) Ignore() *EmbeddingModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmbeddingModelUpsertOne) DoNothing() *EmbeddingModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmbeddingModelCreate.OnConflict
+// documentation for more info.
+func (u *EmbeddingModelUpsertOne) Update(set func(*EmbeddingModelUpsert)) *EmbeddingModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmbeddingModelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *EmbeddingModelUpsertOne) SetActive(v bool) *EmbeddingModelUpsertOne {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *EmbeddingModelUpsertOne) UpdateActive() *EmbeddingModelUpsertOne {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *EmbeddingModelUpsertOne) SetReadyAt(v time.Time) *EmbeddingModelUpsertOne {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.SetReadyAt(v)
+	})
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *EmbeddingModelUpsertOne) UpdateReadyAt() *EmbeddingModelUpsertOne {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.UpdateReadyAt()
+	})
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *EmbeddingModelUpsertOne) ClearReadyAt() *EmbeddingModelUpsertOne {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.ClearReadyAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmbeddingModelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmbeddingModelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmbeddingModelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EmbeddingModelUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EmbeddingModelUpsertOne.ID is not supported by MySQL driver. Use EmbeddingModelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EmbeddingModelUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EmbeddingModelCreateBulk is the builder for creating many EmbeddingModel entities in bulk.
+type EmbeddingModelCreateBulk struct {
+	config
+	err      error
+	builders []*EmbeddingModelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EmbeddingModel entities in the database.
+func (_c *EmbeddingModelCreateBulk) Save(ctx context.Context) ([]*EmbeddingModel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EmbeddingModel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EmbeddingModelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EmbeddingModelCreateBulk) SaveX(ctx context.Context) []*EmbeddingModel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EmbeddingModelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EmbeddingModelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EmbeddingModel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EmbeddingModelUpsert) {
+//			SetDimensions(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EmbeddingModelCreateBulk) OnConflict(opts ...sql.ConflictOption) *EmbeddingModelUpsertBulk {
+	_c.conflict = opts
+	return &EmbeddingModelUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EmbeddingModel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EmbeddingModelCreateBulk) OnConflictColumns(columns ...string) *EmbeddingModelUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EmbeddingModelUpsertBulk{
+		create: _c,
+	}
+}
+
+// EmbeddingModelUpsertBulk is the builder for "upsert"-ing
+// a bulk of EmbeddingModel nodes.
+type EmbeddingModelUpsertBulk struct {
+	create *EmbeddingModelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EmbeddingModel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(embeddingmodel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EmbeddingModelUpsertBulk) UpdateNewValues() *EmbeddingModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(embeddingmodel.FieldID)
+			}
+			if _, exists := b.mutation.Dimensions(); exists {
+				s.SetIgnore(embeddingmodel.FieldDimensions)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(embeddingmodel.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EmbeddingModel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EmbeddingModelUpsertBulk) Ignore() *EmbeddingModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EmbeddingModelUpsertBulk) DoNothing() *EmbeddingModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EmbeddingModelCreateBulk.OnConflict
+// documentation for more info.
+func (u *EmbeddingModelUpsertBulk) Update(set func(*EmbeddingModelUpsert)) *EmbeddingModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EmbeddingModelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *EmbeddingModelUpsertBulk) SetActive(v bool) *EmbeddingModelUpsertBulk {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *EmbeddingModelUpsertBulk) UpdateActive() *EmbeddingModelUpsertBulk {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (u *EmbeddingModelUpsertBulk) SetReadyAt(v time.Time) *EmbeddingModelUpsertBulk {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.SetReadyAt(v)
+	})
+}
+
+// UpdateReadyAt sets the "ready_at" field to the value that was provided on create.
+func (u *EmbeddingModelUpsertBulk) UpdateReadyAt() *EmbeddingModelUpsertBulk {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.UpdateReadyAt()
+	})
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (u *EmbeddingModelUpsertBulk) ClearReadyAt() *EmbeddingModelUpsertBulk {
+	return u.Update(func(s *EmbeddingModelUpsert) {
+		s.ClearReadyAt()
+	})
+}
+
+// Exec executes the query.
+func (u *EmbeddingModelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EmbeddingModelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EmbeddingModelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EmbeddingModelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,262 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/embeddingmodel"
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// EmbeddingModelUpdate is the builder for updating EmbeddingModel entities.
+type EmbeddingModelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EmbeddingModelMutation
+}
+
+// Where appends a list predicates to the EmbeddingModelUpdate builder.
+func (_u *EmbeddingModelUpdate) Where(ps ...predicate.EmbeddingModel) *EmbeddingModelUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *EmbeddingModelUpdate) SetActive(v bool) *EmbeddingModelUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *EmbeddingModelUpdate) SetNillableActive(v *bool) *EmbeddingModelUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (_u *EmbeddingModelUpdate) SetReadyAt(v time.Time) *EmbeddingModelUpdate {
+	_u.mutation.SetReadyAt(v)
+	return _u
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (_u *EmbeddingModelUpdate) SetNillableReadyAt(v *time.Time) *EmbeddingModelUpdate {
+	if v != nil {
+		_u.SetReadyAt(*v)
+	}
+	return _u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (_u *EmbeddingModelUpdate) ClearReadyAt() *EmbeddingModelUpdate {
+	_u.mutation.ClearReadyAt()
+	return _u
+}
+
+// Mutation returns the EmbeddingModelMutation object of the builder.
+func (_u *EmbeddingModelUpdate) Mutation() *EmbeddingModelMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EmbeddingModelUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingModelUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EmbeddingModelUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingModelUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EmbeddingModelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingmodel.Table, embeddingmodel.Columns, sqlgraph.NewFieldSpec(embeddingmodel.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(embeddingmodel.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReadyAt(); ok {
+		_spec.SetField(embeddingmodel.FieldReadyAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadyAtCleared() {
+		_spec.ClearField(embeddingmodel.FieldReadyAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingmodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EmbeddingModelUpdateOne is the builder for updating a single EmbeddingModel entity.
+type EmbeddingModelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EmbeddingModelMutation
+}
+
+// SetActive sets the "active" field.
+func (_u *EmbeddingModelUpdateOne) SetActive(v bool) *EmbeddingModelUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *EmbeddingModelUpdateOne) SetNillableActive(v *bool) *EmbeddingModelUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (_u *EmbeddingModelUpdateOne) SetReadyAt(v time.Time) *EmbeddingModelUpdateOne {
+	_u.mutation.SetReadyAt(v)
+	return _u
+}
+
+// SetNillableReadyAt sets the "ready_at" field if the given value is not nil.
+func (_u *EmbeddingModelUpdateOne) SetNillableReadyAt(v *time.Time) *EmbeddingModelUpdateOne {
+	if v != nil {
+		_u.SetReadyAt(*v)
+	}
+	return _u
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (_u *EmbeddingModelUpdateOne) ClearReadyAt() *EmbeddingModelUpdateOne {
+	_u.mutation.ClearReadyAt()
+	return _u
+}
+
+// Mutation returns the EmbeddingModelMutation object of the builder.
+func (_u *EmbeddingModelUpdateOne) Mutation() *EmbeddingModelMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EmbeddingModelUpdate builder.
+func (_u *EmbeddingModelUpdateOne) Where(ps ...predicate.EmbeddingModel) *EmbeddingModelUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EmbeddingModelUpdateOne) Select(field string, fields ...string) *EmbeddingModelUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EmbeddingModel entity.
+func (_u *EmbeddingModelUpdateOne) Save(ctx context.Context) (*EmbeddingModel, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EmbeddingModelUpdateOne) SaveX(ctx context.Context) *EmbeddingModel {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EmbeddingModelUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EmbeddingModelUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EmbeddingModelUpdateOne) sqlSave(ctx context.Context) (_node *EmbeddingModel, err error) {
+	_spec := sqlgraph.NewUpdateSpec(embeddingmodel.Table, embeddingmodel.Columns, sqlgraph.NewFieldSpec(embeddingmodel.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EmbeddingModel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, embeddingmodel.FieldID)
+		for _, f := range fields {
+			if !embeddingmodel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != embeddingmodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(embeddingmodel.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReadyAt(); ok {
+		_spec.SetField(embeddingmodel.FieldReadyAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReadyAtCleared() {
+		_spec.ClearField(embeddingmodel.FieldReadyAt, field.TypeTime)
+	}
+	_node = &EmbeddingModel{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{embeddingmodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
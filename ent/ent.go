@@ -7,9 +7,24 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/discordchimein"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordguildsetting"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
 	"sev0/ent/discorduser"
+	"sev0/ent/embeddingmodel"
+	"sev0/ent/moderationevent"
+	"sev0/ent/privacyevent"
+	"sev0/ent/privacyoptout"
 	"sync"
 
 	"entgo.io/ent"
@@ -75,9 +90,24 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			discordchannel.Table:          discordchannel.ValidColumn,
+			discordchannelsetting.Table:   discordchannelsetting.ValidColumn,
+			discordchimein.Table:          discordchimein.ValidColumn,
+			discordchunkembedding.Table:   discordchunkembedding.ValidColumn,
+			discorddirectmessage.Table:    discorddirectmessage.ValidColumn,
+			discordguildmember.Table:      discordguildmember.ValidColumn,
+			discordguildsetting.Table:     discordguildsetting.ValidColumn,
 			discordmessage.Table:          discordmessage.ValidColumn,
+			discordmessagechunk.Table:     discordmessagechunk.ValidColumn,
 			discordmessageembedding.Table: discordmessageembedding.ValidColumn,
+			discordprofilechange.Table:    discordprofilechange.ValidColumn,
+			discordreaction.Table:         discordreaction.ValidColumn,
+			discordrole.Table:             discordrole.ValidColumn,
 			discorduser.Table:             discorduser.ValidColumn,
+			embeddingmodel.Table:          embeddingmodel.ValidColumn,
+			moderationevent.Table:         moderationevent.ValidColumn,
+			privacyevent.Table:            privacyevent.ValidColumn,
+			privacyoptout.Table:           privacyoptout.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -8,6 +8,90 @@ import (
 	"sev0/ent"
 )
 
+// The DiscordChannelFunc type is an adapter to allow the use of ordinary
+// function as DiscordChannel mutator.
+type DiscordChannelFunc func(context.Context, *ent.DiscordChannelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordChannelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordChannelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordChannelMutation", m)
+}
+
+// The DiscordChannelSettingFunc type is an adapter to allow the use of ordinary
+// function as DiscordChannelSetting mutator.
+type DiscordChannelSettingFunc func(context.Context, *ent.DiscordChannelSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordChannelSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordChannelSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordChannelSettingMutation", m)
+}
+
+// The DiscordChimeInFunc type is an adapter to allow the use of ordinary
+// function as DiscordChimeIn mutator.
+type DiscordChimeInFunc func(context.Context, *ent.DiscordChimeInMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordChimeInFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordChimeInMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordChimeInMutation", m)
+}
+
+// The DiscordChunkEmbeddingFunc type is an adapter to allow the use of ordinary
+// function as DiscordChunkEmbedding mutator.
+type DiscordChunkEmbeddingFunc func(context.Context, *ent.DiscordChunkEmbeddingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordChunkEmbeddingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordChunkEmbeddingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordChunkEmbeddingMutation", m)
+}
+
+// The DiscordDirectMessageFunc type is an adapter to allow the use of ordinary
+// function as DiscordDirectMessage mutator.
+type DiscordDirectMessageFunc func(context.Context, *ent.DiscordDirectMessageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordDirectMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordDirectMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordDirectMessageMutation", m)
+}
+
+// The DiscordGuildMemberFunc type is an adapter to allow the use of ordinary
+// function as DiscordGuildMember mutator.
+type DiscordGuildMemberFunc func(context.Context, *ent.DiscordGuildMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordGuildMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordGuildMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordGuildMemberMutation", m)
+}
+
+// The DiscordGuildSettingFunc type is an adapter to allow the use of ordinary
+// function as DiscordGuildSetting mutator.
+type DiscordGuildSettingFunc func(context.Context, *ent.DiscordGuildSettingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordGuildSettingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordGuildSettingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordGuildSettingMutation", m)
+}
+
 // The DiscordMessageFunc type is an adapter to allow the use of ordinary
 // function as DiscordMessage mutator.
 type DiscordMessageFunc func(context.Context, *ent.DiscordMessageMutation) (ent.Value, error)
@@ -20,6 +104,18 @@ func (f DiscordMessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordMessageMutation", m)
 }
 
+// The DiscordMessageChunkFunc type is an adapter to allow the use of ordinary
+// function as DiscordMessageChunk mutator.
+type DiscordMessageChunkFunc func(context.Context, *ent.DiscordMessageChunkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordMessageChunkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordMessageChunkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordMessageChunkMutation", m)
+}
+
 // The DiscordMessageEmbeddingFunc type is an adapter to allow the use of ordinary
 // function as DiscordMessageEmbedding mutator.
 type DiscordMessageEmbeddingFunc func(context.Context, *ent.DiscordMessageEmbeddingMutation) (ent.Value, error)
@@ -32,6 +128,42 @@ func (f DiscordMessageEmbeddingFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordMessageEmbeddingMutation", m)
 }
 
+// The DiscordProfileChangeFunc type is an adapter to allow the use of ordinary
+// function as DiscordProfileChange mutator.
+type DiscordProfileChangeFunc func(context.Context, *ent.DiscordProfileChangeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordProfileChangeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordProfileChangeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordProfileChangeMutation", m)
+}
+
+// The DiscordReactionFunc type is an adapter to allow the use of ordinary
+// function as DiscordReaction mutator.
+type DiscordReactionFunc func(context.Context, *ent.DiscordReactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordReactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordReactionMutation", m)
+}
+
+// The DiscordRoleFunc type is an adapter to allow the use of ordinary
+// function as DiscordRole mutator.
+type DiscordRoleFunc func(context.Context, *ent.DiscordRoleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DiscordRoleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DiscordRoleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordRoleMutation", m)
+}
+
 // The DiscordUserFunc type is an adapter to allow the use of ordinary
 // function as DiscordUser mutator.
 type DiscordUserFunc func(context.Context, *ent.DiscordUserMutation) (ent.Value, error)
@@ -44,6 +176,54 @@ func (f DiscordUserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DiscordUserMutation", m)
 }
 
+// The EmbeddingModelFunc type is an adapter to allow the use of ordinary
+// function as EmbeddingModel mutator.
+type EmbeddingModelFunc func(context.Context, *ent.EmbeddingModelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EmbeddingModelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EmbeddingModelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmbeddingModelMutation", m)
+}
+
+// The ModerationEventFunc type is an adapter to allow the use of ordinary
+// function as ModerationEvent mutator.
+type ModerationEventFunc func(context.Context, *ent.ModerationEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ModerationEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ModerationEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ModerationEventMutation", m)
+}
+
+// The PrivacyEventFunc type is an adapter to allow the use of ordinary
+// function as PrivacyEvent mutator.
+type PrivacyEventFunc func(context.Context, *ent.PrivacyEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PrivacyEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PrivacyEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PrivacyEventMutation", m)
+}
+
+// The PrivacyOptOutFunc type is an adapter to allow the use of ordinary
+// function as PrivacyOptOut mutator.
+type PrivacyOptOutFunc func(context.Context, *ent.PrivacyOptOutMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PrivacyOptOutFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PrivacyOptOutMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PrivacyOptOutMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
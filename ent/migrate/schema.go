@@ -9,12 +9,180 @@ import (
 )
 
 var (
+	// DiscordChannelsColumns holds the columns for the "discord_channels" table.
+	DiscordChannelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
+	}
+	// DiscordChannelsTable holds the schema information for the "discord_channels" table.
+	DiscordChannelsTable = &schema.Table{
+		Name:       "discord_channels",
+		Columns:    DiscordChannelsColumns,
+		PrimaryKey: []*schema.Column{DiscordChannelsColumns[0]},
+	}
+	// DiscordChannelSettingsColumns holds the columns for the "discord_channel_settings" table.
+	DiscordChannelSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "mention_replies", Type: field.TypeBool, Default: true},
+		{Name: "chime_in", Type: field.TypeBool, Default: false},
+	}
+	// DiscordChannelSettingsTable holds the schema information for the "discord_channel_settings" table.
+	DiscordChannelSettingsTable = &schema.Table{
+		Name:       "discord_channel_settings",
+		Columns:    DiscordChannelSettingsColumns,
+		PrimaryKey: []*schema.Column{DiscordChannelSettingsColumns[0]},
+	}
+	// DiscordChimeInsColumns holds the columns for the "discord_chime_ins" table.
+	DiscordChimeInsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "channel_id", Type: field.TypeString},
+		{Name: "trigger_message_id", Type: field.TypeString},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "reactions", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// DiscordChimeInsTable holds the schema information for the "discord_chime_ins" table.
+	DiscordChimeInsTable = &schema.Table{
+		Name:       "discord_chime_ins",
+		Columns:    DiscordChimeInsColumns,
+		PrimaryKey: []*schema.Column{DiscordChimeInsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordchimein_guild_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordChimeInsColumns[1], DiscordChimeInsColumns[7]},
+			},
+		},
+	}
+	// DiscordChunkEmbeddingsColumns holds the columns for the "discord_chunk_embeddings" table.
+	DiscordChunkEmbeddingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString},
+		{Name: "embedding", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "vector"}},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "chunk_id", Type: field.TypeString},
+	}
+	// DiscordChunkEmbeddingsTable holds the schema information for the "discord_chunk_embeddings" table.
+	DiscordChunkEmbeddingsTable = &schema.Table{
+		Name:       "discord_chunk_embeddings",
+		Columns:    DiscordChunkEmbeddingsColumns,
+		PrimaryKey: []*schema.Column{DiscordChunkEmbeddingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_chunk_embeddings_discord_message_chunks_embeddings",
+				Columns:    []*schema.Column{DiscordChunkEmbeddingsColumns[4]},
+				RefColumns: []*schema.Column{DiscordMessageChunksColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordchunkembedding_chunk_id_model",
+				Unique:  true,
+				Columns: []*schema.Column{DiscordChunkEmbeddingsColumns[4], DiscordChunkEmbeddingsColumns[1]},
+			},
+		},
+	}
+	// DiscordDirectMessagesColumns holds the columns for the "discord_direct_messages" table.
+	DiscordDirectMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "channel_id", Type: field.TypeString},
+		{Name: "from_bot", Type: field.TypeBool, Default: false},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// DiscordDirectMessagesTable holds the schema information for the "discord_direct_messages" table.
+	DiscordDirectMessagesTable = &schema.Table{
+		Name:       "discord_direct_messages",
+		Columns:    DiscordDirectMessagesColumns,
+		PrimaryKey: []*schema.Column{DiscordDirectMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_direct_messages_discord_users_direct_messages",
+				Columns:    []*schema.Column{DiscordDirectMessagesColumns[5]},
+				RefColumns: []*schema.Column{DiscordUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discorddirectmessage_user_id_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordDirectMessagesColumns[5], DiscordDirectMessagesColumns[4]},
+			},
+		},
+	}
+	// DiscordGuildMembersColumns holds the columns for the "discord_guild_members" table.
+	DiscordGuildMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "nickname", Type: field.TypeString, Nullable: true},
+		{Name: "roles", Type: field.TypeJSON, Nullable: true},
+		{Name: "joined_at", Type: field.TypeTime, Nullable: true},
+		{Name: "left_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// DiscordGuildMembersTable holds the schema information for the "discord_guild_members" table.
+	DiscordGuildMembersTable = &schema.Table{
+		Name:       "discord_guild_members",
+		Columns:    DiscordGuildMembersColumns,
+		PrimaryKey: []*schema.Column{DiscordGuildMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_guild_members_discord_users_memberships",
+				Columns:    []*schema.Column{DiscordGuildMembersColumns[6]},
+				RefColumns: []*schema.Column{DiscordUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordguildmember_guild_id_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{DiscordGuildMembersColumns[1], DiscordGuildMembersColumns[6]},
+			},
+		},
+	}
+	// DiscordGuildSettingsColumns holds the columns for the "discord_guild_settings" table.
+	DiscordGuildSettingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "chime_in", Type: field.TypeBool, Default: false},
+		{Name: "chime_in_probability", Type: field.TypeFloat64, Default: 0.2},
+		{Name: "chime_in_threshold", Type: field.TypeFloat64, Default: 0.75},
+		{Name: "chime_in_cooldown", Type: field.TypeInt, Default: 1800},
+		{Name: "moderation_denylist", Type: field.TypeJSON, Nullable: true},
+		{Name: "moderation_denylist_action", Type: field.TypeEnum, Enums: []string{"mask", "block"}, Default: "mask"},
+		{Name: "moderation_redact", Type: field.TypeBool, Default: true},
+		{Name: "moderation_classifier", Type: field.TypeBool, Default: false},
+		{Name: "moderation_flag_threshold", Type: field.TypeFloat64, Default: 0.5},
+		{Name: "moderation_block_threshold", Type: field.TypeFloat64, Default: 0.8},
+		{Name: "ingest_allowlist", Type: field.TypeJSON, Nullable: true},
+		{Name: "ingest_denylist", Type: field.TypeJSON, Nullable: true},
+		{Name: "retrieval_denylist", Type: field.TypeJSON, Nullable: true},
+		{Name: "restricted_channels", Type: field.TypeJSON, Nullable: true},
+	}
+	// DiscordGuildSettingsTable holds the schema information for the "discord_guild_settings" table.
+	DiscordGuildSettingsTable = &schema.Table{
+		Name:       "discord_guild_settings",
+		Columns:    DiscordGuildSettingsColumns,
+		PrimaryKey: []*schema.Column{DiscordGuildSettingsColumns[0]},
+	}
 	// DiscordMessagesColumns holds the columns for the "discord_messages" table.
 	DiscordMessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "guild_id", Type: field.TypeString, Nullable: true},
+		{Name: "channel_id", Type: field.TypeString, Nullable: true},
 		{Name: "timestamp", Type: field.TypeTime},
 		{Name: "edited_timestamp", Type: field.TypeTime, Nullable: true},
+		{Name: "suspected_injection", Type: field.TypeBool, Default: false},
+		{Name: "reply_to_id", Type: field.TypeString, Nullable: true},
 		{Name: "author_id", Type: field.TypeString},
 	}
 	// DiscordMessagesTable holds the schema information for the "discord_messages" table.
@@ -23,9 +191,15 @@ var (
 		Columns:    DiscordMessagesColumns,
 		PrimaryKey: []*schema.Column{DiscordMessagesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_messages_discord_messages_replies",
+				Columns:    []*schema.Column{DiscordMessagesColumns[7]},
+				RefColumns: []*schema.Column{DiscordMessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "discord_messages_discord_users_messages",
-				Columns:    []*schema.Column{DiscordMessagesColumns[4]},
+				Columns:    []*schema.Column{DiscordMessagesColumns[8]},
 				RefColumns: []*schema.Column{DiscordUsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -34,13 +208,50 @@ var (
 			{
 				Name:    "discordmessage_timestamp",
 				Unique:  false,
-				Columns: []*schema.Column{DiscordMessagesColumns[2]},
+				Columns: []*schema.Column{DiscordMessagesColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					DescColumns: map[string]bool{
+						DiscordMessagesColumns[4].Name: true,
+					},
+				},
+			},
+			{
+				Name:    "discordmessage_channel_id_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordMessagesColumns[3], DiscordMessagesColumns[4]},
 				Annotation: &entsql.IndexAnnotation{
 					DescColumns: map[string]bool{
-						DiscordMessagesColumns[2].Name: true,
+						DiscordMessagesColumns[4].Name: true,
 					},
 				},
 			},
+			{
+				Name:    "discordmessage_guild_id_channel_id",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordMessagesColumns[2], DiscordMessagesColumns[3]},
+			},
+		},
+	}
+	// DiscordMessageChunksColumns holds the columns for the "discord_message_chunks" table.
+	DiscordMessageChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "channel_id", Type: field.TypeString},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+	}
+	// DiscordMessageChunksTable holds the schema information for the "discord_message_chunks" table.
+	DiscordMessageChunksTable = &schema.Table{
+		Name:       "discord_message_chunks",
+		Columns:    DiscordMessageChunksColumns,
+		PrimaryKey: []*schema.Column{DiscordMessageChunksColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordmessagechunk_channel_id_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordMessageChunksColumns[2], DiscordMessageChunksColumns[3]},
+			},
 		},
 	}
 	// DiscordMessageEmbeddingsColumns holds the columns for the "discord_message_embeddings" table.
@@ -72,11 +283,85 @@ var (
 			},
 		},
 	}
+	// DiscordProfileChangesColumns holds the columns for the "discord_profile_changes" table.
+	DiscordProfileChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString, Nullable: true},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"username", "global_name", "nickname", "avatar"}},
+		{Name: "value", Type: field.TypeString},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString},
+	}
+	// DiscordProfileChangesTable holds the schema information for the "discord_profile_changes" table.
+	DiscordProfileChangesTable = &schema.Table{
+		Name:       "discord_profile_changes",
+		Columns:    DiscordProfileChangesColumns,
+		PrimaryKey: []*schema.Column{DiscordProfileChangesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_profile_changes_discord_users_profile_changes",
+				Columns:    []*schema.Column{DiscordProfileChangesColumns[5]},
+				RefColumns: []*schema.Column{DiscordUsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordprofilechange_user_id_changed_at",
+				Unique:  false,
+				Columns: []*schema.Column{DiscordProfileChangesColumns[5], DiscordProfileChangesColumns[4]},
+			},
+		},
+	}
+	// DiscordReactionsColumns holds the columns for the "discord_reactions" table.
+	DiscordReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "emoji", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_id", Type: field.TypeString},
+	}
+	// DiscordReactionsTable holds the schema information for the "discord_reactions" table.
+	DiscordReactionsTable = &schema.Table{
+		Name:       "discord_reactions",
+		Columns:    DiscordReactionsColumns,
+		PrimaryKey: []*schema.Column{DiscordReactionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_reactions_discord_messages_reactions",
+				Columns:    []*schema.Column{DiscordReactionsColumns[4]},
+				RefColumns: []*schema.Column{DiscordMessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "discordreaction_message_id_user_id_emoji",
+				Unique:  true,
+				Columns: []*schema.Column{DiscordReactionsColumns[4], DiscordReactionsColumns[1], DiscordReactionsColumns[2]},
+			},
+		},
+	}
+	// DiscordRolesColumns holds the columns for the "discord_roles" table.
+	DiscordRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "guild_id", Type: field.TypeString},
+		{Name: "name", Type: field.TypeString},
+	}
+	// DiscordRolesTable holds the schema information for the "discord_roles" table.
+	DiscordRolesTable = &schema.Table{
+		Name:       "discord_roles",
+		Columns:    DiscordRolesColumns,
+		PrimaryKey: []*schema.Column{DiscordRolesColumns[0]},
+	}
 	// DiscordUsersColumns holds the columns for the "discord_users" table.
 	DiscordUsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
 		{Name: "username", Type: field.TypeString},
 		{Name: "global_name", Type: field.TypeString},
+		{Name: "avatar", Type: field.TypeString, Nullable: true},
+		{Name: "profiling_opt_out", Type: field.TypeBool, Default: false},
+		{Name: "dm_enabled", Type: field.TypeBool, Default: false},
 	}
 	// DiscordUsersTable holds the schema information for the "discord_users" table.
 	DiscordUsersTable = &schema.Table{
@@ -84,15 +369,231 @@ var (
 		Columns:    DiscordUsersColumns,
 		PrimaryKey: []*schema.Column{DiscordUsersColumns[0]},
 	}
+	// EmbeddingModelsColumns holds the columns for the "embedding_models" table.
+	EmbeddingModelsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "dimensions", Type: field.TypeInt},
+		{Name: "active", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "ready_at", Type: field.TypeTime, Nullable: true},
+	}
+	// EmbeddingModelsTable holds the schema information for the "embedding_models" table.
+	EmbeddingModelsTable = &schema.Table{
+		Name:       "embedding_models",
+		Columns:    EmbeddingModelsColumns,
+		PrimaryKey: []*schema.Column{EmbeddingModelsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "embeddingmodel_active",
+				Unique:  true,
+				Columns: []*schema.Column{EmbeddingModelsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "active",
+				},
+			},
+		},
+	}
+	// ModerationEventsColumns holds the columns for the "moderation_events" table.
+	ModerationEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "guild_id", Type: field.TypeString, Nullable: true},
+		{Name: "channel_id", Type: field.TypeString, Nullable: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "source", Type: field.TypeString},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"rewrite", "block", "flag"}},
+		{Name: "reasons", Type: field.TypeJSON, Nullable: true},
+		{Name: "score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "original", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ModerationEventsTable holds the schema information for the "moderation_events" table.
+	ModerationEventsTable = &schema.Table{
+		Name:       "moderation_events",
+		Columns:    ModerationEventsColumns,
+		PrimaryKey: []*schema.Column{ModerationEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "moderationevent_guild_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ModerationEventsColumns[1], ModerationEventsColumns[9]},
+			},
+		},
+	}
+	// PrivacyEventsColumns holds the columns for the "privacy_events" table.
+	PrivacyEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_id", Type: field.TypeString},
+		{Name: "guild_id", Type: field.TypeString, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"opt_out", "opt_in", "export", "forget"}},
+		{Name: "records", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PrivacyEventsTable holds the schema information for the "privacy_events" table.
+	PrivacyEventsTable = &schema.Table{
+		Name:       "privacy_events",
+		Columns:    PrivacyEventsColumns,
+		PrimaryKey: []*schema.Column{PrivacyEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "privacyevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{PrivacyEventsColumns[1], PrivacyEventsColumns[5]},
+			},
+		},
+	}
+	// PrivacyOptOutsColumns holds the columns for the "privacy_opt_outs" table.
+	PrivacyOptOutsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PrivacyOptOutsTable holds the schema information for the "privacy_opt_outs" table.
+	PrivacyOptOutsTable = &schema.Table{
+		Name:       "privacy_opt_outs",
+		Columns:    PrivacyOptOutsColumns,
+		PrimaryKey: []*schema.Column{PrivacyOptOutsColumns[0]},
+	}
+	// DiscordMessageMentionedUsersColumns holds the columns for the "discord_message_mentioned_users" table.
+	DiscordMessageMentionedUsersColumns = []*schema.Column{
+		{Name: "discord_message_id", Type: field.TypeString},
+		{Name: "discord_user_id", Type: field.TypeString},
+	}
+	// DiscordMessageMentionedUsersTable holds the schema information for the "discord_message_mentioned_users" table.
+	DiscordMessageMentionedUsersTable = &schema.Table{
+		Name:       "discord_message_mentioned_users",
+		Columns:    DiscordMessageMentionedUsersColumns,
+		PrimaryKey: []*schema.Column{DiscordMessageMentionedUsersColumns[0], DiscordMessageMentionedUsersColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_message_mentioned_users_discord_message_id",
+				Columns:    []*schema.Column{DiscordMessageMentionedUsersColumns[0]},
+				RefColumns: []*schema.Column{DiscordMessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "discord_message_mentioned_users_discord_user_id",
+				Columns:    []*schema.Column{DiscordMessageMentionedUsersColumns[1]},
+				RefColumns: []*schema.Column{DiscordUsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DiscordMessageMentionedRolesColumns holds the columns for the "discord_message_mentioned_roles" table.
+	DiscordMessageMentionedRolesColumns = []*schema.Column{
+		{Name: "discord_message_id", Type: field.TypeString},
+		{Name: "discord_role_id", Type: field.TypeString},
+	}
+	// DiscordMessageMentionedRolesTable holds the schema information for the "discord_message_mentioned_roles" table.
+	DiscordMessageMentionedRolesTable = &schema.Table{
+		Name:       "discord_message_mentioned_roles",
+		Columns:    DiscordMessageMentionedRolesColumns,
+		PrimaryKey: []*schema.Column{DiscordMessageMentionedRolesColumns[0], DiscordMessageMentionedRolesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_message_mentioned_roles_discord_message_id",
+				Columns:    []*schema.Column{DiscordMessageMentionedRolesColumns[0]},
+				RefColumns: []*schema.Column{DiscordMessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "discord_message_mentioned_roles_discord_role_id",
+				Columns:    []*schema.Column{DiscordMessageMentionedRolesColumns[1]},
+				RefColumns: []*schema.Column{DiscordRolesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DiscordMessageMentionedChannelsColumns holds the columns for the "discord_message_mentioned_channels" table.
+	DiscordMessageMentionedChannelsColumns = []*schema.Column{
+		{Name: "discord_message_id", Type: field.TypeString},
+		{Name: "discord_channel_id", Type: field.TypeString},
+	}
+	// DiscordMessageMentionedChannelsTable holds the schema information for the "discord_message_mentioned_channels" table.
+	DiscordMessageMentionedChannelsTable = &schema.Table{
+		Name:       "discord_message_mentioned_channels",
+		Columns:    DiscordMessageMentionedChannelsColumns,
+		PrimaryKey: []*schema.Column{DiscordMessageMentionedChannelsColumns[0], DiscordMessageMentionedChannelsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_message_mentioned_channels_discord_message_id",
+				Columns:    []*schema.Column{DiscordMessageMentionedChannelsColumns[0]},
+				RefColumns: []*schema.Column{DiscordMessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "discord_message_mentioned_channels_discord_channel_id",
+				Columns:    []*schema.Column{DiscordMessageMentionedChannelsColumns[1]},
+				RefColumns: []*schema.Column{DiscordChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// DiscordMessageChunkMessagesColumns holds the columns for the "discord_message_chunk_messages" table.
+	DiscordMessageChunkMessagesColumns = []*schema.Column{
+		{Name: "discord_message_chunk_id", Type: field.TypeString},
+		{Name: "discord_message_id", Type: field.TypeString},
+	}
+	// DiscordMessageChunkMessagesTable holds the schema information for the "discord_message_chunk_messages" table.
+	DiscordMessageChunkMessagesTable = &schema.Table{
+		Name:       "discord_message_chunk_messages",
+		Columns:    DiscordMessageChunkMessagesColumns,
+		PrimaryKey: []*schema.Column{DiscordMessageChunkMessagesColumns[0], DiscordMessageChunkMessagesColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "discord_message_chunk_messages_discord_message_chunk_id",
+				Columns:    []*schema.Column{DiscordMessageChunkMessagesColumns[0]},
+				RefColumns: []*schema.Column{DiscordMessageChunksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "discord_message_chunk_messages_discord_message_id",
+				Columns:    []*schema.Column{DiscordMessageChunkMessagesColumns[1]},
+				RefColumns: []*schema.Column{DiscordMessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DiscordChannelsTable,
+		DiscordChannelSettingsTable,
+		DiscordChimeInsTable,
+		DiscordChunkEmbeddingsTable,
+		DiscordDirectMessagesTable,
+		DiscordGuildMembersTable,
+		DiscordGuildSettingsTable,
 		DiscordMessagesTable,
+		DiscordMessageChunksTable,
 		DiscordMessageEmbeddingsTable,
+		DiscordProfileChangesTable,
+		DiscordReactionsTable,
+		DiscordRolesTable,
 		DiscordUsersTable,
+		EmbeddingModelsTable,
+		ModerationEventsTable,
+		PrivacyEventsTable,
+		PrivacyOptOutsTable,
+		DiscordMessageMentionedUsersTable,
+		DiscordMessageMentionedRolesTable,
+		DiscordMessageMentionedChannelsTable,
+		DiscordMessageChunkMessagesTable,
 	}
 )
 
 func init() {
-	DiscordMessagesTable.ForeignKeys[0].RefTable = DiscordUsersTable
+	DiscordChunkEmbeddingsTable.ForeignKeys[0].RefTable = DiscordMessageChunksTable
+	DiscordDirectMessagesTable.ForeignKeys[0].RefTable = DiscordUsersTable
+	DiscordGuildMembersTable.ForeignKeys[0].RefTable = DiscordUsersTable
+	DiscordMessagesTable.ForeignKeys[0].RefTable = DiscordMessagesTable
+	DiscordMessagesTable.ForeignKeys[1].RefTable = DiscordUsersTable
 	DiscordMessageEmbeddingsTable.ForeignKeys[0].RefTable = DiscordMessagesTable
+	DiscordProfileChangesTable.ForeignKeys[0].RefTable = DiscordUsersTable
+	DiscordReactionsTable.ForeignKeys[0].RefTable = DiscordMessagesTable
+	DiscordMessageMentionedUsersTable.ForeignKeys[0].RefTable = DiscordMessagesTable
+	DiscordMessageMentionedUsersTable.ForeignKeys[1].RefTable = DiscordUsersTable
+	DiscordMessageMentionedRolesTable.ForeignKeys[0].RefTable = DiscordMessagesTable
+	DiscordMessageMentionedRolesTable.ForeignKeys[1].RefTable = DiscordRolesTable
+	DiscordMessageMentionedChannelsTable.ForeignKeys[0].RefTable = DiscordMessagesTable
+	DiscordMessageMentionedChannelsTable.ForeignKeys[1].RefTable = DiscordChannelsTable
+	DiscordMessageChunkMessagesTable.ForeignKeys[0].RefTable = DiscordMessageChunksTable
+	DiscordMessageChunkMessagesTable.ForeignKeys[1].RefTable = DiscordMessagesTable
 }
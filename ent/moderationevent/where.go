@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package moderationevent
+
+import (
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldID, id))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldGuildID, v))
+}
+
+// ChannelID applies equality check predicate on the "channel_id" field. It's identical to ChannelIDEQ.
+func ChannelID(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldChannelID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldScore, v))
+}
+
+// Original applies equality check predicate on the "original" field. It's identical to OriginalEQ.
+func Original(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldOriginal, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDIsNil applies the IsNil predicate on the "guild_id" field.
+func GuildIDIsNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIsNull(FieldGuildID))
+}
+
+// GuildIDNotNil applies the NotNil predicate on the "guild_id" field.
+func GuildIDNotNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotNull(FieldGuildID))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// ChannelIDEQ applies the EQ predicate on the "channel_id" field.
+func ChannelIDEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldChannelID, v))
+}
+
+// ChannelIDNEQ applies the NEQ predicate on the "channel_id" field.
+func ChannelIDNEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldChannelID, v))
+}
+
+// ChannelIDIn applies the In predicate on the "channel_id" field.
+func ChannelIDIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldChannelID, vs...))
+}
+
+// ChannelIDNotIn applies the NotIn predicate on the "channel_id" field.
+func ChannelIDNotIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldChannelID, vs...))
+}
+
+// ChannelIDGT applies the GT predicate on the "channel_id" field.
+func ChannelIDGT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldChannelID, v))
+}
+
+// ChannelIDGTE applies the GTE predicate on the "channel_id" field.
+func ChannelIDGTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldChannelID, v))
+}
+
+// ChannelIDLT applies the LT predicate on the "channel_id" field.
+func ChannelIDLT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldChannelID, v))
+}
+
+// ChannelIDLTE applies the LTE predicate on the "channel_id" field.
+func ChannelIDLTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldChannelID, v))
+}
+
+// ChannelIDContains applies the Contains predicate on the "channel_id" field.
+func ChannelIDContains(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContains(FieldChannelID, v))
+}
+
+// ChannelIDHasPrefix applies the HasPrefix predicate on the "channel_id" field.
+func ChannelIDHasPrefix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasPrefix(FieldChannelID, v))
+}
+
+// ChannelIDHasSuffix applies the HasSuffix predicate on the "channel_id" field.
+func ChannelIDHasSuffix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasSuffix(FieldChannelID, v))
+}
+
+// ChannelIDIsNil applies the IsNil predicate on the "channel_id" field.
+func ChannelIDIsNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIsNull(FieldChannelID))
+}
+
+// ChannelIDNotNil applies the NotNil predicate on the "channel_id" field.
+func ChannelIDNotNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotNull(FieldChannelID))
+}
+
+// ChannelIDEqualFold applies the EqualFold predicate on the "channel_id" field.
+func ChannelIDEqualFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEqualFold(FieldChannelID, v))
+}
+
+// ChannelIDContainsFold applies the ContainsFold predicate on the "channel_id" field.
+func ChannelIDContainsFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContainsFold(FieldChannelID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotNull(FieldUserID))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ReasonsIsNil applies the IsNil predicate on the "reasons" field.
+func ReasonsIsNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIsNull(FieldReasons))
+}
+
+// ReasonsNotNil applies the NotNil predicate on the "reasons" field.
+func ReasonsNotNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotNull(FieldReasons))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldScore, v))
+}
+
+// ScoreIsNil applies the IsNil predicate on the "score" field.
+func ScoreIsNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIsNull(FieldScore))
+}
+
+// ScoreNotNil applies the NotNil predicate on the "score" field.
+func ScoreNotNil() predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotNull(FieldScore))
+}
+
+// OriginalEQ applies the EQ predicate on the "original" field.
+func OriginalEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldOriginal, v))
+}
+
+// OriginalNEQ applies the NEQ predicate on the "original" field.
+func OriginalNEQ(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldOriginal, v))
+}
+
+// OriginalIn applies the In predicate on the "original" field.
+func OriginalIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldOriginal, vs...))
+}
+
+// OriginalNotIn applies the NotIn predicate on the "original" field.
+func OriginalNotIn(vs ...string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldOriginal, vs...))
+}
+
+// OriginalGT applies the GT predicate on the "original" field.
+func OriginalGT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldOriginal, v))
+}
+
+// OriginalGTE applies the GTE predicate on the "original" field.
+func OriginalGTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldOriginal, v))
+}
+
+// OriginalLT applies the LT predicate on the "original" field.
+func OriginalLT(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldOriginal, v))
+}
+
+// OriginalLTE applies the LTE predicate on the "original" field.
+func OriginalLTE(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldOriginal, v))
+}
+
+// OriginalContains applies the Contains predicate on the "original" field.
+func OriginalContains(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContains(FieldOriginal, v))
+}
+
+// OriginalHasPrefix applies the HasPrefix predicate on the "original" field.
+func OriginalHasPrefix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasPrefix(FieldOriginal, v))
+}
+
+// OriginalHasSuffix applies the HasSuffix predicate on the "original" field.
+func OriginalHasSuffix(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldHasSuffix(FieldOriginal, v))
+}
+
+// OriginalEqualFold applies the EqualFold predicate on the "original" field.
+func OriginalEqualFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEqualFold(FieldOriginal, v))
+}
+
+// OriginalContainsFold applies the ContainsFold predicate on the "original" field.
+func OriginalContainsFold(v string) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldContainsFold(FieldOriginal, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ModerationEvent) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ModerationEvent) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ModerationEvent) predicate.ModerationEvent {
+	return predicate.ModerationEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/moderationevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationEventCreate is the builder for creating a ModerationEvent entity.
+type ModerationEventCreate struct {
+	config
+	mutation *ModerationEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *ModerationEventCreate) SetGuildID(v string) *ModerationEventCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (_c *ModerationEventCreate) SetNillableGuildID(v *string) *ModerationEventCreate {
+	if v != nil {
+		_c.SetGuildID(*v)
+	}
+	return _c
+}
+
+// SetChannelID sets the "channel_id" field.
+func (_c *ModerationEventCreate) SetChannelID(v string) *ModerationEventCreate {
+	_c.mutation.SetChannelID(v)
+	return _c
+}
+
+// SetNillableChannelID sets the "channel_id" field if the given value is not nil.
+func (_c *ModerationEventCreate) SetNillableChannelID(v *string) *ModerationEventCreate {
+	if v != nil {
+		_c.SetChannelID(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ModerationEventCreate) SetUserID(v string) *ModerationEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *ModerationEventCreate) SetNillableUserID(v *string) *ModerationEventCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *ModerationEventCreate) SetSource(v string) *ModerationEventCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *ModerationEventCreate) SetAction(v moderationevent.Action) *ModerationEventCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetReasons sets the "reasons" field.
+func (_c *ModerationEventCreate) SetReasons(v []string) *ModerationEventCreate {
+	_c.mutation.SetReasons(v)
+	return _c
+}
+
+// SetScore sets the "score" field.
+func (_c *ModerationEventCreate) SetScore(v float64) *ModerationEventCreate {
+	_c.mutation.SetScore(v)
+	return _c
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (_c *ModerationEventCreate) SetNillableScore(v *float64) *ModerationEventCreate {
+	if v != nil {
+		_c.SetScore(*v)
+	}
+	return _c
+}
+
+// SetOriginal sets the "original" field.
+func (_c *ModerationEventCreate) SetOriginal(v string) *ModerationEventCreate {
+	_c.mutation.SetOriginal(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ModerationEventCreate) SetCreatedAt(v time.Time) *ModerationEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ModerationEventCreate) SetNillableCreatedAt(v *time.Time) *ModerationEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ModerationEventMutation object of the builder.
+func (_c *ModerationEventCreate) Mutation() *ModerationEventMutation {
+	return _c.mutation
+}
+
+// Save creates the ModerationEvent in the database.
+func (_c *ModerationEventCreate) Save(ctx context.Context) (*ModerationEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ModerationEventCreate) SaveX(ctx context.Context) *ModerationEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ModerationEventCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := moderationevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ModerationEventCreate) check() error {
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "ModerationEvent.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := moderationevent.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "ModerationEvent.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "ModerationEvent.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := moderationevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "ModerationEvent.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Original(); !ok {
+		return &ValidationError{Name: "original", err: errors.New(`ent: missing required field "ModerationEvent.original"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ModerationEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *ModerationEventCreate) sqlSave(ctx context.Context) (*ModerationEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ModerationEventCreate) createSpec() (*ModerationEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ModerationEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(moderationevent.Table, sqlgraph.NewFieldSpec(moderationevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(moderationevent.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.ChannelID(); ok {
+		_spec.SetField(moderationevent.FieldChannelID, field.TypeString, value)
+		_node.ChannelID = value
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(moderationevent.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(moderationevent.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(moderationevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Reasons(); ok {
+		_spec.SetField(moderationevent.FieldReasons, field.TypeJSON, value)
+		_node.Reasons = value
+	}
+	if value, ok := _c.mutation.Score(); ok {
+		_spec.SetField(moderationevent.FieldScore, field.TypeFloat64, value)
+		_node.Score = &value
+	}
+	if value, ok := _c.mutation.Original(); ok {
+		_spec.SetField(moderationevent.FieldOriginal, field.TypeString, value)
+		_node.Original = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(moderationevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationEvent.Create().
+//		SetGuildID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationEventUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationEventCreate) OnConflict(opts ...sql.ConflictOption) *ModerationEventUpsertOne {
+	_c.conflict = opts
+	return &ModerationEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationEventCreate) OnConflictColumns(columns ...string) *ModerationEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// ModerationEventUpsertOne is the builder for "upsert"-ing
+	//  one ModerationEvent node.
+	ModerationEventUpsertOne struct {
+		create *ModerationEventCreate
+	}
+
+	// ModerationEventUpsert is the "OnConflict" setter.
+	ModerationEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.ModerationEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationEventUpsertOne) UpdateNewValues() *ModerationEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(moderationevent.FieldGuildID)
+		}
+		if _, exists := u.create.mutation.ChannelID(); exists {
+			s.SetIgnore(moderationevent.FieldChannelID)
+		}
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(moderationevent.FieldUserID)
+		}
+		if _, exists := u.create.mutation.Source(); exists {
+			s.SetIgnore(moderationevent.FieldSource)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(moderationevent.FieldAction)
+		}
+		if _, exists := u.create.mutation.Reasons(); exists {
+			s.SetIgnore(moderationevent.FieldReasons)
+		}
+		if _, exists := u.create.mutation.Score(); exists {
+			s.SetIgnore(moderationevent.FieldScore)
+		}
+		if _, exists := u.create.mutation.Original(); exists {
+			s.SetIgnore(moderationevent.FieldOriginal)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(moderationevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ModerationEventUpsertOne) Ignore() *ModerationEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationEventUpsertOne) DoNothing() *ModerationEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationEventCreate.OnConflict
+// documentation for more info.
+func (u *ModerationEventUpsertOne) Update(set func(*ModerationEventUpsert)) *ModerationEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ModerationEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ModerationEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ModerationEventCreateBulk is the builder for creating many ModerationEvent entities in bulk.
+type ModerationEventCreateBulk struct {
+	config
+	err      error
+	builders []*ModerationEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ModerationEvent entities in the database.
+func (_c *ModerationEventCreateBulk) Save(ctx context.Context) ([]*ModerationEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ModerationEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ModerationEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ModerationEventCreateBulk) SaveX(ctx context.Context) []*ModerationEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ModerationEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ModerationEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ModerationEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ModerationEventUpsert) {
+//			SetGuildID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *ModerationEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *ModerationEventUpsertBulk {
+	_c.conflict = opts
+	return &ModerationEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ModerationEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *ModerationEventCreateBulk) OnConflictColumns(columns ...string) *ModerationEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &ModerationEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// ModerationEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of ModerationEvent nodes.
+type ModerationEventUpsertBulk struct {
+	create *ModerationEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ModerationEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *ModerationEventUpsertBulk) UpdateNewValues() *ModerationEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(moderationevent.FieldGuildID)
+			}
+			if _, exists := b.mutation.ChannelID(); exists {
+				s.SetIgnore(moderationevent.FieldChannelID)
+			}
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(moderationevent.FieldUserID)
+			}
+			if _, exists := b.mutation.Source(); exists {
+				s.SetIgnore(moderationevent.FieldSource)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(moderationevent.FieldAction)
+			}
+			if _, exists := b.mutation.Reasons(); exists {
+				s.SetIgnore(moderationevent.FieldReasons)
+			}
+			if _, exists := b.mutation.Score(); exists {
+				s.SetIgnore(moderationevent.FieldScore)
+			}
+			if _, exists := b.mutation.Original(); exists {
+				s.SetIgnore(moderationevent.FieldOriginal)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(moderationevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ModerationEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ModerationEventUpsertBulk) Ignore() *ModerationEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ModerationEventUpsertBulk) DoNothing() *ModerationEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ModerationEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *ModerationEventUpsertBulk) Update(set func(*ModerationEventUpsert)) *ModerationEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ModerationEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ModerationEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the ModerationEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for ModerationEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ModerationEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
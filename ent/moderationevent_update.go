@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/moderationevent"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ModerationEventUpdate is the builder for updating ModerationEvent entities.
+type ModerationEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ModerationEventMutation
+}
+
+// Where appends a list predicates to the ModerationEventUpdate builder.
+func (_u *ModerationEventUpdate) Where(ps ...predicate.ModerationEvent) *ModerationEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the ModerationEventMutation object of the builder.
+func (_u *ModerationEventUpdate) Mutation() *ModerationEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ModerationEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ModerationEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ModerationEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationevent.Table, moderationevent.Columns, sqlgraph.NewFieldSpec(moderationevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(moderationevent.FieldGuildID, field.TypeString)
+	}
+	if _u.mutation.ChannelIDCleared() {
+		_spec.ClearField(moderationevent.FieldChannelID, field.TypeString)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(moderationevent.FieldUserID, field.TypeString)
+	}
+	if _u.mutation.ReasonsCleared() {
+		_spec.ClearField(moderationevent.FieldReasons, field.TypeJSON)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(moderationevent.FieldScore, field.TypeFloat64)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ModerationEventUpdateOne is the builder for updating a single ModerationEvent entity.
+type ModerationEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ModerationEventMutation
+}
+
+// Mutation returns the ModerationEventMutation object of the builder.
+func (_u *ModerationEventUpdateOne) Mutation() *ModerationEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ModerationEventUpdate builder.
+func (_u *ModerationEventUpdateOne) Where(ps ...predicate.ModerationEvent) *ModerationEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ModerationEventUpdateOne) Select(field string, fields ...string) *ModerationEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ModerationEvent entity.
+func (_u *ModerationEventUpdateOne) Save(ctx context.Context) (*ModerationEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ModerationEventUpdateOne) SaveX(ctx context.Context) *ModerationEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ModerationEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ModerationEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *ModerationEventUpdateOne) sqlSave(ctx context.Context) (_node *ModerationEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(moderationevent.Table, moderationevent.Columns, sqlgraph.NewFieldSpec(moderationevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ModerationEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, moderationevent.FieldID)
+		for _, f := range fields {
+			if !moderationevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != moderationevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(moderationevent.FieldGuildID, field.TypeString)
+	}
+	if _u.mutation.ChannelIDCleared() {
+		_spec.ClearField(moderationevent.FieldChannelID, field.TypeString)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(moderationevent.FieldUserID, field.TypeString)
+	}
+	if _u.mutation.ReasonsCleared() {
+		_spec.ClearField(moderationevent.FieldReasons, field.TypeJSON)
+	}
+	if _u.mutation.ScoreCleared() {
+		_spec.ClearField(moderationevent.FieldScore, field.TypeFloat64)
+	}
+	_node = &ModerationEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{moderationevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -6,10 +6,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/discordchimein"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordguildsetting"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
 	"sev0/ent/discorduser"
+	"sev0/ent/embeddingmodel"
+	"sev0/ent/moderationevent"
 	"sev0/ent/predicate"
+	"sev0/ent/privacyevent"
+	"sev0/ent/privacyoptout"
 	"sync"
 	"time"
 
@@ -27,42 +42,55 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeDiscordChannel          = "DiscordChannel"
+	TypeDiscordChannelSetting   = "DiscordChannelSetting"
+	TypeDiscordChimeIn          = "DiscordChimeIn"
+	TypeDiscordChunkEmbedding   = "DiscordChunkEmbedding"
+	TypeDiscordDirectMessage    = "DiscordDirectMessage"
+	TypeDiscordGuildMember      = "DiscordGuildMember"
+	TypeDiscordGuildSetting     = "DiscordGuildSetting"
 	TypeDiscordMessage          = "DiscordMessage"
+	TypeDiscordMessageChunk     = "DiscordMessageChunk"
 	TypeDiscordMessageEmbedding = "DiscordMessageEmbedding"
+	TypeDiscordProfileChange    = "DiscordProfileChange"
+	TypeDiscordReaction         = "DiscordReaction"
+	TypeDiscordRole             = "DiscordRole"
 	TypeDiscordUser             = "DiscordUser"
+	TypeEmbeddingModel          = "EmbeddingModel"
+	TypeModerationEvent         = "ModerationEvent"
+	TypePrivacyEvent            = "PrivacyEvent"
+	TypePrivacyOptOut           = "PrivacyOptOut"
 )
 
-// DiscordMessageMutation represents an operation that mutates the DiscordMessage nodes in the graph.
-type DiscordMessageMutation struct {
+// DiscordChannelMutation represents an operation that mutates the DiscordChannel nodes in the graph.
+type DiscordChannelMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	content           *string
-	timestamp         *time.Time
-	edited_timestamp  *time.Time
-	clearedFields     map[string]struct{}
-	user              *string
-	cleareduser       bool
-	embeddings        map[string]struct{}
-	removedembeddings map[string]struct{}
-	clearedembeddings bool
-	done              bool
-	oldValue          func(context.Context) (*DiscordMessage, error)
-	predicates        []predicate.DiscordMessage
-}
-
-var _ ent.Mutation = (*DiscordMessageMutation)(nil)
-
-// discordmessageOption allows management of the mutation configuration using functional options.
-type discordmessageOption func(*DiscordMessageMutation)
-
-// newDiscordMessageMutation creates new mutation for the DiscordMessage entity.
-func newDiscordMessageMutation(c config, op Op, opts ...discordmessageOption) *DiscordMessageMutation {
-	m := &DiscordMessageMutation{
+	op                  Op
+	typ                 string
+	id                  *string
+	guild_id            *string
+	name                *string
+	parent_id           *string
+	clearedFields       map[string]struct{}
+	mentioned_in        map[string]struct{}
+	removedmentioned_in map[string]struct{}
+	clearedmentioned_in bool
+	done                bool
+	oldValue            func(context.Context) (*DiscordChannel, error)
+	predicates          []predicate.DiscordChannel
+}
+
+var _ ent.Mutation = (*DiscordChannelMutation)(nil)
+
+// discordchannelOption allows management of the mutation configuration using functional options.
+type discordchannelOption func(*DiscordChannelMutation)
+
+// newDiscordChannelMutation creates new mutation for the DiscordChannel entity.
+func newDiscordChannelMutation(c config, op Op, opts ...discordchannelOption) *DiscordChannelMutation {
+	m := &DiscordChannelMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDiscordMessage,
+		typ:           TypeDiscordChannel,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -71,20 +99,20 @@ func newDiscordMessageMutation(c config, op Op, opts ...discordmessageOption) *D
 	return m
 }
 
-// withDiscordMessageID sets the ID field of the mutation.
-func withDiscordMessageID(id string) discordmessageOption {
-	return func(m *DiscordMessageMutation) {
+// withDiscordChannelID sets the ID field of the mutation.
+func withDiscordChannelID(id string) discordchannelOption {
+	return func(m *DiscordChannelMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DiscordMessage
+			value *DiscordChannel
 		)
-		m.oldValue = func(ctx context.Context) (*DiscordMessage, error) {
+		m.oldValue = func(ctx context.Context) (*DiscordChannel, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DiscordMessage.Get(ctx, id)
+					value, err = m.Client().DiscordChannel.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -93,10 +121,10 @@ func withDiscordMessageID(id string) discordmessageOption {
 	}
 }
 
-// withDiscordMessage sets the old DiscordMessage of the mutation.
-func withDiscordMessage(node *DiscordMessage) discordmessageOption {
-	return func(m *DiscordMessageMutation) {
-		m.oldValue = func(context.Context) (*DiscordMessage, error) {
+// withDiscordChannel sets the old DiscordChannel of the mutation.
+func withDiscordChannel(node *DiscordChannel) discordchannelOption {
+	return func(m *DiscordChannelMutation) {
+		m.oldValue = func(context.Context) (*DiscordChannel, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -105,7 +133,7 @@ func withDiscordMessage(node *DiscordMessage) discordmessageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DiscordMessageMutation) Client() *Client {
+func (m DiscordChannelMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -113,7 +141,7 @@ func (m DiscordMessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DiscordMessageMutation) Tx() (*Tx, error) {
+func (m DiscordChannelMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -123,14 +151,14 @@ func (m DiscordMessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DiscordMessage entities.
-func (m *DiscordMessageMutation) SetID(id string) {
+// operation is only accepted on creation of DiscordChannel entities.
+func (m *DiscordChannelMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DiscordMessageMutation) ID() (id string, exists bool) {
+func (m *DiscordChannelMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -141,7 +169,7 @@ func (m *DiscordMessageMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DiscordMessageMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DiscordChannelMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -150,272 +178,196 @@ func (m *DiscordMessageMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DiscordMessage.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DiscordChannel.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetContent sets the "content" field.
-func (m *DiscordMessageMutation) SetContent(s string) {
-	m.content = &s
-}
-
-// Content returns the value of the "content" field in the mutation.
-func (m *DiscordMessageMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldContent returns the old "content" field's value of the DiscordMessage entity.
-// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
-	}
-	return oldValue.Content, nil
-}
-
-// ResetContent resets all changes to the "content" field.
-func (m *DiscordMessageMutation) ResetContent() {
-	m.content = nil
-}
-
-// SetAuthorID sets the "author_id" field.
-func (m *DiscordMessageMutation) SetAuthorID(s string) {
-	m.user = &s
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordChannelMutation) SetGuildID(s string) {
+	m.guild_id = &s
 }
 
-// AuthorID returns the value of the "author_id" field in the mutation.
-func (m *DiscordMessageMutation) AuthorID() (r string, exists bool) {
-	v := m.user
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordChannelMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorID returns the old "author_id" field's value of the DiscordMessage entity.
-// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldGuildID returns the old "guild_id" field's value of the DiscordChannel entity.
+// If the DiscordChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageMutation) OldAuthorID(ctx context.Context) (v string, err error) {
+func (m *DiscordChannelMutation) OldGuildID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
 	}
-	return oldValue.AuthorID, nil
+	return oldValue.GuildID, nil
 }
 
-// ResetAuthorID resets all changes to the "author_id" field.
-func (m *DiscordMessageMutation) ResetAuthorID() {
-	m.user = nil
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordChannelMutation) ResetGuildID() {
+	m.guild_id = nil
 }
 
-// SetTimestamp sets the "timestamp" field.
-func (m *DiscordMessageMutation) SetTimestamp(t time.Time) {
-	m.timestamp = &t
+// SetName sets the "name" field.
+func (m *DiscordChannelMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Timestamp returns the value of the "timestamp" field in the mutation.
-func (m *DiscordMessageMutation) Timestamp() (r time.Time, exists bool) {
-	v := m.timestamp
+// Name returns the value of the "name" field in the mutation.
+func (m *DiscordChannelMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimestamp returns the old "timestamp" field's value of the DiscordMessage entity.
-// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DiscordChannel entity.
+// If the DiscordChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *DiscordChannelMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Timestamp, nil
+	return oldValue.Name, nil
 }
 
-// ResetTimestamp resets all changes to the "timestamp" field.
-func (m *DiscordMessageMutation) ResetTimestamp() {
-	m.timestamp = nil
+// ResetName resets all changes to the "name" field.
+func (m *DiscordChannelMutation) ResetName() {
+	m.name = nil
 }
 
-// SetEditedTimestamp sets the "edited_timestamp" field.
-func (m *DiscordMessageMutation) SetEditedTimestamp(t time.Time) {
-	m.edited_timestamp = &t
+// SetParentID sets the "parent_id" field.
+func (m *DiscordChannelMutation) SetParentID(s string) {
+	m.parent_id = &s
 }
 
-// EditedTimestamp returns the value of the "edited_timestamp" field in the mutation.
-func (m *DiscordMessageMutation) EditedTimestamp() (r time.Time, exists bool) {
-	v := m.edited_timestamp
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *DiscordChannelMutation) ParentID() (r string, exists bool) {
+	v := m.parent_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEditedTimestamp returns the old "edited_timestamp" field's value of the DiscordMessage entity.
-// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// OldParentID returns the old "parent_id" field's value of the DiscordChannel entity.
+// If the DiscordChannel object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageMutation) OldEditedTimestamp(ctx context.Context) (v time.Time, err error) {
+func (m *DiscordChannelMutation) OldParentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEditedTimestamp is only allowed on UpdateOne operations")
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEditedTimestamp requires an ID field in the mutation")
+		return v, errors.New("OldParentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEditedTimestamp: %w", err)
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
 	}
-	return oldValue.EditedTimestamp, nil
+	return oldValue.ParentID, nil
 }
 
-// ClearEditedTimestamp clears the value of the "edited_timestamp" field.
-func (m *DiscordMessageMutation) ClearEditedTimestamp() {
-	m.edited_timestamp = nil
-	m.clearedFields[discordmessage.FieldEditedTimestamp] = struct{}{}
+// ClearParentID clears the value of the "parent_id" field.
+func (m *DiscordChannelMutation) ClearParentID() {
+	m.parent_id = nil
+	m.clearedFields[discordchannel.FieldParentID] = struct{}{}
 }
 
-// EditedTimestampCleared returns if the "edited_timestamp" field was cleared in this mutation.
-func (m *DiscordMessageMutation) EditedTimestampCleared() bool {
-	_, ok := m.clearedFields[discordmessage.FieldEditedTimestamp]
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *DiscordChannelMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[discordchannel.FieldParentID]
 	return ok
 }
 
-// ResetEditedTimestamp resets all changes to the "edited_timestamp" field.
-func (m *DiscordMessageMutation) ResetEditedTimestamp() {
-	m.edited_timestamp = nil
-	delete(m.clearedFields, discordmessage.FieldEditedTimestamp)
-}
-
-// SetUserID sets the "user" edge to the DiscordUser entity by id.
-func (m *DiscordMessageMutation) SetUserID(id string) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the DiscordUser entity.
-func (m *DiscordMessageMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[discordmessage.FieldAuthorID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the DiscordUser entity was cleared.
-func (m *DiscordMessageMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *DiscordMessageMutation) UserID() (id string, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *DiscordMessageMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *DiscordMessageMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *DiscordChannelMutation) ResetParentID() {
+	m.parent_id = nil
+	delete(m.clearedFields, discordchannel.FieldParentID)
 }
 
-// AddEmbeddingIDs adds the "embeddings" edge to the DiscordMessageEmbedding entity by ids.
-func (m *DiscordMessageMutation) AddEmbeddingIDs(ids ...string) {
-	if m.embeddings == nil {
-		m.embeddings = make(map[string]struct{})
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by ids.
+func (m *DiscordChannelMutation) AddMentionedInIDs(ids ...string) {
+	if m.mentioned_in == nil {
+		m.mentioned_in = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.embeddings[ids[i]] = struct{}{}
+		m.mentioned_in[ids[i]] = struct{}{}
 	}
 }
 
-// ClearEmbeddings clears the "embeddings" edge to the DiscordMessageEmbedding entity.
-func (m *DiscordMessageMutation) ClearEmbeddings() {
-	m.clearedembeddings = true
+// ClearMentionedIn clears the "mentioned_in" edge to the DiscordMessage entity.
+func (m *DiscordChannelMutation) ClearMentionedIn() {
+	m.clearedmentioned_in = true
 }
 
-// EmbeddingsCleared reports if the "embeddings" edge to the DiscordMessageEmbedding entity was cleared.
-func (m *DiscordMessageMutation) EmbeddingsCleared() bool {
-	return m.clearedembeddings
+// MentionedInCleared reports if the "mentioned_in" edge to the DiscordMessage entity was cleared.
+func (m *DiscordChannelMutation) MentionedInCleared() bool {
+	return m.clearedmentioned_in
 }
 
-// RemoveEmbeddingIDs removes the "embeddings" edge to the DiscordMessageEmbedding entity by IDs.
-func (m *DiscordMessageMutation) RemoveEmbeddingIDs(ids ...string) {
-	if m.removedembeddings == nil {
-		m.removedembeddings = make(map[string]struct{})
+// RemoveMentionedInIDs removes the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (m *DiscordChannelMutation) RemoveMentionedInIDs(ids ...string) {
+	if m.removedmentioned_in == nil {
+		m.removedmentioned_in = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.embeddings, ids[i])
-		m.removedembeddings[ids[i]] = struct{}{}
+		delete(m.mentioned_in, ids[i])
+		m.removedmentioned_in[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedEmbeddings returns the removed IDs of the "embeddings" edge to the DiscordMessageEmbedding entity.
-func (m *DiscordMessageMutation) RemovedEmbeddingsIDs() (ids []string) {
-	for id := range m.removedembeddings {
+// RemovedMentionedIn returns the removed IDs of the "mentioned_in" edge to the DiscordMessage entity.
+func (m *DiscordChannelMutation) RemovedMentionedInIDs() (ids []string) {
+	for id := range m.removedmentioned_in {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// EmbeddingsIDs returns the "embeddings" edge IDs in the mutation.
-func (m *DiscordMessageMutation) EmbeddingsIDs() (ids []string) {
-	for id := range m.embeddings {
+// MentionedInIDs returns the "mentioned_in" edge IDs in the mutation.
+func (m *DiscordChannelMutation) MentionedInIDs() (ids []string) {
+	for id := range m.mentioned_in {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEmbeddings resets all changes to the "embeddings" edge.
-func (m *DiscordMessageMutation) ResetEmbeddings() {
-	m.embeddings = nil
-	m.clearedembeddings = false
-	m.removedembeddings = nil
+// ResetMentionedIn resets all changes to the "mentioned_in" edge.
+func (m *DiscordChannelMutation) ResetMentionedIn() {
+	m.mentioned_in = nil
+	m.clearedmentioned_in = false
+	m.removedmentioned_in = nil
 }
 
-// Where appends a list predicates to the DiscordMessageMutation builder.
-func (m *DiscordMessageMutation) Where(ps ...predicate.DiscordMessage) {
+// Where appends a list predicates to the DiscordChannelMutation builder.
+func (m *DiscordChannelMutation) Where(ps ...predicate.DiscordChannel) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DiscordMessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DiscordChannelMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DiscordMessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DiscordMessage, len(ps))
+func (m *DiscordChannelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordChannel, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -423,36 +375,33 @@ func (m *DiscordMessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DiscordMessageMutation) Op() Op {
+func (m *DiscordChannelMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DiscordMessageMutation) SetOp(op Op) {
+func (m *DiscordChannelMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DiscordMessage).
-func (m *DiscordMessageMutation) Type() string {
+// Type returns the node type of this mutation (DiscordChannel).
+func (m *DiscordChannelMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DiscordMessageMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.content != nil {
-		fields = append(fields, discordmessage.FieldContent)
+func (m *DiscordChannelMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, discordchannel.FieldGuildID)
 	}
-	if m.user != nil {
-		fields = append(fields, discordmessage.FieldAuthorID)
-	}
-	if m.timestamp != nil {
-		fields = append(fields, discordmessage.FieldTimestamp)
+	if m.name != nil {
+		fields = append(fields, discordchannel.FieldName)
 	}
-	if m.edited_timestamp != nil {
-		fields = append(fields, discordmessage.FieldEditedTimestamp)
+	if m.parent_id != nil {
+		fields = append(fields, discordchannel.FieldParentID)
 	}
 	return fields
 }
@@ -460,16 +409,14 @@ func (m *DiscordMessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DiscordMessageMutation) Field(name string) (ent.Value, bool) {
+func (m *DiscordChannelMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case discordmessage.FieldContent:
-		return m.Content()
-	case discordmessage.FieldAuthorID:
-		return m.AuthorID()
-	case discordmessage.FieldTimestamp:
-		return m.Timestamp()
-	case discordmessage.FieldEditedTimestamp:
-		return m.EditedTimestamp()
+	case discordchannel.FieldGuildID:
+		return m.GuildID()
+	case discordchannel.FieldName:
+		return m.Name()
+	case discordchannel.FieldParentID:
+		return m.ParentID()
 	}
 	return nil, false
 }
@@ -477,150 +424,131 @@ func (m *DiscordMessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DiscordMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DiscordChannelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case discordmessage.FieldContent:
-		return m.OldContent(ctx)
-	case discordmessage.FieldAuthorID:
-		return m.OldAuthorID(ctx)
-	case discordmessage.FieldTimestamp:
-		return m.OldTimestamp(ctx)
-	case discordmessage.FieldEditedTimestamp:
-		return m.OldEditedTimestamp(ctx)
+	case discordchannel.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordchannel.FieldName:
+		return m.OldName(ctx)
+	case discordchannel.FieldParentID:
+		return m.OldParentID(ctx)
 	}
-	return nil, fmt.Errorf("unknown DiscordMessage field %s", name)
+	return nil, fmt.Errorf("unknown DiscordChannel field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscordMessageMutation) SetField(name string, value ent.Value) error {
+func (m *DiscordChannelMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case discordmessage.FieldContent:
+	case discordchannel.FieldGuildID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetGuildID(v)
 		return nil
-	case discordmessage.FieldAuthorID:
+	case discordchannel.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthorID(v)
-		return nil
-	case discordmessage.FieldTimestamp:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimestamp(v)
+		m.SetName(v)
 		return nil
-	case discordmessage.FieldEditedTimestamp:
-		v, ok := value.(time.Time)
+	case discordchannel.FieldParentID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEditedTimestamp(v)
+		m.SetParentID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessage field %s", name)
+	return fmt.Errorf("unknown DiscordChannel field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DiscordMessageMutation) AddedFields() []string {
+func (m *DiscordChannelMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DiscordMessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DiscordChannelMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscordMessageMutation) AddField(name string, value ent.Value) error {
+func (m *DiscordChannelMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DiscordMessage numeric field %s", name)
+	return fmt.Errorf("unknown DiscordChannel numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DiscordMessageMutation) ClearedFields() []string {
+func (m *DiscordChannelMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(discordmessage.FieldEditedTimestamp) {
-		fields = append(fields, discordmessage.FieldEditedTimestamp)
+	if m.FieldCleared(discordchannel.FieldParentID) {
+		fields = append(fields, discordchannel.FieldParentID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DiscordMessageMutation) FieldCleared(name string) bool {
+func (m *DiscordChannelMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DiscordMessageMutation) ClearField(name string) error {
+func (m *DiscordChannelMutation) ClearField(name string) error {
 	switch name {
-	case discordmessage.FieldEditedTimestamp:
-		m.ClearEditedTimestamp()
+	case discordchannel.FieldParentID:
+		m.ClearParentID()
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessage nullable field %s", name)
+	return fmt.Errorf("unknown DiscordChannel nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DiscordMessageMutation) ResetField(name string) error {
+func (m *DiscordChannelMutation) ResetField(name string) error {
 	switch name {
-	case discordmessage.FieldContent:
-		m.ResetContent()
-		return nil
-	case discordmessage.FieldAuthorID:
-		m.ResetAuthorID()
+	case discordchannel.FieldGuildID:
+		m.ResetGuildID()
 		return nil
-	case discordmessage.FieldTimestamp:
-		m.ResetTimestamp()
+	case discordchannel.FieldName:
+		m.ResetName()
 		return nil
-	case discordmessage.FieldEditedTimestamp:
-		m.ResetEditedTimestamp()
+	case discordchannel.FieldParentID:
+		m.ResetParentID()
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessage field %s", name)
+	return fmt.Errorf("unknown DiscordChannel field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DiscordMessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, discordmessage.EdgeUser)
-	}
-	if m.embeddings != nil {
-		edges = append(edges, discordmessage.EdgeEmbeddings)
+func (m *DiscordChannelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.mentioned_in != nil {
+		edges = append(edges, discordchannel.EdgeMentionedIn)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DiscordMessageMutation) AddedIDs(name string) []ent.Value {
+func (m *DiscordChannelMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case discordmessage.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case discordmessage.EdgeEmbeddings:
-		ids := make([]ent.Value, 0, len(m.embeddings))
-		for id := range m.embeddings {
+	case discordchannel.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.mentioned_in))
+		for id := range m.mentioned_in {
 			ids = append(ids, id)
 		}
 		return ids
@@ -629,21 +557,21 @@ func (m *DiscordMessageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DiscordMessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedembeddings != nil {
-		edges = append(edges, discordmessage.EdgeEmbeddings)
+func (m *DiscordChannelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmentioned_in != nil {
+		edges = append(edges, discordchannel.EdgeMentionedIn)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DiscordMessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *DiscordChannelMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case discordmessage.EdgeEmbeddings:
-		ids := make([]ent.Value, 0, len(m.removedembeddings))
-		for id := range m.removedembeddings {
+	case discordchannel.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_in))
+		for id := range m.removedmentioned_in {
 			ids = append(ids, id)
 		}
 		return ids
@@ -652,82 +580,69 @@ func (m *DiscordMessageMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DiscordMessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, discordmessage.EdgeUser)
-	}
-	if m.clearedembeddings {
-		edges = append(edges, discordmessage.EdgeEmbeddings)
+func (m *DiscordChannelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmentioned_in {
+		edges = append(edges, discordchannel.EdgeMentionedIn)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DiscordMessageMutation) EdgeCleared(name string) bool {
+func (m *DiscordChannelMutation) EdgeCleared(name string) bool {
 	switch name {
-	case discordmessage.EdgeUser:
-		return m.cleareduser
-	case discordmessage.EdgeEmbeddings:
-		return m.clearedembeddings
+	case discordchannel.EdgeMentionedIn:
+		return m.clearedmentioned_in
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DiscordMessageMutation) ClearEdge(name string) error {
+func (m *DiscordChannelMutation) ClearEdge(name string) error {
 	switch name {
-	case discordmessage.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessage unique edge %s", name)
+	return fmt.Errorf("unknown DiscordChannel unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DiscordMessageMutation) ResetEdge(name string) error {
+func (m *DiscordChannelMutation) ResetEdge(name string) error {
 	switch name {
-	case discordmessage.EdgeUser:
-		m.ResetUser()
-		return nil
-	case discordmessage.EdgeEmbeddings:
-		m.ResetEmbeddings()
+	case discordchannel.EdgeMentionedIn:
+		m.ResetMentionedIn()
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessage edge %s", name)
+	return fmt.Errorf("unknown DiscordChannel edge %s", name)
 }
 
-// DiscordMessageEmbeddingMutation represents an operation that mutates the DiscordMessageEmbedding nodes in the graph.
-type DiscordMessageEmbeddingMutation struct {
+// DiscordChannelSettingMutation represents an operation that mutates the DiscordChannelSetting nodes in the graph.
+type DiscordChannelSettingMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *string
-	model          *string
-	embedding      *pgvector.Vector
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	message        *string
-	clearedmessage bool
-	done           bool
-	oldValue       func(context.Context) (*DiscordMessageEmbedding, error)
-	predicates     []predicate.DiscordMessageEmbedding
+	op              Op
+	typ             string
+	id              *string
+	guild_id        *string
+	mention_replies *bool
+	chime_in        *bool
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*DiscordChannelSetting, error)
+	predicates      []predicate.DiscordChannelSetting
 }
 
-var _ ent.Mutation = (*DiscordMessageEmbeddingMutation)(nil)
+var _ ent.Mutation = (*DiscordChannelSettingMutation)(nil)
 
-// discordmessageembeddingOption allows management of the mutation configuration using functional options.
-type discordmessageembeddingOption func(*DiscordMessageEmbeddingMutation)
+// discordchannelsettingOption allows management of the mutation configuration using functional options.
+type discordchannelsettingOption func(*DiscordChannelSettingMutation)
 
-// newDiscordMessageEmbeddingMutation creates new mutation for the DiscordMessageEmbedding entity.
-func newDiscordMessageEmbeddingMutation(c config, op Op, opts ...discordmessageembeddingOption) *DiscordMessageEmbeddingMutation {
-	m := &DiscordMessageEmbeddingMutation{
+// newDiscordChannelSettingMutation creates new mutation for the DiscordChannelSetting entity.
+func newDiscordChannelSettingMutation(c config, op Op, opts ...discordchannelsettingOption) *DiscordChannelSettingMutation {
+	m := &DiscordChannelSettingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDiscordMessageEmbedding,
+		typ:           TypeDiscordChannelSetting,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -736,20 +651,20 @@ func newDiscordMessageEmbeddingMutation(c config, op Op, opts ...discordmessagee
 	return m
 }
 
-// withDiscordMessageEmbeddingID sets the ID field of the mutation.
-func withDiscordMessageEmbeddingID(id string) discordmessageembeddingOption {
-	return func(m *DiscordMessageEmbeddingMutation) {
+// withDiscordChannelSettingID sets the ID field of the mutation.
+func withDiscordChannelSettingID(id string) discordchannelsettingOption {
+	return func(m *DiscordChannelSettingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DiscordMessageEmbedding
+			value *DiscordChannelSetting
 		)
-		m.oldValue = func(ctx context.Context) (*DiscordMessageEmbedding, error) {
+		m.oldValue = func(ctx context.Context) (*DiscordChannelSetting, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DiscordMessageEmbedding.Get(ctx, id)
+					value, err = m.Client().DiscordChannelSetting.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -758,10 +673,10 @@ func withDiscordMessageEmbeddingID(id string) discordmessageembeddingOption {
 	}
 }
 
-// withDiscordMessageEmbedding sets the old DiscordMessageEmbedding of the mutation.
-func withDiscordMessageEmbedding(node *DiscordMessageEmbedding) discordmessageembeddingOption {
-	return func(m *DiscordMessageEmbeddingMutation) {
-		m.oldValue = func(context.Context) (*DiscordMessageEmbedding, error) {
+// withDiscordChannelSetting sets the old DiscordChannelSetting of the mutation.
+func withDiscordChannelSetting(node *DiscordChannelSetting) discordchannelsettingOption {
+	return func(m *DiscordChannelSettingMutation) {
+		m.oldValue = func(context.Context) (*DiscordChannelSetting, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -770,7 +685,7 @@ func withDiscordMessageEmbedding(node *DiscordMessageEmbedding) discordmessageem
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DiscordMessageEmbeddingMutation) Client() *Client {
+func (m DiscordChannelSettingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -778,7 +693,7 @@ func (m DiscordMessageEmbeddingMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DiscordMessageEmbeddingMutation) Tx() (*Tx, error) {
+func (m DiscordChannelSettingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -788,14 +703,14 @@ func (m DiscordMessageEmbeddingMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DiscordMessageEmbedding entities.
-func (m *DiscordMessageEmbeddingMutation) SetID(id string) {
+// operation is only accepted on creation of DiscordChannelSetting entities.
+func (m *DiscordChannelSettingMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DiscordMessageEmbeddingMutation) ID() (id string, exists bool) {
+func (m *DiscordChannelSettingMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -806,7 +721,7 @@ func (m *DiscordMessageEmbeddingMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DiscordMessageEmbeddingMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DiscordChannelSettingMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -815,192 +730,129 @@ func (m *DiscordMessageEmbeddingMutation) IDs(ctx context.Context) ([]string, er
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DiscordMessageEmbedding.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DiscordChannelSetting.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetMessageID sets the "message_id" field.
-func (m *DiscordMessageEmbeddingMutation) SetMessageID(s string) {
-	m.message = &s
-}
-
-// MessageID returns the value of the "message_id" field in the mutation.
-func (m *DiscordMessageEmbeddingMutation) MessageID() (r string, exists bool) {
-	v := m.message
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldMessageID returns the old "message_id" field's value of the DiscordMessageEmbedding entity.
-// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageEmbeddingMutation) OldMessageID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessageID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
-	}
-	return oldValue.MessageID, nil
-}
-
-// ResetMessageID resets all changes to the "message_id" field.
-func (m *DiscordMessageEmbeddingMutation) ResetMessageID() {
-	m.message = nil
-}
-
-// SetModel sets the "model" field.
-func (m *DiscordMessageEmbeddingMutation) SetModel(s string) {
-	m.model = &s
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordChannelSettingMutation) SetGuildID(s string) {
+	m.guild_id = &s
 }
 
-// Model returns the value of the "model" field in the mutation.
-func (m *DiscordMessageEmbeddingMutation) Model() (r string, exists bool) {
-	v := m.model
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordChannelSettingMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldModel returns the old "model" field's value of the DiscordMessageEmbedding entity.
-// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// OldGuildID returns the old "guild_id" field's value of the DiscordChannelSetting entity.
+// If the DiscordChannelSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageEmbeddingMutation) OldModel(ctx context.Context) (v string, err error) {
+func (m *DiscordChannelSettingMutation) OldGuildID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldModel requires an ID field in the mutation")
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
 	}
-	return oldValue.Model, nil
+	return oldValue.GuildID, nil
 }
 
-// ResetModel resets all changes to the "model" field.
-func (m *DiscordMessageEmbeddingMutation) ResetModel() {
-	m.model = nil
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordChannelSettingMutation) ResetGuildID() {
+	m.guild_id = nil
 }
 
-// SetEmbedding sets the "embedding" field.
-func (m *DiscordMessageEmbeddingMutation) SetEmbedding(pg pgvector.Vector) {
-	m.embedding = &pg
+// SetMentionReplies sets the "mention_replies" field.
+func (m *DiscordChannelSettingMutation) SetMentionReplies(b bool) {
+	m.mention_replies = &b
 }
 
-// Embedding returns the value of the "embedding" field in the mutation.
-func (m *DiscordMessageEmbeddingMutation) Embedding() (r pgvector.Vector, exists bool) {
-	v := m.embedding
+// MentionReplies returns the value of the "mention_replies" field in the mutation.
+func (m *DiscordChannelSettingMutation) MentionReplies() (r bool, exists bool) {
+	v := m.mention_replies
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmbedding returns the old "embedding" field's value of the DiscordMessageEmbedding entity.
-// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// OldMentionReplies returns the old "mention_replies" field's value of the DiscordChannelSetting entity.
+// If the DiscordChannelSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageEmbeddingMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+func (m *DiscordChannelSettingMutation) OldMentionReplies(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+		return v, errors.New("OldMentionReplies is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+		return v, errors.New("OldMentionReplies requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+		return v, fmt.Errorf("querying old value for OldMentionReplies: %w", err)
 	}
-	return oldValue.Embedding, nil
+	return oldValue.MentionReplies, nil
 }
 
-// ResetEmbedding resets all changes to the "embedding" field.
-func (m *DiscordMessageEmbeddingMutation) ResetEmbedding() {
-	m.embedding = nil
+// ResetMentionReplies resets all changes to the "mention_replies" field.
+func (m *DiscordChannelSettingMutation) ResetMentionReplies() {
+	m.mention_replies = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *DiscordMessageEmbeddingMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetChimeIn sets the "chime_in" field.
+func (m *DiscordChannelSettingMutation) SetChimeIn(b bool) {
+	m.chime_in = &b
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *DiscordMessageEmbeddingMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ChimeIn returns the value of the "chime_in" field in the mutation.
+func (m *DiscordChannelSettingMutation) ChimeIn() (r bool, exists bool) {
+	v := m.chime_in
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the DiscordMessageEmbedding entity.
-// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// OldChimeIn returns the old "chime_in" field's value of the DiscordChannelSetting entity.
+// If the DiscordChannelSetting object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordMessageEmbeddingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DiscordChannelSettingMutation) OldChimeIn(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldChimeIn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldChimeIn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *DiscordMessageEmbeddingMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// ClearMessage clears the "message" edge to the DiscordMessage entity.
-func (m *DiscordMessageEmbeddingMutation) ClearMessage() {
-	m.clearedmessage = true
-	m.clearedFields[discordmessageembedding.FieldMessageID] = struct{}{}
-}
-
-// MessageCleared reports if the "message" edge to the DiscordMessage entity was cleared.
-func (m *DiscordMessageEmbeddingMutation) MessageCleared() bool {
-	return m.clearedmessage
-}
-
-// MessageIDs returns the "message" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *DiscordMessageEmbeddingMutation) MessageIDs() (ids []string) {
-	if id := m.message; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldChimeIn: %w", err)
 	}
-	return
+	return oldValue.ChimeIn, nil
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *DiscordMessageEmbeddingMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetChimeIn resets all changes to the "chime_in" field.
+func (m *DiscordChannelSettingMutation) ResetChimeIn() {
+	m.chime_in = nil
 }
 
-// Where appends a list predicates to the DiscordMessageEmbeddingMutation builder.
-func (m *DiscordMessageEmbeddingMutation) Where(ps ...predicate.DiscordMessageEmbedding) {
+// Where appends a list predicates to the DiscordChannelSettingMutation builder.
+func (m *DiscordChannelSettingMutation) Where(ps ...predicate.DiscordChannelSetting) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DiscordMessageEmbeddingMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DiscordChannelSettingMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DiscordMessageEmbeddingMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DiscordMessageEmbedding, len(ps))
+func (m *DiscordChannelSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordChannelSetting, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1008,36 +860,33 @@ func (m *DiscordMessageEmbeddingMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DiscordMessageEmbeddingMutation) Op() Op {
+func (m *DiscordChannelSettingMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DiscordMessageEmbeddingMutation) SetOp(op Op) {
+func (m *DiscordChannelSettingMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (DiscordMessageEmbedding).
-func (m *DiscordMessageEmbeddingMutation) Type() string {
+// Type returns the node type of this mutation (DiscordChannelSetting).
+func (m *DiscordChannelSettingMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DiscordMessageEmbeddingMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.message != nil {
-		fields = append(fields, discordmessageembedding.FieldMessageID)
-	}
-	if m.model != nil {
-		fields = append(fields, discordmessageembedding.FieldModel)
+func (m *DiscordChannelSettingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.guild_id != nil {
+		fields = append(fields, discordchannelsetting.FieldGuildID)
 	}
-	if m.embedding != nil {
-		fields = append(fields, discordmessageembedding.FieldEmbedding)
+	if m.mention_replies != nil {
+		fields = append(fields, discordchannelsetting.FieldMentionReplies)
 	}
-	if m.created_at != nil {
-		fields = append(fields, discordmessageembedding.FieldCreatedAt)
+	if m.chime_in != nil {
+		fields = append(fields, discordchannelsetting.FieldChimeIn)
 	}
 	return fields
 }
@@ -1045,16 +894,14 @@ func (m *DiscordMessageEmbeddingMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DiscordMessageEmbeddingMutation) Field(name string) (ent.Value, bool) {
+func (m *DiscordChannelSettingMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case discordmessageembedding.FieldMessageID:
-		return m.MessageID()
-	case discordmessageembedding.FieldModel:
-		return m.Model()
-	case discordmessageembedding.FieldEmbedding:
-		return m.Embedding()
-	case discordmessageembedding.FieldCreatedAt:
-		return m.CreatedAt()
+	case discordchannelsetting.FieldGuildID:
+		return m.GuildID()
+	case discordchannelsetting.FieldMentionReplies:
+		return m.MentionReplies()
+	case discordchannelsetting.FieldChimeIn:
+		return m.ChimeIn()
 	}
 	return nil, false
 }
@@ -1062,220 +909,186 @@ func (m *DiscordMessageEmbeddingMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DiscordMessageEmbeddingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DiscordChannelSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case discordmessageembedding.FieldMessageID:
-		return m.OldMessageID(ctx)
-	case discordmessageembedding.FieldModel:
-		return m.OldModel(ctx)
-	case discordmessageembedding.FieldEmbedding:
-		return m.OldEmbedding(ctx)
-	case discordmessageembedding.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+	case discordchannelsetting.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordchannelsetting.FieldMentionReplies:
+		return m.OldMentionReplies(ctx)
+	case discordchannelsetting.FieldChimeIn:
+		return m.OldChimeIn(ctx)
 	}
-	return nil, fmt.Errorf("unknown DiscordMessageEmbedding field %s", name)
+	return nil, fmt.Errorf("unknown DiscordChannelSetting field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscordMessageEmbeddingMutation) SetField(name string, value ent.Value) error {
+func (m *DiscordChannelSettingMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case discordmessageembedding.FieldMessageID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessageID(v)
-		return nil
-	case discordmessageembedding.FieldModel:
+	case discordchannelsetting.FieldGuildID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetModel(v)
+		m.SetGuildID(v)
 		return nil
-	case discordmessageembedding.FieldEmbedding:
-		v, ok := value.(pgvector.Vector)
+	case discordchannelsetting.FieldMentionReplies:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmbedding(v)
+		m.SetMentionReplies(v)
 		return nil
-	case discordmessageembedding.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case discordchannelsetting.FieldChimeIn:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetChimeIn(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessageEmbedding field %s", name)
+	return fmt.Errorf("unknown DiscordChannelSetting field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DiscordMessageEmbeddingMutation) AddedFields() []string {
+func (m *DiscordChannelSettingMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DiscordMessageEmbeddingMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DiscordChannelSettingMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscordMessageEmbeddingMutation) AddField(name string, value ent.Value) error {
+func (m *DiscordChannelSettingMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown DiscordMessageEmbedding numeric field %s", name)
+	return fmt.Errorf("unknown DiscordChannelSetting numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DiscordMessageEmbeddingMutation) ClearedFields() []string {
+func (m *DiscordChannelSettingMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DiscordMessageEmbeddingMutation) FieldCleared(name string) bool {
+func (m *DiscordChannelSettingMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DiscordMessageEmbeddingMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DiscordMessageEmbedding nullable field %s", name)
+func (m *DiscordChannelSettingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordChannelSetting nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DiscordMessageEmbeddingMutation) ResetField(name string) error {
+func (m *DiscordChannelSettingMutation) ResetField(name string) error {
 	switch name {
-	case discordmessageembedding.FieldMessageID:
-		m.ResetMessageID()
-		return nil
-	case discordmessageembedding.FieldModel:
-		m.ResetModel()
+	case discordchannelsetting.FieldGuildID:
+		m.ResetGuildID()
 		return nil
-	case discordmessageembedding.FieldEmbedding:
-		m.ResetEmbedding()
+	case discordchannelsetting.FieldMentionReplies:
+		m.ResetMentionReplies()
 		return nil
-	case discordmessageembedding.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case discordchannelsetting.FieldChimeIn:
+		m.ResetChimeIn()
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordMessageEmbedding field %s", name)
+	return fmt.Errorf("unknown DiscordChannelSetting field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DiscordMessageEmbeddingMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.message != nil {
-		edges = append(edges, discordmessageembedding.EdgeMessage)
-	}
+func (m *DiscordChannelSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DiscordMessageEmbeddingMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case discordmessageembedding.EdgeMessage:
-		if id := m.message; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *DiscordChannelSettingMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DiscordMessageEmbeddingMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DiscordChannelSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DiscordMessageEmbeddingMutation) RemovedIDs(name string) []ent.Value {
+func (m *DiscordChannelSettingMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DiscordMessageEmbeddingMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmessage {
-		edges = append(edges, discordmessageembedding.EdgeMessage)
-	}
+func (m *DiscordChannelSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DiscordMessageEmbeddingMutation) EdgeCleared(name string) bool {
-	switch name {
-	case discordmessageembedding.EdgeMessage:
-		return m.clearedmessage
-	}
+func (m *DiscordChannelSettingMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DiscordMessageEmbeddingMutation) ClearEdge(name string) error {
-	switch name {
-	case discordmessageembedding.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	}
-	return fmt.Errorf("unknown DiscordMessageEmbedding unique edge %s", name)
+func (m *DiscordChannelSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DiscordChannelSetting unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DiscordMessageEmbeddingMutation) ResetEdge(name string) error {
-	switch name {
-	case discordmessageembedding.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	}
-	return fmt.Errorf("unknown DiscordMessageEmbedding edge %s", name)
+func (m *DiscordChannelSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DiscordChannelSetting edge %s", name)
 }
 
-// DiscordUserMutation represents an operation that mutates the DiscordUser nodes in the graph.
-type DiscordUserMutation struct {
+// DiscordChimeInMutation represents an operation that mutates the DiscordChimeIn nodes in the graph.
+type DiscordChimeInMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	username        *string
-	global_name     *string
-	clearedFields   map[string]struct{}
-	messages        map[string]struct{}
-	removedmessages map[string]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*DiscordUser, error)
-	predicates      []predicate.DiscordUser
-}
-
-var _ ent.Mutation = (*DiscordUserMutation)(nil)
-
-// discorduserOption allows management of the mutation configuration using functional options.
-type discorduserOption func(*DiscordUserMutation)
-
-// newDiscordUserMutation creates new mutation for the DiscordUser entity.
-func newDiscordUserMutation(c config, op Op, opts ...discorduserOption) *DiscordUserMutation {
-	m := &DiscordUserMutation{
+	op                 Op
+	typ                string
+	id                 *string
+	guild_id           *string
+	channel_id         *string
+	trigger_message_id *string
+	score              *float64
+	addscore           *float64
+	reason             *string
+	reactions          *int
+	addreactions       *int
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*DiscordChimeIn, error)
+	predicates         []predicate.DiscordChimeIn
+}
+
+var _ ent.Mutation = (*DiscordChimeInMutation)(nil)
+
+// discordchimeinOption allows management of the mutation configuration using functional options.
+type discordchimeinOption func(*DiscordChimeInMutation)
+
+// newDiscordChimeInMutation creates new mutation for the DiscordChimeIn entity.
+func newDiscordChimeInMutation(c config, op Op, opts ...discordchimeinOption) *DiscordChimeInMutation {
+	m := &DiscordChimeInMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDiscordUser,
+		typ:           TypeDiscordChimeIn,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1284,20 +1097,20 @@ func newDiscordUserMutation(c config, op Op, opts ...discorduserOption) *Discord
 	return m
 }
 
-// withDiscordUserID sets the ID field of the mutation.
-func withDiscordUserID(id string) discorduserOption {
-	return func(m *DiscordUserMutation) {
+// withDiscordChimeInID sets the ID field of the mutation.
+func withDiscordChimeInID(id string) discordchimeinOption {
+	return func(m *DiscordChimeInMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *DiscordUser
+			value *DiscordChimeIn
 		)
-		m.oldValue = func(ctx context.Context) (*DiscordUser, error) {
+		m.oldValue = func(ctx context.Context) (*DiscordChimeIn, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().DiscordUser.Get(ctx, id)
+					value, err = m.Client().DiscordChimeIn.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1306,10 +1119,10 @@ func withDiscordUserID(id string) discorduserOption {
 	}
 }
 
-// withDiscordUser sets the old DiscordUser of the mutation.
-func withDiscordUser(node *DiscordUser) discorduserOption {
-	return func(m *DiscordUserMutation) {
-		m.oldValue = func(context.Context) (*DiscordUser, error) {
+// withDiscordChimeIn sets the old DiscordChimeIn of the mutation.
+func withDiscordChimeIn(node *DiscordChimeIn) discordchimeinOption {
+	return func(m *DiscordChimeInMutation) {
+		m.oldValue = func(context.Context) (*DiscordChimeIn, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1318,7 +1131,7 @@ func withDiscordUser(node *DiscordUser) discorduserOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DiscordUserMutation) Client() *Client {
+func (m DiscordChimeInMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1326,7 +1139,7 @@ func (m DiscordUserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DiscordUserMutation) Tx() (*Tx, error) {
+func (m DiscordChimeInMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1336,14 +1149,14 @@ func (m DiscordUserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of DiscordUser entities.
-func (m *DiscordUserMutation) SetID(id string) {
+// operation is only accepted on creation of DiscordChimeIn entities.
+func (m *DiscordChimeInMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DiscordUserMutation) ID() (id string, exists bool) {
+func (m *DiscordChimeInMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1354,7 +1167,7 @@ func (m *DiscordUserMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DiscordUserMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *DiscordChimeInMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1363,191 +1176,395 @@ func (m *DiscordUserMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().DiscordUser.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DiscordChimeIn.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUsername sets the "username" field.
-func (m *DiscordUserMutation) SetUsername(s string) {
-	m.username = &s
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordChimeInMutation) SetGuildID(s string) {
+	m.guild_id = &s
 }
 
-// Username returns the value of the "username" field in the mutation.
-func (m *DiscordUserMutation) Username() (r string, exists bool) {
-	v := m.username
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordChimeInMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsername returns the old "username" field's value of the DiscordUser entity.
-// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// OldGuildID returns the old "guild_id" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordUserMutation) OldUsername(ctx context.Context) (v string, err error) {
+func (m *DiscordChimeInMutation) OldGuildID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsername requires an ID field in the mutation")
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
 	}
-	return oldValue.Username, nil
+	return oldValue.GuildID, nil
 }
 
-// ResetUsername resets all changes to the "username" field.
-func (m *DiscordUserMutation) ResetUsername() {
-	m.username = nil
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordChimeInMutation) ResetGuildID() {
+	m.guild_id = nil
 }
 
-// SetGlobalName sets the "global_name" field.
-func (m *DiscordUserMutation) SetGlobalName(s string) {
-	m.global_name = &s
+// SetChannelID sets the "channel_id" field.
+func (m *DiscordChimeInMutation) SetChannelID(s string) {
+	m.channel_id = &s
 }
 
-// GlobalName returns the value of the "global_name" field in the mutation.
-func (m *DiscordUserMutation) GlobalName() (r string, exists bool) {
-	v := m.global_name
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *DiscordChimeInMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGlobalName returns the old "global_name" field's value of the DiscordUser entity.
-// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// OldChannelID returns the old "channel_id" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DiscordUserMutation) OldGlobalName(ctx context.Context) (v string, err error) {
+func (m *DiscordChimeInMutation) OldChannelID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGlobalName is only allowed on UpdateOne operations")
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGlobalName requires an ID field in the mutation")
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGlobalName: %w", err)
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
 	}
-	return oldValue.GlobalName, nil
+	return oldValue.ChannelID, nil
 }
 
-// ResetGlobalName resets all changes to the "global_name" field.
-func (m *DiscordUserMutation) ResetGlobalName() {
-	m.global_name = nil
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *DiscordChimeInMutation) ResetChannelID() {
+	m.channel_id = nil
 }
 
-// AddMessageIDs adds the "messages" edge to the DiscordMessage entity by ids.
-func (m *DiscordUserMutation) AddMessageIDs(ids ...string) {
-	if m.messages == nil {
-		m.messages = make(map[string]struct{})
+// SetTriggerMessageID sets the "trigger_message_id" field.
+func (m *DiscordChimeInMutation) SetTriggerMessageID(s string) {
+	m.trigger_message_id = &s
+}
+
+// TriggerMessageID returns the value of the "trigger_message_id" field in the mutation.
+func (m *DiscordChimeInMutation) TriggerMessageID() (r string, exists bool) {
+	v := m.trigger_message_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldTriggerMessageID returns the old "trigger_message_id" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChimeInMutation) OldTriggerMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerMessageID requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerMessageID: %w", err)
+	}
+	return oldValue.TriggerMessageID, nil
 }
 
-// ClearMessages clears the "messages" edge to the DiscordMessage entity.
-func (m *DiscordUserMutation) ClearMessages() {
-	m.clearedmessages = true
+// ResetTriggerMessageID resets all changes to the "trigger_message_id" field.
+func (m *DiscordChimeInMutation) ResetTriggerMessageID() {
+	m.trigger_message_id = nil
 }
 
-// MessagesCleared reports if the "messages" edge to the DiscordMessage entity was cleared.
-func (m *DiscordUserMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// SetScore sets the "score" field.
+func (m *DiscordChimeInMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
 }
 
-// RemoveMessageIDs removes the "messages" edge to the DiscordMessage entity by IDs.
-func (m *DiscordUserMutation) RemoveMessageIDs(ids ...string) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[string]struct{})
+// Score returns the value of the "score" field in the mutation.
+func (m *DiscordChimeInMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChimeInMutation) OldScore(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
 	}
+	return oldValue.Score, nil
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the DiscordMessage entity.
-func (m *DiscordUserMutation) RemovedMessagesIDs() (ids []string) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// AddScore adds f to the "score" field.
+func (m *DiscordChimeInMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
 	}
-	return
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *DiscordUserMutation) MessagesIDs() (ids []string) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *DiscordChimeInMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *DiscordUserMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetScore resets all changes to the "score" field.
+func (m *DiscordChimeInMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
 }
 
-// Where appends a list predicates to the DiscordUserMutation builder.
-func (m *DiscordUserMutation) Where(ps ...predicate.DiscordUser) {
-	m.predicates = append(m.predicates, ps...)
+// SetReason sets the "reason" field.
+func (m *DiscordChimeInMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// WhereP appends storage-level predicates to the DiscordUserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DiscordUserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.DiscordUser, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// Reason returns the value of the "reason" field in the mutation.
+func (m *DiscordChimeInMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *DiscordUserMutation) Op() Op {
-	return m.op
+// OldReason returns the old "reason" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChimeInMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *DiscordUserMutation) SetOp(op Op) {
-	m.op = op
+// ClearReason clears the value of the "reason" field.
+func (m *DiscordChimeInMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[discordchimein.FieldReason] = struct{}{}
 }
 
-// Type returns the node type of this mutation (DiscordUser).
-func (m *DiscordUserMutation) Type() string {
-	return m.typ
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *DiscordChimeInMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[discordchimein.FieldReason]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *DiscordUserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.username != nil {
-		fields = append(fields, discorduser.FieldUsername)
-	}
-	if m.global_name != nil {
-		fields = append(fields, discorduser.FieldGlobalName)
-	}
+// ResetReason resets all changes to the "reason" field.
+func (m *DiscordChimeInMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, discordchimein.FieldReason)
+}
+
+// SetReactions sets the "reactions" field.
+func (m *DiscordChimeInMutation) SetReactions(i int) {
+	m.reactions = &i
+	m.addreactions = nil
+}
+
+// Reactions returns the value of the "reactions" field in the mutation.
+func (m *DiscordChimeInMutation) Reactions() (r int, exists bool) {
+	v := m.reactions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReactions returns the old "reactions" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChimeInMutation) OldReactions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReactions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReactions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReactions: %w", err)
+	}
+	return oldValue.Reactions, nil
+}
+
+// AddReactions adds i to the "reactions" field.
+func (m *DiscordChimeInMutation) AddReactions(i int) {
+	if m.addreactions != nil {
+		*m.addreactions += i
+	} else {
+		m.addreactions = &i
+	}
+}
+
+// AddedReactions returns the value that was added to the "reactions" field in this mutation.
+func (m *DiscordChimeInMutation) AddedReactions() (r int, exists bool) {
+	v := m.addreactions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReactions resets all changes to the "reactions" field.
+func (m *DiscordChimeInMutation) ResetReactions() {
+	m.reactions = nil
+	m.addreactions = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DiscordChimeInMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DiscordChimeInMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DiscordChimeIn entity.
+// If the DiscordChimeIn object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChimeInMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DiscordChimeInMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the DiscordChimeInMutation builder.
+func (m *DiscordChimeInMutation) Where(ps ...predicate.DiscordChimeIn) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordChimeInMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordChimeInMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordChimeIn, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordChimeInMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordChimeInMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordChimeIn).
+func (m *DiscordChimeInMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordChimeInMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.guild_id != nil {
+		fields = append(fields, discordchimein.FieldGuildID)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, discordchimein.FieldChannelID)
+	}
+	if m.trigger_message_id != nil {
+		fields = append(fields, discordchimein.FieldTriggerMessageID)
+	}
+	if m.score != nil {
+		fields = append(fields, discordchimein.FieldScore)
+	}
+	if m.reason != nil {
+		fields = append(fields, discordchimein.FieldReason)
+	}
+	if m.reactions != nil {
+		fields = append(fields, discordchimein.FieldReactions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, discordchimein.FieldCreatedAt)
+	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DiscordUserMutation) Field(name string) (ent.Value, bool) {
+func (m *DiscordChimeInMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case discorduser.FieldUsername:
-		return m.Username()
-	case discorduser.FieldGlobalName:
-		return m.GlobalName()
+	case discordchimein.FieldGuildID:
+		return m.GuildID()
+	case discordchimein.FieldChannelID:
+		return m.ChannelID()
+	case discordchimein.FieldTriggerMessageID:
+		return m.TriggerMessageID()
+	case discordchimein.FieldScore:
+		return m.Score()
+	case discordchimein.FieldReason:
+		return m.Reason()
+	case discordchimein.FieldReactions:
+		return m.Reactions()
+	case discordchimein.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -1555,174 +1572,11300 @@ func (m *DiscordUserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DiscordUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DiscordChimeInMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case discorduser.FieldUsername:
-		return m.OldUsername(ctx)
-	case discorduser.FieldGlobalName:
-		return m.OldGlobalName(ctx)
+	case discordchimein.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordchimein.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case discordchimein.FieldTriggerMessageID:
+		return m.OldTriggerMessageID(ctx)
+	case discordchimein.FieldScore:
+		return m.OldScore(ctx)
+	case discordchimein.FieldReason:
+		return m.OldReason(ctx)
+	case discordchimein.FieldReactions:
+		return m.OldReactions(ctx)
+	case discordchimein.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown DiscordUser field %s", name)
+	return nil, fmt.Errorf("unknown DiscordChimeIn field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscordUserMutation) SetField(name string, value ent.Value) error {
+func (m *DiscordChimeInMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case discorduser.FieldUsername:
+	case discordchimein.FieldGuildID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsername(v)
+		m.SetGuildID(v)
 		return nil
-	case discorduser.FieldGlobalName:
+	case discordchimein.FieldChannelID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetGlobalName(v)
+		m.SetChannelID(v)
+		return nil
+	case discordchimein.FieldTriggerMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerMessageID(v)
+		return nil
+	case discordchimein.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case discordchimein.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case discordchimein.FieldReactions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReactions(v)
+		return nil
+	case discordchimein.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordUser field %s", name)
+	return fmt.Errorf("unknown DiscordChimeIn field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DiscordUserMutation) AddedFields() []string {
-	return nil
+func (m *DiscordChimeInMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, discordchimein.FieldScore)
+	}
+	if m.addreactions != nil {
+		fields = append(fields, discordchimein.FieldReactions)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DiscordUserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DiscordChimeInMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case discordchimein.FieldScore:
+		return m.AddedScore()
+	case discordchimein.FieldReactions:
+		return m.AddedReactions()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DiscordUserMutation) AddField(name string, value ent.Value) error {
+func (m *DiscordChimeInMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case discordchimein.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	case discordchimein.FieldReactions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReactions(v)
+		return nil
 	}
-	return fmt.Errorf("unknown DiscordUser numeric field %s", name)
+	return fmt.Errorf("unknown DiscordChimeIn numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DiscordUserMutation) ClearedFields() []string {
-	return nil
+func (m *DiscordChimeInMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discordchimein.FieldReason) {
+		fields = append(fields, discordchimein.FieldReason)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DiscordUserMutation) FieldCleared(name string) bool {
+func (m *DiscordChimeInMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DiscordUserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown DiscordUser nullable field %s", name)
+func (m *DiscordChimeInMutation) ClearField(name string) error {
+	switch name {
+	case discordchimein.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordChimeIn nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DiscordUserMutation) ResetField(name string) error {
+func (m *DiscordChimeInMutation) ResetField(name string) error {
 	switch name {
-	case discorduser.FieldUsername:
-		m.ResetUsername()
+	case discordchimein.FieldGuildID:
+		m.ResetGuildID()
 		return nil
-	case discorduser.FieldGlobalName:
-		m.ResetGlobalName()
+	case discordchimein.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case discordchimein.FieldTriggerMessageID:
+		m.ResetTriggerMessageID()
+		return nil
+	case discordchimein.FieldScore:
+		m.ResetScore()
+		return nil
+	case discordchimein.FieldReason:
+		m.ResetReason()
+		return nil
+	case discordchimein.FieldReactions:
+		m.ResetReactions()
+		return nil
+	case discordchimein.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown DiscordUser field %s", name)
+	return fmt.Errorf("unknown DiscordChimeIn field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DiscordUserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.messages != nil {
-		edges = append(edges, discorduser.EdgeMessages)
-	}
+func (m *DiscordChimeInMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DiscordUserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case discorduser.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DiscordChimeInMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DiscordUserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedmessages != nil {
-		edges = append(edges, discorduser.EdgeMessages)
-	}
+func (m *DiscordChimeInMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DiscordUserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case discorduser.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DiscordChimeInMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DiscordUserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmessages {
-		edges = append(edges, discorduser.EdgeMessages)
-	}
+func (m *DiscordChimeInMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DiscordUserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case discorduser.EdgeMessages:
-		return m.clearedmessages
-	}
+func (m *DiscordChimeInMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DiscordUserMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown DiscordUser unique edge %s", name)
+func (m *DiscordChimeInMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DiscordChimeIn unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DiscordUserMutation) ResetEdge(name string) error {
-	switch name {
-	case discorduser.EdgeMessages:
-		m.ResetMessages()
-		return nil
+func (m *DiscordChimeInMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DiscordChimeIn edge %s", name)
+}
+
+// DiscordChunkEmbeddingMutation represents an operation that mutates the DiscordChunkEmbedding nodes in the graph.
+type DiscordChunkEmbeddingMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	model         *string
+	embedding     *pgvector.Vector
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	chunk         *string
+	clearedchunk  bool
+	done          bool
+	oldValue      func(context.Context) (*DiscordChunkEmbedding, error)
+	predicates    []predicate.DiscordChunkEmbedding
+}
+
+var _ ent.Mutation = (*DiscordChunkEmbeddingMutation)(nil)
+
+// discordchunkembeddingOption allows management of the mutation configuration using functional options.
+type discordchunkembeddingOption func(*DiscordChunkEmbeddingMutation)
+
+// newDiscordChunkEmbeddingMutation creates new mutation for the DiscordChunkEmbedding entity.
+func newDiscordChunkEmbeddingMutation(c config, op Op, opts ...discordchunkembeddingOption) *DiscordChunkEmbeddingMutation {
+	m := &DiscordChunkEmbeddingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordChunkEmbedding,
+		clearedFields: make(map[string]struct{}),
 	}
-	return fmt.Errorf("unknown DiscordUser edge %s", name)
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordChunkEmbeddingID sets the ID field of the mutation.
+func withDiscordChunkEmbeddingID(id string) discordchunkembeddingOption {
+	return func(m *DiscordChunkEmbeddingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordChunkEmbedding
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordChunkEmbedding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordChunkEmbedding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordChunkEmbedding sets the old DiscordChunkEmbedding of the mutation.
+func withDiscordChunkEmbedding(node *DiscordChunkEmbedding) discordchunkembeddingOption {
+	return func(m *DiscordChunkEmbeddingMutation) {
+		m.oldValue = func(context.Context) (*DiscordChunkEmbedding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordChunkEmbeddingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordChunkEmbeddingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordChunkEmbedding entities.
+func (m *DiscordChunkEmbeddingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordChunkEmbeddingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordChunkEmbeddingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordChunkEmbedding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChunkID sets the "chunk_id" field.
+func (m *DiscordChunkEmbeddingMutation) SetChunkID(s string) {
+	m.chunk = &s
+}
+
+// ChunkID returns the value of the "chunk_id" field in the mutation.
+func (m *DiscordChunkEmbeddingMutation) ChunkID() (r string, exists bool) {
+	v := m.chunk
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChunkID returns the old "chunk_id" field's value of the DiscordChunkEmbedding entity.
+// If the DiscordChunkEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChunkEmbeddingMutation) OldChunkID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChunkID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChunkID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChunkID: %w", err)
+	}
+	return oldValue.ChunkID, nil
+}
+
+// ResetChunkID resets all changes to the "chunk_id" field.
+func (m *DiscordChunkEmbeddingMutation) ResetChunkID() {
+	m.chunk = nil
+}
+
+// SetModel sets the "model" field.
+func (m *DiscordChunkEmbeddingMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *DiscordChunkEmbeddingMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the DiscordChunkEmbedding entity.
+// If the DiscordChunkEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChunkEmbeddingMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *DiscordChunkEmbeddingMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *DiscordChunkEmbeddingMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *DiscordChunkEmbeddingMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the DiscordChunkEmbedding entity.
+// If the DiscordChunkEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChunkEmbeddingMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *DiscordChunkEmbeddingMutation) ResetEmbedding() {
+	m.embedding = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DiscordChunkEmbeddingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DiscordChunkEmbeddingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DiscordChunkEmbedding entity.
+// If the DiscordChunkEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordChunkEmbeddingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DiscordChunkEmbeddingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearChunk clears the "chunk" edge to the DiscordMessageChunk entity.
+func (m *DiscordChunkEmbeddingMutation) ClearChunk() {
+	m.clearedchunk = true
+	m.clearedFields[discordchunkembedding.FieldChunkID] = struct{}{}
+}
+
+// ChunkCleared reports if the "chunk" edge to the DiscordMessageChunk entity was cleared.
+func (m *DiscordChunkEmbeddingMutation) ChunkCleared() bool {
+	return m.clearedchunk
+}
+
+// ChunkIDs returns the "chunk" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChunkID instead. It exists only for internal usage by the builders.
+func (m *DiscordChunkEmbeddingMutation) ChunkIDs() (ids []string) {
+	if id := m.chunk; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChunk resets all changes to the "chunk" edge.
+func (m *DiscordChunkEmbeddingMutation) ResetChunk() {
+	m.chunk = nil
+	m.clearedchunk = false
+}
+
+// Where appends a list predicates to the DiscordChunkEmbeddingMutation builder.
+func (m *DiscordChunkEmbeddingMutation) Where(ps ...predicate.DiscordChunkEmbedding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordChunkEmbeddingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordChunkEmbeddingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordChunkEmbedding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordChunkEmbeddingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordChunkEmbeddingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordChunkEmbedding).
+func (m *DiscordChunkEmbeddingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordChunkEmbeddingMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.chunk != nil {
+		fields = append(fields, discordchunkembedding.FieldChunkID)
+	}
+	if m.model != nil {
+		fields = append(fields, discordchunkembedding.FieldModel)
+	}
+	if m.embedding != nil {
+		fields = append(fields, discordchunkembedding.FieldEmbedding)
+	}
+	if m.created_at != nil {
+		fields = append(fields, discordchunkembedding.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordChunkEmbeddingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordchunkembedding.FieldChunkID:
+		return m.ChunkID()
+	case discordchunkembedding.FieldModel:
+		return m.Model()
+	case discordchunkembedding.FieldEmbedding:
+		return m.Embedding()
+	case discordchunkembedding.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordChunkEmbeddingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordchunkembedding.FieldChunkID:
+		return m.OldChunkID(ctx)
+	case discordchunkembedding.FieldModel:
+		return m.OldModel(ctx)
+	case discordchunkembedding.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case discordchunkembedding.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordChunkEmbedding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordChunkEmbeddingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordchunkembedding.FieldChunkID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChunkID(v)
+		return nil
+	case discordchunkembedding.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case discordchunkembedding.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case discordchunkembedding.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordChunkEmbedding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordChunkEmbeddingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordChunkEmbeddingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordChunkEmbeddingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordChunkEmbedding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordChunkEmbeddingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordChunkEmbeddingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordChunkEmbeddingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordChunkEmbedding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordChunkEmbeddingMutation) ResetField(name string) error {
+	switch name {
+	case discordchunkembedding.FieldChunkID:
+		m.ResetChunkID()
+		return nil
+	case discordchunkembedding.FieldModel:
+		m.ResetModel()
+		return nil
+	case discordchunkembedding.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case discordchunkembedding.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordChunkEmbedding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordChunkEmbeddingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.chunk != nil {
+		edges = append(edges, discordchunkembedding.EdgeChunk)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordChunkEmbeddingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordchunkembedding.EdgeChunk:
+		if id := m.chunk; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordChunkEmbeddingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordChunkEmbeddingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordChunkEmbeddingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedchunk {
+		edges = append(edges, discordchunkembedding.EdgeChunk)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordChunkEmbeddingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordchunkembedding.EdgeChunk:
+		return m.clearedchunk
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordChunkEmbeddingMutation) ClearEdge(name string) error {
+	switch name {
+	case discordchunkembedding.EdgeChunk:
+		m.ClearChunk()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordChunkEmbedding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordChunkEmbeddingMutation) ResetEdge(name string) error {
+	switch name {
+	case discordchunkembedding.EdgeChunk:
+		m.ResetChunk()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordChunkEmbedding edge %s", name)
+}
+
+// DiscordDirectMessageMutation represents an operation that mutates the DiscordDirectMessage nodes in the graph.
+type DiscordDirectMessageMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	channel_id    *string
+	from_bot      *bool
+	content       *string
+	timestamp     *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*DiscordDirectMessage, error)
+	predicates    []predicate.DiscordDirectMessage
+}
+
+var _ ent.Mutation = (*DiscordDirectMessageMutation)(nil)
+
+// discorddirectmessageOption allows management of the mutation configuration using functional options.
+type discorddirectmessageOption func(*DiscordDirectMessageMutation)
+
+// newDiscordDirectMessageMutation creates new mutation for the DiscordDirectMessage entity.
+func newDiscordDirectMessageMutation(c config, op Op, opts ...discorddirectmessageOption) *DiscordDirectMessageMutation {
+	m := &DiscordDirectMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordDirectMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordDirectMessageID sets the ID field of the mutation.
+func withDiscordDirectMessageID(id string) discorddirectmessageOption {
+	return func(m *DiscordDirectMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordDirectMessage
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordDirectMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordDirectMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordDirectMessage sets the old DiscordDirectMessage of the mutation.
+func withDiscordDirectMessage(node *DiscordDirectMessage) discorddirectmessageOption {
+	return func(m *DiscordDirectMessageMutation) {
+		m.oldValue = func(context.Context) (*DiscordDirectMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordDirectMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordDirectMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordDirectMessage entities.
+func (m *DiscordDirectMessageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordDirectMessageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordDirectMessageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordDirectMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DiscordDirectMessageMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DiscordDirectMessageMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DiscordDirectMessage entity.
+// If the DiscordDirectMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordDirectMessageMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DiscordDirectMessageMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetChannelID sets the "channel_id" field.
+func (m *DiscordDirectMessageMutation) SetChannelID(s string) {
+	m.channel_id = &s
+}
+
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *DiscordDirectMessageMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelID returns the old "channel_id" field's value of the DiscordDirectMessage entity.
+// If the DiscordDirectMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordDirectMessageMutation) OldChannelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *DiscordDirectMessageMutation) ResetChannelID() {
+	m.channel_id = nil
+}
+
+// SetFromBot sets the "from_bot" field.
+func (m *DiscordDirectMessageMutation) SetFromBot(b bool) {
+	m.from_bot = &b
+}
+
+// FromBot returns the value of the "from_bot" field in the mutation.
+func (m *DiscordDirectMessageMutation) FromBot() (r bool, exists bool) {
+	v := m.from_bot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromBot returns the old "from_bot" field's value of the DiscordDirectMessage entity.
+// If the DiscordDirectMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordDirectMessageMutation) OldFromBot(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromBot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromBot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromBot: %w", err)
+	}
+	return oldValue.FromBot, nil
+}
+
+// ResetFromBot resets all changes to the "from_bot" field.
+func (m *DiscordDirectMessageMutation) ResetFromBot() {
+	m.from_bot = nil
+}
+
+// SetContent sets the "content" field.
+func (m *DiscordDirectMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *DiscordDirectMessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the DiscordDirectMessage entity.
+// If the DiscordDirectMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordDirectMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *DiscordDirectMessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *DiscordDirectMessageMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *DiscordDirectMessageMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the DiscordDirectMessage entity.
+// If the DiscordDirectMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordDirectMessageMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *DiscordDirectMessageMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// ClearUser clears the "user" edge to the DiscordUser entity.
+func (m *DiscordDirectMessageMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[discorddirectmessage.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the DiscordUser entity was cleared.
+func (m *DiscordDirectMessageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DiscordDirectMessageMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DiscordDirectMessageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the DiscordDirectMessageMutation builder.
+func (m *DiscordDirectMessageMutation) Where(ps ...predicate.DiscordDirectMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordDirectMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordDirectMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordDirectMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordDirectMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordDirectMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordDirectMessage).
+func (m *DiscordDirectMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordDirectMessageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, discorddirectmessage.FieldUserID)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, discorddirectmessage.FieldChannelID)
+	}
+	if m.from_bot != nil {
+		fields = append(fields, discorddirectmessage.FieldFromBot)
+	}
+	if m.content != nil {
+		fields = append(fields, discorddirectmessage.FieldContent)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, discorddirectmessage.FieldTimestamp)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordDirectMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discorddirectmessage.FieldUserID:
+		return m.UserID()
+	case discorddirectmessage.FieldChannelID:
+		return m.ChannelID()
+	case discorddirectmessage.FieldFromBot:
+		return m.FromBot()
+	case discorddirectmessage.FieldContent:
+		return m.Content()
+	case discorddirectmessage.FieldTimestamp:
+		return m.Timestamp()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordDirectMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discorddirectmessage.FieldUserID:
+		return m.OldUserID(ctx)
+	case discorddirectmessage.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case discorddirectmessage.FieldFromBot:
+		return m.OldFromBot(ctx)
+	case discorddirectmessage.FieldContent:
+		return m.OldContent(ctx)
+	case discorddirectmessage.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordDirectMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordDirectMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discorddirectmessage.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case discorddirectmessage.FieldChannelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case discorddirectmessage.FieldFromBot:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromBot(v)
+		return nil
+	case discorddirectmessage.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case discorddirectmessage.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordDirectMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordDirectMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordDirectMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordDirectMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordDirectMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordDirectMessageMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordDirectMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordDirectMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordDirectMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordDirectMessageMutation) ResetField(name string) error {
+	switch name {
+	case discorddirectmessage.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case discorddirectmessage.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case discorddirectmessage.FieldFromBot:
+		m.ResetFromBot()
+		return nil
+	case discorddirectmessage.FieldContent:
+		m.ResetContent()
+		return nil
+	case discorddirectmessage.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordDirectMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordDirectMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, discorddirectmessage.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordDirectMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discorddirectmessage.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordDirectMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordDirectMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordDirectMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, discorddirectmessage.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordDirectMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discorddirectmessage.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordDirectMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case discorddirectmessage.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordDirectMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordDirectMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case discorddirectmessage.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordDirectMessage edge %s", name)
+}
+
+// DiscordGuildMemberMutation represents an operation that mutates the DiscordGuildMember nodes in the graph.
+type DiscordGuildMemberMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	nickname      *string
+	roles         *[]string
+	appendroles   []string
+	joined_at     *time.Time
+	left_at       *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*DiscordGuildMember, error)
+	predicates    []predicate.DiscordGuildMember
+}
+
+var _ ent.Mutation = (*DiscordGuildMemberMutation)(nil)
+
+// discordguildmemberOption allows management of the mutation configuration using functional options.
+type discordguildmemberOption func(*DiscordGuildMemberMutation)
+
+// newDiscordGuildMemberMutation creates new mutation for the DiscordGuildMember entity.
+func newDiscordGuildMemberMutation(c config, op Op, opts ...discordguildmemberOption) *DiscordGuildMemberMutation {
+	m := &DiscordGuildMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordGuildMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordGuildMemberID sets the ID field of the mutation.
+func withDiscordGuildMemberID(id int) discordguildmemberOption {
+	return func(m *DiscordGuildMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordGuildMember
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordGuildMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordGuildMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordGuildMember sets the old DiscordGuildMember of the mutation.
+func withDiscordGuildMember(node *DiscordGuildMember) discordguildmemberOption {
+	return func(m *DiscordGuildMemberMutation) {
+		m.oldValue = func(context.Context) (*DiscordGuildMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordGuildMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordGuildMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordGuildMemberMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordGuildMemberMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordGuildMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordGuildMemberMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordGuildMemberMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the DiscordGuildMember entity.
+// If the DiscordGuildMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildMemberMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordGuildMemberMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DiscordGuildMemberMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DiscordGuildMemberMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DiscordGuildMember entity.
+// If the DiscordGuildMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildMemberMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DiscordGuildMemberMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetNickname sets the "nickname" field.
+func (m *DiscordGuildMemberMutation) SetNickname(s string) {
+	m.nickname = &s
+}
+
+// Nickname returns the value of the "nickname" field in the mutation.
+func (m *DiscordGuildMemberMutation) Nickname() (r string, exists bool) {
+	v := m.nickname
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickname returns the old "nickname" field's value of the DiscordGuildMember entity.
+// If the DiscordGuildMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildMemberMutation) OldNickname(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickname is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickname requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickname: %w", err)
+	}
+	return oldValue.Nickname, nil
+}
+
+// ClearNickname clears the value of the "nickname" field.
+func (m *DiscordGuildMemberMutation) ClearNickname() {
+	m.nickname = nil
+	m.clearedFields[discordguildmember.FieldNickname] = struct{}{}
+}
+
+// NicknameCleared returns if the "nickname" field was cleared in this mutation.
+func (m *DiscordGuildMemberMutation) NicknameCleared() bool {
+	_, ok := m.clearedFields[discordguildmember.FieldNickname]
+	return ok
+}
+
+// ResetNickname resets all changes to the "nickname" field.
+func (m *DiscordGuildMemberMutation) ResetNickname() {
+	m.nickname = nil
+	delete(m.clearedFields, discordguildmember.FieldNickname)
+}
+
+// SetRoles sets the "roles" field.
+func (m *DiscordGuildMemberMutation) SetRoles(s []string) {
+	m.roles = &s
+	m.appendroles = nil
+}
+
+// Roles returns the value of the "roles" field in the mutation.
+func (m *DiscordGuildMemberMutation) Roles() (r []string, exists bool) {
+	v := m.roles
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoles returns the old "roles" field's value of the DiscordGuildMember entity.
+// If the DiscordGuildMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildMemberMutation) OldRoles(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoles is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoles requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoles: %w", err)
+	}
+	return oldValue.Roles, nil
+}
+
+// AppendRoles adds s to the "roles" field.
+func (m *DiscordGuildMemberMutation) AppendRoles(s []string) {
+	m.appendroles = append(m.appendroles, s...)
+}
+
+// AppendedRoles returns the list of values that were appended to the "roles" field in this mutation.
+func (m *DiscordGuildMemberMutation) AppendedRoles() ([]string, bool) {
+	if len(m.appendroles) == 0 {
+		return nil, false
+	}
+	return m.appendroles, true
+}
+
+// ClearRoles clears the value of the "roles" field.
+func (m *DiscordGuildMemberMutation) ClearRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	m.clearedFields[discordguildmember.FieldRoles] = struct{}{}
+}
+
+// RolesCleared returns if the "roles" field was cleared in this mutation.
+func (m *DiscordGuildMemberMutation) RolesCleared() bool {
+	_, ok := m.clearedFields[discordguildmember.FieldRoles]
+	return ok
+}
+
+// ResetRoles resets all changes to the "roles" field.
+func (m *DiscordGuildMemberMutation) ResetRoles() {
+	m.roles = nil
+	m.appendroles = nil
+	delete(m.clearedFields, discordguildmember.FieldRoles)
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *DiscordGuildMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *DiscordGuildMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the DiscordGuildMember entity.
+// If the DiscordGuildMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ClearJoinedAt clears the value of the "joined_at" field.
+func (m *DiscordGuildMemberMutation) ClearJoinedAt() {
+	m.joined_at = nil
+	m.clearedFields[discordguildmember.FieldJoinedAt] = struct{}{}
+}
+
+// JoinedAtCleared returns if the "joined_at" field was cleared in this mutation.
+func (m *DiscordGuildMemberMutation) JoinedAtCleared() bool {
+	_, ok := m.clearedFields[discordguildmember.FieldJoinedAt]
+	return ok
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *DiscordGuildMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
+	delete(m.clearedFields, discordguildmember.FieldJoinedAt)
+}
+
+// SetLeftAt sets the "left_at" field.
+func (m *DiscordGuildMemberMutation) SetLeftAt(t time.Time) {
+	m.left_at = &t
+}
+
+// LeftAt returns the value of the "left_at" field in the mutation.
+func (m *DiscordGuildMemberMutation) LeftAt() (r time.Time, exists bool) {
+	v := m.left_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeftAt returns the old "left_at" field's value of the DiscordGuildMember entity.
+// If the DiscordGuildMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildMemberMutation) OldLeftAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeftAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeftAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeftAt: %w", err)
+	}
+	return oldValue.LeftAt, nil
+}
+
+// ClearLeftAt clears the value of the "left_at" field.
+func (m *DiscordGuildMemberMutation) ClearLeftAt() {
+	m.left_at = nil
+	m.clearedFields[discordguildmember.FieldLeftAt] = struct{}{}
+}
+
+// LeftAtCleared returns if the "left_at" field was cleared in this mutation.
+func (m *DiscordGuildMemberMutation) LeftAtCleared() bool {
+	_, ok := m.clearedFields[discordguildmember.FieldLeftAt]
+	return ok
+}
+
+// ResetLeftAt resets all changes to the "left_at" field.
+func (m *DiscordGuildMemberMutation) ResetLeftAt() {
+	m.left_at = nil
+	delete(m.clearedFields, discordguildmember.FieldLeftAt)
+}
+
+// ClearUser clears the "user" edge to the DiscordUser entity.
+func (m *DiscordGuildMemberMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[discordguildmember.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the DiscordUser entity was cleared.
+func (m *DiscordGuildMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DiscordGuildMemberMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DiscordGuildMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the DiscordGuildMemberMutation builder.
+func (m *DiscordGuildMemberMutation) Where(ps ...predicate.DiscordGuildMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordGuildMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordGuildMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordGuildMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordGuildMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordGuildMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordGuildMember).
+func (m *DiscordGuildMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordGuildMemberMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.guild_id != nil {
+		fields = append(fields, discordguildmember.FieldGuildID)
+	}
+	if m.user != nil {
+		fields = append(fields, discordguildmember.FieldUserID)
+	}
+	if m.nickname != nil {
+		fields = append(fields, discordguildmember.FieldNickname)
+	}
+	if m.roles != nil {
+		fields = append(fields, discordguildmember.FieldRoles)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, discordguildmember.FieldJoinedAt)
+	}
+	if m.left_at != nil {
+		fields = append(fields, discordguildmember.FieldLeftAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordGuildMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordguildmember.FieldGuildID:
+		return m.GuildID()
+	case discordguildmember.FieldUserID:
+		return m.UserID()
+	case discordguildmember.FieldNickname:
+		return m.Nickname()
+	case discordguildmember.FieldRoles:
+		return m.Roles()
+	case discordguildmember.FieldJoinedAt:
+		return m.JoinedAt()
+	case discordguildmember.FieldLeftAt:
+		return m.LeftAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordGuildMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordguildmember.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordguildmember.FieldUserID:
+		return m.OldUserID(ctx)
+	case discordguildmember.FieldNickname:
+		return m.OldNickname(ctx)
+	case discordguildmember.FieldRoles:
+		return m.OldRoles(ctx)
+	case discordguildmember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case discordguildmember.FieldLeftAt:
+		return m.OldLeftAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordGuildMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordGuildMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordguildmember.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case discordguildmember.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case discordguildmember.FieldNickname:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickname(v)
+		return nil
+	case discordguildmember.FieldRoles:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoles(v)
+		return nil
+	case discordguildmember.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case discordguildmember.FieldLeftAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeftAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordGuildMemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordGuildMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordGuildMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordGuildMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordGuildMemberMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discordguildmember.FieldNickname) {
+		fields = append(fields, discordguildmember.FieldNickname)
+	}
+	if m.FieldCleared(discordguildmember.FieldRoles) {
+		fields = append(fields, discordguildmember.FieldRoles)
+	}
+	if m.FieldCleared(discordguildmember.FieldJoinedAt) {
+		fields = append(fields, discordguildmember.FieldJoinedAt)
+	}
+	if m.FieldCleared(discordguildmember.FieldLeftAt) {
+		fields = append(fields, discordguildmember.FieldLeftAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordGuildMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordGuildMemberMutation) ClearField(name string) error {
+	switch name {
+	case discordguildmember.FieldNickname:
+		m.ClearNickname()
+		return nil
+	case discordguildmember.FieldRoles:
+		m.ClearRoles()
+		return nil
+	case discordguildmember.FieldJoinedAt:
+		m.ClearJoinedAt()
+		return nil
+	case discordguildmember.FieldLeftAt:
+		m.ClearLeftAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordGuildMemberMutation) ResetField(name string) error {
+	switch name {
+	case discordguildmember.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case discordguildmember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case discordguildmember.FieldNickname:
+		m.ResetNickname()
+		return nil
+	case discordguildmember.FieldRoles:
+		m.ResetRoles()
+		return nil
+	case discordguildmember.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case discordguildmember.FieldLeftAt:
+		m.ResetLeftAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordGuildMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, discordguildmember.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordGuildMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordguildmember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordGuildMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordGuildMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordGuildMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, discordguildmember.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordGuildMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordguildmember.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordGuildMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case discordguildmember.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordGuildMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case discordguildmember.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildMember edge %s", name)
+}
+
+// DiscordGuildSettingMutation represents an operation that mutates the DiscordGuildSetting nodes in the graph.
+type DiscordGuildSettingMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *string
+	chime_in                      *bool
+	chime_in_probability          *float64
+	addchime_in_probability       *float64
+	chime_in_threshold            *float64
+	addchime_in_threshold         *float64
+	chime_in_cooldown             *int
+	addchime_in_cooldown          *int
+	moderation_denylist           *[]string
+	appendmoderation_denylist     []string
+	moderation_denylist_action    *discordguildsetting.ModerationDenylistAction
+	moderation_redact             *bool
+	moderation_classifier         *bool
+	moderation_flag_threshold     *float64
+	addmoderation_flag_threshold  *float64
+	moderation_block_threshold    *float64
+	addmoderation_block_threshold *float64
+	ingest_allowlist              *[]string
+	appendingest_allowlist        []string
+	ingest_denylist               *[]string
+	appendingest_denylist         []string
+	retrieval_denylist            *[]string
+	appendretrieval_denylist      []string
+	restricted_channels           *[]string
+	appendrestricted_channels     []string
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*DiscordGuildSetting, error)
+	predicates                    []predicate.DiscordGuildSetting
+}
+
+var _ ent.Mutation = (*DiscordGuildSettingMutation)(nil)
+
+// discordguildsettingOption allows management of the mutation configuration using functional options.
+type discordguildsettingOption func(*DiscordGuildSettingMutation)
+
+// newDiscordGuildSettingMutation creates new mutation for the DiscordGuildSetting entity.
+func newDiscordGuildSettingMutation(c config, op Op, opts ...discordguildsettingOption) *DiscordGuildSettingMutation {
+	m := &DiscordGuildSettingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordGuildSetting,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordGuildSettingID sets the ID field of the mutation.
+func withDiscordGuildSettingID(id string) discordguildsettingOption {
+	return func(m *DiscordGuildSettingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordGuildSetting
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordGuildSetting, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordGuildSetting.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordGuildSetting sets the old DiscordGuildSetting of the mutation.
+func withDiscordGuildSetting(node *DiscordGuildSetting) discordguildsettingOption {
+	return func(m *DiscordGuildSettingMutation) {
+		m.oldValue = func(context.Context) (*DiscordGuildSetting, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordGuildSettingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordGuildSettingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordGuildSetting entities.
+func (m *DiscordGuildSettingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordGuildSettingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordGuildSettingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordGuildSetting.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChimeIn sets the "chime_in" field.
+func (m *DiscordGuildSettingMutation) SetChimeIn(b bool) {
+	m.chime_in = &b
+}
+
+// ChimeIn returns the value of the "chime_in" field in the mutation.
+func (m *DiscordGuildSettingMutation) ChimeIn() (r bool, exists bool) {
+	v := m.chime_in
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChimeIn returns the old "chime_in" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldChimeIn(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChimeIn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChimeIn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChimeIn: %w", err)
+	}
+	return oldValue.ChimeIn, nil
+}
+
+// ResetChimeIn resets all changes to the "chime_in" field.
+func (m *DiscordGuildSettingMutation) ResetChimeIn() {
+	m.chime_in = nil
+}
+
+// SetChimeInProbability sets the "chime_in_probability" field.
+func (m *DiscordGuildSettingMutation) SetChimeInProbability(f float64) {
+	m.chime_in_probability = &f
+	m.addchime_in_probability = nil
+}
+
+// ChimeInProbability returns the value of the "chime_in_probability" field in the mutation.
+func (m *DiscordGuildSettingMutation) ChimeInProbability() (r float64, exists bool) {
+	v := m.chime_in_probability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChimeInProbability returns the old "chime_in_probability" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldChimeInProbability(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChimeInProbability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChimeInProbability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChimeInProbability: %w", err)
+	}
+	return oldValue.ChimeInProbability, nil
+}
+
+// AddChimeInProbability adds f to the "chime_in_probability" field.
+func (m *DiscordGuildSettingMutation) AddChimeInProbability(f float64) {
+	if m.addchime_in_probability != nil {
+		*m.addchime_in_probability += f
+	} else {
+		m.addchime_in_probability = &f
+	}
+}
+
+// AddedChimeInProbability returns the value that was added to the "chime_in_probability" field in this mutation.
+func (m *DiscordGuildSettingMutation) AddedChimeInProbability() (r float64, exists bool) {
+	v := m.addchime_in_probability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChimeInProbability resets all changes to the "chime_in_probability" field.
+func (m *DiscordGuildSettingMutation) ResetChimeInProbability() {
+	m.chime_in_probability = nil
+	m.addchime_in_probability = nil
+}
+
+// SetChimeInThreshold sets the "chime_in_threshold" field.
+func (m *DiscordGuildSettingMutation) SetChimeInThreshold(f float64) {
+	m.chime_in_threshold = &f
+	m.addchime_in_threshold = nil
+}
+
+// ChimeInThreshold returns the value of the "chime_in_threshold" field in the mutation.
+func (m *DiscordGuildSettingMutation) ChimeInThreshold() (r float64, exists bool) {
+	v := m.chime_in_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChimeInThreshold returns the old "chime_in_threshold" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldChimeInThreshold(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChimeInThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChimeInThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChimeInThreshold: %w", err)
+	}
+	return oldValue.ChimeInThreshold, nil
+}
+
+// AddChimeInThreshold adds f to the "chime_in_threshold" field.
+func (m *DiscordGuildSettingMutation) AddChimeInThreshold(f float64) {
+	if m.addchime_in_threshold != nil {
+		*m.addchime_in_threshold += f
+	} else {
+		m.addchime_in_threshold = &f
+	}
+}
+
+// AddedChimeInThreshold returns the value that was added to the "chime_in_threshold" field in this mutation.
+func (m *DiscordGuildSettingMutation) AddedChimeInThreshold() (r float64, exists bool) {
+	v := m.addchime_in_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChimeInThreshold resets all changes to the "chime_in_threshold" field.
+func (m *DiscordGuildSettingMutation) ResetChimeInThreshold() {
+	m.chime_in_threshold = nil
+	m.addchime_in_threshold = nil
+}
+
+// SetChimeInCooldown sets the "chime_in_cooldown" field.
+func (m *DiscordGuildSettingMutation) SetChimeInCooldown(i int) {
+	m.chime_in_cooldown = &i
+	m.addchime_in_cooldown = nil
+}
+
+// ChimeInCooldown returns the value of the "chime_in_cooldown" field in the mutation.
+func (m *DiscordGuildSettingMutation) ChimeInCooldown() (r int, exists bool) {
+	v := m.chime_in_cooldown
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChimeInCooldown returns the old "chime_in_cooldown" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldChimeInCooldown(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChimeInCooldown is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChimeInCooldown requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChimeInCooldown: %w", err)
+	}
+	return oldValue.ChimeInCooldown, nil
+}
+
+// AddChimeInCooldown adds i to the "chime_in_cooldown" field.
+func (m *DiscordGuildSettingMutation) AddChimeInCooldown(i int) {
+	if m.addchime_in_cooldown != nil {
+		*m.addchime_in_cooldown += i
+	} else {
+		m.addchime_in_cooldown = &i
+	}
+}
+
+// AddedChimeInCooldown returns the value that was added to the "chime_in_cooldown" field in this mutation.
+func (m *DiscordGuildSettingMutation) AddedChimeInCooldown() (r int, exists bool) {
+	v := m.addchime_in_cooldown
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChimeInCooldown resets all changes to the "chime_in_cooldown" field.
+func (m *DiscordGuildSettingMutation) ResetChimeInCooldown() {
+	m.chime_in_cooldown = nil
+	m.addchime_in_cooldown = nil
+}
+
+// SetModerationDenylist sets the "moderation_denylist" field.
+func (m *DiscordGuildSettingMutation) SetModerationDenylist(s []string) {
+	m.moderation_denylist = &s
+	m.appendmoderation_denylist = nil
+}
+
+// ModerationDenylist returns the value of the "moderation_denylist" field in the mutation.
+func (m *DiscordGuildSettingMutation) ModerationDenylist() (r []string, exists bool) {
+	v := m.moderation_denylist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationDenylist returns the old "moderation_denylist" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldModerationDenylist(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationDenylist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationDenylist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationDenylist: %w", err)
+	}
+	return oldValue.ModerationDenylist, nil
+}
+
+// AppendModerationDenylist adds s to the "moderation_denylist" field.
+func (m *DiscordGuildSettingMutation) AppendModerationDenylist(s []string) {
+	m.appendmoderation_denylist = append(m.appendmoderation_denylist, s...)
+}
+
+// AppendedModerationDenylist returns the list of values that were appended to the "moderation_denylist" field in this mutation.
+func (m *DiscordGuildSettingMutation) AppendedModerationDenylist() ([]string, bool) {
+	if len(m.appendmoderation_denylist) == 0 {
+		return nil, false
+	}
+	return m.appendmoderation_denylist, true
+}
+
+// ClearModerationDenylist clears the value of the "moderation_denylist" field.
+func (m *DiscordGuildSettingMutation) ClearModerationDenylist() {
+	m.moderation_denylist = nil
+	m.appendmoderation_denylist = nil
+	m.clearedFields[discordguildsetting.FieldModerationDenylist] = struct{}{}
+}
+
+// ModerationDenylistCleared returns if the "moderation_denylist" field was cleared in this mutation.
+func (m *DiscordGuildSettingMutation) ModerationDenylistCleared() bool {
+	_, ok := m.clearedFields[discordguildsetting.FieldModerationDenylist]
+	return ok
+}
+
+// ResetModerationDenylist resets all changes to the "moderation_denylist" field.
+func (m *DiscordGuildSettingMutation) ResetModerationDenylist() {
+	m.moderation_denylist = nil
+	m.appendmoderation_denylist = nil
+	delete(m.clearedFields, discordguildsetting.FieldModerationDenylist)
+}
+
+// SetModerationDenylistAction sets the "moderation_denylist_action" field.
+func (m *DiscordGuildSettingMutation) SetModerationDenylistAction(dda discordguildsetting.ModerationDenylistAction) {
+	m.moderation_denylist_action = &dda
+}
+
+// ModerationDenylistAction returns the value of the "moderation_denylist_action" field in the mutation.
+func (m *DiscordGuildSettingMutation) ModerationDenylistAction() (r discordguildsetting.ModerationDenylistAction, exists bool) {
+	v := m.moderation_denylist_action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationDenylistAction returns the old "moderation_denylist_action" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldModerationDenylistAction(ctx context.Context) (v discordguildsetting.ModerationDenylistAction, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationDenylistAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationDenylistAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationDenylistAction: %w", err)
+	}
+	return oldValue.ModerationDenylistAction, nil
+}
+
+// ResetModerationDenylistAction resets all changes to the "moderation_denylist_action" field.
+func (m *DiscordGuildSettingMutation) ResetModerationDenylistAction() {
+	m.moderation_denylist_action = nil
+}
+
+// SetModerationRedact sets the "moderation_redact" field.
+func (m *DiscordGuildSettingMutation) SetModerationRedact(b bool) {
+	m.moderation_redact = &b
+}
+
+// ModerationRedact returns the value of the "moderation_redact" field in the mutation.
+func (m *DiscordGuildSettingMutation) ModerationRedact() (r bool, exists bool) {
+	v := m.moderation_redact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationRedact returns the old "moderation_redact" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldModerationRedact(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationRedact is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationRedact requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationRedact: %w", err)
+	}
+	return oldValue.ModerationRedact, nil
+}
+
+// ResetModerationRedact resets all changes to the "moderation_redact" field.
+func (m *DiscordGuildSettingMutation) ResetModerationRedact() {
+	m.moderation_redact = nil
+}
+
+// SetModerationClassifier sets the "moderation_classifier" field.
+func (m *DiscordGuildSettingMutation) SetModerationClassifier(b bool) {
+	m.moderation_classifier = &b
+}
+
+// ModerationClassifier returns the value of the "moderation_classifier" field in the mutation.
+func (m *DiscordGuildSettingMutation) ModerationClassifier() (r bool, exists bool) {
+	v := m.moderation_classifier
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationClassifier returns the old "moderation_classifier" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldModerationClassifier(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationClassifier is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationClassifier requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationClassifier: %w", err)
+	}
+	return oldValue.ModerationClassifier, nil
+}
+
+// ResetModerationClassifier resets all changes to the "moderation_classifier" field.
+func (m *DiscordGuildSettingMutation) ResetModerationClassifier() {
+	m.moderation_classifier = nil
+}
+
+// SetModerationFlagThreshold sets the "moderation_flag_threshold" field.
+func (m *DiscordGuildSettingMutation) SetModerationFlagThreshold(f float64) {
+	m.moderation_flag_threshold = &f
+	m.addmoderation_flag_threshold = nil
+}
+
+// ModerationFlagThreshold returns the value of the "moderation_flag_threshold" field in the mutation.
+func (m *DiscordGuildSettingMutation) ModerationFlagThreshold() (r float64, exists bool) {
+	v := m.moderation_flag_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationFlagThreshold returns the old "moderation_flag_threshold" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldModerationFlagThreshold(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationFlagThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationFlagThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationFlagThreshold: %w", err)
+	}
+	return oldValue.ModerationFlagThreshold, nil
+}
+
+// AddModerationFlagThreshold adds f to the "moderation_flag_threshold" field.
+func (m *DiscordGuildSettingMutation) AddModerationFlagThreshold(f float64) {
+	if m.addmoderation_flag_threshold != nil {
+		*m.addmoderation_flag_threshold += f
+	} else {
+		m.addmoderation_flag_threshold = &f
+	}
+}
+
+// AddedModerationFlagThreshold returns the value that was added to the "moderation_flag_threshold" field in this mutation.
+func (m *DiscordGuildSettingMutation) AddedModerationFlagThreshold() (r float64, exists bool) {
+	v := m.addmoderation_flag_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetModerationFlagThreshold resets all changes to the "moderation_flag_threshold" field.
+func (m *DiscordGuildSettingMutation) ResetModerationFlagThreshold() {
+	m.moderation_flag_threshold = nil
+	m.addmoderation_flag_threshold = nil
+}
+
+// SetModerationBlockThreshold sets the "moderation_block_threshold" field.
+func (m *DiscordGuildSettingMutation) SetModerationBlockThreshold(f float64) {
+	m.moderation_block_threshold = &f
+	m.addmoderation_block_threshold = nil
+}
+
+// ModerationBlockThreshold returns the value of the "moderation_block_threshold" field in the mutation.
+func (m *DiscordGuildSettingMutation) ModerationBlockThreshold() (r float64, exists bool) {
+	v := m.moderation_block_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModerationBlockThreshold returns the old "moderation_block_threshold" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldModerationBlockThreshold(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModerationBlockThreshold is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModerationBlockThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModerationBlockThreshold: %w", err)
+	}
+	return oldValue.ModerationBlockThreshold, nil
+}
+
+// AddModerationBlockThreshold adds f to the "moderation_block_threshold" field.
+func (m *DiscordGuildSettingMutation) AddModerationBlockThreshold(f float64) {
+	if m.addmoderation_block_threshold != nil {
+		*m.addmoderation_block_threshold += f
+	} else {
+		m.addmoderation_block_threshold = &f
+	}
+}
+
+// AddedModerationBlockThreshold returns the value that was added to the "moderation_block_threshold" field in this mutation.
+func (m *DiscordGuildSettingMutation) AddedModerationBlockThreshold() (r float64, exists bool) {
+	v := m.addmoderation_block_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetModerationBlockThreshold resets all changes to the "moderation_block_threshold" field.
+func (m *DiscordGuildSettingMutation) ResetModerationBlockThreshold() {
+	m.moderation_block_threshold = nil
+	m.addmoderation_block_threshold = nil
+}
+
+// SetIngestAllowlist sets the "ingest_allowlist" field.
+func (m *DiscordGuildSettingMutation) SetIngestAllowlist(s []string) {
+	m.ingest_allowlist = &s
+	m.appendingest_allowlist = nil
+}
+
+// IngestAllowlist returns the value of the "ingest_allowlist" field in the mutation.
+func (m *DiscordGuildSettingMutation) IngestAllowlist() (r []string, exists bool) {
+	v := m.ingest_allowlist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngestAllowlist returns the old "ingest_allowlist" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldIngestAllowlist(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngestAllowlist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngestAllowlist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngestAllowlist: %w", err)
+	}
+	return oldValue.IngestAllowlist, nil
+}
+
+// AppendIngestAllowlist adds s to the "ingest_allowlist" field.
+func (m *DiscordGuildSettingMutation) AppendIngestAllowlist(s []string) {
+	m.appendingest_allowlist = append(m.appendingest_allowlist, s...)
+}
+
+// AppendedIngestAllowlist returns the list of values that were appended to the "ingest_allowlist" field in this mutation.
+func (m *DiscordGuildSettingMutation) AppendedIngestAllowlist() ([]string, bool) {
+	if len(m.appendingest_allowlist) == 0 {
+		return nil, false
+	}
+	return m.appendingest_allowlist, true
+}
+
+// ClearIngestAllowlist clears the value of the "ingest_allowlist" field.
+func (m *DiscordGuildSettingMutation) ClearIngestAllowlist() {
+	m.ingest_allowlist = nil
+	m.appendingest_allowlist = nil
+	m.clearedFields[discordguildsetting.FieldIngestAllowlist] = struct{}{}
+}
+
+// IngestAllowlistCleared returns if the "ingest_allowlist" field was cleared in this mutation.
+func (m *DiscordGuildSettingMutation) IngestAllowlistCleared() bool {
+	_, ok := m.clearedFields[discordguildsetting.FieldIngestAllowlist]
+	return ok
+}
+
+// ResetIngestAllowlist resets all changes to the "ingest_allowlist" field.
+func (m *DiscordGuildSettingMutation) ResetIngestAllowlist() {
+	m.ingest_allowlist = nil
+	m.appendingest_allowlist = nil
+	delete(m.clearedFields, discordguildsetting.FieldIngestAllowlist)
+}
+
+// SetIngestDenylist sets the "ingest_denylist" field.
+func (m *DiscordGuildSettingMutation) SetIngestDenylist(s []string) {
+	m.ingest_denylist = &s
+	m.appendingest_denylist = nil
+}
+
+// IngestDenylist returns the value of the "ingest_denylist" field in the mutation.
+func (m *DiscordGuildSettingMutation) IngestDenylist() (r []string, exists bool) {
+	v := m.ingest_denylist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIngestDenylist returns the old "ingest_denylist" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldIngestDenylist(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIngestDenylist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIngestDenylist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIngestDenylist: %w", err)
+	}
+	return oldValue.IngestDenylist, nil
+}
+
+// AppendIngestDenylist adds s to the "ingest_denylist" field.
+func (m *DiscordGuildSettingMutation) AppendIngestDenylist(s []string) {
+	m.appendingest_denylist = append(m.appendingest_denylist, s...)
+}
+
+// AppendedIngestDenylist returns the list of values that were appended to the "ingest_denylist" field in this mutation.
+func (m *DiscordGuildSettingMutation) AppendedIngestDenylist() ([]string, bool) {
+	if len(m.appendingest_denylist) == 0 {
+		return nil, false
+	}
+	return m.appendingest_denylist, true
+}
+
+// ClearIngestDenylist clears the value of the "ingest_denylist" field.
+func (m *DiscordGuildSettingMutation) ClearIngestDenylist() {
+	m.ingest_denylist = nil
+	m.appendingest_denylist = nil
+	m.clearedFields[discordguildsetting.FieldIngestDenylist] = struct{}{}
+}
+
+// IngestDenylistCleared returns if the "ingest_denylist" field was cleared in this mutation.
+func (m *DiscordGuildSettingMutation) IngestDenylistCleared() bool {
+	_, ok := m.clearedFields[discordguildsetting.FieldIngestDenylist]
+	return ok
+}
+
+// ResetIngestDenylist resets all changes to the "ingest_denylist" field.
+func (m *DiscordGuildSettingMutation) ResetIngestDenylist() {
+	m.ingest_denylist = nil
+	m.appendingest_denylist = nil
+	delete(m.clearedFields, discordguildsetting.FieldIngestDenylist)
+}
+
+// SetRetrievalDenylist sets the "retrieval_denylist" field.
+func (m *DiscordGuildSettingMutation) SetRetrievalDenylist(s []string) {
+	m.retrieval_denylist = &s
+	m.appendretrieval_denylist = nil
+}
+
+// RetrievalDenylist returns the value of the "retrieval_denylist" field in the mutation.
+func (m *DiscordGuildSettingMutation) RetrievalDenylist() (r []string, exists bool) {
+	v := m.retrieval_denylist
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRetrievalDenylist returns the old "retrieval_denylist" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldRetrievalDenylist(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRetrievalDenylist is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRetrievalDenylist requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRetrievalDenylist: %w", err)
+	}
+	return oldValue.RetrievalDenylist, nil
+}
+
+// AppendRetrievalDenylist adds s to the "retrieval_denylist" field.
+func (m *DiscordGuildSettingMutation) AppendRetrievalDenylist(s []string) {
+	m.appendretrieval_denylist = append(m.appendretrieval_denylist, s...)
+}
+
+// AppendedRetrievalDenylist returns the list of values that were appended to the "retrieval_denylist" field in this mutation.
+func (m *DiscordGuildSettingMutation) AppendedRetrievalDenylist() ([]string, bool) {
+	if len(m.appendretrieval_denylist) == 0 {
+		return nil, false
+	}
+	return m.appendretrieval_denylist, true
+}
+
+// ClearRetrievalDenylist clears the value of the "retrieval_denylist" field.
+func (m *DiscordGuildSettingMutation) ClearRetrievalDenylist() {
+	m.retrieval_denylist = nil
+	m.appendretrieval_denylist = nil
+	m.clearedFields[discordguildsetting.FieldRetrievalDenylist] = struct{}{}
+}
+
+// RetrievalDenylistCleared returns if the "retrieval_denylist" field was cleared in this mutation.
+func (m *DiscordGuildSettingMutation) RetrievalDenylistCleared() bool {
+	_, ok := m.clearedFields[discordguildsetting.FieldRetrievalDenylist]
+	return ok
+}
+
+// ResetRetrievalDenylist resets all changes to the "retrieval_denylist" field.
+func (m *DiscordGuildSettingMutation) ResetRetrievalDenylist() {
+	m.retrieval_denylist = nil
+	m.appendretrieval_denylist = nil
+	delete(m.clearedFields, discordguildsetting.FieldRetrievalDenylist)
+}
+
+// SetRestrictedChannels sets the "restricted_channels" field.
+func (m *DiscordGuildSettingMutation) SetRestrictedChannels(s []string) {
+	m.restricted_channels = &s
+	m.appendrestricted_channels = nil
+}
+
+// RestrictedChannels returns the value of the "restricted_channels" field in the mutation.
+func (m *DiscordGuildSettingMutation) RestrictedChannels() (r []string, exists bool) {
+	v := m.restricted_channels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRestrictedChannels returns the old "restricted_channels" field's value of the DiscordGuildSetting entity.
+// If the DiscordGuildSetting object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordGuildSettingMutation) OldRestrictedChannels(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRestrictedChannels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRestrictedChannels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRestrictedChannels: %w", err)
+	}
+	return oldValue.RestrictedChannels, nil
+}
+
+// AppendRestrictedChannels adds s to the "restricted_channels" field.
+func (m *DiscordGuildSettingMutation) AppendRestrictedChannels(s []string) {
+	m.appendrestricted_channels = append(m.appendrestricted_channels, s...)
+}
+
+// AppendedRestrictedChannels returns the list of values that were appended to the "restricted_channels" field in this mutation.
+func (m *DiscordGuildSettingMutation) AppendedRestrictedChannels() ([]string, bool) {
+	if len(m.appendrestricted_channels) == 0 {
+		return nil, false
+	}
+	return m.appendrestricted_channels, true
+}
+
+// ClearRestrictedChannels clears the value of the "restricted_channels" field.
+func (m *DiscordGuildSettingMutation) ClearRestrictedChannels() {
+	m.restricted_channels = nil
+	m.appendrestricted_channels = nil
+	m.clearedFields[discordguildsetting.FieldRestrictedChannels] = struct{}{}
+}
+
+// RestrictedChannelsCleared returns if the "restricted_channels" field was cleared in this mutation.
+func (m *DiscordGuildSettingMutation) RestrictedChannelsCleared() bool {
+	_, ok := m.clearedFields[discordguildsetting.FieldRestrictedChannels]
+	return ok
+}
+
+// ResetRestrictedChannels resets all changes to the "restricted_channels" field.
+func (m *DiscordGuildSettingMutation) ResetRestrictedChannels() {
+	m.restricted_channels = nil
+	m.appendrestricted_channels = nil
+	delete(m.clearedFields, discordguildsetting.FieldRestrictedChannels)
+}
+
+// Where appends a list predicates to the DiscordGuildSettingMutation builder.
+func (m *DiscordGuildSettingMutation) Where(ps ...predicate.DiscordGuildSetting) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordGuildSettingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordGuildSettingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordGuildSetting, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordGuildSettingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordGuildSettingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordGuildSetting).
+func (m *DiscordGuildSettingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordGuildSettingMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.chime_in != nil {
+		fields = append(fields, discordguildsetting.FieldChimeIn)
+	}
+	if m.chime_in_probability != nil {
+		fields = append(fields, discordguildsetting.FieldChimeInProbability)
+	}
+	if m.chime_in_threshold != nil {
+		fields = append(fields, discordguildsetting.FieldChimeInThreshold)
+	}
+	if m.chime_in_cooldown != nil {
+		fields = append(fields, discordguildsetting.FieldChimeInCooldown)
+	}
+	if m.moderation_denylist != nil {
+		fields = append(fields, discordguildsetting.FieldModerationDenylist)
+	}
+	if m.moderation_denylist_action != nil {
+		fields = append(fields, discordguildsetting.FieldModerationDenylistAction)
+	}
+	if m.moderation_redact != nil {
+		fields = append(fields, discordguildsetting.FieldModerationRedact)
+	}
+	if m.moderation_classifier != nil {
+		fields = append(fields, discordguildsetting.FieldModerationClassifier)
+	}
+	if m.moderation_flag_threshold != nil {
+		fields = append(fields, discordguildsetting.FieldModerationFlagThreshold)
+	}
+	if m.moderation_block_threshold != nil {
+		fields = append(fields, discordguildsetting.FieldModerationBlockThreshold)
+	}
+	if m.ingest_allowlist != nil {
+		fields = append(fields, discordguildsetting.FieldIngestAllowlist)
+	}
+	if m.ingest_denylist != nil {
+		fields = append(fields, discordguildsetting.FieldIngestDenylist)
+	}
+	if m.retrieval_denylist != nil {
+		fields = append(fields, discordguildsetting.FieldRetrievalDenylist)
+	}
+	if m.restricted_channels != nil {
+		fields = append(fields, discordguildsetting.FieldRestrictedChannels)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordGuildSettingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordguildsetting.FieldChimeIn:
+		return m.ChimeIn()
+	case discordguildsetting.FieldChimeInProbability:
+		return m.ChimeInProbability()
+	case discordguildsetting.FieldChimeInThreshold:
+		return m.ChimeInThreshold()
+	case discordguildsetting.FieldChimeInCooldown:
+		return m.ChimeInCooldown()
+	case discordguildsetting.FieldModerationDenylist:
+		return m.ModerationDenylist()
+	case discordguildsetting.FieldModerationDenylistAction:
+		return m.ModerationDenylistAction()
+	case discordguildsetting.FieldModerationRedact:
+		return m.ModerationRedact()
+	case discordguildsetting.FieldModerationClassifier:
+		return m.ModerationClassifier()
+	case discordguildsetting.FieldModerationFlagThreshold:
+		return m.ModerationFlagThreshold()
+	case discordguildsetting.FieldModerationBlockThreshold:
+		return m.ModerationBlockThreshold()
+	case discordguildsetting.FieldIngestAllowlist:
+		return m.IngestAllowlist()
+	case discordguildsetting.FieldIngestDenylist:
+		return m.IngestDenylist()
+	case discordguildsetting.FieldRetrievalDenylist:
+		return m.RetrievalDenylist()
+	case discordguildsetting.FieldRestrictedChannels:
+		return m.RestrictedChannels()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordGuildSettingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordguildsetting.FieldChimeIn:
+		return m.OldChimeIn(ctx)
+	case discordguildsetting.FieldChimeInProbability:
+		return m.OldChimeInProbability(ctx)
+	case discordguildsetting.FieldChimeInThreshold:
+		return m.OldChimeInThreshold(ctx)
+	case discordguildsetting.FieldChimeInCooldown:
+		return m.OldChimeInCooldown(ctx)
+	case discordguildsetting.FieldModerationDenylist:
+		return m.OldModerationDenylist(ctx)
+	case discordguildsetting.FieldModerationDenylistAction:
+		return m.OldModerationDenylistAction(ctx)
+	case discordguildsetting.FieldModerationRedact:
+		return m.OldModerationRedact(ctx)
+	case discordguildsetting.FieldModerationClassifier:
+		return m.OldModerationClassifier(ctx)
+	case discordguildsetting.FieldModerationFlagThreshold:
+		return m.OldModerationFlagThreshold(ctx)
+	case discordguildsetting.FieldModerationBlockThreshold:
+		return m.OldModerationBlockThreshold(ctx)
+	case discordguildsetting.FieldIngestAllowlist:
+		return m.OldIngestAllowlist(ctx)
+	case discordguildsetting.FieldIngestDenylist:
+		return m.OldIngestDenylist(ctx)
+	case discordguildsetting.FieldRetrievalDenylist:
+		return m.OldRetrievalDenylist(ctx)
+	case discordguildsetting.FieldRestrictedChannels:
+		return m.OldRestrictedChannels(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordGuildSetting field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordGuildSettingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordguildsetting.FieldChimeIn:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChimeIn(v)
+		return nil
+	case discordguildsetting.FieldChimeInProbability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChimeInProbability(v)
+		return nil
+	case discordguildsetting.FieldChimeInThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChimeInThreshold(v)
+		return nil
+	case discordguildsetting.FieldChimeInCooldown:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChimeInCooldown(v)
+		return nil
+	case discordguildsetting.FieldModerationDenylist:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationDenylist(v)
+		return nil
+	case discordguildsetting.FieldModerationDenylistAction:
+		v, ok := value.(discordguildsetting.ModerationDenylistAction)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationDenylistAction(v)
+		return nil
+	case discordguildsetting.FieldModerationRedact:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationRedact(v)
+		return nil
+	case discordguildsetting.FieldModerationClassifier:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationClassifier(v)
+		return nil
+	case discordguildsetting.FieldModerationFlagThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationFlagThreshold(v)
+		return nil
+	case discordguildsetting.FieldModerationBlockThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModerationBlockThreshold(v)
+		return nil
+	case discordguildsetting.FieldIngestAllowlist:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngestAllowlist(v)
+		return nil
+	case discordguildsetting.FieldIngestDenylist:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIngestDenylist(v)
+		return nil
+	case discordguildsetting.FieldRetrievalDenylist:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRetrievalDenylist(v)
+		return nil
+	case discordguildsetting.FieldRestrictedChannels:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRestrictedChannels(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildSetting field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordGuildSettingMutation) AddedFields() []string {
+	var fields []string
+	if m.addchime_in_probability != nil {
+		fields = append(fields, discordguildsetting.FieldChimeInProbability)
+	}
+	if m.addchime_in_threshold != nil {
+		fields = append(fields, discordguildsetting.FieldChimeInThreshold)
+	}
+	if m.addchime_in_cooldown != nil {
+		fields = append(fields, discordguildsetting.FieldChimeInCooldown)
+	}
+	if m.addmoderation_flag_threshold != nil {
+		fields = append(fields, discordguildsetting.FieldModerationFlagThreshold)
+	}
+	if m.addmoderation_block_threshold != nil {
+		fields = append(fields, discordguildsetting.FieldModerationBlockThreshold)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordGuildSettingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case discordguildsetting.FieldChimeInProbability:
+		return m.AddedChimeInProbability()
+	case discordguildsetting.FieldChimeInThreshold:
+		return m.AddedChimeInThreshold()
+	case discordguildsetting.FieldChimeInCooldown:
+		return m.AddedChimeInCooldown()
+	case discordguildsetting.FieldModerationFlagThreshold:
+		return m.AddedModerationFlagThreshold()
+	case discordguildsetting.FieldModerationBlockThreshold:
+		return m.AddedModerationBlockThreshold()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordGuildSettingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case discordguildsetting.FieldChimeInProbability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChimeInProbability(v)
+		return nil
+	case discordguildsetting.FieldChimeInThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChimeInThreshold(v)
+		return nil
+	case discordguildsetting.FieldChimeInCooldown:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChimeInCooldown(v)
+		return nil
+	case discordguildsetting.FieldModerationFlagThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModerationFlagThreshold(v)
+		return nil
+	case discordguildsetting.FieldModerationBlockThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModerationBlockThreshold(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildSetting numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordGuildSettingMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discordguildsetting.FieldModerationDenylist) {
+		fields = append(fields, discordguildsetting.FieldModerationDenylist)
+	}
+	if m.FieldCleared(discordguildsetting.FieldIngestAllowlist) {
+		fields = append(fields, discordguildsetting.FieldIngestAllowlist)
+	}
+	if m.FieldCleared(discordguildsetting.FieldIngestDenylist) {
+		fields = append(fields, discordguildsetting.FieldIngestDenylist)
+	}
+	if m.FieldCleared(discordguildsetting.FieldRetrievalDenylist) {
+		fields = append(fields, discordguildsetting.FieldRetrievalDenylist)
+	}
+	if m.FieldCleared(discordguildsetting.FieldRestrictedChannels) {
+		fields = append(fields, discordguildsetting.FieldRestrictedChannels)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordGuildSettingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordGuildSettingMutation) ClearField(name string) error {
+	switch name {
+	case discordguildsetting.FieldModerationDenylist:
+		m.ClearModerationDenylist()
+		return nil
+	case discordguildsetting.FieldIngestAllowlist:
+		m.ClearIngestAllowlist()
+		return nil
+	case discordguildsetting.FieldIngestDenylist:
+		m.ClearIngestDenylist()
+		return nil
+	case discordguildsetting.FieldRetrievalDenylist:
+		m.ClearRetrievalDenylist()
+		return nil
+	case discordguildsetting.FieldRestrictedChannels:
+		m.ClearRestrictedChannels()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildSetting nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordGuildSettingMutation) ResetField(name string) error {
+	switch name {
+	case discordguildsetting.FieldChimeIn:
+		m.ResetChimeIn()
+		return nil
+	case discordguildsetting.FieldChimeInProbability:
+		m.ResetChimeInProbability()
+		return nil
+	case discordguildsetting.FieldChimeInThreshold:
+		m.ResetChimeInThreshold()
+		return nil
+	case discordguildsetting.FieldChimeInCooldown:
+		m.ResetChimeInCooldown()
+		return nil
+	case discordguildsetting.FieldModerationDenylist:
+		m.ResetModerationDenylist()
+		return nil
+	case discordguildsetting.FieldModerationDenylistAction:
+		m.ResetModerationDenylistAction()
+		return nil
+	case discordguildsetting.FieldModerationRedact:
+		m.ResetModerationRedact()
+		return nil
+	case discordguildsetting.FieldModerationClassifier:
+		m.ResetModerationClassifier()
+		return nil
+	case discordguildsetting.FieldModerationFlagThreshold:
+		m.ResetModerationFlagThreshold()
+		return nil
+	case discordguildsetting.FieldModerationBlockThreshold:
+		m.ResetModerationBlockThreshold()
+		return nil
+	case discordguildsetting.FieldIngestAllowlist:
+		m.ResetIngestAllowlist()
+		return nil
+	case discordguildsetting.FieldIngestDenylist:
+		m.ResetIngestDenylist()
+		return nil
+	case discordguildsetting.FieldRetrievalDenylist:
+		m.ResetRetrievalDenylist()
+		return nil
+	case discordguildsetting.FieldRestrictedChannels:
+		m.ResetRestrictedChannels()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordGuildSetting field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordGuildSettingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordGuildSettingMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordGuildSettingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordGuildSettingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordGuildSettingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordGuildSettingMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordGuildSettingMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DiscordGuildSetting unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordGuildSettingMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DiscordGuildSetting edge %s", name)
+}
+
+// DiscordMessageMutation represents an operation that mutates the DiscordMessage nodes in the graph.
+type DiscordMessageMutation struct {
+	config
+	op                        Op
+	typ                       string
+	id                        *string
+	content                   *string
+	guild_id                  *string
+	channel_id                *string
+	timestamp                 *time.Time
+	edited_timestamp          *time.Time
+	suspected_injection       *bool
+	clearedFields             map[string]struct{}
+	user                      *string
+	cleareduser               bool
+	embeddings                map[string]struct{}
+	removedembeddings         map[string]struct{}
+	clearedembeddings         bool
+	reactions                 map[int]struct{}
+	removedreactions          map[int]struct{}
+	clearedreactions          bool
+	reply_to                  *string
+	clearedreply_to           bool
+	replies                   map[string]struct{}
+	removedreplies            map[string]struct{}
+	clearedreplies            bool
+	mentioned_users           map[string]struct{}
+	removedmentioned_users    map[string]struct{}
+	clearedmentioned_users    bool
+	mentioned_roles           map[string]struct{}
+	removedmentioned_roles    map[string]struct{}
+	clearedmentioned_roles    bool
+	mentioned_channels        map[string]struct{}
+	removedmentioned_channels map[string]struct{}
+	clearedmentioned_channels bool
+	chunks                    map[string]struct{}
+	removedchunks             map[string]struct{}
+	clearedchunks             bool
+	done                      bool
+	oldValue                  func(context.Context) (*DiscordMessage, error)
+	predicates                []predicate.DiscordMessage
+}
+
+var _ ent.Mutation = (*DiscordMessageMutation)(nil)
+
+// discordmessageOption allows management of the mutation configuration using functional options.
+type discordmessageOption func(*DiscordMessageMutation)
+
+// newDiscordMessageMutation creates new mutation for the DiscordMessage entity.
+func newDiscordMessageMutation(c config, op Op, opts ...discordmessageOption) *DiscordMessageMutation {
+	m := &DiscordMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordMessageID sets the ID field of the mutation.
+func withDiscordMessageID(id string) discordmessageOption {
+	return func(m *DiscordMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordMessage
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordMessage sets the old DiscordMessage of the mutation.
+func withDiscordMessage(node *DiscordMessage) discordmessageOption {
+	return func(m *DiscordMessageMutation) {
+		m.oldValue = func(context.Context) (*DiscordMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordMessage entities.
+func (m *DiscordMessageMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordMessageMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordMessageMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetContent sets the "content" field.
+func (m *DiscordMessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *DiscordMessageMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *DiscordMessageMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *DiscordMessageMutation) SetAuthorID(s string) {
+	m.user = &s
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *DiscordMessageMutation) AuthorID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldAuthorID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *DiscordMessageMutation) ResetAuthorID() {
+	m.user = nil
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordMessageMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordMessageMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (m *DiscordMessageMutation) ClearGuildID() {
+	m.guild_id = nil
+	m.clearedFields[discordmessage.FieldGuildID] = struct{}{}
+}
+
+// GuildIDCleared returns if the "guild_id" field was cleared in this mutation.
+func (m *DiscordMessageMutation) GuildIDCleared() bool {
+	_, ok := m.clearedFields[discordmessage.FieldGuildID]
+	return ok
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordMessageMutation) ResetGuildID() {
+	m.guild_id = nil
+	delete(m.clearedFields, discordmessage.FieldGuildID)
+}
+
+// SetChannelID sets the "channel_id" field.
+func (m *DiscordMessageMutation) SetChannelID(s string) {
+	m.channel_id = &s
+}
+
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *DiscordMessageMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelID returns the old "channel_id" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldChannelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (m *DiscordMessageMutation) ClearChannelID() {
+	m.channel_id = nil
+	m.clearedFields[discordmessage.FieldChannelID] = struct{}{}
+}
+
+// ChannelIDCleared returns if the "channel_id" field was cleared in this mutation.
+func (m *DiscordMessageMutation) ChannelIDCleared() bool {
+	_, ok := m.clearedFields[discordmessage.FieldChannelID]
+	return ok
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *DiscordMessageMutation) ResetChannelID() {
+	m.channel_id = nil
+	delete(m.clearedFields, discordmessage.FieldChannelID)
+}
+
+// SetReplyToID sets the "reply_to_id" field.
+func (m *DiscordMessageMutation) SetReplyToID(s string) {
+	m.reply_to = &s
+}
+
+// ReplyToID returns the value of the "reply_to_id" field in the mutation.
+func (m *DiscordMessageMutation) ReplyToID() (r string, exists bool) {
+	v := m.reply_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReplyToID returns the old "reply_to_id" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldReplyToID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReplyToID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReplyToID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReplyToID: %w", err)
+	}
+	return oldValue.ReplyToID, nil
+}
+
+// ClearReplyToID clears the value of the "reply_to_id" field.
+func (m *DiscordMessageMutation) ClearReplyToID() {
+	m.reply_to = nil
+	m.clearedFields[discordmessage.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToIDCleared returns if the "reply_to_id" field was cleared in this mutation.
+func (m *DiscordMessageMutation) ReplyToIDCleared() bool {
+	_, ok := m.clearedFields[discordmessage.FieldReplyToID]
+	return ok
+}
+
+// ResetReplyToID resets all changes to the "reply_to_id" field.
+func (m *DiscordMessageMutation) ResetReplyToID() {
+	m.reply_to = nil
+	delete(m.clearedFields, discordmessage.FieldReplyToID)
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *DiscordMessageMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *DiscordMessageMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *DiscordMessageMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetEditedTimestamp sets the "edited_timestamp" field.
+func (m *DiscordMessageMutation) SetEditedTimestamp(t time.Time) {
+	m.edited_timestamp = &t
+}
+
+// EditedTimestamp returns the value of the "edited_timestamp" field in the mutation.
+func (m *DiscordMessageMutation) EditedTimestamp() (r time.Time, exists bool) {
+	v := m.edited_timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedTimestamp returns the old "edited_timestamp" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldEditedTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedTimestamp: %w", err)
+	}
+	return oldValue.EditedTimestamp, nil
+}
+
+// ClearEditedTimestamp clears the value of the "edited_timestamp" field.
+func (m *DiscordMessageMutation) ClearEditedTimestamp() {
+	m.edited_timestamp = nil
+	m.clearedFields[discordmessage.FieldEditedTimestamp] = struct{}{}
+}
+
+// EditedTimestampCleared returns if the "edited_timestamp" field was cleared in this mutation.
+func (m *DiscordMessageMutation) EditedTimestampCleared() bool {
+	_, ok := m.clearedFields[discordmessage.FieldEditedTimestamp]
+	return ok
+}
+
+// ResetEditedTimestamp resets all changes to the "edited_timestamp" field.
+func (m *DiscordMessageMutation) ResetEditedTimestamp() {
+	m.edited_timestamp = nil
+	delete(m.clearedFields, discordmessage.FieldEditedTimestamp)
+}
+
+// SetSuspectedInjection sets the "suspected_injection" field.
+func (m *DiscordMessageMutation) SetSuspectedInjection(b bool) {
+	m.suspected_injection = &b
+}
+
+// SuspectedInjection returns the value of the "suspected_injection" field in the mutation.
+func (m *DiscordMessageMutation) SuspectedInjection() (r bool, exists bool) {
+	v := m.suspected_injection
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSuspectedInjection returns the old "suspected_injection" field's value of the DiscordMessage entity.
+// If the DiscordMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageMutation) OldSuspectedInjection(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSuspectedInjection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSuspectedInjection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSuspectedInjection: %w", err)
+	}
+	return oldValue.SuspectedInjection, nil
+}
+
+// ResetSuspectedInjection resets all changes to the "suspected_injection" field.
+func (m *DiscordMessageMutation) ResetSuspectedInjection() {
+	m.suspected_injection = nil
+}
+
+// SetUserID sets the "user" edge to the DiscordUser entity by id.
+func (m *DiscordMessageMutation) SetUserID(id string) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the DiscordUser entity.
+func (m *DiscordMessageMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[discordmessage.FieldAuthorID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the DiscordUser entity was cleared.
+func (m *DiscordMessageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *DiscordMessageMutation) UserID() (id string, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DiscordMessageMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DiscordMessageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddEmbeddingIDs adds the "embeddings" edge to the DiscordMessageEmbedding entity by ids.
+func (m *DiscordMessageMutation) AddEmbeddingIDs(ids ...string) {
+	if m.embeddings == nil {
+		m.embeddings = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.embeddings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEmbeddings clears the "embeddings" edge to the DiscordMessageEmbedding entity.
+func (m *DiscordMessageMutation) ClearEmbeddings() {
+	m.clearedembeddings = true
+}
+
+// EmbeddingsCleared reports if the "embeddings" edge to the DiscordMessageEmbedding entity was cleared.
+func (m *DiscordMessageMutation) EmbeddingsCleared() bool {
+	return m.clearedembeddings
+}
+
+// RemoveEmbeddingIDs removes the "embeddings" edge to the DiscordMessageEmbedding entity by IDs.
+func (m *DiscordMessageMutation) RemoveEmbeddingIDs(ids ...string) {
+	if m.removedembeddings == nil {
+		m.removedembeddings = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.embeddings, ids[i])
+		m.removedembeddings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEmbeddings returns the removed IDs of the "embeddings" edge to the DiscordMessageEmbedding entity.
+func (m *DiscordMessageMutation) RemovedEmbeddingsIDs() (ids []string) {
+	for id := range m.removedembeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EmbeddingsIDs returns the "embeddings" edge IDs in the mutation.
+func (m *DiscordMessageMutation) EmbeddingsIDs() (ids []string) {
+	for id := range m.embeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEmbeddings resets all changes to the "embeddings" edge.
+func (m *DiscordMessageMutation) ResetEmbeddings() {
+	m.embeddings = nil
+	m.clearedembeddings = false
+	m.removedembeddings = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the DiscordReaction entity by ids.
+func (m *DiscordMessageMutation) AddReactionIDs(ids ...int) {
+	if m.reactions == nil {
+		m.reactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the DiscordReaction entity.
+func (m *DiscordMessageMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the DiscordReaction entity was cleared.
+func (m *DiscordMessageMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the DiscordReaction entity by IDs.
+func (m *DiscordMessageMutation) RemoveReactionIDs(ids ...int) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the DiscordReaction entity.
+func (m *DiscordMessageMutation) RemovedReactionsIDs() (ids []int) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *DiscordMessageMutation) ReactionsIDs() (ids []int) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *DiscordMessageMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// ClearReplyTo clears the "reply_to" edge to the DiscordMessage entity.
+func (m *DiscordMessageMutation) ClearReplyTo() {
+	m.clearedreply_to = true
+	m.clearedFields[discordmessage.FieldReplyToID] = struct{}{}
+}
+
+// ReplyToCleared reports if the "reply_to" edge to the DiscordMessage entity was cleared.
+func (m *DiscordMessageMutation) ReplyToCleared() bool {
+	return m.ReplyToIDCleared() || m.clearedreply_to
+}
+
+// ReplyToIDs returns the "reply_to" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ReplyToID instead. It exists only for internal usage by the builders.
+func (m *DiscordMessageMutation) ReplyToIDs() (ids []string) {
+	if id := m.reply_to; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetReplyTo resets all changes to the "reply_to" edge.
+func (m *DiscordMessageMutation) ResetReplyTo() {
+	m.reply_to = nil
+	m.clearedreply_to = false
+}
+
+// AddReplyIDs adds the "replies" edge to the DiscordMessage entity by ids.
+func (m *DiscordMessageMutation) AddReplyIDs(ids ...string) {
+	if m.replies == nil {
+		m.replies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the DiscordMessage entity.
+func (m *DiscordMessageMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the DiscordMessage entity was cleared.
+func (m *DiscordMessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the DiscordMessage entity by IDs.
+func (m *DiscordMessageMutation) RemoveReplyIDs(ids ...string) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the DiscordMessage entity.
+func (m *DiscordMessageMutation) RemovedRepliesIDs() (ids []string) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *DiscordMessageMutation) RepliesIDs() (ids []string) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *DiscordMessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// AddMentionedUserIDs adds the "mentioned_users" edge to the DiscordUser entity by ids.
+func (m *DiscordMessageMutation) AddMentionedUserIDs(ids ...string) {
+	if m.mentioned_users == nil {
+		m.mentioned_users = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.mentioned_users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedUsers clears the "mentioned_users" edge to the DiscordUser entity.
+func (m *DiscordMessageMutation) ClearMentionedUsers() {
+	m.clearedmentioned_users = true
+}
+
+// MentionedUsersCleared reports if the "mentioned_users" edge to the DiscordUser entity was cleared.
+func (m *DiscordMessageMutation) MentionedUsersCleared() bool {
+	return m.clearedmentioned_users
+}
+
+// RemoveMentionedUserIDs removes the "mentioned_users" edge to the DiscordUser entity by IDs.
+func (m *DiscordMessageMutation) RemoveMentionedUserIDs(ids ...string) {
+	if m.removedmentioned_users == nil {
+		m.removedmentioned_users = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_users, ids[i])
+		m.removedmentioned_users[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedUsers returns the removed IDs of the "mentioned_users" edge to the DiscordUser entity.
+func (m *DiscordMessageMutation) RemovedMentionedUsersIDs() (ids []string) {
+	for id := range m.removedmentioned_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedUsersIDs returns the "mentioned_users" edge IDs in the mutation.
+func (m *DiscordMessageMutation) MentionedUsersIDs() (ids []string) {
+	for id := range m.mentioned_users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedUsers resets all changes to the "mentioned_users" edge.
+func (m *DiscordMessageMutation) ResetMentionedUsers() {
+	m.mentioned_users = nil
+	m.clearedmentioned_users = false
+	m.removedmentioned_users = nil
+}
+
+// AddMentionedRoleIDs adds the "mentioned_roles" edge to the DiscordRole entity by ids.
+func (m *DiscordMessageMutation) AddMentionedRoleIDs(ids ...string) {
+	if m.mentioned_roles == nil {
+		m.mentioned_roles = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.mentioned_roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedRoles clears the "mentioned_roles" edge to the DiscordRole entity.
+func (m *DiscordMessageMutation) ClearMentionedRoles() {
+	m.clearedmentioned_roles = true
+}
+
+// MentionedRolesCleared reports if the "mentioned_roles" edge to the DiscordRole entity was cleared.
+func (m *DiscordMessageMutation) MentionedRolesCleared() bool {
+	return m.clearedmentioned_roles
+}
+
+// RemoveMentionedRoleIDs removes the "mentioned_roles" edge to the DiscordRole entity by IDs.
+func (m *DiscordMessageMutation) RemoveMentionedRoleIDs(ids ...string) {
+	if m.removedmentioned_roles == nil {
+		m.removedmentioned_roles = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_roles, ids[i])
+		m.removedmentioned_roles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedRoles returns the removed IDs of the "mentioned_roles" edge to the DiscordRole entity.
+func (m *DiscordMessageMutation) RemovedMentionedRolesIDs() (ids []string) {
+	for id := range m.removedmentioned_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedRolesIDs returns the "mentioned_roles" edge IDs in the mutation.
+func (m *DiscordMessageMutation) MentionedRolesIDs() (ids []string) {
+	for id := range m.mentioned_roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedRoles resets all changes to the "mentioned_roles" edge.
+func (m *DiscordMessageMutation) ResetMentionedRoles() {
+	m.mentioned_roles = nil
+	m.clearedmentioned_roles = false
+	m.removedmentioned_roles = nil
+}
+
+// AddMentionedChannelIDs adds the "mentioned_channels" edge to the DiscordChannel entity by ids.
+func (m *DiscordMessageMutation) AddMentionedChannelIDs(ids ...string) {
+	if m.mentioned_channels == nil {
+		m.mentioned_channels = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.mentioned_channels[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedChannels clears the "mentioned_channels" edge to the DiscordChannel entity.
+func (m *DiscordMessageMutation) ClearMentionedChannels() {
+	m.clearedmentioned_channels = true
+}
+
+// MentionedChannelsCleared reports if the "mentioned_channels" edge to the DiscordChannel entity was cleared.
+func (m *DiscordMessageMutation) MentionedChannelsCleared() bool {
+	return m.clearedmentioned_channels
+}
+
+// RemoveMentionedChannelIDs removes the "mentioned_channels" edge to the DiscordChannel entity by IDs.
+func (m *DiscordMessageMutation) RemoveMentionedChannelIDs(ids ...string) {
+	if m.removedmentioned_channels == nil {
+		m.removedmentioned_channels = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_channels, ids[i])
+		m.removedmentioned_channels[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedChannels returns the removed IDs of the "mentioned_channels" edge to the DiscordChannel entity.
+func (m *DiscordMessageMutation) RemovedMentionedChannelsIDs() (ids []string) {
+	for id := range m.removedmentioned_channels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedChannelsIDs returns the "mentioned_channels" edge IDs in the mutation.
+func (m *DiscordMessageMutation) MentionedChannelsIDs() (ids []string) {
+	for id := range m.mentioned_channels {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedChannels resets all changes to the "mentioned_channels" edge.
+func (m *DiscordMessageMutation) ResetMentionedChannels() {
+	m.mentioned_channels = nil
+	m.clearedmentioned_channels = false
+	m.removedmentioned_channels = nil
+}
+
+// AddChunkIDs adds the "chunks" edge to the DiscordMessageChunk entity by ids.
+func (m *DiscordMessageMutation) AddChunkIDs(ids ...string) {
+	if m.chunks == nil {
+		m.chunks = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.chunks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChunks clears the "chunks" edge to the DiscordMessageChunk entity.
+func (m *DiscordMessageMutation) ClearChunks() {
+	m.clearedchunks = true
+}
+
+// ChunksCleared reports if the "chunks" edge to the DiscordMessageChunk entity was cleared.
+func (m *DiscordMessageMutation) ChunksCleared() bool {
+	return m.clearedchunks
+}
+
+// RemoveChunkIDs removes the "chunks" edge to the DiscordMessageChunk entity by IDs.
+func (m *DiscordMessageMutation) RemoveChunkIDs(ids ...string) {
+	if m.removedchunks == nil {
+		m.removedchunks = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.chunks, ids[i])
+		m.removedchunks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChunks returns the removed IDs of the "chunks" edge to the DiscordMessageChunk entity.
+func (m *DiscordMessageMutation) RemovedChunksIDs() (ids []string) {
+	for id := range m.removedchunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChunksIDs returns the "chunks" edge IDs in the mutation.
+func (m *DiscordMessageMutation) ChunksIDs() (ids []string) {
+	for id := range m.chunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChunks resets all changes to the "chunks" edge.
+func (m *DiscordMessageMutation) ResetChunks() {
+	m.chunks = nil
+	m.clearedchunks = false
+	m.removedchunks = nil
+}
+
+// Where appends a list predicates to the DiscordMessageMutation builder.
+func (m *DiscordMessageMutation) Where(ps ...predicate.DiscordMessage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordMessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordMessage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordMessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordMessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordMessage).
+func (m *DiscordMessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordMessageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.content != nil {
+		fields = append(fields, discordmessage.FieldContent)
+	}
+	if m.user != nil {
+		fields = append(fields, discordmessage.FieldAuthorID)
+	}
+	if m.guild_id != nil {
+		fields = append(fields, discordmessage.FieldGuildID)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, discordmessage.FieldChannelID)
+	}
+	if m.reply_to != nil {
+		fields = append(fields, discordmessage.FieldReplyToID)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, discordmessage.FieldTimestamp)
+	}
+	if m.edited_timestamp != nil {
+		fields = append(fields, discordmessage.FieldEditedTimestamp)
+	}
+	if m.suspected_injection != nil {
+		fields = append(fields, discordmessage.FieldSuspectedInjection)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordMessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordmessage.FieldContent:
+		return m.Content()
+	case discordmessage.FieldAuthorID:
+		return m.AuthorID()
+	case discordmessage.FieldGuildID:
+		return m.GuildID()
+	case discordmessage.FieldChannelID:
+		return m.ChannelID()
+	case discordmessage.FieldReplyToID:
+		return m.ReplyToID()
+	case discordmessage.FieldTimestamp:
+		return m.Timestamp()
+	case discordmessage.FieldEditedTimestamp:
+		return m.EditedTimestamp()
+	case discordmessage.FieldSuspectedInjection:
+		return m.SuspectedInjection()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordmessage.FieldContent:
+		return m.OldContent(ctx)
+	case discordmessage.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case discordmessage.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordmessage.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case discordmessage.FieldReplyToID:
+		return m.OldReplyToID(ctx)
+	case discordmessage.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case discordmessage.FieldEditedTimestamp:
+		return m.OldEditedTimestamp(ctx)
+	case discordmessage.FieldSuspectedInjection:
+		return m.OldSuspectedInjection(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordMessage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordMessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordmessage.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case discordmessage.FieldAuthorID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case discordmessage.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case discordmessage.FieldChannelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case discordmessage.FieldReplyToID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplyToID(v)
+		return nil
+	case discordmessage.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case discordmessage.FieldEditedTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedTimestamp(v)
+		return nil
+	case discordmessage.FieldSuspectedInjection:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSuspectedInjection(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordMessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordMessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordMessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordMessage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discordmessage.FieldGuildID) {
+		fields = append(fields, discordmessage.FieldGuildID)
+	}
+	if m.FieldCleared(discordmessage.FieldChannelID) {
+		fields = append(fields, discordmessage.FieldChannelID)
+	}
+	if m.FieldCleared(discordmessage.FieldReplyToID) {
+		fields = append(fields, discordmessage.FieldReplyToID)
+	}
+	if m.FieldCleared(discordmessage.FieldEditedTimestamp) {
+		fields = append(fields, discordmessage.FieldEditedTimestamp)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordMessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordMessageMutation) ClearField(name string) error {
+	switch name {
+	case discordmessage.FieldGuildID:
+		m.ClearGuildID()
+		return nil
+	case discordmessage.FieldChannelID:
+		m.ClearChannelID()
+		return nil
+	case discordmessage.FieldReplyToID:
+		m.ClearReplyToID()
+		return nil
+	case discordmessage.FieldEditedTimestamp:
+		m.ClearEditedTimestamp()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordMessageMutation) ResetField(name string) error {
+	switch name {
+	case discordmessage.FieldContent:
+		m.ResetContent()
+		return nil
+	case discordmessage.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case discordmessage.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case discordmessage.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case discordmessage.FieldReplyToID:
+		m.ResetReplyToID()
+		return nil
+	case discordmessage.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case discordmessage.FieldEditedTimestamp:
+		m.ResetEditedTimestamp()
+		return nil
+	case discordmessage.FieldSuspectedInjection:
+		m.ResetSuspectedInjection()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.user != nil {
+		edges = append(edges, discordmessage.EdgeUser)
+	}
+	if m.embeddings != nil {
+		edges = append(edges, discordmessage.EdgeEmbeddings)
+	}
+	if m.reactions != nil {
+		edges = append(edges, discordmessage.EdgeReactions)
+	}
+	if m.reply_to != nil {
+		edges = append(edges, discordmessage.EdgeReplyTo)
+	}
+	if m.replies != nil {
+		edges = append(edges, discordmessage.EdgeReplies)
+	}
+	if m.mentioned_users != nil {
+		edges = append(edges, discordmessage.EdgeMentionedUsers)
+	}
+	if m.mentioned_roles != nil {
+		edges = append(edges, discordmessage.EdgeMentionedRoles)
+	}
+	if m.mentioned_channels != nil {
+		edges = append(edges, discordmessage.EdgeMentionedChannels)
+	}
+	if m.chunks != nil {
+		edges = append(edges, discordmessage.EdgeChunks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordMessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordmessage.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case discordmessage.EdgeEmbeddings:
+		ids := make([]ent.Value, 0, len(m.embeddings))
+		for id := range m.embeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeReplyTo:
+		if id := m.reply_to; id != nil {
+			return []ent.Value{*id}
+		}
+	case discordmessage.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeMentionedUsers:
+		ids := make([]ent.Value, 0, len(m.mentioned_users))
+		for id := range m.mentioned_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeMentionedRoles:
+		ids := make([]ent.Value, 0, len(m.mentioned_roles))
+		for id := range m.mentioned_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeMentionedChannels:
+		ids := make([]ent.Value, 0, len(m.mentioned_channels))
+		for id := range m.mentioned_channels {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.chunks))
+		for id := range m.chunks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedembeddings != nil {
+		edges = append(edges, discordmessage.EdgeEmbeddings)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, discordmessage.EdgeReactions)
+	}
+	if m.removedreplies != nil {
+		edges = append(edges, discordmessage.EdgeReplies)
+	}
+	if m.removedmentioned_users != nil {
+		edges = append(edges, discordmessage.EdgeMentionedUsers)
+	}
+	if m.removedmentioned_roles != nil {
+		edges = append(edges, discordmessage.EdgeMentionedRoles)
+	}
+	if m.removedmentioned_channels != nil {
+		edges = append(edges, discordmessage.EdgeMentionedChannels)
+	}
+	if m.removedchunks != nil {
+		edges = append(edges, discordmessage.EdgeChunks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordMessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case discordmessage.EdgeEmbeddings:
+		ids := make([]ent.Value, 0, len(m.removedembeddings))
+		for id := range m.removedembeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeMentionedUsers:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_users))
+		for id := range m.removedmentioned_users {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeMentionedRoles:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_roles))
+		for id := range m.removedmentioned_roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeMentionedChannels:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_channels))
+		for id := range m.removedmentioned_channels {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessage.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.removedchunks))
+		for id := range m.removedchunks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.cleareduser {
+		edges = append(edges, discordmessage.EdgeUser)
+	}
+	if m.clearedembeddings {
+		edges = append(edges, discordmessage.EdgeEmbeddings)
+	}
+	if m.clearedreactions {
+		edges = append(edges, discordmessage.EdgeReactions)
+	}
+	if m.clearedreply_to {
+		edges = append(edges, discordmessage.EdgeReplyTo)
+	}
+	if m.clearedreplies {
+		edges = append(edges, discordmessage.EdgeReplies)
+	}
+	if m.clearedmentioned_users {
+		edges = append(edges, discordmessage.EdgeMentionedUsers)
+	}
+	if m.clearedmentioned_roles {
+		edges = append(edges, discordmessage.EdgeMentionedRoles)
+	}
+	if m.clearedmentioned_channels {
+		edges = append(edges, discordmessage.EdgeMentionedChannels)
+	}
+	if m.clearedchunks {
+		edges = append(edges, discordmessage.EdgeChunks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordmessage.EdgeUser:
+		return m.cleareduser
+	case discordmessage.EdgeEmbeddings:
+		return m.clearedembeddings
+	case discordmessage.EdgeReactions:
+		return m.clearedreactions
+	case discordmessage.EdgeReplyTo:
+		return m.clearedreply_to
+	case discordmessage.EdgeReplies:
+		return m.clearedreplies
+	case discordmessage.EdgeMentionedUsers:
+		return m.clearedmentioned_users
+	case discordmessage.EdgeMentionedRoles:
+		return m.clearedmentioned_roles
+	case discordmessage.EdgeMentionedChannels:
+		return m.clearedmentioned_channels
+	case discordmessage.EdgeChunks:
+		return m.clearedchunks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case discordmessage.EdgeUser:
+		m.ClearUser()
+		return nil
+	case discordmessage.EdgeReplyTo:
+		m.ClearReplyTo()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case discordmessage.EdgeUser:
+		m.ResetUser()
+		return nil
+	case discordmessage.EdgeEmbeddings:
+		m.ResetEmbeddings()
+		return nil
+	case discordmessage.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case discordmessage.EdgeReplyTo:
+		m.ResetReplyTo()
+		return nil
+	case discordmessage.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	case discordmessage.EdgeMentionedUsers:
+		m.ResetMentionedUsers()
+		return nil
+	case discordmessage.EdgeMentionedRoles:
+		m.ResetMentionedRoles()
+		return nil
+	case discordmessage.EdgeMentionedChannels:
+		m.ResetMentionedChannels()
+		return nil
+	case discordmessage.EdgeChunks:
+		m.ResetChunks()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessage edge %s", name)
+}
+
+// DiscordMessageChunkMutation represents an operation that mutates the DiscordMessageChunk nodes in the graph.
+type DiscordMessageChunkMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	guild_id          *string
+	channel_id        *string
+	start_at          *time.Time
+	end_at            *time.Time
+	content           *string
+	clearedFields     map[string]struct{}
+	messages          map[string]struct{}
+	removedmessages   map[string]struct{}
+	clearedmessages   bool
+	embeddings        map[string]struct{}
+	removedembeddings map[string]struct{}
+	clearedembeddings bool
+	done              bool
+	oldValue          func(context.Context) (*DiscordMessageChunk, error)
+	predicates        []predicate.DiscordMessageChunk
+}
+
+var _ ent.Mutation = (*DiscordMessageChunkMutation)(nil)
+
+// discordmessagechunkOption allows management of the mutation configuration using functional options.
+type discordmessagechunkOption func(*DiscordMessageChunkMutation)
+
+// newDiscordMessageChunkMutation creates new mutation for the DiscordMessageChunk entity.
+func newDiscordMessageChunkMutation(c config, op Op, opts ...discordmessagechunkOption) *DiscordMessageChunkMutation {
+	m := &DiscordMessageChunkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordMessageChunk,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordMessageChunkID sets the ID field of the mutation.
+func withDiscordMessageChunkID(id string) discordmessagechunkOption {
+	return func(m *DiscordMessageChunkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordMessageChunk
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordMessageChunk, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordMessageChunk.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordMessageChunk sets the old DiscordMessageChunk of the mutation.
+func withDiscordMessageChunk(node *DiscordMessageChunk) discordmessagechunkOption {
+	return func(m *DiscordMessageChunkMutation) {
+		m.oldValue = func(context.Context) (*DiscordMessageChunk, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordMessageChunkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordMessageChunkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordMessageChunk entities.
+func (m *DiscordMessageChunkMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordMessageChunkMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordMessageChunkMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordMessageChunk.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordMessageChunkMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordMessageChunkMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the DiscordMessageChunk entity.
+// If the DiscordMessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageChunkMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordMessageChunkMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetChannelID sets the "channel_id" field.
+func (m *DiscordMessageChunkMutation) SetChannelID(s string) {
+	m.channel_id = &s
+}
+
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *DiscordMessageChunkMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelID returns the old "channel_id" field's value of the DiscordMessageChunk entity.
+// If the DiscordMessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageChunkMutation) OldChannelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *DiscordMessageChunkMutation) ResetChannelID() {
+	m.channel_id = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *DiscordMessageChunkMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *DiscordMessageChunkMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the DiscordMessageChunk entity.
+// If the DiscordMessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageChunkMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *DiscordMessageChunkMutation) ResetStartAt() {
+	m.start_at = nil
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *DiscordMessageChunkMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *DiscordMessageChunkMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the DiscordMessageChunk entity.
+// If the DiscordMessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageChunkMutation) OldEndAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *DiscordMessageChunkMutation) ResetEndAt() {
+	m.end_at = nil
+}
+
+// SetContent sets the "content" field.
+func (m *DiscordMessageChunkMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *DiscordMessageChunkMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the DiscordMessageChunk entity.
+// If the DiscordMessageChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageChunkMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *DiscordMessageChunkMutation) ResetContent() {
+	m.content = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the DiscordMessage entity by ids.
+func (m *DiscordMessageChunkMutation) AddMessageIDs(ids ...string) {
+	if m.messages == nil {
+		m.messages = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the DiscordMessage entity.
+func (m *DiscordMessageChunkMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the DiscordMessage entity was cleared.
+func (m *DiscordMessageChunkMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the DiscordMessage entity by IDs.
+func (m *DiscordMessageChunkMutation) RemoveMessageIDs(ids ...string) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the DiscordMessage entity.
+func (m *DiscordMessageChunkMutation) RemovedMessagesIDs() (ids []string) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *DiscordMessageChunkMutation) MessagesIDs() (ids []string) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *DiscordMessageChunkMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddEmbeddingIDs adds the "embeddings" edge to the DiscordChunkEmbedding entity by ids.
+func (m *DiscordMessageChunkMutation) AddEmbeddingIDs(ids ...string) {
+	if m.embeddings == nil {
+		m.embeddings = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.embeddings[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEmbeddings clears the "embeddings" edge to the DiscordChunkEmbedding entity.
+func (m *DiscordMessageChunkMutation) ClearEmbeddings() {
+	m.clearedembeddings = true
+}
+
+// EmbeddingsCleared reports if the "embeddings" edge to the DiscordChunkEmbedding entity was cleared.
+func (m *DiscordMessageChunkMutation) EmbeddingsCleared() bool {
+	return m.clearedembeddings
+}
+
+// RemoveEmbeddingIDs removes the "embeddings" edge to the DiscordChunkEmbedding entity by IDs.
+func (m *DiscordMessageChunkMutation) RemoveEmbeddingIDs(ids ...string) {
+	if m.removedembeddings == nil {
+		m.removedembeddings = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.embeddings, ids[i])
+		m.removedembeddings[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEmbeddings returns the removed IDs of the "embeddings" edge to the DiscordChunkEmbedding entity.
+func (m *DiscordMessageChunkMutation) RemovedEmbeddingsIDs() (ids []string) {
+	for id := range m.removedembeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EmbeddingsIDs returns the "embeddings" edge IDs in the mutation.
+func (m *DiscordMessageChunkMutation) EmbeddingsIDs() (ids []string) {
+	for id := range m.embeddings {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEmbeddings resets all changes to the "embeddings" edge.
+func (m *DiscordMessageChunkMutation) ResetEmbeddings() {
+	m.embeddings = nil
+	m.clearedembeddings = false
+	m.removedembeddings = nil
+}
+
+// Where appends a list predicates to the DiscordMessageChunkMutation builder.
+func (m *DiscordMessageChunkMutation) Where(ps ...predicate.DiscordMessageChunk) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordMessageChunkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordMessageChunkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordMessageChunk, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordMessageChunkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordMessageChunkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordMessageChunk).
+func (m *DiscordMessageChunkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordMessageChunkMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.guild_id != nil {
+		fields = append(fields, discordmessagechunk.FieldGuildID)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, discordmessagechunk.FieldChannelID)
+	}
+	if m.start_at != nil {
+		fields = append(fields, discordmessagechunk.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, discordmessagechunk.FieldEndAt)
+	}
+	if m.content != nil {
+		fields = append(fields, discordmessagechunk.FieldContent)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordMessageChunkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordmessagechunk.FieldGuildID:
+		return m.GuildID()
+	case discordmessagechunk.FieldChannelID:
+		return m.ChannelID()
+	case discordmessagechunk.FieldStartAt:
+		return m.StartAt()
+	case discordmessagechunk.FieldEndAt:
+		return m.EndAt()
+	case discordmessagechunk.FieldContent:
+		return m.Content()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordMessageChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordmessagechunk.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordmessagechunk.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case discordmessagechunk.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case discordmessagechunk.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case discordmessagechunk.FieldContent:
+		return m.OldContent(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordMessageChunk field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordMessageChunkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordmessagechunk.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case discordmessagechunk.FieldChannelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case discordmessagechunk.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case discordmessagechunk.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case discordmessagechunk.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageChunk field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordMessageChunkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordMessageChunkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordMessageChunkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordMessageChunk numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordMessageChunkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordMessageChunkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordMessageChunkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordMessageChunk nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordMessageChunkMutation) ResetField(name string) error {
+	switch name {
+	case discordmessagechunk.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case discordmessagechunk.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case discordmessagechunk.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case discordmessagechunk.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case discordmessagechunk.FieldContent:
+		m.ResetContent()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageChunk field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordMessageChunkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.messages != nil {
+		edges = append(edges, discordmessagechunk.EdgeMessages)
+	}
+	if m.embeddings != nil {
+		edges = append(edges, discordmessagechunk.EdgeEmbeddings)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordMessageChunkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordmessagechunk.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessagechunk.EdgeEmbeddings:
+		ids := make([]ent.Value, 0, len(m.embeddings))
+		for id := range m.embeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordMessageChunkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmessages != nil {
+		edges = append(edges, discordmessagechunk.EdgeMessages)
+	}
+	if m.removedembeddings != nil {
+		edges = append(edges, discordmessagechunk.EdgeEmbeddings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordMessageChunkMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case discordmessagechunk.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case discordmessagechunk.EdgeEmbeddings:
+		ids := make([]ent.Value, 0, len(m.removedembeddings))
+		for id := range m.removedembeddings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordMessageChunkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessages {
+		edges = append(edges, discordmessagechunk.EdgeMessages)
+	}
+	if m.clearedembeddings {
+		edges = append(edges, discordmessagechunk.EdgeEmbeddings)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordMessageChunkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordmessagechunk.EdgeMessages:
+		return m.clearedmessages
+	case discordmessagechunk.EdgeEmbeddings:
+		return m.clearedembeddings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordMessageChunkMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordMessageChunk unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordMessageChunkMutation) ResetEdge(name string) error {
+	switch name {
+	case discordmessagechunk.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case discordmessagechunk.EdgeEmbeddings:
+		m.ResetEmbeddings()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageChunk edge %s", name)
+}
+
+// DiscordMessageEmbeddingMutation represents an operation that mutates the DiscordMessageEmbedding nodes in the graph.
+type DiscordMessageEmbeddingMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	model          *string
+	embedding      *pgvector.Vector
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *string
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*DiscordMessageEmbedding, error)
+	predicates     []predicate.DiscordMessageEmbedding
+}
+
+var _ ent.Mutation = (*DiscordMessageEmbeddingMutation)(nil)
+
+// discordmessageembeddingOption allows management of the mutation configuration using functional options.
+type discordmessageembeddingOption func(*DiscordMessageEmbeddingMutation)
+
+// newDiscordMessageEmbeddingMutation creates new mutation for the DiscordMessageEmbedding entity.
+func newDiscordMessageEmbeddingMutation(c config, op Op, opts ...discordmessageembeddingOption) *DiscordMessageEmbeddingMutation {
+	m := &DiscordMessageEmbeddingMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordMessageEmbedding,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordMessageEmbeddingID sets the ID field of the mutation.
+func withDiscordMessageEmbeddingID(id string) discordmessageembeddingOption {
+	return func(m *DiscordMessageEmbeddingMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordMessageEmbedding
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordMessageEmbedding, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordMessageEmbedding.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordMessageEmbedding sets the old DiscordMessageEmbedding of the mutation.
+func withDiscordMessageEmbedding(node *DiscordMessageEmbedding) discordmessageembeddingOption {
+	return func(m *DiscordMessageEmbeddingMutation) {
+		m.oldValue = func(context.Context) (*DiscordMessageEmbedding, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordMessageEmbeddingMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordMessageEmbeddingMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordMessageEmbedding entities.
+func (m *DiscordMessageEmbeddingMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordMessageEmbeddingMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordMessageEmbeddingMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordMessageEmbedding.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *DiscordMessageEmbeddingMutation) SetMessageID(s string) {
+	m.message = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *DiscordMessageEmbeddingMutation) MessageID() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the DiscordMessageEmbedding entity.
+// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageEmbeddingMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *DiscordMessageEmbeddingMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetModel sets the "model" field.
+func (m *DiscordMessageEmbeddingMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *DiscordMessageEmbeddingMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the DiscordMessageEmbedding entity.
+// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageEmbeddingMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *DiscordMessageEmbeddingMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *DiscordMessageEmbeddingMutation) SetEmbedding(pg pgvector.Vector) {
+	m.embedding = &pg
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *DiscordMessageEmbeddingMutation) Embedding() (r pgvector.Vector, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the DiscordMessageEmbedding entity.
+// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageEmbeddingMutation) OldEmbedding(ctx context.Context) (v pgvector.Vector, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *DiscordMessageEmbeddingMutation) ResetEmbedding() {
+	m.embedding = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DiscordMessageEmbeddingMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DiscordMessageEmbeddingMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DiscordMessageEmbedding entity.
+// If the DiscordMessageEmbedding object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordMessageEmbeddingMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DiscordMessageEmbeddingMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the DiscordMessage entity.
+func (m *DiscordMessageEmbeddingMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[discordmessageembedding.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the DiscordMessage entity was cleared.
+func (m *DiscordMessageEmbeddingMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *DiscordMessageEmbeddingMutation) MessageIDs() (ids []string) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *DiscordMessageEmbeddingMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the DiscordMessageEmbeddingMutation builder.
+func (m *DiscordMessageEmbeddingMutation) Where(ps ...predicate.DiscordMessageEmbedding) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordMessageEmbeddingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordMessageEmbeddingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordMessageEmbedding, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordMessageEmbeddingMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordMessageEmbeddingMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordMessageEmbedding).
+func (m *DiscordMessageEmbeddingMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordMessageEmbeddingMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, discordmessageembedding.FieldMessageID)
+	}
+	if m.model != nil {
+		fields = append(fields, discordmessageembedding.FieldModel)
+	}
+	if m.embedding != nil {
+		fields = append(fields, discordmessageembedding.FieldEmbedding)
+	}
+	if m.created_at != nil {
+		fields = append(fields, discordmessageembedding.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordMessageEmbeddingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordmessageembedding.FieldMessageID:
+		return m.MessageID()
+	case discordmessageembedding.FieldModel:
+		return m.Model()
+	case discordmessageembedding.FieldEmbedding:
+		return m.Embedding()
+	case discordmessageembedding.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordMessageEmbeddingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordmessageembedding.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case discordmessageembedding.FieldModel:
+		return m.OldModel(ctx)
+	case discordmessageembedding.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case discordmessageembedding.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordMessageEmbedding field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordMessageEmbeddingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordmessageembedding.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case discordmessageembedding.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case discordmessageembedding.FieldEmbedding:
+		v, ok := value.(pgvector.Vector)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case discordmessageembedding.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageEmbedding field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordMessageEmbeddingMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordMessageEmbeddingMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordMessageEmbeddingMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordMessageEmbedding numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordMessageEmbeddingMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordMessageEmbeddingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordMessageEmbeddingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordMessageEmbedding nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordMessageEmbeddingMutation) ResetField(name string) error {
+	switch name {
+	case discordmessageembedding.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case discordmessageembedding.FieldModel:
+		m.ResetModel()
+		return nil
+	case discordmessageembedding.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case discordmessageembedding.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageEmbedding field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordMessageEmbeddingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, discordmessageembedding.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordMessageEmbeddingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordmessageembedding.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordMessageEmbeddingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordMessageEmbeddingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordMessageEmbeddingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, discordmessageembedding.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordMessageEmbeddingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordmessageembedding.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordMessageEmbeddingMutation) ClearEdge(name string) error {
+	switch name {
+	case discordmessageembedding.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageEmbedding unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordMessageEmbeddingMutation) ResetEdge(name string) error {
+	switch name {
+	case discordmessageembedding.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordMessageEmbedding edge %s", name)
+}
+
+// DiscordProfileChangeMutation represents an operation that mutates the DiscordProfileChange nodes in the graph.
+type DiscordProfileChangeMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	kind          *discordprofilechange.Kind
+	value         *string
+	changed_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*DiscordProfileChange, error)
+	predicates    []predicate.DiscordProfileChange
+}
+
+var _ ent.Mutation = (*DiscordProfileChangeMutation)(nil)
+
+// discordprofilechangeOption allows management of the mutation configuration using functional options.
+type discordprofilechangeOption func(*DiscordProfileChangeMutation)
+
+// newDiscordProfileChangeMutation creates new mutation for the DiscordProfileChange entity.
+func newDiscordProfileChangeMutation(c config, op Op, opts ...discordprofilechangeOption) *DiscordProfileChangeMutation {
+	m := &DiscordProfileChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordProfileChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordProfileChangeID sets the ID field of the mutation.
+func withDiscordProfileChangeID(id int) discordprofilechangeOption {
+	return func(m *DiscordProfileChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordProfileChange
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordProfileChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordProfileChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordProfileChange sets the old DiscordProfileChange of the mutation.
+func withDiscordProfileChange(node *DiscordProfileChange) discordprofilechangeOption {
+	return func(m *DiscordProfileChangeMutation) {
+		m.oldValue = func(context.Context) (*DiscordProfileChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordProfileChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordProfileChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordProfileChangeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordProfileChangeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordProfileChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DiscordProfileChangeMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DiscordProfileChangeMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DiscordProfileChange entity.
+// If the DiscordProfileChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordProfileChangeMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DiscordProfileChangeMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordProfileChangeMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordProfileChangeMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the DiscordProfileChange entity.
+// If the DiscordProfileChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordProfileChangeMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (m *DiscordProfileChangeMutation) ClearGuildID() {
+	m.guild_id = nil
+	m.clearedFields[discordprofilechange.FieldGuildID] = struct{}{}
+}
+
+// GuildIDCleared returns if the "guild_id" field was cleared in this mutation.
+func (m *DiscordProfileChangeMutation) GuildIDCleared() bool {
+	_, ok := m.clearedFields[discordprofilechange.FieldGuildID]
+	return ok
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordProfileChangeMutation) ResetGuildID() {
+	m.guild_id = nil
+	delete(m.clearedFields, discordprofilechange.FieldGuildID)
+}
+
+// SetKind sets the "kind" field.
+func (m *DiscordProfileChangeMutation) SetKind(d discordprofilechange.Kind) {
+	m.kind = &d
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *DiscordProfileChangeMutation) Kind() (r discordprofilechange.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the DiscordProfileChange entity.
+// If the DiscordProfileChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordProfileChangeMutation) OldKind(ctx context.Context) (v discordprofilechange.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *DiscordProfileChangeMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetValue sets the "value" field.
+func (m *DiscordProfileChangeMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *DiscordProfileChangeMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the DiscordProfileChange entity.
+// If the DiscordProfileChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordProfileChangeMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *DiscordProfileChangeMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *DiscordProfileChangeMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *DiscordProfileChangeMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedAt returns the old "changed_at" field's value of the DiscordProfileChange entity.
+// If the DiscordProfileChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordProfileChangeMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *DiscordProfileChangeMutation) ResetChangedAt() {
+	m.changed_at = nil
+}
+
+// ClearUser clears the "user" edge to the DiscordUser entity.
+func (m *DiscordProfileChangeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[discordprofilechange.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the DiscordUser entity was cleared.
+func (m *DiscordProfileChangeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DiscordProfileChangeMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DiscordProfileChangeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the DiscordProfileChangeMutation builder.
+func (m *DiscordProfileChangeMutation) Where(ps ...predicate.DiscordProfileChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordProfileChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordProfileChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordProfileChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordProfileChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordProfileChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordProfileChange).
+func (m *DiscordProfileChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordProfileChangeMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, discordprofilechange.FieldUserID)
+	}
+	if m.guild_id != nil {
+		fields = append(fields, discordprofilechange.FieldGuildID)
+	}
+	if m.kind != nil {
+		fields = append(fields, discordprofilechange.FieldKind)
+	}
+	if m.value != nil {
+		fields = append(fields, discordprofilechange.FieldValue)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, discordprofilechange.FieldChangedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordProfileChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordprofilechange.FieldUserID:
+		return m.UserID()
+	case discordprofilechange.FieldGuildID:
+		return m.GuildID()
+	case discordprofilechange.FieldKind:
+		return m.Kind()
+	case discordprofilechange.FieldValue:
+		return m.Value()
+	case discordprofilechange.FieldChangedAt:
+		return m.ChangedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordProfileChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordprofilechange.FieldUserID:
+		return m.OldUserID(ctx)
+	case discordprofilechange.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordprofilechange.FieldKind:
+		return m.OldKind(ctx)
+	case discordprofilechange.FieldValue:
+		return m.OldValue(ctx)
+	case discordprofilechange.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordProfileChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordProfileChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordprofilechange.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case discordprofilechange.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case discordprofilechange.FieldKind:
+		v, ok := value.(discordprofilechange.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case discordprofilechange.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case discordprofilechange.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordProfileChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordProfileChangeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordProfileChangeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordProfileChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordProfileChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordProfileChangeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discordprofilechange.FieldGuildID) {
+		fields = append(fields, discordprofilechange.FieldGuildID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordProfileChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordProfileChangeMutation) ClearField(name string) error {
+	switch name {
+	case discordprofilechange.FieldGuildID:
+		m.ClearGuildID()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordProfileChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordProfileChangeMutation) ResetField(name string) error {
+	switch name {
+	case discordprofilechange.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case discordprofilechange.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case discordprofilechange.FieldKind:
+		m.ResetKind()
+		return nil
+	case discordprofilechange.FieldValue:
+		m.ResetValue()
+		return nil
+	case discordprofilechange.FieldChangedAt:
+		m.ResetChangedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordProfileChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordProfileChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, discordprofilechange.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordProfileChangeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordprofilechange.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordProfileChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordProfileChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordProfileChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, discordprofilechange.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordProfileChangeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordprofilechange.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordProfileChangeMutation) ClearEdge(name string) error {
+	switch name {
+	case discordprofilechange.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordProfileChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordProfileChangeMutation) ResetEdge(name string) error {
+	switch name {
+	case discordprofilechange.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordProfileChange edge %s", name)
+}
+
+// DiscordReactionMutation represents an operation that mutates the DiscordReaction nodes in the graph.
+type DiscordReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	user_id        *string
+	emoji          *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *string
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*DiscordReaction, error)
+	predicates     []predicate.DiscordReaction
+}
+
+var _ ent.Mutation = (*DiscordReactionMutation)(nil)
+
+// discordreactionOption allows management of the mutation configuration using functional options.
+type discordreactionOption func(*DiscordReactionMutation)
+
+// newDiscordReactionMutation creates new mutation for the DiscordReaction entity.
+func newDiscordReactionMutation(c config, op Op, opts ...discordreactionOption) *DiscordReactionMutation {
+	m := &DiscordReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordReactionID sets the ID field of the mutation.
+func withDiscordReactionID(id int) discordreactionOption {
+	return func(m *DiscordReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordReaction
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordReaction sets the old DiscordReaction of the mutation.
+func withDiscordReaction(node *DiscordReaction) discordreactionOption {
+	return func(m *DiscordReactionMutation) {
+		m.oldValue = func(context.Context) (*DiscordReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordReactionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordReactionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetMessageID sets the "message_id" field.
+func (m *DiscordReactionMutation) SetMessageID(s string) {
+	m.message = &s
+}
+
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *DiscordReactionMutation) MessageID() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageID returns the old "message_id" field's value of the DiscordReaction entity.
+// If the DiscordReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordReactionMutation) OldMessageID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
+}
+
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *DiscordReactionMutation) ResetMessageID() {
+	m.message = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *DiscordReactionMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *DiscordReactionMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the DiscordReaction entity.
+// If the DiscordReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordReactionMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *DiscordReactionMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *DiscordReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *DiscordReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the DiscordReaction entity.
+// If the DiscordReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *DiscordReactionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DiscordReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DiscordReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the DiscordReaction entity.
+// If the DiscordReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DiscordReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearMessage clears the "message" edge to the DiscordMessage entity.
+func (m *DiscordReactionMutation) ClearMessage() {
+	m.clearedmessage = true
+	m.clearedFields[discordreaction.FieldMessageID] = struct{}{}
+}
+
+// MessageCleared reports if the "message" edge to the DiscordMessage entity was cleared.
+func (m *DiscordReactionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *DiscordReactionMutation) MessageIDs() (ids []string) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *DiscordReactionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the DiscordReactionMutation builder.
+func (m *DiscordReactionMutation) Where(ps ...predicate.DiscordReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordReaction).
+func (m *DiscordReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordReactionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.message != nil {
+		fields = append(fields, discordreaction.FieldMessageID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, discordreaction.FieldUserID)
+	}
+	if m.emoji != nil {
+		fields = append(fields, discordreaction.FieldEmoji)
+	}
+	if m.created_at != nil {
+		fields = append(fields, discordreaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordreaction.FieldMessageID:
+		return m.MessageID()
+	case discordreaction.FieldUserID:
+		return m.UserID()
+	case discordreaction.FieldEmoji:
+		return m.Emoji()
+	case discordreaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordreaction.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case discordreaction.FieldUserID:
+		return m.OldUserID(ctx)
+	case discordreaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case discordreaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordReaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordreaction.FieldMessageID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case discordreaction.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case discordreaction.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case discordreaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordReaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordReactionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordReactionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordReaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordReaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordReactionMutation) ResetField(name string) error {
+	switch name {
+	case discordreaction.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case discordreaction.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case discordreaction.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case discordreaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordReaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, discordreaction.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordReactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordreaction.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, discordreaction.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordReactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordreaction.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordReactionMutation) ClearEdge(name string) error {
+	switch name {
+	case discordreaction.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordReaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case discordreaction.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordReaction edge %s", name)
+}
+
+// DiscordRoleMutation represents an operation that mutates the DiscordRole nodes in the graph.
+type DiscordRoleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *string
+	guild_id            *string
+	name                *string
+	clearedFields       map[string]struct{}
+	mentioned_in        map[string]struct{}
+	removedmentioned_in map[string]struct{}
+	clearedmentioned_in bool
+	done                bool
+	oldValue            func(context.Context) (*DiscordRole, error)
+	predicates          []predicate.DiscordRole
+}
+
+var _ ent.Mutation = (*DiscordRoleMutation)(nil)
+
+// discordroleOption allows management of the mutation configuration using functional options.
+type discordroleOption func(*DiscordRoleMutation)
+
+// newDiscordRoleMutation creates new mutation for the DiscordRole entity.
+func newDiscordRoleMutation(c config, op Op, opts ...discordroleOption) *DiscordRoleMutation {
+	m := &DiscordRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordRoleID sets the ID field of the mutation.
+func withDiscordRoleID(id string) discordroleOption {
+	return func(m *DiscordRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordRole
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordRole sets the old DiscordRole of the mutation.
+func withDiscordRole(node *DiscordRole) discordroleOption {
+	return func(m *DiscordRoleMutation) {
+		m.oldValue = func(context.Context) (*DiscordRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordRole entities.
+func (m *DiscordRoleMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordRoleMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordRoleMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *DiscordRoleMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *DiscordRoleMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the DiscordRole entity.
+// If the DiscordRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordRoleMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *DiscordRoleMutation) ResetGuildID() {
+	m.guild_id = nil
+}
+
+// SetName sets the "name" field.
+func (m *DiscordRoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DiscordRoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DiscordRole entity.
+// If the DiscordRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordRoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DiscordRoleMutation) ResetName() {
+	m.name = nil
+}
+
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by ids.
+func (m *DiscordRoleMutation) AddMentionedInIDs(ids ...string) {
+	if m.mentioned_in == nil {
+		m.mentioned_in = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.mentioned_in[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedIn clears the "mentioned_in" edge to the DiscordMessage entity.
+func (m *DiscordRoleMutation) ClearMentionedIn() {
+	m.clearedmentioned_in = true
+}
+
+// MentionedInCleared reports if the "mentioned_in" edge to the DiscordMessage entity was cleared.
+func (m *DiscordRoleMutation) MentionedInCleared() bool {
+	return m.clearedmentioned_in
+}
+
+// RemoveMentionedInIDs removes the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (m *DiscordRoleMutation) RemoveMentionedInIDs(ids ...string) {
+	if m.removedmentioned_in == nil {
+		m.removedmentioned_in = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_in, ids[i])
+		m.removedmentioned_in[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedIn returns the removed IDs of the "mentioned_in" edge to the DiscordMessage entity.
+func (m *DiscordRoleMutation) RemovedMentionedInIDs() (ids []string) {
+	for id := range m.removedmentioned_in {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedInIDs returns the "mentioned_in" edge IDs in the mutation.
+func (m *DiscordRoleMutation) MentionedInIDs() (ids []string) {
+	for id := range m.mentioned_in {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedIn resets all changes to the "mentioned_in" edge.
+func (m *DiscordRoleMutation) ResetMentionedIn() {
+	m.mentioned_in = nil
+	m.clearedmentioned_in = false
+	m.removedmentioned_in = nil
+}
+
+// Where appends a list predicates to the DiscordRoleMutation builder.
+func (m *DiscordRoleMutation) Where(ps ...predicate.DiscordRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordRole).
+func (m *DiscordRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordRoleMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.guild_id != nil {
+		fields = append(fields, discordrole.FieldGuildID)
+	}
+	if m.name != nil {
+		fields = append(fields, discordrole.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discordrole.FieldGuildID:
+		return m.GuildID()
+	case discordrole.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discordrole.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case discordrole.FieldName:
+		return m.OldName(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discordrole.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case discordrole.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordRoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordRoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordRoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordRoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DiscordRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordRoleMutation) ResetField(name string) error {
+	switch name {
+	case discordrole.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case discordrole.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.mentioned_in != nil {
+		edges = append(edges, discordrole.EdgeMentionedIn)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordRoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discordrole.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.mentioned_in))
+		for id := range m.mentioned_in {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmentioned_in != nil {
+		edges = append(edges, discordrole.EdgeMentionedIn)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordRoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case discordrole.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_in))
+		for id := range m.removedmentioned_in {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmentioned_in {
+		edges = append(edges, discordrole.EdgeMentionedIn)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordRoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discordrole.EdgeMentionedIn:
+		return m.clearedmentioned_in
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordRoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordRoleMutation) ResetEdge(name string) error {
+	switch name {
+	case discordrole.EdgeMentionedIn:
+		m.ResetMentionedIn()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordRole edge %s", name)
+}
+
+// DiscordUserMutation represents an operation that mutates the DiscordUser nodes in the graph.
+type DiscordUserMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *string
+	username               *string
+	global_name            *string
+	avatar                 *string
+	profiling_opt_out      *bool
+	dm_enabled             *bool
+	clearedFields          map[string]struct{}
+	messages               map[string]struct{}
+	removedmessages        map[string]struct{}
+	clearedmessages        bool
+	mentioned_in           map[string]struct{}
+	removedmentioned_in    map[string]struct{}
+	clearedmentioned_in    bool
+	memberships            map[int]struct{}
+	removedmemberships     map[int]struct{}
+	clearedmemberships     bool
+	profile_changes        map[int]struct{}
+	removedprofile_changes map[int]struct{}
+	clearedprofile_changes bool
+	direct_messages        map[string]struct{}
+	removeddirect_messages map[string]struct{}
+	cleareddirect_messages bool
+	done                   bool
+	oldValue               func(context.Context) (*DiscordUser, error)
+	predicates             []predicate.DiscordUser
+}
+
+var _ ent.Mutation = (*DiscordUserMutation)(nil)
+
+// discorduserOption allows management of the mutation configuration using functional options.
+type discorduserOption func(*DiscordUserMutation)
+
+// newDiscordUserMutation creates new mutation for the DiscordUser entity.
+func newDiscordUserMutation(c config, op Op, opts ...discorduserOption) *DiscordUserMutation {
+	m := &DiscordUserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDiscordUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDiscordUserID sets the ID field of the mutation.
+func withDiscordUserID(id string) discorduserOption {
+	return func(m *DiscordUserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DiscordUser
+		)
+		m.oldValue = func(ctx context.Context) (*DiscordUser, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DiscordUser.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDiscordUser sets the old DiscordUser of the mutation.
+func withDiscordUser(node *DiscordUser) discorduserOption {
+	return func(m *DiscordUserMutation) {
+		m.oldValue = func(context.Context) (*DiscordUser, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DiscordUserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DiscordUserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DiscordUser entities.
+func (m *DiscordUserMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DiscordUserMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DiscordUserMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DiscordUser.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUsername sets the "username" field.
+func (m *DiscordUserMutation) SetUsername(s string) {
+	m.username = &s
+}
+
+// Username returns the value of the "username" field in the mutation.
+func (m *DiscordUserMutation) Username() (r string, exists bool) {
+	v := m.username
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsername returns the old "username" field's value of the DiscordUser entity.
+// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordUserMutation) OldUsername(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsername is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsername requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsername: %w", err)
+	}
+	return oldValue.Username, nil
+}
+
+// ResetUsername resets all changes to the "username" field.
+func (m *DiscordUserMutation) ResetUsername() {
+	m.username = nil
+}
+
+// SetGlobalName sets the "global_name" field.
+func (m *DiscordUserMutation) SetGlobalName(s string) {
+	m.global_name = &s
+}
+
+// GlobalName returns the value of the "global_name" field in the mutation.
+func (m *DiscordUserMutation) GlobalName() (r string, exists bool) {
+	v := m.global_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGlobalName returns the old "global_name" field's value of the DiscordUser entity.
+// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordUserMutation) OldGlobalName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGlobalName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGlobalName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGlobalName: %w", err)
+	}
+	return oldValue.GlobalName, nil
+}
+
+// ResetGlobalName resets all changes to the "global_name" field.
+func (m *DiscordUserMutation) ResetGlobalName() {
+	m.global_name = nil
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *DiscordUserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *DiscordUserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the DiscordUser entity.
+// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordUserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *DiscordUserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[discorduser.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *DiscordUserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[discorduser.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *DiscordUserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, discorduser.FieldAvatar)
+}
+
+// SetProfilingOptOut sets the "profiling_opt_out" field.
+func (m *DiscordUserMutation) SetProfilingOptOut(b bool) {
+	m.profiling_opt_out = &b
+}
+
+// ProfilingOptOut returns the value of the "profiling_opt_out" field in the mutation.
+func (m *DiscordUserMutation) ProfilingOptOut() (r bool, exists bool) {
+	v := m.profiling_opt_out
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfilingOptOut returns the old "profiling_opt_out" field's value of the DiscordUser entity.
+// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordUserMutation) OldProfilingOptOut(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfilingOptOut is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfilingOptOut requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfilingOptOut: %w", err)
+	}
+	return oldValue.ProfilingOptOut, nil
+}
+
+// ResetProfilingOptOut resets all changes to the "profiling_opt_out" field.
+func (m *DiscordUserMutation) ResetProfilingOptOut() {
+	m.profiling_opt_out = nil
+}
+
+// SetDmEnabled sets the "dm_enabled" field.
+func (m *DiscordUserMutation) SetDmEnabled(b bool) {
+	m.dm_enabled = &b
+}
+
+// DmEnabled returns the value of the "dm_enabled" field in the mutation.
+func (m *DiscordUserMutation) DmEnabled() (r bool, exists bool) {
+	v := m.dm_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDmEnabled returns the old "dm_enabled" field's value of the DiscordUser entity.
+// If the DiscordUser object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DiscordUserMutation) OldDmEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDmEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDmEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDmEnabled: %w", err)
+	}
+	return oldValue.DmEnabled, nil
+}
+
+// ResetDmEnabled resets all changes to the "dm_enabled" field.
+func (m *DiscordUserMutation) ResetDmEnabled() {
+	m.dm_enabled = nil
+}
+
+// AddMessageIDs adds the "messages" edge to the DiscordMessage entity by ids.
+func (m *DiscordUserMutation) AddMessageIDs(ids ...string) {
+	if m.messages == nil {
+		m.messages = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMessages clears the "messages" edge to the DiscordMessage entity.
+func (m *DiscordUserMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the DiscordMessage entity was cleared.
+func (m *DiscordUserMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the DiscordMessage entity by IDs.
+func (m *DiscordUserMutation) RemoveMessageIDs(ids ...string) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the DiscordMessage entity.
+func (m *DiscordUserMutation) RemovedMessagesIDs() (ids []string) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *DiscordUserMutation) MessagesIDs() (ids []string) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMessages resets all changes to the "messages" edge.
+func (m *DiscordUserMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
+}
+
+// AddMentionedInIDs adds the "mentioned_in" edge to the DiscordMessage entity by ids.
+func (m *DiscordUserMutation) AddMentionedInIDs(ids ...string) {
+	if m.mentioned_in == nil {
+		m.mentioned_in = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.mentioned_in[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMentionedIn clears the "mentioned_in" edge to the DiscordMessage entity.
+func (m *DiscordUserMutation) ClearMentionedIn() {
+	m.clearedmentioned_in = true
+}
+
+// MentionedInCleared reports if the "mentioned_in" edge to the DiscordMessage entity was cleared.
+func (m *DiscordUserMutation) MentionedInCleared() bool {
+	return m.clearedmentioned_in
+}
+
+// RemoveMentionedInIDs removes the "mentioned_in" edge to the DiscordMessage entity by IDs.
+func (m *DiscordUserMutation) RemoveMentionedInIDs(ids ...string) {
+	if m.removedmentioned_in == nil {
+		m.removedmentioned_in = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.mentioned_in, ids[i])
+		m.removedmentioned_in[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMentionedIn returns the removed IDs of the "mentioned_in" edge to the DiscordMessage entity.
+func (m *DiscordUserMutation) RemovedMentionedInIDs() (ids []string) {
+	for id := range m.removedmentioned_in {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MentionedInIDs returns the "mentioned_in" edge IDs in the mutation.
+func (m *DiscordUserMutation) MentionedInIDs() (ids []string) {
+	for id := range m.mentioned_in {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMentionedIn resets all changes to the "mentioned_in" edge.
+func (m *DiscordUserMutation) ResetMentionedIn() {
+	m.mentioned_in = nil
+	m.clearedmentioned_in = false
+	m.removedmentioned_in = nil
+}
+
+// AddMembershipIDs adds the "memberships" edge to the DiscordGuildMember entity by ids.
+func (m *DiscordUserMutation) AddMembershipIDs(ids ...int) {
+	if m.memberships == nil {
+		m.memberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMemberships clears the "memberships" edge to the DiscordGuildMember entity.
+func (m *DiscordUserMutation) ClearMemberships() {
+	m.clearedmemberships = true
+}
+
+// MembershipsCleared reports if the "memberships" edge to the DiscordGuildMember entity was cleared.
+func (m *DiscordUserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the DiscordGuildMember entity by IDs.
+func (m *DiscordUserMutation) RemoveMembershipIDs(ids ...int) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the DiscordGuildMember entity.
+func (m *DiscordUserMutation) RemovedMembershipsIDs() (ids []int) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *DiscordUserMutation) MembershipsIDs() (ids []int) {
+	for id := range m.memberships {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *DiscordUserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
+}
+
+// AddProfileChangeIDs adds the "profile_changes" edge to the DiscordProfileChange entity by ids.
+func (m *DiscordUserMutation) AddProfileChangeIDs(ids ...int) {
+	if m.profile_changes == nil {
+		m.profile_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.profile_changes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProfileChanges clears the "profile_changes" edge to the DiscordProfileChange entity.
+func (m *DiscordUserMutation) ClearProfileChanges() {
+	m.clearedprofile_changes = true
+}
+
+// ProfileChangesCleared reports if the "profile_changes" edge to the DiscordProfileChange entity was cleared.
+func (m *DiscordUserMutation) ProfileChangesCleared() bool {
+	return m.clearedprofile_changes
+}
+
+// RemoveProfileChangeIDs removes the "profile_changes" edge to the DiscordProfileChange entity by IDs.
+func (m *DiscordUserMutation) RemoveProfileChangeIDs(ids ...int) {
+	if m.removedprofile_changes == nil {
+		m.removedprofile_changes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.profile_changes, ids[i])
+		m.removedprofile_changes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProfileChanges returns the removed IDs of the "profile_changes" edge to the DiscordProfileChange entity.
+func (m *DiscordUserMutation) RemovedProfileChangesIDs() (ids []int) {
+	for id := range m.removedprofile_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProfileChangesIDs returns the "profile_changes" edge IDs in the mutation.
+func (m *DiscordUserMutation) ProfileChangesIDs() (ids []int) {
+	for id := range m.profile_changes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProfileChanges resets all changes to the "profile_changes" edge.
+func (m *DiscordUserMutation) ResetProfileChanges() {
+	m.profile_changes = nil
+	m.clearedprofile_changes = false
+	m.removedprofile_changes = nil
+}
+
+// AddDirectMessageIDs adds the "direct_messages" edge to the DiscordDirectMessage entity by ids.
+func (m *DiscordUserMutation) AddDirectMessageIDs(ids ...string) {
+	if m.direct_messages == nil {
+		m.direct_messages = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.direct_messages[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDirectMessages clears the "direct_messages" edge to the DiscordDirectMessage entity.
+func (m *DiscordUserMutation) ClearDirectMessages() {
+	m.cleareddirect_messages = true
+}
+
+// DirectMessagesCleared reports if the "direct_messages" edge to the DiscordDirectMessage entity was cleared.
+func (m *DiscordUserMutation) DirectMessagesCleared() bool {
+	return m.cleareddirect_messages
+}
+
+// RemoveDirectMessageIDs removes the "direct_messages" edge to the DiscordDirectMessage entity by IDs.
+func (m *DiscordUserMutation) RemoveDirectMessageIDs(ids ...string) {
+	if m.removeddirect_messages == nil {
+		m.removeddirect_messages = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.direct_messages, ids[i])
+		m.removeddirect_messages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDirectMessages returns the removed IDs of the "direct_messages" edge to the DiscordDirectMessage entity.
+func (m *DiscordUserMutation) RemovedDirectMessagesIDs() (ids []string) {
+	for id := range m.removeddirect_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DirectMessagesIDs returns the "direct_messages" edge IDs in the mutation.
+func (m *DiscordUserMutation) DirectMessagesIDs() (ids []string) {
+	for id := range m.direct_messages {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDirectMessages resets all changes to the "direct_messages" edge.
+func (m *DiscordUserMutation) ResetDirectMessages() {
+	m.direct_messages = nil
+	m.cleareddirect_messages = false
+	m.removeddirect_messages = nil
+}
+
+// Where appends a list predicates to the DiscordUserMutation builder.
+func (m *DiscordUserMutation) Where(ps ...predicate.DiscordUser) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DiscordUserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DiscordUserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DiscordUser, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DiscordUserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DiscordUserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DiscordUser).
+func (m *DiscordUserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DiscordUserMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.username != nil {
+		fields = append(fields, discorduser.FieldUsername)
+	}
+	if m.global_name != nil {
+		fields = append(fields, discorduser.FieldGlobalName)
+	}
+	if m.avatar != nil {
+		fields = append(fields, discorduser.FieldAvatar)
+	}
+	if m.profiling_opt_out != nil {
+		fields = append(fields, discorduser.FieldProfilingOptOut)
+	}
+	if m.dm_enabled != nil {
+		fields = append(fields, discorduser.FieldDmEnabled)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DiscordUserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case discorduser.FieldUsername:
+		return m.Username()
+	case discorduser.FieldGlobalName:
+		return m.GlobalName()
+	case discorduser.FieldAvatar:
+		return m.Avatar()
+	case discorduser.FieldProfilingOptOut:
+		return m.ProfilingOptOut()
+	case discorduser.FieldDmEnabled:
+		return m.DmEnabled()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DiscordUserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case discorduser.FieldUsername:
+		return m.OldUsername(ctx)
+	case discorduser.FieldGlobalName:
+		return m.OldGlobalName(ctx)
+	case discorduser.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case discorduser.FieldProfilingOptOut:
+		return m.OldProfilingOptOut(ctx)
+	case discorduser.FieldDmEnabled:
+		return m.OldDmEnabled(ctx)
+	}
+	return nil, fmt.Errorf("unknown DiscordUser field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordUserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case discorduser.FieldUsername:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsername(v)
+		return nil
+	case discorduser.FieldGlobalName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGlobalName(v)
+		return nil
+	case discorduser.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case discorduser.FieldProfilingOptOut:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfilingOptOut(v)
+		return nil
+	case discorduser.FieldDmEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDmEnabled(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordUser field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DiscordUserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DiscordUserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DiscordUserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordUser numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DiscordUserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(discorduser.FieldAvatar) {
+		fields = append(fields, discorduser.FieldAvatar)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DiscordUserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DiscordUserMutation) ClearField(name string) error {
+	switch name {
+	case discorduser.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordUser nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DiscordUserMutation) ResetField(name string) error {
+	switch name {
+	case discorduser.FieldUsername:
+		m.ResetUsername()
+		return nil
+	case discorduser.FieldGlobalName:
+		m.ResetGlobalName()
+		return nil
+	case discorduser.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case discorduser.FieldProfilingOptOut:
+		m.ResetProfilingOptOut()
+		return nil
+	case discorduser.FieldDmEnabled:
+		m.ResetDmEnabled()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordUser field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DiscordUserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.messages != nil {
+		edges = append(edges, discorduser.EdgeMessages)
+	}
+	if m.mentioned_in != nil {
+		edges = append(edges, discorduser.EdgeMentionedIn)
+	}
+	if m.memberships != nil {
+		edges = append(edges, discorduser.EdgeMemberships)
+	}
+	if m.profile_changes != nil {
+		edges = append(edges, discorduser.EdgeProfileChanges)
+	}
+	if m.direct_messages != nil {
+		edges = append(edges, discorduser.EdgeDirectMessages)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DiscordUserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case discorduser.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.mentioned_in))
+		for id := range m.mentioned_in {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeProfileChanges:
+		ids := make([]ent.Value, 0, len(m.profile_changes))
+		for id := range m.profile_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeDirectMessages:
+		ids := make([]ent.Value, 0, len(m.direct_messages))
+		for id := range m.direct_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DiscordUserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedmessages != nil {
+		edges = append(edges, discorduser.EdgeMessages)
+	}
+	if m.removedmentioned_in != nil {
+		edges = append(edges, discorduser.EdgeMentionedIn)
+	}
+	if m.removedmemberships != nil {
+		edges = append(edges, discorduser.EdgeMemberships)
+	}
+	if m.removedprofile_changes != nil {
+		edges = append(edges, discorduser.EdgeProfileChanges)
+	}
+	if m.removeddirect_messages != nil {
+		edges = append(edges, discorduser.EdgeDirectMessages)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DiscordUserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case discorduser.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeMentionedIn:
+		ids := make([]ent.Value, 0, len(m.removedmentioned_in))
+		for id := range m.removedmentioned_in {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeProfileChanges:
+		ids := make([]ent.Value, 0, len(m.removedprofile_changes))
+		for id := range m.removedprofile_changes {
+			ids = append(ids, id)
+		}
+		return ids
+	case discorduser.EdgeDirectMessages:
+		ids := make([]ent.Value, 0, len(m.removeddirect_messages))
+		for id := range m.removeddirect_messages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DiscordUserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedmessages {
+		edges = append(edges, discorduser.EdgeMessages)
+	}
+	if m.clearedmentioned_in {
+		edges = append(edges, discorduser.EdgeMentionedIn)
+	}
+	if m.clearedmemberships {
+		edges = append(edges, discorduser.EdgeMemberships)
+	}
+	if m.clearedprofile_changes {
+		edges = append(edges, discorduser.EdgeProfileChanges)
+	}
+	if m.cleareddirect_messages {
+		edges = append(edges, discorduser.EdgeDirectMessages)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DiscordUserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case discorduser.EdgeMessages:
+		return m.clearedmessages
+	case discorduser.EdgeMentionedIn:
+		return m.clearedmentioned_in
+	case discorduser.EdgeMemberships:
+		return m.clearedmemberships
+	case discorduser.EdgeProfileChanges:
+		return m.clearedprofile_changes
+	case discorduser.EdgeDirectMessages:
+		return m.cleareddirect_messages
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DiscordUserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DiscordUser unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DiscordUserMutation) ResetEdge(name string) error {
+	switch name {
+	case discorduser.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case discorduser.EdgeMentionedIn:
+		m.ResetMentionedIn()
+		return nil
+	case discorduser.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case discorduser.EdgeProfileChanges:
+		m.ResetProfileChanges()
+		return nil
+	case discorduser.EdgeDirectMessages:
+		m.ResetDirectMessages()
+		return nil
+	}
+	return fmt.Errorf("unknown DiscordUser edge %s", name)
+}
+
+// EmbeddingModelMutation represents an operation that mutates the EmbeddingModel nodes in the graph.
+type EmbeddingModelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	dimensions    *int
+	adddimensions *int
+	active        *bool
+	created_at    *time.Time
+	ready_at      *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmbeddingModel, error)
+	predicates    []predicate.EmbeddingModel
+}
+
+var _ ent.Mutation = (*EmbeddingModelMutation)(nil)
+
+// embeddingmodelOption allows management of the mutation configuration using functional options.
+type embeddingmodelOption func(*EmbeddingModelMutation)
+
+// newEmbeddingModelMutation creates new mutation for the EmbeddingModel entity.
+func newEmbeddingModelMutation(c config, op Op, opts ...embeddingmodelOption) *EmbeddingModelMutation {
+	m := &EmbeddingModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmbeddingModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmbeddingModelID sets the ID field of the mutation.
+func withEmbeddingModelID(id string) embeddingmodelOption {
+	return func(m *EmbeddingModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmbeddingModel
+		)
+		m.oldValue = func(ctx context.Context) (*EmbeddingModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmbeddingModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmbeddingModel sets the old EmbeddingModel of the mutation.
+func withEmbeddingModel(node *EmbeddingModel) embeddingmodelOption {
+	return func(m *EmbeddingModelMutation) {
+		m.oldValue = func(context.Context) (*EmbeddingModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmbeddingModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmbeddingModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EmbeddingModel entities.
+func (m *EmbeddingModelMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmbeddingModelMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmbeddingModelMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmbeddingModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDimensions sets the "dimensions" field.
+func (m *EmbeddingModelMutation) SetDimensions(i int) {
+	m.dimensions = &i
+	m.adddimensions = nil
+}
+
+// Dimensions returns the value of the "dimensions" field in the mutation.
+func (m *EmbeddingModelMutation) Dimensions() (r int, exists bool) {
+	v := m.dimensions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDimensions returns the old "dimensions" field's value of the EmbeddingModel entity.
+// If the EmbeddingModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingModelMutation) OldDimensions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDimensions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDimensions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDimensions: %w", err)
+	}
+	return oldValue.Dimensions, nil
+}
+
+// AddDimensions adds i to the "dimensions" field.
+func (m *EmbeddingModelMutation) AddDimensions(i int) {
+	if m.adddimensions != nil {
+		*m.adddimensions += i
+	} else {
+		m.adddimensions = &i
+	}
+}
+
+// AddedDimensions returns the value that was added to the "dimensions" field in this mutation.
+func (m *EmbeddingModelMutation) AddedDimensions() (r int, exists bool) {
+	v := m.adddimensions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDimensions resets all changes to the "dimensions" field.
+func (m *EmbeddingModelMutation) ResetDimensions() {
+	m.dimensions = nil
+	m.adddimensions = nil
+}
+
+// SetActive sets the "active" field.
+func (m *EmbeddingModelMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *EmbeddingModelMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the EmbeddingModel entity.
+// If the EmbeddingModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingModelMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *EmbeddingModelMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmbeddingModelMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmbeddingModelMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmbeddingModel entity.
+// If the EmbeddingModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingModelMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmbeddingModelMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReadyAt sets the "ready_at" field.
+func (m *EmbeddingModelMutation) SetReadyAt(t time.Time) {
+	m.ready_at = &t
+}
+
+// ReadyAt returns the value of the "ready_at" field in the mutation.
+func (m *EmbeddingModelMutation) ReadyAt() (r time.Time, exists bool) {
+	v := m.ready_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadyAt returns the old "ready_at" field's value of the EmbeddingModel entity.
+// If the EmbeddingModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmbeddingModelMutation) OldReadyAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadyAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadyAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadyAt: %w", err)
+	}
+	return oldValue.ReadyAt, nil
+}
+
+// ClearReadyAt clears the value of the "ready_at" field.
+func (m *EmbeddingModelMutation) ClearReadyAt() {
+	m.ready_at = nil
+	m.clearedFields[embeddingmodel.FieldReadyAt] = struct{}{}
+}
+
+// ReadyAtCleared returns if the "ready_at" field was cleared in this mutation.
+func (m *EmbeddingModelMutation) ReadyAtCleared() bool {
+	_, ok := m.clearedFields[embeddingmodel.FieldReadyAt]
+	return ok
+}
+
+// ResetReadyAt resets all changes to the "ready_at" field.
+func (m *EmbeddingModelMutation) ResetReadyAt() {
+	m.ready_at = nil
+	delete(m.clearedFields, embeddingmodel.FieldReadyAt)
+}
+
+// Where appends a list predicates to the EmbeddingModelMutation builder.
+func (m *EmbeddingModelMutation) Where(ps ...predicate.EmbeddingModel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmbeddingModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmbeddingModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmbeddingModel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmbeddingModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmbeddingModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmbeddingModel).
+func (m *EmbeddingModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmbeddingModelMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.dimensions != nil {
+		fields = append(fields, embeddingmodel.FieldDimensions)
+	}
+	if m.active != nil {
+		fields = append(fields, embeddingmodel.FieldActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, embeddingmodel.FieldCreatedAt)
+	}
+	if m.ready_at != nil {
+		fields = append(fields, embeddingmodel.FieldReadyAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmbeddingModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingmodel.FieldDimensions:
+		return m.Dimensions()
+	case embeddingmodel.FieldActive:
+		return m.Active()
+	case embeddingmodel.FieldCreatedAt:
+		return m.CreatedAt()
+	case embeddingmodel.FieldReadyAt:
+		return m.ReadyAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmbeddingModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case embeddingmodel.FieldDimensions:
+		return m.OldDimensions(ctx)
+	case embeddingmodel.FieldActive:
+		return m.OldActive(ctx)
+	case embeddingmodel.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case embeddingmodel.FieldReadyAt:
+		return m.OldReadyAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmbeddingModel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case embeddingmodel.FieldDimensions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDimensions(v)
+		return nil
+	case embeddingmodel.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case embeddingmodel.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case embeddingmodel.FieldReadyAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadyAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingModel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmbeddingModelMutation) AddedFields() []string {
+	var fields []string
+	if m.adddimensions != nil {
+		fields = append(fields, embeddingmodel.FieldDimensions)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmbeddingModelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case embeddingmodel.FieldDimensions:
+		return m.AddedDimensions()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmbeddingModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case embeddingmodel.FieldDimensions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDimensions(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingModel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmbeddingModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(embeddingmodel.FieldReadyAt) {
+		fields = append(fields, embeddingmodel.FieldReadyAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmbeddingModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmbeddingModelMutation) ClearField(name string) error {
+	switch name {
+	case embeddingmodel.FieldReadyAt:
+		m.ClearReadyAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingModel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmbeddingModelMutation) ResetField(name string) error {
+	switch name {
+	case embeddingmodel.FieldDimensions:
+		m.ResetDimensions()
+		return nil
+	case embeddingmodel.FieldActive:
+		m.ResetActive()
+		return nil
+	case embeddingmodel.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case embeddingmodel.FieldReadyAt:
+		m.ResetReadyAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmbeddingModel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmbeddingModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmbeddingModelMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmbeddingModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmbeddingModelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmbeddingModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmbeddingModelMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmbeddingModelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingModel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmbeddingModelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmbeddingModel edge %s", name)
+}
+
+// ModerationEventMutation represents an operation that mutates the ModerationEvent nodes in the graph.
+type ModerationEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	guild_id      *string
+	channel_id    *string
+	user_id       *string
+	source        *string
+	action        *moderationevent.Action
+	reasons       *[]string
+	appendreasons []string
+	score         *float64
+	addscore      *float64
+	original      *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ModerationEvent, error)
+	predicates    []predicate.ModerationEvent
+}
+
+var _ ent.Mutation = (*ModerationEventMutation)(nil)
+
+// moderationeventOption allows management of the mutation configuration using functional options.
+type moderationeventOption func(*ModerationEventMutation)
+
+// newModerationEventMutation creates new mutation for the ModerationEvent entity.
+func newModerationEventMutation(c config, op Op, opts ...moderationeventOption) *ModerationEventMutation {
+	m := &ModerationEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeModerationEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withModerationEventID sets the ID field of the mutation.
+func withModerationEventID(id int) moderationeventOption {
+	return func(m *ModerationEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ModerationEvent
+		)
+		m.oldValue = func(ctx context.Context) (*ModerationEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ModerationEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withModerationEvent sets the old ModerationEvent of the mutation.
+func withModerationEvent(node *ModerationEvent) moderationeventOption {
+	return func(m *ModerationEventMutation) {
+		m.oldValue = func(context.Context) (*ModerationEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ModerationEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ModerationEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ModerationEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ModerationEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ModerationEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *ModerationEventMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *ModerationEventMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (m *ModerationEventMutation) ClearGuildID() {
+	m.guild_id = nil
+	m.clearedFields[moderationevent.FieldGuildID] = struct{}{}
+}
+
+// GuildIDCleared returns if the "guild_id" field was cleared in this mutation.
+func (m *ModerationEventMutation) GuildIDCleared() bool {
+	_, ok := m.clearedFields[moderationevent.FieldGuildID]
+	return ok
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *ModerationEventMutation) ResetGuildID() {
+	m.guild_id = nil
+	delete(m.clearedFields, moderationevent.FieldGuildID)
+}
+
+// SetChannelID sets the "channel_id" field.
+func (m *ModerationEventMutation) SetChannelID(s string) {
+	m.channel_id = &s
+}
+
+// ChannelID returns the value of the "channel_id" field in the mutation.
+func (m *ModerationEventMutation) ChannelID() (r string, exists bool) {
+	v := m.channel_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannelID returns the old "channel_id" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldChannelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannelID: %w", err)
+	}
+	return oldValue.ChannelID, nil
+}
+
+// ClearChannelID clears the value of the "channel_id" field.
+func (m *ModerationEventMutation) ClearChannelID() {
+	m.channel_id = nil
+	m.clearedFields[moderationevent.FieldChannelID] = struct{}{}
+}
+
+// ChannelIDCleared returns if the "channel_id" field was cleared in this mutation.
+func (m *ModerationEventMutation) ChannelIDCleared() bool {
+	_, ok := m.clearedFields[moderationevent.FieldChannelID]
+	return ok
+}
+
+// ResetChannelID resets all changes to the "channel_id" field.
+func (m *ModerationEventMutation) ResetChannelID() {
+	m.channel_id = nil
+	delete(m.clearedFields, moderationevent.FieldChannelID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ModerationEventMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ModerationEventMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ModerationEventMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[moderationevent.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ModerationEventMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[moderationevent.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ModerationEventMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, moderationevent.FieldUserID)
+}
+
+// SetSource sets the "source" field.
+func (m *ModerationEventMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *ModerationEventMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *ModerationEventMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetAction sets the "action" field.
+func (m *ModerationEventMutation) SetAction(value moderationevent.Action) {
+	m.action = &value
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *ModerationEventMutation) Action() (r moderationevent.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldAction(ctx context.Context) (v moderationevent.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *ModerationEventMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetReasons sets the "reasons" field.
+func (m *ModerationEventMutation) SetReasons(s []string) {
+	m.reasons = &s
+	m.appendreasons = nil
+}
+
+// Reasons returns the value of the "reasons" field in the mutation.
+func (m *ModerationEventMutation) Reasons() (r []string, exists bool) {
+	v := m.reasons
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReasons returns the old "reasons" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldReasons(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReasons is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReasons requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReasons: %w", err)
+	}
+	return oldValue.Reasons, nil
+}
+
+// AppendReasons adds s to the "reasons" field.
+func (m *ModerationEventMutation) AppendReasons(s []string) {
+	m.appendreasons = append(m.appendreasons, s...)
+}
+
+// AppendedReasons returns the list of values that were appended to the "reasons" field in this mutation.
+func (m *ModerationEventMutation) AppendedReasons() ([]string, bool) {
+	if len(m.appendreasons) == 0 {
+		return nil, false
+	}
+	return m.appendreasons, true
+}
+
+// ClearReasons clears the value of the "reasons" field.
+func (m *ModerationEventMutation) ClearReasons() {
+	m.reasons = nil
+	m.appendreasons = nil
+	m.clearedFields[moderationevent.FieldReasons] = struct{}{}
+}
+
+// ReasonsCleared returns if the "reasons" field was cleared in this mutation.
+func (m *ModerationEventMutation) ReasonsCleared() bool {
+	_, ok := m.clearedFields[moderationevent.FieldReasons]
+	return ok
+}
+
+// ResetReasons resets all changes to the "reasons" field.
+func (m *ModerationEventMutation) ResetReasons() {
+	m.reasons = nil
+	m.appendreasons = nil
+	delete(m.clearedFields, moderationevent.FieldReasons)
+}
+
+// SetScore sets the "score" field.
+func (m *ModerationEventMutation) SetScore(f float64) {
+	m.score = &f
+	m.addscore = nil
+}
+
+// Score returns the value of the "score" field in the mutation.
+func (m *ModerationEventMutation) Score() (r float64, exists bool) {
+	v := m.score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldScore returns the old "score" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScore: %w", err)
+	}
+	return oldValue.Score, nil
+}
+
+// AddScore adds f to the "score" field.
+func (m *ModerationEventMutation) AddScore(f float64) {
+	if m.addscore != nil {
+		*m.addscore += f
+	} else {
+		m.addscore = &f
+	}
+}
+
+// AddedScore returns the value that was added to the "score" field in this mutation.
+func (m *ModerationEventMutation) AddedScore() (r float64, exists bool) {
+	v := m.addscore
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearScore clears the value of the "score" field.
+func (m *ModerationEventMutation) ClearScore() {
+	m.score = nil
+	m.addscore = nil
+	m.clearedFields[moderationevent.FieldScore] = struct{}{}
+}
+
+// ScoreCleared returns if the "score" field was cleared in this mutation.
+func (m *ModerationEventMutation) ScoreCleared() bool {
+	_, ok := m.clearedFields[moderationevent.FieldScore]
+	return ok
+}
+
+// ResetScore resets all changes to the "score" field.
+func (m *ModerationEventMutation) ResetScore() {
+	m.score = nil
+	m.addscore = nil
+	delete(m.clearedFields, moderationevent.FieldScore)
+}
+
+// SetOriginal sets the "original" field.
+func (m *ModerationEventMutation) SetOriginal(s string) {
+	m.original = &s
+}
+
+// Original returns the value of the "original" field in the mutation.
+func (m *ModerationEventMutation) Original() (r string, exists bool) {
+	v := m.original
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOriginal returns the old "original" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldOriginal(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOriginal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOriginal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOriginal: %w", err)
+	}
+	return oldValue.Original, nil
+}
+
+// ResetOriginal resets all changes to the "original" field.
+func (m *ModerationEventMutation) ResetOriginal() {
+	m.original = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ModerationEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ModerationEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ModerationEvent entity.
+// If the ModerationEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ModerationEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ModerationEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the ModerationEventMutation builder.
+func (m *ModerationEventMutation) Where(ps ...predicate.ModerationEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ModerationEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ModerationEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ModerationEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ModerationEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ModerationEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ModerationEvent).
+func (m *ModerationEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ModerationEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.guild_id != nil {
+		fields = append(fields, moderationevent.FieldGuildID)
+	}
+	if m.channel_id != nil {
+		fields = append(fields, moderationevent.FieldChannelID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, moderationevent.FieldUserID)
+	}
+	if m.source != nil {
+		fields = append(fields, moderationevent.FieldSource)
+	}
+	if m.action != nil {
+		fields = append(fields, moderationevent.FieldAction)
+	}
+	if m.reasons != nil {
+		fields = append(fields, moderationevent.FieldReasons)
+	}
+	if m.score != nil {
+		fields = append(fields, moderationevent.FieldScore)
+	}
+	if m.original != nil {
+		fields = append(fields, moderationevent.FieldOriginal)
+	}
+	if m.created_at != nil {
+		fields = append(fields, moderationevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ModerationEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case moderationevent.FieldGuildID:
+		return m.GuildID()
+	case moderationevent.FieldChannelID:
+		return m.ChannelID()
+	case moderationevent.FieldUserID:
+		return m.UserID()
+	case moderationevent.FieldSource:
+		return m.Source()
+	case moderationevent.FieldAction:
+		return m.Action()
+	case moderationevent.FieldReasons:
+		return m.Reasons()
+	case moderationevent.FieldScore:
+		return m.Score()
+	case moderationevent.FieldOriginal:
+		return m.Original()
+	case moderationevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ModerationEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case moderationevent.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case moderationevent.FieldChannelID:
+		return m.OldChannelID(ctx)
+	case moderationevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case moderationevent.FieldSource:
+		return m.OldSource(ctx)
+	case moderationevent.FieldAction:
+		return m.OldAction(ctx)
+	case moderationevent.FieldReasons:
+		return m.OldReasons(ctx)
+	case moderationevent.FieldScore:
+		return m.OldScore(ctx)
+	case moderationevent.FieldOriginal:
+		return m.OldOriginal(ctx)
+	case moderationevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ModerationEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case moderationevent.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case moderationevent.FieldChannelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannelID(v)
+		return nil
+	case moderationevent.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case moderationevent.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case moderationevent.FieldAction:
+		v, ok := value.(moderationevent.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case moderationevent.FieldReasons:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReasons(v)
+		return nil
+	case moderationevent.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScore(v)
+		return nil
+	case moderationevent.FieldOriginal:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOriginal(v)
+		return nil
+	case moderationevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ModerationEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addscore != nil {
+		fields = append(fields, moderationevent.FieldScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ModerationEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case moderationevent.FieldScore:
+		return m.AddedScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ModerationEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case moderationevent.FieldScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ModerationEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(moderationevent.FieldGuildID) {
+		fields = append(fields, moderationevent.FieldGuildID)
+	}
+	if m.FieldCleared(moderationevent.FieldChannelID) {
+		fields = append(fields, moderationevent.FieldChannelID)
+	}
+	if m.FieldCleared(moderationevent.FieldUserID) {
+		fields = append(fields, moderationevent.FieldUserID)
+	}
+	if m.FieldCleared(moderationevent.FieldReasons) {
+		fields = append(fields, moderationevent.FieldReasons)
+	}
+	if m.FieldCleared(moderationevent.FieldScore) {
+		fields = append(fields, moderationevent.FieldScore)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ModerationEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ModerationEventMutation) ClearField(name string) error {
+	switch name {
+	case moderationevent.FieldGuildID:
+		m.ClearGuildID()
+		return nil
+	case moderationevent.FieldChannelID:
+		m.ClearChannelID()
+		return nil
+	case moderationevent.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case moderationevent.FieldReasons:
+		m.ClearReasons()
+		return nil
+	case moderationevent.FieldScore:
+		m.ClearScore()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ModerationEventMutation) ResetField(name string) error {
+	switch name {
+	case moderationevent.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case moderationevent.FieldChannelID:
+		m.ResetChannelID()
+		return nil
+	case moderationevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case moderationevent.FieldSource:
+		m.ResetSource()
+		return nil
+	case moderationevent.FieldAction:
+		m.ResetAction()
+		return nil
+	case moderationevent.FieldReasons:
+		m.ResetReasons()
+		return nil
+	case moderationevent.FieldScore:
+		m.ResetScore()
+		return nil
+	case moderationevent.FieldOriginal:
+		m.ResetOriginal()
+		return nil
+	case moderationevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ModerationEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ModerationEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ModerationEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ModerationEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ModerationEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ModerationEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ModerationEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ModerationEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ModerationEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ModerationEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ModerationEvent edge %s", name)
+}
+
+// PrivacyEventMutation represents an operation that mutates the PrivacyEvent nodes in the graph.
+type PrivacyEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	user_id       *string
+	guild_id      *string
+	action        *privacyevent.Action
+	records       *int
+	addrecords    *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PrivacyEvent, error)
+	predicates    []predicate.PrivacyEvent
+}
+
+var _ ent.Mutation = (*PrivacyEventMutation)(nil)
+
+// privacyeventOption allows management of the mutation configuration using functional options.
+type privacyeventOption func(*PrivacyEventMutation)
+
+// newPrivacyEventMutation creates new mutation for the PrivacyEvent entity.
+func newPrivacyEventMutation(c config, op Op, opts ...privacyeventOption) *PrivacyEventMutation {
+	m := &PrivacyEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivacyEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivacyEventID sets the ID field of the mutation.
+func withPrivacyEventID(id int) privacyeventOption {
+	return func(m *PrivacyEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivacyEvent
+		)
+		m.oldValue = func(ctx context.Context) (*PrivacyEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivacyEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivacyEvent sets the old PrivacyEvent of the mutation.
+func withPrivacyEvent(node *PrivacyEvent) privacyeventOption {
+	return func(m *PrivacyEventMutation) {
+		m.oldValue = func(context.Context) (*PrivacyEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivacyEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivacyEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivacyEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivacyEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivacyEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PrivacyEventMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PrivacyEventMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PrivacyEvent entity.
+// If the PrivacyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyEventMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PrivacyEventMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetGuildID sets the "guild_id" field.
+func (m *PrivacyEventMutation) SetGuildID(s string) {
+	m.guild_id = &s
+}
+
+// GuildID returns the value of the "guild_id" field in the mutation.
+func (m *PrivacyEventMutation) GuildID() (r string, exists bool) {
+	v := m.guild_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuildID returns the old "guild_id" field's value of the PrivacyEvent entity.
+// If the PrivacyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyEventMutation) OldGuildID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuildID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuildID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuildID: %w", err)
+	}
+	return oldValue.GuildID, nil
+}
+
+// ClearGuildID clears the value of the "guild_id" field.
+func (m *PrivacyEventMutation) ClearGuildID() {
+	m.guild_id = nil
+	m.clearedFields[privacyevent.FieldGuildID] = struct{}{}
+}
+
+// GuildIDCleared returns if the "guild_id" field was cleared in this mutation.
+func (m *PrivacyEventMutation) GuildIDCleared() bool {
+	_, ok := m.clearedFields[privacyevent.FieldGuildID]
+	return ok
+}
+
+// ResetGuildID resets all changes to the "guild_id" field.
+func (m *PrivacyEventMutation) ResetGuildID() {
+	m.guild_id = nil
+	delete(m.clearedFields, privacyevent.FieldGuildID)
+}
+
+// SetAction sets the "action" field.
+func (m *PrivacyEventMutation) SetAction(pr privacyevent.Action) {
+	m.action = &pr
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *PrivacyEventMutation) Action() (r privacyevent.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the PrivacyEvent entity.
+// If the PrivacyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyEventMutation) OldAction(ctx context.Context) (v privacyevent.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *PrivacyEventMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetRecords sets the "records" field.
+func (m *PrivacyEventMutation) SetRecords(i int) {
+	m.records = &i
+	m.addrecords = nil
+}
+
+// Records returns the value of the "records" field in the mutation.
+func (m *PrivacyEventMutation) Records() (r int, exists bool) {
+	v := m.records
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecords returns the old "records" field's value of the PrivacyEvent entity.
+// If the PrivacyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyEventMutation) OldRecords(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecords: %w", err)
+	}
+	return oldValue.Records, nil
+}
+
+// AddRecords adds i to the "records" field.
+func (m *PrivacyEventMutation) AddRecords(i int) {
+	if m.addrecords != nil {
+		*m.addrecords += i
+	} else {
+		m.addrecords = &i
+	}
+}
+
+// AddedRecords returns the value that was added to the "records" field in this mutation.
+func (m *PrivacyEventMutation) AddedRecords() (r int, exists bool) {
+	v := m.addrecords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRecords resets all changes to the "records" field.
+func (m *PrivacyEventMutation) ResetRecords() {
+	m.records = nil
+	m.addrecords = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PrivacyEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PrivacyEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PrivacyEvent entity.
+// If the PrivacyEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PrivacyEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PrivacyEventMutation builder.
+func (m *PrivacyEventMutation) Where(ps ...predicate.PrivacyEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrivacyEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrivacyEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrivacyEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrivacyEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrivacyEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PrivacyEvent).
+func (m *PrivacyEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivacyEventMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, privacyevent.FieldUserID)
+	}
+	if m.guild_id != nil {
+		fields = append(fields, privacyevent.FieldGuildID)
+	}
+	if m.action != nil {
+		fields = append(fields, privacyevent.FieldAction)
+	}
+	if m.records != nil {
+		fields = append(fields, privacyevent.FieldRecords)
+	}
+	if m.created_at != nil {
+		fields = append(fields, privacyevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivacyEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privacyevent.FieldUserID:
+		return m.UserID()
+	case privacyevent.FieldGuildID:
+		return m.GuildID()
+	case privacyevent.FieldAction:
+		return m.Action()
+	case privacyevent.FieldRecords:
+		return m.Records()
+	case privacyevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivacyEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privacyevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case privacyevent.FieldGuildID:
+		return m.OldGuildID(ctx)
+	case privacyevent.FieldAction:
+		return m.OldAction(ctx)
+	case privacyevent.FieldRecords:
+		return m.OldRecords(ctx)
+	case privacyevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivacyEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privacyevent.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case privacyevent.FieldGuildID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuildID(v)
+		return nil
+	case privacyevent.FieldAction:
+		v, ok := value.(privacyevent.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case privacyevent.FieldRecords:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecords(v)
+		return nil
+	case privacyevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivacyEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addrecords != nil {
+		fields = append(fields, privacyevent.FieldRecords)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivacyEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case privacyevent.FieldRecords:
+		return m.AddedRecords()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case privacyevent.FieldRecords:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRecords(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivacyEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(privacyevent.FieldGuildID) {
+		fields = append(fields, privacyevent.FieldGuildID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivacyEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivacyEventMutation) ClearField(name string) error {
+	switch name {
+	case privacyevent.FieldGuildID:
+		m.ClearGuildID()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivacyEventMutation) ResetField(name string) error {
+	switch name {
+	case privacyevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case privacyevent.FieldGuildID:
+		m.ResetGuildID()
+		return nil
+	case privacyevent.FieldAction:
+		m.ResetAction()
+		return nil
+	case privacyevent.FieldRecords:
+		m.ResetRecords()
+		return nil
+	case privacyevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivacyEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivacyEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivacyEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivacyEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivacyEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivacyEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivacyEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PrivacyEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivacyEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PrivacyEvent edge %s", name)
+}
+
+// PrivacyOptOutMutation represents an operation that mutates the PrivacyOptOut nodes in the graph.
+type PrivacyOptOutMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PrivacyOptOut, error)
+	predicates    []predicate.PrivacyOptOut
+}
+
+var _ ent.Mutation = (*PrivacyOptOutMutation)(nil)
+
+// privacyoptoutOption allows management of the mutation configuration using functional options.
+type privacyoptoutOption func(*PrivacyOptOutMutation)
+
+// newPrivacyOptOutMutation creates new mutation for the PrivacyOptOut entity.
+func newPrivacyOptOutMutation(c config, op Op, opts ...privacyoptoutOption) *PrivacyOptOutMutation {
+	m := &PrivacyOptOutMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrivacyOptOut,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrivacyOptOutID sets the ID field of the mutation.
+func withPrivacyOptOutID(id string) privacyoptoutOption {
+	return func(m *PrivacyOptOutMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PrivacyOptOut
+		)
+		m.oldValue = func(ctx context.Context) (*PrivacyOptOut, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PrivacyOptOut.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrivacyOptOut sets the old PrivacyOptOut of the mutation.
+func withPrivacyOptOut(node *PrivacyOptOut) privacyoptoutOption {
+	return func(m *PrivacyOptOutMutation) {
+		m.oldValue = func(context.Context) (*PrivacyOptOut, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrivacyOptOutMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrivacyOptOutMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PrivacyOptOut entities.
+func (m *PrivacyOptOutMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrivacyOptOutMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrivacyOptOutMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PrivacyOptOut.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PrivacyOptOutMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PrivacyOptOutMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PrivacyOptOut entity.
+// If the PrivacyOptOut object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrivacyOptOutMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PrivacyOptOutMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the PrivacyOptOutMutation builder.
+func (m *PrivacyOptOutMutation) Where(ps ...predicate.PrivacyOptOut) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrivacyOptOutMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrivacyOptOutMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PrivacyOptOut, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrivacyOptOutMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrivacyOptOutMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PrivacyOptOut).
+func (m *PrivacyOptOutMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrivacyOptOutMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, privacyoptout.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrivacyOptOutMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case privacyoptout.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrivacyOptOutMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case privacyoptout.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PrivacyOptOut field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyOptOutMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case privacyoptout.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyOptOut field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrivacyOptOutMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrivacyOptOutMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrivacyOptOutMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PrivacyOptOut numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrivacyOptOutMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrivacyOptOutMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrivacyOptOutMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PrivacyOptOut nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrivacyOptOutMutation) ResetField(name string) error {
+	switch name {
+	case privacyoptout.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PrivacyOptOut field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrivacyOptOutMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrivacyOptOutMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrivacyOptOutMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrivacyOptOutMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrivacyOptOutMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrivacyOptOutMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrivacyOptOutMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PrivacyOptOut unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrivacyOptOutMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PrivacyOptOut edge %s", name)
 }
@@ -6,11 +6,56 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// DiscordChannel is the predicate function for discordchannel builders.
+type DiscordChannel func(*sql.Selector)
+
+// DiscordChannelSetting is the predicate function for discordchannelsetting builders.
+type DiscordChannelSetting func(*sql.Selector)
+
+// DiscordChimeIn is the predicate function for discordchimein builders.
+type DiscordChimeIn func(*sql.Selector)
+
+// DiscordChunkEmbedding is the predicate function for discordchunkembedding builders.
+type DiscordChunkEmbedding func(*sql.Selector)
+
+// DiscordDirectMessage is the predicate function for discorddirectmessage builders.
+type DiscordDirectMessage func(*sql.Selector)
+
+// DiscordGuildMember is the predicate function for discordguildmember builders.
+type DiscordGuildMember func(*sql.Selector)
+
+// DiscordGuildSetting is the predicate function for discordguildsetting builders.
+type DiscordGuildSetting func(*sql.Selector)
+
 // DiscordMessage is the predicate function for discordmessage builders.
 type DiscordMessage func(*sql.Selector)
 
+// DiscordMessageChunk is the predicate function for discordmessagechunk builders.
+type DiscordMessageChunk func(*sql.Selector)
+
 // DiscordMessageEmbedding is the predicate function for discordmessageembedding builders.
 type DiscordMessageEmbedding func(*sql.Selector)
 
+// DiscordProfileChange is the predicate function for discordprofilechange builders.
+type DiscordProfileChange func(*sql.Selector)
+
+// DiscordReaction is the predicate function for discordreaction builders.
+type DiscordReaction func(*sql.Selector)
+
+// DiscordRole is the predicate function for discordrole builders.
+type DiscordRole func(*sql.Selector)
+
 // DiscordUser is the predicate function for discorduser builders.
 type DiscordUser func(*sql.Selector)
+
+// EmbeddingModel is the predicate function for embeddingmodel builders.
+type EmbeddingModel func(*sql.Selector)
+
+// ModerationEvent is the predicate function for moderationevent builders.
+type ModerationEvent func(*sql.Selector)
+
+// PrivacyEvent is the predicate function for privacyevent builders.
+type PrivacyEvent func(*sql.Selector)
+
+// PrivacyOptOut is the predicate function for privacyoptout builders.
+type PrivacyOptOut func(*sql.Selector)
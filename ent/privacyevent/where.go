@@ -0,0 +1,330 @@
+// Code generated by ent, DO NOT EDIT.
+
+package privacyevent
+
+import (
+	"sev0/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// GuildID applies equality check predicate on the "guild_id" field. It's identical to GuildIDEQ.
+func GuildID(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldGuildID, v))
+}
+
+// Records applies equality check predicate on the "records" field. It's identical to RecordsEQ.
+func Records(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldRecords, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDContains applies the Contains predicate on the "user_id" field.
+func UserIDContains(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldContains(FieldUserID, v))
+}
+
+// UserIDHasPrefix applies the HasPrefix predicate on the "user_id" field.
+func UserIDHasPrefix(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldHasPrefix(FieldUserID, v))
+}
+
+// UserIDHasSuffix applies the HasSuffix predicate on the "user_id" field.
+func UserIDHasSuffix(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldHasSuffix(FieldUserID, v))
+}
+
+// UserIDEqualFold applies the EqualFold predicate on the "user_id" field.
+func UserIDEqualFold(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEqualFold(FieldUserID, v))
+}
+
+// UserIDContainsFold applies the ContainsFold predicate on the "user_id" field.
+func UserIDContainsFold(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldContainsFold(FieldUserID, v))
+}
+
+// GuildIDEQ applies the EQ predicate on the "guild_id" field.
+func GuildIDEQ(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldGuildID, v))
+}
+
+// GuildIDNEQ applies the NEQ predicate on the "guild_id" field.
+func GuildIDNEQ(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNEQ(FieldGuildID, v))
+}
+
+// GuildIDIn applies the In predicate on the "guild_id" field.
+func GuildIDIn(vs ...string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIn(FieldGuildID, vs...))
+}
+
+// GuildIDNotIn applies the NotIn predicate on the "guild_id" field.
+func GuildIDNotIn(vs ...string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotIn(FieldGuildID, vs...))
+}
+
+// GuildIDGT applies the GT predicate on the "guild_id" field.
+func GuildIDGT(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGT(FieldGuildID, v))
+}
+
+// GuildIDGTE applies the GTE predicate on the "guild_id" field.
+func GuildIDGTE(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGTE(FieldGuildID, v))
+}
+
+// GuildIDLT applies the LT predicate on the "guild_id" field.
+func GuildIDLT(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLT(FieldGuildID, v))
+}
+
+// GuildIDLTE applies the LTE predicate on the "guild_id" field.
+func GuildIDLTE(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLTE(FieldGuildID, v))
+}
+
+// GuildIDContains applies the Contains predicate on the "guild_id" field.
+func GuildIDContains(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldContains(FieldGuildID, v))
+}
+
+// GuildIDHasPrefix applies the HasPrefix predicate on the "guild_id" field.
+func GuildIDHasPrefix(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldHasPrefix(FieldGuildID, v))
+}
+
+// GuildIDHasSuffix applies the HasSuffix predicate on the "guild_id" field.
+func GuildIDHasSuffix(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldHasSuffix(FieldGuildID, v))
+}
+
+// GuildIDIsNil applies the IsNil predicate on the "guild_id" field.
+func GuildIDIsNil() predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIsNull(FieldGuildID))
+}
+
+// GuildIDNotNil applies the NotNil predicate on the "guild_id" field.
+func GuildIDNotNil() predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotNull(FieldGuildID))
+}
+
+// GuildIDEqualFold applies the EqualFold predicate on the "guild_id" field.
+func GuildIDEqualFold(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEqualFold(FieldGuildID, v))
+}
+
+// GuildIDContainsFold applies the ContainsFold predicate on the "guild_id" field.
+func GuildIDContainsFold(v string) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldContainsFold(FieldGuildID, v))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// RecordsEQ applies the EQ predicate on the "records" field.
+func RecordsEQ(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldRecords, v))
+}
+
+// RecordsNEQ applies the NEQ predicate on the "records" field.
+func RecordsNEQ(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNEQ(FieldRecords, v))
+}
+
+// RecordsIn applies the In predicate on the "records" field.
+func RecordsIn(vs ...int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIn(FieldRecords, vs...))
+}
+
+// RecordsNotIn applies the NotIn predicate on the "records" field.
+func RecordsNotIn(vs ...int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotIn(FieldRecords, vs...))
+}
+
+// RecordsGT applies the GT predicate on the "records" field.
+func RecordsGT(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGT(FieldRecords, v))
+}
+
+// RecordsGTE applies the GTE predicate on the "records" field.
+func RecordsGTE(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGTE(FieldRecords, v))
+}
+
+// RecordsLT applies the LT predicate on the "records" field.
+func RecordsLT(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLT(FieldRecords, v))
+}
+
+// RecordsLTE applies the LTE predicate on the "records" field.
+func RecordsLTE(v int) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLTE(FieldRecords, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PrivacyEvent) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PrivacyEvent) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PrivacyEvent) predicate.PrivacyEvent {
+	return predicate.PrivacyEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,548 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/privacyevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PrivacyEventCreate is the builder for creating a PrivacyEvent entity.
+type PrivacyEventCreate struct {
+	config
+	mutation *PrivacyEventMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PrivacyEventCreate) SetUserID(v string) *PrivacyEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetGuildID sets the "guild_id" field.
+func (_c *PrivacyEventCreate) SetGuildID(v string) *PrivacyEventCreate {
+	_c.mutation.SetGuildID(v)
+	return _c
+}
+
+// SetNillableGuildID sets the "guild_id" field if the given value is not nil.
+func (_c *PrivacyEventCreate) SetNillableGuildID(v *string) *PrivacyEventCreate {
+	if v != nil {
+		_c.SetGuildID(*v)
+	}
+	return _c
+}
+
+// SetAction sets the "action" field.
+func (_c *PrivacyEventCreate) SetAction(v privacyevent.Action) *PrivacyEventCreate {
+	_c.mutation.SetAction(v)
+	return _c
+}
+
+// SetRecords sets the "records" field.
+func (_c *PrivacyEventCreate) SetRecords(v int) *PrivacyEventCreate {
+	_c.mutation.SetRecords(v)
+	return _c
+}
+
+// SetNillableRecords sets the "records" field if the given value is not nil.
+func (_c *PrivacyEventCreate) SetNillableRecords(v *int) *PrivacyEventCreate {
+	if v != nil {
+		_c.SetRecords(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PrivacyEventCreate) SetCreatedAt(v time.Time) *PrivacyEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PrivacyEventCreate) SetNillableCreatedAt(v *time.Time) *PrivacyEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PrivacyEventMutation object of the builder.
+func (_c *PrivacyEventCreate) Mutation() *PrivacyEventMutation {
+	return _c.mutation
+}
+
+// Save creates the PrivacyEvent in the database.
+func (_c *PrivacyEventCreate) Save(ctx context.Context) (*PrivacyEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PrivacyEventCreate) SaveX(ctx context.Context) *PrivacyEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrivacyEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrivacyEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PrivacyEventCreate) defaults() {
+	if _, ok := _c.mutation.Records(); !ok {
+		v := privacyevent.DefaultRecords
+		_c.mutation.SetRecords(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := privacyevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PrivacyEventCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PrivacyEvent.user_id"`)}
+	}
+	if v, ok := _c.mutation.UserID(); ok {
+		if err := privacyevent.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "PrivacyEvent.user_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "PrivacyEvent.action"`)}
+	}
+	if v, ok := _c.mutation.Action(); ok {
+		if err := privacyevent.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "PrivacyEvent.action": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Records(); !ok {
+		return &ValidationError{Name: "records", err: errors.New(`ent: missing required field "PrivacyEvent.records"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PrivacyEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *PrivacyEventCreate) sqlSave(ctx context.Context) (*PrivacyEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PrivacyEventCreate) createSpec() (*PrivacyEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PrivacyEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(privacyevent.Table, sqlgraph.NewFieldSpec(privacyevent.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = _c.conflict
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(privacyevent.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.GuildID(); ok {
+		_spec.SetField(privacyevent.FieldGuildID, field.TypeString, value)
+		_node.GuildID = value
+	}
+	if value, ok := _c.mutation.Action(); ok {
+		_spec.SetField(privacyevent.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := _c.mutation.Records(); ok {
+		_spec.SetField(privacyevent.FieldRecords, field.TypeInt, value)
+		_node.Records = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(privacyevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PrivacyEvent.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PrivacyEventUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PrivacyEventCreate) OnConflict(opts ...sql.ConflictOption) *PrivacyEventUpsertOne {
+	_c.conflict = opts
+	return &PrivacyEventUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PrivacyEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PrivacyEventCreate) OnConflictColumns(columns ...string) *PrivacyEventUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PrivacyEventUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// PrivacyEventUpsertOne is the builder for "upsert"-ing
+	//  one PrivacyEvent node.
+	PrivacyEventUpsertOne struct {
+		create *PrivacyEventCreate
+	}
+
+	// PrivacyEventUpsert is the "OnConflict" setter.
+	PrivacyEventUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PrivacyEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PrivacyEventUpsertOne) UpdateNewValues() *PrivacyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.UserID(); exists {
+			s.SetIgnore(privacyevent.FieldUserID)
+		}
+		if _, exists := u.create.mutation.GuildID(); exists {
+			s.SetIgnore(privacyevent.FieldGuildID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(privacyevent.FieldAction)
+		}
+		if _, exists := u.create.mutation.Records(); exists {
+			s.SetIgnore(privacyevent.FieldRecords)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(privacyevent.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PrivacyEvent.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PrivacyEventUpsertOne) Ignore() *PrivacyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PrivacyEventUpsertOne) DoNothing() *PrivacyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PrivacyEventCreate.OnConflict
+// documentation for more info.
+func (u *PrivacyEventUpsertOne) Update(set func(*PrivacyEventUpsert)) *PrivacyEventUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PrivacyEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PrivacyEventUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PrivacyEventCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PrivacyEventUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PrivacyEventUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PrivacyEventUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PrivacyEventCreateBulk is the builder for creating many PrivacyEvent entities in bulk.
+type PrivacyEventCreateBulk struct {
+	config
+	err      error
+	builders []*PrivacyEventCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PrivacyEvent entities in the database.
+func (_c *PrivacyEventCreateBulk) Save(ctx context.Context) ([]*PrivacyEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PrivacyEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrivacyEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PrivacyEventCreateBulk) SaveX(ctx context.Context) []*PrivacyEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrivacyEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrivacyEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PrivacyEvent.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PrivacyEventUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *PrivacyEventCreateBulk) OnConflict(opts ...sql.ConflictOption) *PrivacyEventUpsertBulk {
+	_c.conflict = opts
+	return &PrivacyEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PrivacyEvent.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *PrivacyEventCreateBulk) OnConflictColumns(columns ...string) *PrivacyEventUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &PrivacyEventUpsertBulk{
+		create: _c,
+	}
+}
+
+// PrivacyEventUpsertBulk is the builder for "upsert"-ing
+// a bulk of PrivacyEvent nodes.
+type PrivacyEventUpsertBulk struct {
+	create *PrivacyEventCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PrivacyEvent.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PrivacyEventUpsertBulk) UpdateNewValues() *PrivacyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.UserID(); exists {
+				s.SetIgnore(privacyevent.FieldUserID)
+			}
+			if _, exists := b.mutation.GuildID(); exists {
+				s.SetIgnore(privacyevent.FieldGuildID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(privacyevent.FieldAction)
+			}
+			if _, exists := b.mutation.Records(); exists {
+				s.SetIgnore(privacyevent.FieldRecords)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(privacyevent.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PrivacyEvent.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PrivacyEventUpsertBulk) Ignore() *PrivacyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PrivacyEventUpsertBulk) DoNothing() *PrivacyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PrivacyEventCreateBulk.OnConflict
+// documentation for more info.
+func (u *PrivacyEventUpsertBulk) Update(set func(*PrivacyEventUpsert)) *PrivacyEventUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PrivacyEventUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *PrivacyEventUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PrivacyEventCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PrivacyEventCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PrivacyEventUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
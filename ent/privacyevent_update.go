@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/predicate"
+	"sev0/ent/privacyevent"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PrivacyEventUpdate is the builder for updating PrivacyEvent entities.
+type PrivacyEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrivacyEventMutation
+}
+
+// Where appends a list predicates to the PrivacyEventUpdate builder.
+func (_u *PrivacyEventUpdate) Where(ps ...predicate.PrivacyEvent) *PrivacyEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PrivacyEventMutation object of the builder.
+func (_u *PrivacyEventUpdate) Mutation() *PrivacyEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PrivacyEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrivacyEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PrivacyEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrivacyEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PrivacyEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(privacyevent.Table, privacyevent.Columns, sqlgraph.NewFieldSpec(privacyevent.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(privacyevent.FieldGuildID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privacyevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PrivacyEventUpdateOne is the builder for updating a single PrivacyEvent entity.
+type PrivacyEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrivacyEventMutation
+}
+
+// Mutation returns the PrivacyEventMutation object of the builder.
+func (_u *PrivacyEventUpdateOne) Mutation() *PrivacyEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PrivacyEventUpdate builder.
+func (_u *PrivacyEventUpdateOne) Where(ps ...predicate.PrivacyEvent) *PrivacyEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PrivacyEventUpdateOne) Select(field string, fields ...string) *PrivacyEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PrivacyEvent entity.
+func (_u *PrivacyEventUpdateOne) Save(ctx context.Context) (*PrivacyEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrivacyEventUpdateOne) SaveX(ctx context.Context) *PrivacyEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PrivacyEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrivacyEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PrivacyEventUpdateOne) sqlSave(ctx context.Context) (_node *PrivacyEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(privacyevent.Table, privacyevent.Columns, sqlgraph.NewFieldSpec(privacyevent.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PrivacyEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, privacyevent.FieldID)
+		for _, f := range fields {
+			if !privacyevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != privacyevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.GuildIDCleared() {
+		_spec.ClearField(privacyevent.FieldGuildID, field.TypeString)
+	}
+	_node = &PrivacyEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privacyevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
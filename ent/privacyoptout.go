@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"sev0/ent/privacyoptout"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PrivacyOptOut is the model entity for the PrivacyOptOut schema.
+type PrivacyOptOut struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PrivacyOptOut) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case privacyoptout.FieldID:
+			values[i] = new(sql.NullString)
+		case privacyoptout.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PrivacyOptOut fields.
+func (_m *PrivacyOptOut) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case privacyoptout.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case privacyoptout.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PrivacyOptOut.
+// This includes values selected through modifiers, order, etc.
+func (_m *PrivacyOptOut) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PrivacyOptOut.
+// Note that you need to call PrivacyOptOut.Unwrap() before calling this method if this PrivacyOptOut
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PrivacyOptOut) Update() *PrivacyOptOutUpdateOne {
+	return NewPrivacyOptOutClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PrivacyOptOut entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PrivacyOptOut) Unwrap() *PrivacyOptOut {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PrivacyOptOut is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PrivacyOptOut) String() string {
+	var builder strings.Builder
+	builder.WriteString("PrivacyOptOut(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PrivacyOptOuts is a parsable slice of PrivacyOptOut.
+type PrivacyOptOuts []*PrivacyOptOut
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"sev0/ent/predicate"
+	"sev0/ent/privacyoptout"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PrivacyOptOutDelete is the builder for deleting a PrivacyOptOut entity.
+type PrivacyOptOutDelete struct {
+	config
+	hooks    []Hook
+	mutation *PrivacyOptOutMutation
+}
+
+// Where appends a list predicates to the PrivacyOptOutDelete builder.
+func (_d *PrivacyOptOutDelete) Where(ps ...predicate.PrivacyOptOut) *PrivacyOptOutDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *PrivacyOptOutDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PrivacyOptOutDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *PrivacyOptOutDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(privacyoptout.Table, sqlgraph.NewFieldSpec(privacyoptout.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// PrivacyOptOutDeleteOne is the builder for deleting a single PrivacyOptOut entity.
+type PrivacyOptOutDeleteOne struct {
+	_d *PrivacyOptOutDelete
+}
+
+// Where appends a list predicates to the PrivacyOptOutDelete builder.
+func (_d *PrivacyOptOutDeleteOne) Where(ps ...predicate.PrivacyOptOut) *PrivacyOptOutDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *PrivacyOptOutDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{privacyoptout.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *PrivacyOptOutDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sev0/ent/predicate"
+	"sev0/ent/privacyoptout"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PrivacyOptOutUpdate is the builder for updating PrivacyOptOut entities.
+type PrivacyOptOutUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrivacyOptOutMutation
+}
+
+// Where appends a list predicates to the PrivacyOptOutUpdate builder.
+func (_u *PrivacyOptOutUpdate) Where(ps ...predicate.PrivacyOptOut) *PrivacyOptOutUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the PrivacyOptOutMutation object of the builder.
+func (_u *PrivacyOptOutUpdate) Mutation() *PrivacyOptOutMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PrivacyOptOutUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrivacyOptOutUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PrivacyOptOutUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrivacyOptOutUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PrivacyOptOutUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(privacyoptout.Table, privacyoptout.Columns, sqlgraph.NewFieldSpec(privacyoptout.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privacyoptout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PrivacyOptOutUpdateOne is the builder for updating a single PrivacyOptOut entity.
+type PrivacyOptOutUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrivacyOptOutMutation
+}
+
+// Mutation returns the PrivacyOptOutMutation object of the builder.
+func (_u *PrivacyOptOutUpdateOne) Mutation() *PrivacyOptOutMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PrivacyOptOutUpdate builder.
+func (_u *PrivacyOptOutUpdateOne) Where(ps ...predicate.PrivacyOptOut) *PrivacyOptOutUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PrivacyOptOutUpdateOne) Select(field string, fields ...string) *PrivacyOptOutUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PrivacyOptOut entity.
+func (_u *PrivacyOptOutUpdateOne) Save(ctx context.Context) (*PrivacyOptOut, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrivacyOptOutUpdateOne) SaveX(ctx context.Context) *PrivacyOptOut {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PrivacyOptOutUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrivacyOptOutUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *PrivacyOptOutUpdateOne) sqlSave(ctx context.Context) (_node *PrivacyOptOut, err error) {
+	_spec := sqlgraph.NewUpdateSpec(privacyoptout.Table, privacyoptout.Columns, sqlgraph.NewFieldSpec(privacyoptout.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PrivacyOptOut.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, privacyoptout.FieldID)
+		for _, f := range fields {
+			if !privacyoptout.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != privacyoptout.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &PrivacyOptOut{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{privacyoptout.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
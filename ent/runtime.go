@@ -3,9 +3,24 @@
 package ent
 
 import (
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/discordchimein"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordguildsetting"
 	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
 	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discordrole"
 	"sev0/ent/discorduser"
+	"sev0/ent/embeddingmodel"
+	"sev0/ent/moderationevent"
+	"sev0/ent/privacyevent"
+	"sev0/ent/privacyoptout"
 	"sev0/ent/schema"
 	"time"
 )
@@ -14,16 +29,242 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	discordchannelFields := schema.DiscordChannel{}.Fields()
+	_ = discordchannelFields
+	// discordchannelDescGuildID is the schema descriptor for guild_id field.
+	discordchannelDescGuildID := discordchannelFields[1].Descriptor()
+	// discordchannel.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	discordchannel.GuildIDValidator = discordchannelDescGuildID.Validators[0].(func(string) error)
+	// discordchannelDescName is the schema descriptor for name field.
+	discordchannelDescName := discordchannelFields[2].Descriptor()
+	// discordchannel.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	discordchannel.NameValidator = discordchannelDescName.Validators[0].(func(string) error)
+	// discordchannelDescID is the schema descriptor for id field.
+	discordchannelDescID := discordchannelFields[0].Descriptor()
+	// discordchannel.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordchannel.IDValidator = discordchannelDescID.Validators[0].(func(string) error)
+	discordchannelsettingFields := schema.DiscordChannelSetting{}.Fields()
+	_ = discordchannelsettingFields
+	// discordchannelsettingDescGuildID is the schema descriptor for guild_id field.
+	discordchannelsettingDescGuildID := discordchannelsettingFields[1].Descriptor()
+	// discordchannelsetting.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	discordchannelsetting.GuildIDValidator = discordchannelsettingDescGuildID.Validators[0].(func(string) error)
+	// discordchannelsettingDescMentionReplies is the schema descriptor for mention_replies field.
+	discordchannelsettingDescMentionReplies := discordchannelsettingFields[2].Descriptor()
+	// discordchannelsetting.DefaultMentionReplies holds the default value on creation for the mention_replies field.
+	discordchannelsetting.DefaultMentionReplies = discordchannelsettingDescMentionReplies.Default.(bool)
+	// discordchannelsettingDescChimeIn is the schema descriptor for chime_in field.
+	discordchannelsettingDescChimeIn := discordchannelsettingFields[3].Descriptor()
+	// discordchannelsetting.DefaultChimeIn holds the default value on creation for the chime_in field.
+	discordchannelsetting.DefaultChimeIn = discordchannelsettingDescChimeIn.Default.(bool)
+	// discordchannelsettingDescID is the schema descriptor for id field.
+	discordchannelsettingDescID := discordchannelsettingFields[0].Descriptor()
+	// discordchannelsetting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordchannelsetting.IDValidator = discordchannelsettingDescID.Validators[0].(func(string) error)
+	discordchimeinFields := schema.DiscordChimeIn{}.Fields()
+	_ = discordchimeinFields
+	// discordchimeinDescGuildID is the schema descriptor for guild_id field.
+	discordchimeinDescGuildID := discordchimeinFields[1].Descriptor()
+	// discordchimein.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	discordchimein.GuildIDValidator = discordchimeinDescGuildID.Validators[0].(func(string) error)
+	// discordchimeinDescChannelID is the schema descriptor for channel_id field.
+	discordchimeinDescChannelID := discordchimeinFields[2].Descriptor()
+	// discordchimein.ChannelIDValidator is a validator for the "channel_id" field. It is called by the builders before save.
+	discordchimein.ChannelIDValidator = discordchimeinDescChannelID.Validators[0].(func(string) error)
+	// discordchimeinDescTriggerMessageID is the schema descriptor for trigger_message_id field.
+	discordchimeinDescTriggerMessageID := discordchimeinFields[3].Descriptor()
+	// discordchimein.TriggerMessageIDValidator is a validator for the "trigger_message_id" field. It is called by the builders before save.
+	discordchimein.TriggerMessageIDValidator = discordchimeinDescTriggerMessageID.Validators[0].(func(string) error)
+	// discordchimeinDescReactions is the schema descriptor for reactions field.
+	discordchimeinDescReactions := discordchimeinFields[6].Descriptor()
+	// discordchimein.DefaultReactions holds the default value on creation for the reactions field.
+	discordchimein.DefaultReactions = discordchimeinDescReactions.Default.(int)
+	// discordchimeinDescCreatedAt is the schema descriptor for created_at field.
+	discordchimeinDescCreatedAt := discordchimeinFields[7].Descriptor()
+	// discordchimein.DefaultCreatedAt holds the default value on creation for the created_at field.
+	discordchimein.DefaultCreatedAt = discordchimeinDescCreatedAt.Default.(func() time.Time)
+	// discordchimeinDescID is the schema descriptor for id field.
+	discordchimeinDescID := discordchimeinFields[0].Descriptor()
+	// discordchimein.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordchimein.IDValidator = discordchimeinDescID.Validators[0].(func(string) error)
+	discordchunkembeddingFields := schema.DiscordChunkEmbedding{}.Fields()
+	_ = discordchunkembeddingFields
+	// discordchunkembeddingDescChunkID is the schema descriptor for chunk_id field.
+	discordchunkembeddingDescChunkID := discordchunkembeddingFields[1].Descriptor()
+	// discordchunkembedding.ChunkIDValidator is a validator for the "chunk_id" field. It is called by the builders before save.
+	discordchunkembedding.ChunkIDValidator = discordchunkembeddingDescChunkID.Validators[0].(func(string) error)
+	// discordchunkembeddingDescModel is the schema descriptor for model field.
+	discordchunkembeddingDescModel := discordchunkembeddingFields[2].Descriptor()
+	// discordchunkembedding.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	discordchunkembedding.ModelValidator = discordchunkembeddingDescModel.Validators[0].(func(string) error)
+	// discordchunkembeddingDescCreatedAt is the schema descriptor for created_at field.
+	discordchunkembeddingDescCreatedAt := discordchunkembeddingFields[4].Descriptor()
+	// discordchunkembedding.DefaultCreatedAt holds the default value on creation for the created_at field.
+	discordchunkembedding.DefaultCreatedAt = discordchunkembeddingDescCreatedAt.Default.(func() time.Time)
+	// discordchunkembeddingDescID is the schema descriptor for id field.
+	discordchunkembeddingDescID := discordchunkembeddingFields[0].Descriptor()
+	// discordchunkembedding.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordchunkembedding.IDValidator = discordchunkembeddingDescID.Validators[0].(func(string) error)
+	discorddirectmessageFields := schema.DiscordDirectMessage{}.Fields()
+	_ = discorddirectmessageFields
+	// discorddirectmessageDescUserID is the schema descriptor for user_id field.
+	discorddirectmessageDescUserID := discorddirectmessageFields[1].Descriptor()
+	// discorddirectmessage.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	discorddirectmessage.UserIDValidator = discorddirectmessageDescUserID.Validators[0].(func(string) error)
+	// discorddirectmessageDescChannelID is the schema descriptor for channel_id field.
+	discorddirectmessageDescChannelID := discorddirectmessageFields[2].Descriptor()
+	// discorddirectmessage.ChannelIDValidator is a validator for the "channel_id" field. It is called by the builders before save.
+	discorddirectmessage.ChannelIDValidator = discorddirectmessageDescChannelID.Validators[0].(func(string) error)
+	// discorddirectmessageDescFromBot is the schema descriptor for from_bot field.
+	discorddirectmessageDescFromBot := discorddirectmessageFields[3].Descriptor()
+	// discorddirectmessage.DefaultFromBot holds the default value on creation for the from_bot field.
+	discorddirectmessage.DefaultFromBot = discorddirectmessageDescFromBot.Default.(bool)
+	// discorddirectmessageDescID is the schema descriptor for id field.
+	discorddirectmessageDescID := discorddirectmessageFields[0].Descriptor()
+	// discorddirectmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discorddirectmessage.IDValidator = discorddirectmessageDescID.Validators[0].(func(string) error)
+	discordguildmemberFields := schema.DiscordGuildMember{}.Fields()
+	_ = discordguildmemberFields
+	// discordguildmemberDescGuildID is the schema descriptor for guild_id field.
+	discordguildmemberDescGuildID := discordguildmemberFields[0].Descriptor()
+	// discordguildmember.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	discordguildmember.GuildIDValidator = discordguildmemberDescGuildID.Validators[0].(func(string) error)
+	// discordguildmemberDescUserID is the schema descriptor for user_id field.
+	discordguildmemberDescUserID := discordguildmemberFields[1].Descriptor()
+	// discordguildmember.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	discordguildmember.UserIDValidator = discordguildmemberDescUserID.Validators[0].(func(string) error)
+	discordguildsettingFields := schema.DiscordGuildSetting{}.Fields()
+	_ = discordguildsettingFields
+	// discordguildsettingDescChimeIn is the schema descriptor for chime_in field.
+	discordguildsettingDescChimeIn := discordguildsettingFields[1].Descriptor()
+	// discordguildsetting.DefaultChimeIn holds the default value on creation for the chime_in field.
+	discordguildsetting.DefaultChimeIn = discordguildsettingDescChimeIn.Default.(bool)
+	// discordguildsettingDescChimeInProbability is the schema descriptor for chime_in_probability field.
+	discordguildsettingDescChimeInProbability := discordguildsettingFields[2].Descriptor()
+	// discordguildsetting.DefaultChimeInProbability holds the default value on creation for the chime_in_probability field.
+	discordguildsetting.DefaultChimeInProbability = discordguildsettingDescChimeInProbability.Default.(float64)
+	// discordguildsetting.ChimeInProbabilityValidator is a validator for the "chime_in_probability" field. It is called by the builders before save.
+	discordguildsetting.ChimeInProbabilityValidator = func() func(float64) error {
+		validators := discordguildsettingDescChimeInProbability.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(chime_in_probability float64) error {
+			for _, fn := range fns {
+				if err := fn(chime_in_probability); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// discordguildsettingDescChimeInThreshold is the schema descriptor for chime_in_threshold field.
+	discordguildsettingDescChimeInThreshold := discordguildsettingFields[3].Descriptor()
+	// discordguildsetting.DefaultChimeInThreshold holds the default value on creation for the chime_in_threshold field.
+	discordguildsetting.DefaultChimeInThreshold = discordguildsettingDescChimeInThreshold.Default.(float64)
+	// discordguildsetting.ChimeInThresholdValidator is a validator for the "chime_in_threshold" field. It is called by the builders before save.
+	discordguildsetting.ChimeInThresholdValidator = func() func(float64) error {
+		validators := discordguildsettingDescChimeInThreshold.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(chime_in_threshold float64) error {
+			for _, fn := range fns {
+				if err := fn(chime_in_threshold); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// discordguildsettingDescChimeInCooldown is the schema descriptor for chime_in_cooldown field.
+	discordguildsettingDescChimeInCooldown := discordguildsettingFields[4].Descriptor()
+	// discordguildsetting.DefaultChimeInCooldown holds the default value on creation for the chime_in_cooldown field.
+	discordguildsetting.DefaultChimeInCooldown = discordguildsettingDescChimeInCooldown.Default.(int)
+	// discordguildsetting.ChimeInCooldownValidator is a validator for the "chime_in_cooldown" field. It is called by the builders before save.
+	discordguildsetting.ChimeInCooldownValidator = discordguildsettingDescChimeInCooldown.Validators[0].(func(int) error)
+	// discordguildsettingDescModerationRedact is the schema descriptor for moderation_redact field.
+	discordguildsettingDescModerationRedact := discordguildsettingFields[7].Descriptor()
+	// discordguildsetting.DefaultModerationRedact holds the default value on creation for the moderation_redact field.
+	discordguildsetting.DefaultModerationRedact = discordguildsettingDescModerationRedact.Default.(bool)
+	// discordguildsettingDescModerationClassifier is the schema descriptor for moderation_classifier field.
+	discordguildsettingDescModerationClassifier := discordguildsettingFields[8].Descriptor()
+	// discordguildsetting.DefaultModerationClassifier holds the default value on creation for the moderation_classifier field.
+	discordguildsetting.DefaultModerationClassifier = discordguildsettingDescModerationClassifier.Default.(bool)
+	// discordguildsettingDescModerationFlagThreshold is the schema descriptor for moderation_flag_threshold field.
+	discordguildsettingDescModerationFlagThreshold := discordguildsettingFields[9].Descriptor()
+	// discordguildsetting.DefaultModerationFlagThreshold holds the default value on creation for the moderation_flag_threshold field.
+	discordguildsetting.DefaultModerationFlagThreshold = discordguildsettingDescModerationFlagThreshold.Default.(float64)
+	// discordguildsetting.ModerationFlagThresholdValidator is a validator for the "moderation_flag_threshold" field. It is called by the builders before save.
+	discordguildsetting.ModerationFlagThresholdValidator = func() func(float64) error {
+		validators := discordguildsettingDescModerationFlagThreshold.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(moderation_flag_threshold float64) error {
+			for _, fn := range fns {
+				if err := fn(moderation_flag_threshold); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// discordguildsettingDescModerationBlockThreshold is the schema descriptor for moderation_block_threshold field.
+	discordguildsettingDescModerationBlockThreshold := discordguildsettingFields[10].Descriptor()
+	// discordguildsetting.DefaultModerationBlockThreshold holds the default value on creation for the moderation_block_threshold field.
+	discordguildsetting.DefaultModerationBlockThreshold = discordguildsettingDescModerationBlockThreshold.Default.(float64)
+	// discordguildsetting.ModerationBlockThresholdValidator is a validator for the "moderation_block_threshold" field. It is called by the builders before save.
+	discordguildsetting.ModerationBlockThresholdValidator = func() func(float64) error {
+		validators := discordguildsettingDescModerationBlockThreshold.Validators
+		fns := [...]func(float64) error{
+			validators[0].(func(float64) error),
+			validators[1].(func(float64) error),
+		}
+		return func(moderation_block_threshold float64) error {
+			for _, fn := range fns {
+				if err := fn(moderation_block_threshold); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// discordguildsettingDescID is the schema descriptor for id field.
+	discordguildsettingDescID := discordguildsettingFields[0].Descriptor()
+	// discordguildsetting.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordguildsetting.IDValidator = discordguildsettingDescID.Validators[0].(func(string) error)
 	discordmessageFields := schema.DiscordMessage{}.Fields()
 	_ = discordmessageFields
 	// discordmessageDescAuthorID is the schema descriptor for author_id field.
 	discordmessageDescAuthorID := discordmessageFields[2].Descriptor()
 	// discordmessage.AuthorIDValidator is a validator for the "author_id" field. It is called by the builders before save.
 	discordmessage.AuthorIDValidator = discordmessageDescAuthorID.Validators[0].(func(string) error)
+	// discordmessageDescSuspectedInjection is the schema descriptor for suspected_injection field.
+	discordmessageDescSuspectedInjection := discordmessageFields[8].Descriptor()
+	// discordmessage.DefaultSuspectedInjection holds the default value on creation for the suspected_injection field.
+	discordmessage.DefaultSuspectedInjection = discordmessageDescSuspectedInjection.Default.(bool)
 	// discordmessageDescID is the schema descriptor for id field.
 	discordmessageDescID := discordmessageFields[0].Descriptor()
 	// discordmessage.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	discordmessage.IDValidator = discordmessageDescID.Validators[0].(func(string) error)
+	discordmessagechunkFields := schema.DiscordMessageChunk{}.Fields()
+	_ = discordmessagechunkFields
+	// discordmessagechunkDescGuildID is the schema descriptor for guild_id field.
+	discordmessagechunkDescGuildID := discordmessagechunkFields[1].Descriptor()
+	// discordmessagechunk.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	discordmessagechunk.GuildIDValidator = discordmessagechunkDescGuildID.Validators[0].(func(string) error)
+	// discordmessagechunkDescChannelID is the schema descriptor for channel_id field.
+	discordmessagechunkDescChannelID := discordmessagechunkFields[2].Descriptor()
+	// discordmessagechunk.ChannelIDValidator is a validator for the "channel_id" field. It is called by the builders before save.
+	discordmessagechunk.ChannelIDValidator = discordmessagechunkDescChannelID.Validators[0].(func(string) error)
+	// discordmessagechunkDescID is the schema descriptor for id field.
+	discordmessagechunkDescID := discordmessagechunkFields[0].Descriptor()
+	// discordmessagechunk.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordmessagechunk.IDValidator = discordmessagechunkDescID.Validators[0].(func(string) error)
 	discordmessageembeddingFields := schema.DiscordMessageEmbedding{}.Fields()
 	_ = discordmessageembeddingFields
 	// discordmessageembeddingDescMessageID is the schema descriptor for message_id field.
@@ -42,6 +283,52 @@ func init() {
 	discordmessageembeddingDescID := discordmessageembeddingFields[0].Descriptor()
 	// discordmessageembedding.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	discordmessageembedding.IDValidator = discordmessageembeddingDescID.Validators[0].(func(string) error)
+	discordprofilechangeFields := schema.DiscordProfileChange{}.Fields()
+	_ = discordprofilechangeFields
+	// discordprofilechangeDescUserID is the schema descriptor for user_id field.
+	discordprofilechangeDescUserID := discordprofilechangeFields[0].Descriptor()
+	// discordprofilechange.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	discordprofilechange.UserIDValidator = discordprofilechangeDescUserID.Validators[0].(func(string) error)
+	// discordprofilechangeDescValue is the schema descriptor for value field.
+	discordprofilechangeDescValue := discordprofilechangeFields[3].Descriptor()
+	// discordprofilechange.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	discordprofilechange.ValueValidator = discordprofilechangeDescValue.Validators[0].(func(string) error)
+	// discordprofilechangeDescChangedAt is the schema descriptor for changed_at field.
+	discordprofilechangeDescChangedAt := discordprofilechangeFields[4].Descriptor()
+	// discordprofilechange.DefaultChangedAt holds the default value on creation for the changed_at field.
+	discordprofilechange.DefaultChangedAt = discordprofilechangeDescChangedAt.Default.(func() time.Time)
+	discordreactionFields := schema.DiscordReaction{}.Fields()
+	_ = discordreactionFields
+	// discordreactionDescMessageID is the schema descriptor for message_id field.
+	discordreactionDescMessageID := discordreactionFields[0].Descriptor()
+	// discordreaction.MessageIDValidator is a validator for the "message_id" field. It is called by the builders before save.
+	discordreaction.MessageIDValidator = discordreactionDescMessageID.Validators[0].(func(string) error)
+	// discordreactionDescUserID is the schema descriptor for user_id field.
+	discordreactionDescUserID := discordreactionFields[1].Descriptor()
+	// discordreaction.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	discordreaction.UserIDValidator = discordreactionDescUserID.Validators[0].(func(string) error)
+	// discordreactionDescEmoji is the schema descriptor for emoji field.
+	discordreactionDescEmoji := discordreactionFields[2].Descriptor()
+	// discordreaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	discordreaction.EmojiValidator = discordreactionDescEmoji.Validators[0].(func(string) error)
+	// discordreactionDescCreatedAt is the schema descriptor for created_at field.
+	discordreactionDescCreatedAt := discordreactionFields[3].Descriptor()
+	// discordreaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	discordreaction.DefaultCreatedAt = discordreactionDescCreatedAt.Default.(func() time.Time)
+	discordroleFields := schema.DiscordRole{}.Fields()
+	_ = discordroleFields
+	// discordroleDescGuildID is the schema descriptor for guild_id field.
+	discordroleDescGuildID := discordroleFields[1].Descriptor()
+	// discordrole.GuildIDValidator is a validator for the "guild_id" field. It is called by the builders before save.
+	discordrole.GuildIDValidator = discordroleDescGuildID.Validators[0].(func(string) error)
+	// discordroleDescName is the schema descriptor for name field.
+	discordroleDescName := discordroleFields[2].Descriptor()
+	// discordrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	discordrole.NameValidator = discordroleDescName.Validators[0].(func(string) error)
+	// discordroleDescID is the schema descriptor for id field.
+	discordroleDescID := discordroleFields[0].Descriptor()
+	// discordrole.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	discordrole.IDValidator = discordroleDescID.Validators[0].(func(string) error)
 	discorduserFields := schema.DiscordUser{}.Fields()
 	_ = discorduserFields
 	// discorduserDescUsername is the schema descriptor for username field.
@@ -52,8 +339,68 @@ func init() {
 	discorduserDescGlobalName := discorduserFields[2].Descriptor()
 	// discorduser.GlobalNameValidator is a validator for the "global_name" field. It is called by the builders before save.
 	discorduser.GlobalNameValidator = discorduserDescGlobalName.Validators[0].(func(string) error)
+	// discorduserDescProfilingOptOut is the schema descriptor for profiling_opt_out field.
+	discorduserDescProfilingOptOut := discorduserFields[4].Descriptor()
+	// discorduser.DefaultProfilingOptOut holds the default value on creation for the profiling_opt_out field.
+	discorduser.DefaultProfilingOptOut = discorduserDescProfilingOptOut.Default.(bool)
+	// discorduserDescDmEnabled is the schema descriptor for dm_enabled field.
+	discorduserDescDmEnabled := discorduserFields[5].Descriptor()
+	// discorduser.DefaultDmEnabled holds the default value on creation for the dm_enabled field.
+	discorduser.DefaultDmEnabled = discorduserDescDmEnabled.Default.(bool)
 	// discorduserDescID is the schema descriptor for id field.
 	discorduserDescID := discorduserFields[0].Descriptor()
 	// discorduser.IDValidator is a validator for the "id" field. It is called by the builders before save.
 	discorduser.IDValidator = discorduserDescID.Validators[0].(func(string) error)
+	embeddingmodelFields := schema.EmbeddingModel{}.Fields()
+	_ = embeddingmodelFields
+	// embeddingmodelDescDimensions is the schema descriptor for dimensions field.
+	embeddingmodelDescDimensions := embeddingmodelFields[1].Descriptor()
+	// embeddingmodel.DimensionsValidator is a validator for the "dimensions" field. It is called by the builders before save.
+	embeddingmodel.DimensionsValidator = embeddingmodelDescDimensions.Validators[0].(func(int) error)
+	// embeddingmodelDescActive is the schema descriptor for active field.
+	embeddingmodelDescActive := embeddingmodelFields[2].Descriptor()
+	// embeddingmodel.DefaultActive holds the default value on creation for the active field.
+	embeddingmodel.DefaultActive = embeddingmodelDescActive.Default.(bool)
+	// embeddingmodelDescCreatedAt is the schema descriptor for created_at field.
+	embeddingmodelDescCreatedAt := embeddingmodelFields[3].Descriptor()
+	// embeddingmodel.DefaultCreatedAt holds the default value on creation for the created_at field.
+	embeddingmodel.DefaultCreatedAt = embeddingmodelDescCreatedAt.Default.(func() time.Time)
+	// embeddingmodelDescID is the schema descriptor for id field.
+	embeddingmodelDescID := embeddingmodelFields[0].Descriptor()
+	// embeddingmodel.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	embeddingmodel.IDValidator = embeddingmodelDescID.Validators[0].(func(string) error)
+	moderationeventFields := schema.ModerationEvent{}.Fields()
+	_ = moderationeventFields
+	// moderationeventDescSource is the schema descriptor for source field.
+	moderationeventDescSource := moderationeventFields[3].Descriptor()
+	// moderationevent.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	moderationevent.SourceValidator = moderationeventDescSource.Validators[0].(func(string) error)
+	// moderationeventDescCreatedAt is the schema descriptor for created_at field.
+	moderationeventDescCreatedAt := moderationeventFields[8].Descriptor()
+	// moderationevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	moderationevent.DefaultCreatedAt = moderationeventDescCreatedAt.Default.(func() time.Time)
+	privacyeventFields := schema.PrivacyEvent{}.Fields()
+	_ = privacyeventFields
+	// privacyeventDescUserID is the schema descriptor for user_id field.
+	privacyeventDescUserID := privacyeventFields[0].Descriptor()
+	// privacyevent.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	privacyevent.UserIDValidator = privacyeventDescUserID.Validators[0].(func(string) error)
+	// privacyeventDescRecords is the schema descriptor for records field.
+	privacyeventDescRecords := privacyeventFields[3].Descriptor()
+	// privacyevent.DefaultRecords holds the default value on creation for the records field.
+	privacyevent.DefaultRecords = privacyeventDescRecords.Default.(int)
+	// privacyeventDescCreatedAt is the schema descriptor for created_at field.
+	privacyeventDescCreatedAt := privacyeventFields[4].Descriptor()
+	// privacyevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	privacyevent.DefaultCreatedAt = privacyeventDescCreatedAt.Default.(func() time.Time)
+	privacyoptoutFields := schema.PrivacyOptOut{}.Fields()
+	_ = privacyoptoutFields
+	// privacyoptoutDescCreatedAt is the schema descriptor for created_at field.
+	privacyoptoutDescCreatedAt := privacyoptoutFields[1].Descriptor()
+	// privacyoptout.DefaultCreatedAt holds the default value on creation for the created_at field.
+	privacyoptout.DefaultCreatedAt = privacyoptoutDescCreatedAt.Default.(func() time.Time)
+	// privacyoptoutDescID is the schema descriptor for id field.
+	privacyoptoutDescID := privacyoptoutFields[0].Descriptor()
+	// privacyoptout.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	privacyoptout.IDValidator = privacyoptoutDescID.Validators[0].(func(string) error)
 }
@@ -0,0 +1,31 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChannel holds the schema definition for the DiscordChannel entity.
+type DiscordChannel struct {
+	ent.Schema
+}
+
+// Fields of the DiscordChannel.
+func (DiscordChannel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("guild_id").NotEmpty().Immutable(),
+		field.String("name").NotEmpty(),
+		// parent_id is the category the channel lives in, or the channel a
+		// thread was started from.
+		field.String("parent_id").Optional(),
+	}
+}
+
+// Edges of the DiscordChannel.
+func (DiscordChannel) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("mentioned_in", DiscordMessage.Type).Ref("mentioned_channels"),
+	}
+}
@@ -0,0 +1,26 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordChannelSetting holds the schema definition for the
+// DiscordChannelSetting entity. Channels without a row use the defaults.
+type DiscordChannelSetting struct {
+	ent.Schema
+}
+
+// Fields of the DiscordChannelSetting.
+func (DiscordChannelSetting) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ID of the channel
+		field.String("id").NotEmpty().Immutable(),
+		field.String("guild_id").NotEmpty().Immutable(),
+		// mention_replies lets the bot answer when it's mentioned or replied to
+		field.Bool("mention_replies").Default(true),
+		// chime_in lets the bot join conversations unprompted, as long as
+		// the guild allows it.
+		field.Bool("chime_in").Default(false),
+	}
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscordChimeIn holds the schema definition for the DiscordChimeIn entity.
+// Every row is a message the bot posted unprompted, kept to see how often it
+// happens and how people take it.
+type DiscordChimeIn struct {
+	ent.Schema
+}
+
+// Fields of the DiscordChimeIn.
+func (DiscordChimeIn) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ID of the message the bot posted
+		field.String("id").NotEmpty().Immutable(),
+		field.String("guild_id").NotEmpty().Immutable(),
+		field.String("channel_id").NotEmpty().Immutable(),
+		// trigger_message_id is the message the bot chimed in after
+		field.String("trigger_message_id").NotEmpty().Immutable(),
+		field.Float("score").Immutable(),
+		field.String("reason").Optional().Immutable(),
+		// reactions is the number of reactions on the bot's message
+		field.Int("reactions").Default(0),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (DiscordChimeIn) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "created_at"),
+	}
+}
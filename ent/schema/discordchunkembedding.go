@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/pgvector/pgvector-go"
+)
+
+// DiscordChunkEmbedding holds the schema definition for the
+// DiscordChunkEmbedding entity.
+type DiscordChunkEmbedding struct {
+	ent.Schema
+}
+
+// Fields of the DiscordChunkEmbedding.
+func (DiscordChunkEmbedding) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("chunk_id").NotEmpty().Immutable(),
+		field.String("model").NotEmpty().Immutable(),
+		field.Other("embedding", pgvector.Vector{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "vector",
+			}),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the DiscordChunkEmbedding.
+func (DiscordChunkEmbedding) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("chunk", DiscordMessageChunk.Type).
+			Ref("embeddings").
+			Unique().
+			Field("chunk_id").Required().Immutable(),
+	}
+}
+
+func (DiscordChunkEmbedding) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("chunk_id", "model").Unique(),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscordDirectMessage holds the schema definition for the
+// DiscordDirectMessage entity. DMs between a user and the bot are kept apart
+// from DiscordMessage so they never show up in the guild tools.
+type DiscordDirectMessage struct {
+	ent.Schema
+}
+
+// Fields of the DiscordDirectMessage.
+func (DiscordDirectMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		// user_id is the user the conversation is with, also for the bot's
+		// own replies.
+		field.String("user_id").NotEmpty().Immutable(),
+		field.String("channel_id").NotEmpty().Immutable(),
+		field.Bool("from_bot").Default(false).Immutable(),
+		field.Text("content"),
+		field.Time("timestamp").Immutable(),
+	}
+}
+
+// Edges of the DiscordDirectMessage.
+func (DiscordDirectMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", DiscordUser.Type).
+			Ref("direct_messages").
+			Unique().
+			Field("user_id").Required().Immutable(),
+	}
+}
+
+func (DiscordDirectMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "timestamp"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscordGuildMember holds the schema definition for the DiscordGuildMember entity.
+// It is a user's membership in a single guild.
+type DiscordGuildMember struct {
+	ent.Schema
+}
+
+// Fields of the DiscordGuildMember.
+func (DiscordGuildMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("guild_id").NotEmpty().Immutable(),
+		field.String("user_id").NotEmpty().Immutable(),
+		field.String("nickname").Optional(),
+		field.Strings("roles").Optional(),
+		field.Time("joined_at").Optional(),
+		field.Time("left_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the DiscordGuildMember.
+func (DiscordGuildMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", DiscordUser.Type).
+			Ref("memberships").
+			Unique().
+			Field("user_id").Required().Immutable(),
+	}
+}
+
+func (DiscordGuildMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "user_id").Unique(),
+	}
+}
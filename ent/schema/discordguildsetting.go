@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordGuildSetting holds the schema definition for the
+// DiscordGuildSetting entity. Guilds without a row use the defaults.
+type DiscordGuildSetting struct {
+	ent.Schema
+}
+
+// Fields of the DiscordGuildSetting.
+func (DiscordGuildSetting) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ID of the guild
+		field.String("id").NotEmpty().Immutable(),
+		// chime_in is the kill switch of the chime-in mode for the whole guild,
+		// channels still have to opt in on their own.
+		field.Bool("chime_in").Default(false),
+		// chime_in_probability is the chance a message gets looked at at all
+		field.Float("chime_in_probability").Default(0.2).Min(0).Max(1),
+		// chime_in_threshold is the classifier score a conversation needs
+		field.Float("chime_in_threshold").Default(0.75).Min(0).Max(1),
+		// chime_in_cooldown is the minimum time between chime-ins in a channel,
+		// in seconds.
+		field.Int("chime_in_cooldown").Default(1800).NonNegative(),
+		// moderation_denylist holds terms generated answers must not contain,
+		// on top of the MODERATION_DENYLIST ones.
+		field.Strings("moderation_denylist").Optional(),
+		// moderation_denylist_action is whether denylisted terms get masked or
+		// keep the whole answer from being posted
+		field.Enum("moderation_denylist_action").
+			Values("mask", "block").
+			Default("mask"),
+		// moderation_redact removes emails, tokens and keys from answers
+		field.Bool("moderation_redact").Default(true),
+		// moderation_classifier has a model rate answers before they are posted
+		field.Bool("moderation_classifier").Default(false),
+		// moderation_flag_threshold is the classifier score that gets an
+		// answer logged for review, it is still posted
+		field.Float("moderation_flag_threshold").Default(0.5).Min(0).Max(1),
+		// moderation_block_threshold is the classifier score that keeps an
+		// answer from being posted
+		field.Float("moderation_block_threshold").Default(0.8).Min(0).Max(1),
+		// ingest_allowlist holds the channels and categories the bot archives,
+		// when set nothing else is archived
+		field.Strings("ingest_allowlist").Optional(),
+		// ingest_denylist holds the channels and categories the bot never
+		// archives
+		field.Strings("ingest_denylist").Optional(),
+		// retrieval_denylist holds the channels and categories whose archived
+		// messages are never surfaced
+		field.Strings("retrieval_denylist").Optional(),
+		// restricted_channels holds the channels and categories whose archived
+		// messages are only surfaced to users that can see them
+		field.Strings("restricted_channels").Optional(),
+	}
+}
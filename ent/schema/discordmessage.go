@@ -19,8 +19,14 @@ func (DiscordMessage) Fields() []ent.Field {
 		field.String("id").NotEmpty().Immutable(),
 		field.Text("content"),
 		field.String("author_id").NotEmpty().Immutable(),
+		field.String("guild_id").Optional(),
+		field.String("channel_id").Optional(),
+		field.String("reply_to_id").Optional(),
 		field.Time("timestamp").Immutable(),
 		field.Time("edited_timestamp").Optional(),
+		// suspected_injection is set at ingestion when the content looks like
+		// it tries to give the bot instructions, it's never shown to models
+		field.Bool("suspected_injection").Default(false),
 	}
 }
 
@@ -32,11 +38,24 @@ func (DiscordMessage) Edges() []ent.Edge {
 			Unique().
 			Field("author_id").Required().Immutable(),
 		edge.To("embeddings", DiscordMessageEmbedding.Type),
+		edge.To("reactions", DiscordReaction.Type),
+		edge.To("replies", DiscordMessage.Type).
+			From("reply_to").
+			Unique().
+			Field("reply_to_id"),
+		edge.To("mentioned_users", DiscordUser.Type),
+		edge.To("mentioned_roles", DiscordRole.Type),
+		edge.To("mentioned_channels", DiscordChannel.Type),
+		// chunks overlap, so a message can be part of more than one
+		edge.From("chunks", DiscordMessageChunk.Type).Ref("messages"),
 	}
 }
 
 func (DiscordMessage) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("timestamp").Annotations(entsql.DescColumns("timestamp")),
+		index.Fields("channel_id", "timestamp").
+			Annotations(entsql.DescColumns("timestamp")),
+		index.Fields("guild_id", "channel_id"),
 	}
 }
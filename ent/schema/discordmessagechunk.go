@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscordMessageChunk holds the schema definition for the DiscordMessageChunk
+// entity. A chunk is a window of consecutive messages of a channel, embedded
+// as a whole because single messages are often too short to mean anything.
+type DiscordMessageChunk struct {
+	ent.Schema
+}
+
+// Fields of the DiscordMessageChunk.
+func (DiscordMessageChunk) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ID of the first message of the chunk
+		field.String("id").NotEmpty().Immutable(),
+		field.String("guild_id").NotEmpty().Immutable(),
+		field.String("channel_id").NotEmpty().Immutable(),
+		field.Time("start_at").Immutable(),
+		field.Time("end_at"),
+		// content is the chat log that gets embedded
+		field.Text("content"),
+	}
+}
+
+// Edges of the DiscordMessageChunk.
+func (DiscordMessageChunk) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("messages", DiscordMessage.Type),
+		edge.To("embeddings", DiscordChunkEmbedding.Type),
+	}
+}
+
+func (DiscordMessageChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("channel_id", "start_at"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscordProfileChange holds the schema definition for the DiscordProfileChange entity.
+// A row is written every time a user is seen with a new name or avatar.
+type DiscordProfileChange struct {
+	ent.Schema
+}
+
+// Fields of the DiscordProfileChange.
+func (DiscordProfileChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty().Immutable(),
+		// guild_id is only set for nicknames, which are per guild.
+		field.String("guild_id").Optional().Immutable(),
+		field.Enum("kind").
+			Values("username", "global_name", "nickname", "avatar").
+			Immutable(),
+		field.String("value").NotEmpty().Immutable(),
+		field.Time("changed_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the DiscordProfileChange.
+func (DiscordProfileChange) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", DiscordUser.Type).
+			Ref("profile_changes").
+			Unique().
+			Field("user_id").Required().Immutable(),
+	}
+}
+
+func (DiscordProfileChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "changed_at"),
+	}
+}
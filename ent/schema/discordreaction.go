@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DiscordReaction holds the schema definition for the DiscordReaction entity.
+// Every row is a single user reacting to a message with a single emoji.
+type DiscordReaction struct {
+	ent.Schema
+}
+
+// Fields of the DiscordReaction.
+func (DiscordReaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("message_id").NotEmpty().Immutable(),
+		field.String("user_id").NotEmpty().Immutable(),
+		// emoji is the API name, i.e. the unicode emoji or "name:id" for custom ones.
+		field.String("emoji").NotEmpty().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the DiscordReaction.
+func (DiscordReaction) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("message", DiscordMessage.Type).
+			Ref("reactions").
+			Unique().
+			Field("message_id").Required().Immutable(),
+	}
+}
+
+func (DiscordReaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("message_id", "user_id", "emoji").Unique(),
+	}
+}
@@ -0,0 +1,28 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// DiscordRole holds the schema definition for the DiscordRole entity.
+type DiscordRole struct {
+	ent.Schema
+}
+
+// Fields of the DiscordRole.
+func (DiscordRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").NotEmpty().Immutable(),
+		field.String("guild_id").NotEmpty().Immutable(),
+		field.String("name").NotEmpty(),
+	}
+}
+
+// Edges of the DiscordRole.
+func (DiscordRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("mentioned_in", DiscordMessage.Type).Ref("mentioned_roles"),
+	}
+}
@@ -17,6 +17,11 @@ func (DiscordUser) Fields() []ent.Field {
 		field.String("id").NotEmpty().Immutable(),
 		field.String("username").NotEmpty(),
 		field.String("global_name").NotEmpty(),
+		field.String("avatar").Optional(),
+		// profiling_opt_out keeps the user out of the profiling features
+		field.Bool("profiling_opt_out").Default(false),
+		// dm_enabled lets the user chat with the bot in DMs
+		field.Bool("dm_enabled").Default(false),
 	}
 }
 
@@ -24,5 +29,9 @@ func (DiscordUser) Fields() []ent.Field {
 func (DiscordUser) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("messages", DiscordMessage.Type),
+		edge.From("mentioned_in", DiscordMessage.Type).Ref("mentioned_users"),
+		edge.To("memberships", DiscordGuildMember.Type),
+		edge.To("profile_changes", DiscordProfileChange.Type),
+		edge.To("direct_messages", DiscordDirectMessage.Type),
 	}
 }
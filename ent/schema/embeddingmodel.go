@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// EmbeddingModel holds the schema definition for the EmbeddingModel entity.
+// Every model vectors are stored for is registered here, along with the
+// dimension its vectors are indexed with.
+type EmbeddingModel struct {
+	ent.Schema
+}
+
+// Fields of the EmbeddingModel.
+func (EmbeddingModel) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the Genkit name of the embedder, e.g. "openai/text-embedding-3-small"
+		field.String("id").NotEmpty().Immutable(),
+		field.Int("dimensions").Positive().Immutable(),
+		// active marks the model the searches use, only one model is active
+		field.Bool("active").Default(false),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// ready_at is when every archived message got a vector from the model
+		field.Time("ready_at").Optional().Nillable(),
+	}
+}
+
+func (EmbeddingModel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("active").
+			Unique().
+			Annotations(entsql.IndexWhere("active")),
+	}
+}
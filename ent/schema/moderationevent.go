@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ModerationEvent holds the schema definition for the ModerationEvent entity.
+// Every row is a generated answer the moderation rewrote, blocked or flagged.
+type ModerationEvent struct {
+	ent.Schema
+}
+
+// Fields of the ModerationEvent.
+func (ModerationEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// guild_id is empty for direct messages
+		field.String("guild_id").Optional().Immutable(),
+		field.String("channel_id").Optional().Immutable(),
+		// user_id is the member the answer was for, if anyone asked
+		field.String("user_id").Optional().Immutable(),
+		// source is the feature that generated the answer, like ask or chime_in
+		field.String("source").NotEmpty().Immutable(),
+		field.Enum("action").
+			Values("rewrite", "block", "flag").
+			Immutable(),
+		field.Strings("reasons").Optional().Immutable(),
+		// score is the classifier score, when the classifier ran
+		field.Float("score").Optional().Nillable().Immutable(),
+		// original is the answer before moderation
+		field.Text("original").Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (ModerationEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guild_id", "created_at"),
+	}
+}
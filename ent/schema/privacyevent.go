@@ -0,0 +1,37 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PrivacyEvent holds the schema definition for the PrivacyEvent entity. Every
+// row is a privacy request a user made, kept as the record that it was carried
+// out.
+type PrivacyEvent struct {
+	ent.Schema
+}
+
+// Fields of the PrivacyEvent.
+func (PrivacyEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_id").NotEmpty().Immutable(),
+		// guild_id is where the command was run, empty in DMs
+		field.String("guild_id").Optional().Immutable(),
+		field.Enum("action").
+			Values("opt_out", "opt_in", "export", "forget").
+			Immutable(),
+		// records is how many rows were exported or deleted
+		field.Int("records").Default(0).Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (PrivacyEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,24 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// PrivacyOptOut holds the schema definition for the PrivacyOptOut entity.
+// Users with a row are not archived. It is kept apart from DiscordUser so it
+// survives the user being forgotten.
+type PrivacyOptOut struct {
+	ent.Schema
+}
+
+// Fields of the PrivacyOptOut.
+func (PrivacyOptOut) Fields() []ent.Field {
+	return []ent.Field{
+		// id is the ID of the user
+		field.String("id").NotEmpty().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
@@ -4,6 +4,8 @@ package ent
 
 import (
 	"context"
+	stdsql "database/sql"
+	"fmt"
 	"sync"
 
 	"entgo.io/ent/dialect"
@@ -12,12 +14,42 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// DiscordChannel is the client for interacting with the DiscordChannel builders.
+	DiscordChannel *DiscordChannelClient
+	// DiscordChannelSetting is the client for interacting with the DiscordChannelSetting builders.
+	DiscordChannelSetting *DiscordChannelSettingClient
+	// DiscordChimeIn is the client for interacting with the DiscordChimeIn builders.
+	DiscordChimeIn *DiscordChimeInClient
+	// DiscordChunkEmbedding is the client for interacting with the DiscordChunkEmbedding builders.
+	DiscordChunkEmbedding *DiscordChunkEmbeddingClient
+	// DiscordDirectMessage is the client for interacting with the DiscordDirectMessage builders.
+	DiscordDirectMessage *DiscordDirectMessageClient
+	// DiscordGuildMember is the client for interacting with the DiscordGuildMember builders.
+	DiscordGuildMember *DiscordGuildMemberClient
+	// DiscordGuildSetting is the client for interacting with the DiscordGuildSetting builders.
+	DiscordGuildSetting *DiscordGuildSettingClient
 	// DiscordMessage is the client for interacting with the DiscordMessage builders.
 	DiscordMessage *DiscordMessageClient
+	// DiscordMessageChunk is the client for interacting with the DiscordMessageChunk builders.
+	DiscordMessageChunk *DiscordMessageChunkClient
 	// DiscordMessageEmbedding is the client for interacting with the DiscordMessageEmbedding builders.
 	DiscordMessageEmbedding *DiscordMessageEmbeddingClient
+	// DiscordProfileChange is the client for interacting with the DiscordProfileChange builders.
+	DiscordProfileChange *DiscordProfileChangeClient
+	// DiscordReaction is the client for interacting with the DiscordReaction builders.
+	DiscordReaction *DiscordReactionClient
+	// DiscordRole is the client for interacting with the DiscordRole builders.
+	DiscordRole *DiscordRoleClient
 	// DiscordUser is the client for interacting with the DiscordUser builders.
 	DiscordUser *DiscordUserClient
+	// EmbeddingModel is the client for interacting with the EmbeddingModel builders.
+	EmbeddingModel *EmbeddingModelClient
+	// ModerationEvent is the client for interacting with the ModerationEvent builders.
+	ModerationEvent *ModerationEventClient
+	// PrivacyEvent is the client for interacting with the PrivacyEvent builders.
+	PrivacyEvent *PrivacyEventClient
+	// PrivacyOptOut is the client for interacting with the PrivacyOptOut builders.
+	PrivacyOptOut *PrivacyOptOutClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,9 +181,24 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.DiscordChannel = NewDiscordChannelClient(tx.config)
+	tx.DiscordChannelSetting = NewDiscordChannelSettingClient(tx.config)
+	tx.DiscordChimeIn = NewDiscordChimeInClient(tx.config)
+	tx.DiscordChunkEmbedding = NewDiscordChunkEmbeddingClient(tx.config)
+	tx.DiscordDirectMessage = NewDiscordDirectMessageClient(tx.config)
+	tx.DiscordGuildMember = NewDiscordGuildMemberClient(tx.config)
+	tx.DiscordGuildSetting = NewDiscordGuildSettingClient(tx.config)
 	tx.DiscordMessage = NewDiscordMessageClient(tx.config)
+	tx.DiscordMessageChunk = NewDiscordMessageChunkClient(tx.config)
 	tx.DiscordMessageEmbedding = NewDiscordMessageEmbeddingClient(tx.config)
+	tx.DiscordProfileChange = NewDiscordProfileChangeClient(tx.config)
+	tx.DiscordReaction = NewDiscordReactionClient(tx.config)
+	tx.DiscordRole = NewDiscordRoleClient(tx.config)
 	tx.DiscordUser = NewDiscordUserClient(tx.config)
+	tx.EmbeddingModel = NewEmbeddingModelClient(tx.config)
+	tx.ModerationEvent = NewModerationEventClient(tx.config)
+	tx.PrivacyEvent = NewPrivacyEventClient(tx.config)
+	tx.PrivacyOptOut = NewPrivacyOptOutClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -161,7 +208,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: DiscordMessage.QueryXXX(), the query will be executed
+// applies a query, for example: DiscordChannel.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -214,3 +261,27 @@ func (tx *txDriver) Query(ctx context.Context, query string, args, v any) error
 }
 
 var _ dialect.Driver = (*txDriver)(nil)
+
+// ExecContext allows calling the underlying ExecContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.ExecContext for more information.
+func (tx *txDriver) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := tx.tx.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the transaction if it is supported by it.
+// See, database/sql#Tx.QueryContext for more information.
+func (tx *txDriver) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := tx.tx.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Tx.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
@@ -0,0 +1,57 @@
+// Package archive holds the queries over the stored Discord history that are
+// shared between the Genkit tools and the slash commands
+package archive
+
+import (
+	"regexp"
+	"strings"
+
+	"sev0/ent"
+)
+
+// JumpURL returns the Discord link to the original message, or an empty string
+// if the message was stored before channels were being recorded.
+func JumpURL(m *ent.DiscordMessage) string {
+	if m.GuildID == "" || m.ChannelID == "" {
+		return ""
+	}
+
+	return "https://discord.com/channels/" + m.GuildID + "/" + m.ChannelID + "/" + m.ID
+}
+
+// FormatEmoji turns a stored emoji API name back into something Discord
+// renders inside a message.
+func FormatEmoji(apiName string) string {
+	if strings.Contains(apiName, ":") {
+		return "<:" + apiName + ">"
+	}
+
+	return apiName
+}
+
+var mentionRe = regexp.MustCompile(`<(@!?|@&|#)(\d+)>`)
+
+// RenderContent replaces the raw user, role and channel mentions in a message
+// with their names. The mention edges have to be loaded, see WithDetails.
+func RenderContent(m *ent.DiscordMessage) string {
+	names := make(map[string]string)
+	for _, u := range m.Edges.MentionedUsers {
+		names["@"+u.ID] = "@" + u.GlobalName
+	}
+	for _, r := range m.Edges.MentionedRoles {
+		names["@&"+r.ID] = "@" + r.Name
+	}
+	for _, c := range m.Edges.MentionedChannels {
+		names["#"+c.ID] = "#" + c.Name
+	}
+
+	return mentionRe.ReplaceAllStringFunc(m.Content, func(mention string) string {
+		match := mentionRe.FindStringSubmatch(mention)
+		kind := strings.TrimSuffix(match[1], "!")
+		if name, ok := names[kind+match[2]]; ok {
+			return name
+		}
+
+		return mention
+	})
+}
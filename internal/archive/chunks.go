@@ -0,0 +1,101 @@
+package archive
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"sev0/ent"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+type ChunkResult struct {
+	Chunk *ent.DiscordMessageChunk
+	// Messages are all the messages of the chunk, oldest first
+	Messages []*ent.DiscordMessage
+	Score    float64
+}
+
+// ChunkSearch returns the conversation chunks closest to vector, which has to
+// come from the embedding model named model. A chunk matches the filters
+// when any of its messages does. Chunks with a message the caller can't see
+// are left out, their vectors carry what that message said.
+func ChunkSearch(
+	ctx context.Context,
+	entClient *ent.Client,
+	vector []float32,
+	model string,
+	params SearchParams,
+) ([]ChunkResult, error) {
+	where, args := params.where(ctx, []any{pgvector.NewVector(vector), model})
+	visibleWhere, args := visibleSQL(ctx, args)
+	args = append(args, params.Limit)
+
+	rows, err := entClient.QueryContext(ctx, fmt.Sprintf(`
+		SELECT e.chunk_id, 1 - (e.embedding::vector(%[1]d) <=> $1::vector(%[1]d)) AS similarity
+		FROM discord_chunk_embeddings e
+		WHERE e.model = $2 AND EXISTS (
+			SELECT 1
+			FROM discord_message_chunk_messages cm
+			JOIN discord_messages m ON m.id = cm.discord_message_id
+			WHERE cm.discord_message_chunk_id = e.chunk_id AND %[2]s
+		) AND NOT EXISTS (
+			SELECT 1
+			FROM discord_message_chunk_messages cm
+			JOIN discord_messages m ON m.id = cm.discord_message_id
+			WHERE cm.discord_message_chunk_id = e.chunk_id AND NOT (%[3]s)
+		)
+		ORDER BY e.embedding::vector(%[1]d) <=> $1::vector(%[1]d)
+		LIMIT $%[4]d`, len(vector), where, visibleWhere, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	scores := make(map[string]float64)
+	var ids []string
+	for rows.Next() {
+		var (
+			id    string
+			score float64
+		)
+		if err := rows.Scan(&id, &score); err != nil {
+			return nil, err
+		}
+		scores[id] = score
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	chunks, err := entClient.DiscordMessageChunk.Query().
+		Where(discordmessagechunk.IDIn(ids...)).
+		WithMessages(func(q *ent.DiscordMessageQuery) {
+			WithDetails(q).
+				Where(visible(ctx)).
+				Order(ent.Asc(discordmessage.FieldTimestamp))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := lo.FilterMap(chunks, func(c *ent.DiscordMessageChunk, _ int) (ChunkResult, bool) {
+		return ChunkResult{
+			Chunk:    c,
+			Messages: c.Edges.Messages,
+			Score:    scores[c.ID],
+		}, len(c.Edges.Messages) > 0
+	})
+	slices.SortFunc(results, func(a, b ChunkResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	return results, nil
+}
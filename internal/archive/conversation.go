@@ -0,0 +1,156 @@
+package archive
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordmessage"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+)
+
+const (
+	maxReplyAncestors = 10
+	maxReplyDepth     = 3
+	maxReplies        = 50
+	maxSurrounding    = 50
+)
+
+type Thread struct {
+	Anchor *ent.DiscordMessage
+	// Messages holds the anchor, its reply chain and the surrounding messages,
+	// oldest first.
+	Messages []*ent.DiscordMessage
+	// InReplyChain is the set of message IDs that are connected to the anchor
+	// through replies.
+	InReplyChain map[string]bool
+}
+
+// WithDetails eager loads everything needed to render a message.
+func WithDetails(q *ent.DiscordMessageQuery) *ent.DiscordMessageQuery {
+	return q.WithUser().
+		WithMentionedUsers().
+		WithMentionedRoles().
+		WithMentionedChannels()
+}
+
+// Conversation walks the reply graph around a message and adds whatever was
+// said in the same channel within window of it.
+func Conversation(
+	ctx context.Context,
+	entClient *ent.Client,
+	guildID string,
+	messageID string,
+	window time.Duration,
+) (*Thread, error) {
+	query := entClient.DiscordMessage.Query().
+		Where(discordmessage.ID(messageID), visible(ctx))
+	if guildID != "" {
+		query.Where(discordmessage.GuildID(guildID))
+	}
+	anchor, err := WithDetails(query).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	found := map[string]*ent.DiscordMessage{anchor.ID: anchor}
+	inChain := map[string]bool{anchor.ID: true}
+
+	// Walk up to the start of the reply chain
+	cur := anchor
+	for range maxReplyAncestors {
+		if cur.ReplyToID == "" {
+			break
+		}
+		parent, err := WithDetails(entClient.DiscordMessage.Query().
+			Where(discordmessage.ID(cur.ReplyToID), visible(ctx))).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		found[parent.ID] = parent
+		inChain[parent.ID] = true
+		cur = parent
+	}
+
+	// Walk down through the replies to the anchor
+	replyCount := 0
+	frontier := []string{anchor.ID}
+	for depth := 0; depth < maxReplyDepth && len(frontier) > 0 && replyCount < maxReplies; depth++ {
+		replies, err := WithDetails(entClient.DiscordMessage.Query().
+			Where(discordmessage.ReplyToIDIn(frontier...), visible(ctx))).
+			Order(discordmessage.ByTimestamp()).
+			Limit(maxReplies - replyCount).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		frontier = make([]string, 0, len(replies))
+		for _, r := range replies {
+			found[r.ID] = r
+			inChain[r.ID] = true
+			frontier = append(frontier, r.ID)
+		}
+		replyCount += len(replies)
+	}
+
+	if anchor.ChannelID != "" && window > 0 {
+		// Half of the slots on each side of the anchor, a busy channel would
+		// otherwise fill all of them before it
+		sides := []struct {
+			pred  predicate.DiscordMessage
+			order sql.OrderTermOption
+		}{
+			{
+				discordmessage.And(
+					discordmessage.TimestampGTE(anchor.Timestamp.Add(-window)),
+					discordmessage.TimestampLT(anchor.Timestamp),
+				),
+				sql.OrderDesc(),
+			},
+			{
+				discordmessage.And(
+					discordmessage.TimestampGT(anchor.Timestamp),
+					discordmessage.TimestampLTE(anchor.Timestamp.Add(window)),
+				),
+				sql.OrderAsc(),
+			},
+		}
+		for _, side := range sides {
+			surrounding, err := WithDetails(entClient.DiscordMessage.Query().
+				Where(
+					discordmessage.ChannelID(anchor.ChannelID),
+					side.pred,
+					visible(ctx),
+				)).
+				Order(discordmessage.ByTimestamp(side.order)).
+				Limit(maxSurrounding / 2).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range surrounding {
+				found[m.ID] = m
+			}
+		}
+	}
+
+	messages := lo.Values(found)
+	slices.SortFunc(messages, func(a, b *ent.DiscordMessage) int {
+		return a.Timestamp.Compare(b.Timestamp)
+	})
+
+	return &Thread{
+		Anchor:       anchor,
+		Messages:     messages,
+		InReplyChain: inChain,
+	}, nil
+}
@@ -0,0 +1,119 @@
+package archive
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordmessage"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+)
+
+var channelRefRe = regexp.MustCompile(`^<#(\d+)>$`)
+
+type RecentParams struct {
+	GuildID   string
+	ChannelID string
+	AuthorIDs []string
+	Before    time.Time
+	After     time.Time
+	Limit     int
+}
+
+// RecentMessages returns up to Limit messages matching params, oldest first.
+// When only After is set the messages right after it are returned, otherwise
+// the latest ones.
+func RecentMessages(
+	ctx context.Context,
+	entClient *ent.Client,
+	params RecentParams,
+) ([]*ent.DiscordMessage, error) {
+	query := WithDetails(entClient.DiscordMessage.Query()).Where(visible(ctx))
+	if params.GuildID != "" {
+		query.Where(discordmessage.GuildID(params.GuildID))
+	}
+	if params.ChannelID != "" {
+		query.Where(discordmessage.ChannelID(params.ChannelID))
+	}
+	if len(params.AuthorIDs) > 0 {
+		query.Where(discordmessage.AuthorIDIn(params.AuthorIDs...))
+	}
+	if !params.Before.IsZero() {
+		query.Where(discordmessage.TimestampLT(params.Before))
+	}
+	if !params.After.IsZero() {
+		query.Where(discordmessage.TimestampGT(params.After))
+	}
+
+	if params.Before.IsZero() && !params.After.IsZero() {
+		return query.
+			Order(discordmessage.ByTimestamp(sql.OrderAsc())).
+			Limit(params.Limit).
+			All(ctx)
+	}
+
+	messages, err := query.
+		Order(discordmessage.ByTimestamp(sql.OrderDesc())).
+		Limit(params.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(messages)
+
+	return messages, nil
+}
+
+// ResolveChannel finds a channel by mention, ID or name.
+func ResolveChannel(
+	ctx context.Context,
+	entClient *ent.Client,
+	guildID string,
+	name string,
+) (*ent.DiscordChannel, error) {
+	name = strings.TrimSpace(name)
+	if match := channelRefRe.FindStringSubmatch(name); match != nil {
+		name = match[1]
+	}
+	name = strings.TrimPrefix(name, "#")
+
+	query := entClient.DiscordChannel.Query().
+		Where(discordchannel.Or(
+			discordchannel.ID(name),
+			discordchannel.NameEqualFold(name),
+		))
+	if guildID != "" {
+		query.Where(discordchannel.GuildID(guildID))
+	}
+
+	return query.First(ctx)
+}
+
+// ChannelNames maps the IDs of the channels the messages were sent in to
+// their names.
+func ChannelNames(
+	ctx context.Context,
+	entClient *ent.Client,
+	messages []*ent.DiscordMessage,
+) (map[string]string, error) {
+	ids := lo.Uniq(lo.FilterMap(messages, func(m *ent.DiscordMessage, _ int) (string, bool) {
+		return m.ChannelID, m.ChannelID != ""
+	}))
+
+	channels, err := entClient.DiscordChannel.Query().
+		Where(discordchannel.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.SliceToMap(channels, func(c *ent.DiscordChannel) (string, string) {
+		return c.ID, c.Name
+	}), nil
+}
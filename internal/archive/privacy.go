@@ -0,0 +1,324 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discorddirectmessage"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordreaction"
+	"sev0/ent/discorduser"
+	"sev0/ent/moderationevent"
+	"sev0/ent/predicate"
+	"sev0/ent/privacyoptout"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+)
+
+// optedOutSQL is notOptedOut for the raw search queries, over the message
+// alias m.
+const optedOutSQL = "m.author_id NOT IN (SELECT id FROM privacy_opt_outs)"
+
+// notOptedOut leaves out the messages of users that opted out of the archive,
+// whatever was archived before they did stays hidden.
+func notOptedOut() predicate.DiscordMessage {
+	return func(s *sql.Selector) {
+		s.Where(sql.NotIn(
+			s.C(discordmessage.FieldAuthorID),
+			sql.Select(privacyoptout.FieldID).From(sql.Table(privacyoptout.Table)),
+		))
+	}
+}
+
+// userNotOptedOut is notOptedOut for users.
+func userNotOptedOut() predicate.DiscordUser {
+	return func(s *sql.Selector) {
+		s.Where(sql.NotIn(
+			s.C(discorduser.FieldID),
+			sql.Select(privacyoptout.FieldID).From(sql.Table(privacyoptout.Table)),
+		))
+	}
+}
+
+// OptedOut tells whether a user asked not to be archived.
+func OptedOut(ctx context.Context, entClient *ent.Client, userID string) (bool, error) {
+	return entClient.PrivacyOptOut.Query().
+		Where(privacyoptout.ID(userID)).
+		Exist(ctx)
+}
+
+// UserExport is everything stored about a user, as handed out to them.
+type UserExport struct {
+	ExportedAt     time.Time              `json:"exported_at"`
+	OptedOut       bool                   `json:"opted_out"`
+	User           *ent.DiscordUser       `json:"user,omitempty"`
+	Memberships    []ExportMembership     `json:"memberships"`
+	ProfileChanges []ExportProfileChange  `json:"profile_changes"`
+	Messages       []ExportMessage        `json:"messages"`
+	Reactions      []ExportReaction       `json:"reactions"`
+	DirectMessages []ExportDirectMessage  `json:"direct_messages"`
+	Embeddings     int                    `json:"embeddings"`
+	Moderation     []ExportModerationNote `json:"moderation_events"`
+}
+
+type ExportMembership struct {
+	GuildID  string     `json:"guild_id"`
+	Nickname string     `json:"nickname,omitempty"`
+	Roles    []string   `json:"roles,omitempty"`
+	JoinedAt time.Time  `json:"joined_at"`
+	LeftAt   *time.Time `json:"left_at,omitempty"`
+}
+
+type ExportProfileChange struct {
+	GuildID   string    `json:"guild_id,omitempty"`
+	Kind      string    `json:"kind"`
+	Value     string    `json:"value"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type ExportMessage struct {
+	ID              string    `json:"id"`
+	GuildID         string    `json:"guild_id"`
+	ChannelID       string    `json:"channel_id"`
+	ReplyToID       string    `json:"reply_to_id,omitempty"`
+	Content         string    `json:"content"`
+	Timestamp       time.Time `json:"timestamp"`
+	EditedTimestamp time.Time `json:"edited_timestamp,omitzero"`
+}
+
+type ExportReaction struct {
+	MessageID string    `json:"message_id"`
+	Emoji     string    `json:"emoji"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExportDirectMessage struct {
+	FromBot   bool      `json:"from_bot"`
+	Content   string    `json:"content"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+type ExportModerationNote struct {
+	GuildID   string    `json:"guild_id,omitempty"`
+	Source    string    `json:"source"`
+	Action    string    `json:"action"`
+	Reasons   []string  `json:"reasons,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ExportUser gathers everything stored about a user. Embeddings are only
+// counted, the vectors mean nothing outside of the archive.
+func ExportUser(
+	ctx context.Context,
+	entClient *ent.Client,
+	userID string,
+) (*UserExport, error) {
+	export := &UserExport{ExportedAt: time.Now()}
+
+	var err error
+	if export.OptedOut, err = OptedOut(ctx, entClient, userID); err != nil {
+		return nil, err
+	}
+
+	export.User, err = entClient.DiscordUser.Get(ctx, userID)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	memberships, err := entClient.DiscordGuildMember.Query().
+		Where(discordguildmember.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export.Memberships = lo.Map(memberships, func(m *ent.DiscordGuildMember, _ int) ExportMembership {
+		return ExportMembership{
+			GuildID:  m.GuildID,
+			Nickname: m.Nickname,
+			Roles:    m.Roles,
+			JoinedAt: m.JoinedAt,
+			LeftAt:   m.LeftAt,
+		}
+	})
+
+	changes, err := entClient.DiscordProfileChange.Query().
+		Where(discordprofilechange.UserID(userID)).
+		Order(ent.Asc(discordprofilechange.FieldChangedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export.ProfileChanges = lo.Map(changes, func(c *ent.DiscordProfileChange, _ int) ExportProfileChange {
+		return ExportProfileChange{
+			GuildID:   c.GuildID,
+			Kind:      string(c.Kind),
+			Value:     c.Value,
+			ChangedAt: c.ChangedAt,
+		}
+	})
+
+	messages, err := entClient.DiscordMessage.Query().
+		Where(discordmessage.AuthorID(userID)).
+		Order(ent.Asc(discordmessage.FieldTimestamp)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export.Messages = lo.Map(messages, func(m *ent.DiscordMessage, _ int) ExportMessage {
+		return ExportMessage{
+			ID:              m.ID,
+			GuildID:         m.GuildID,
+			ChannelID:       m.ChannelID,
+			ReplyToID:       m.ReplyToID,
+			Content:         m.Content,
+			Timestamp:       m.Timestamp,
+			EditedTimestamp: m.EditedTimestamp,
+		}
+	})
+
+	reactions, err := entClient.DiscordReaction.Query().
+		Where(discordreaction.UserID(userID)).
+		Order(ent.Asc(discordreaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export.Reactions = lo.Map(reactions, func(r *ent.DiscordReaction, _ int) ExportReaction {
+		return ExportReaction{MessageID: r.MessageID, Emoji: r.Emoji, CreatedAt: r.CreatedAt}
+	})
+
+	dms, err := entClient.DiscordDirectMessage.Query().
+		Where(discorddirectmessage.UserID(userID)).
+		Order(ent.Asc(discorddirectmessage.FieldTimestamp)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export.DirectMessages = lo.Map(dms, func(dm *ent.DiscordDirectMessage, _ int) ExportDirectMessage {
+		return ExportDirectMessage{FromBot: dm.FromBot, Content: dm.Content, Timestamp: dm.Timestamp}
+	})
+
+	export.Embeddings, err = entClient.DiscordMessageEmbedding.Query().
+		Where(discordmessageembedding.HasMessageWith(discordmessage.AuthorID(userID))).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := entClient.ModerationEvent.Query().
+		Where(moderationevent.UserID(userID)).
+		Order(ent.Asc(moderationevent.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	export.Moderation = lo.Map(events, func(e *ent.ModerationEvent, _ int) ExportModerationNote {
+		return ExportModerationNote{
+			GuildID:   e.GuildID,
+			Source:    e.Source,
+			Action:    string(e.Action),
+			Reasons:   e.Reasons,
+			CreatedAt: e.CreatedAt,
+		}
+	})
+
+	return export, nil
+}
+
+// Records is the number of rows in the export.
+func (e *UserExport) Records() int {
+	records := len(e.Memberships) + len(e.ProfileChanges) + len(e.Messages) +
+		len(e.Reactions) + len(e.DirectMessages) + e.Embeddings + len(e.Moderation)
+	if e.User != nil {
+		records++
+	}
+
+	return records
+}
+
+// ForgetUser deletes everything stored about a user in a single transaction
+// and returns how many rows went. Conversation chunks with any of their
+// messages go too, since their content quotes them. The opt-out and the
+// privacy events are kept, they are the record of the request.
+func ForgetUser(
+	ctx context.Context,
+	entClient *ent.Client,
+	userID string,
+) (int, error) {
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := forgetUser(ctx, tx.Client(), userID)
+	if err != nil {
+		return 0, errors.Join(err, tx.Rollback())
+	}
+
+	return deleted, tx.Commit()
+}
+
+func forgetUser(ctx context.Context, client *ent.Client, userID string) (int, error) {
+	authored := discordmessage.AuthorID(userID)
+
+	chunkIDs, err := client.DiscordMessageChunk.Query().
+		Where(discordmessagechunk.HasMessagesWith(authored)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Children first, the foreign keys don't cascade
+	deletes := []func(context.Context) (int, error){
+		client.DiscordChunkEmbedding.Delete().
+			Where(discordchunkembedding.ChunkIDIn(chunkIDs...)).
+			Exec,
+		client.DiscordMessageChunk.Delete().
+			Where(discordmessagechunk.IDIn(chunkIDs...)).
+			Exec,
+		client.DiscordMessageEmbedding.Delete().
+			Where(discordmessageembedding.HasMessageWith(authored)).
+			Exec,
+		client.DiscordReaction.Delete().
+			Where(discordreaction.Or(
+				discordreaction.UserID(userID),
+				discordreaction.HasMessageWith(authored),
+			)).
+			Exec,
+		client.DiscordMessage.Delete().Where(authored).Exec,
+		client.DiscordGuildMember.Delete().
+			Where(discordguildmember.UserID(userID)).
+			Exec,
+		client.DiscordProfileChange.Delete().
+			Where(discordprofilechange.UserID(userID)).
+			Exec,
+		client.DiscordDirectMessage.Delete().
+			Where(discorddirectmessage.UserID(userID)).
+			Exec,
+		client.ModerationEvent.Delete().
+			Where(moderationevent.UserID(userID)).
+			Exec,
+		client.DiscordUser.Delete().
+			Where(discorduser.ID(userID)).
+			Exec,
+	}
+
+	total := 0
+	for _, del := range deletes {
+		n, err := del(ctx)
+		if err != nil {
+			return 0, err
+		}
+		total += n
+	}
+
+	return total, nil
+}
@@ -0,0 +1,327 @@
+package archive
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessageembedding"
+	"sev0/ent/discorduser"
+	"sev0/ent/predicate"
+	"sev0/internal/embeddings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+)
+
+const (
+	profileTopHours      = 5
+	profileTopChannels   = 5
+	profileTopReacted    = 5
+	profileQuotes        = 5
+	profileTopicExamples = 3
+	profileMaxTopics     = 5
+	// profileMaxEmbeddings caps how many of the latest messages are clustered
+	profileMaxEmbeddings = 500
+	// profileMinLength skips messages too short to say anything about a topic
+	profileMinLength = 15
+)
+
+// ErrProfilingOptOut is returned when profiling a user that asked not to be.
+var ErrProfilingOptOut = errors.New("archive: user opted out of profiling")
+
+type ProfileParams struct {
+	GuildID        string
+	UserID         string
+	EmbeddingModel string
+}
+
+type Profile struct {
+	User           *ent.DiscordUser
+	MessageCount   int
+	FirstMessage   time.Time
+	LastMessage    time.Time
+	ActiveHours    []HourCount
+	ActiveChannels []ChannelCount
+	Topics         []Topic
+	TopReacted     []ReactedMessage
+	Quotes         []*ent.DiscordMessage
+}
+
+// HourCount is the number of messages sent during an hour of the day, UTC.
+type HourCount struct {
+	Hour  int `json:"hour"`
+	Count int `json:"count"`
+}
+
+type ChannelCount struct {
+	ChannelID string
+	Name      string
+	Count     int
+}
+
+type totalsRow struct {
+	Count int `json:"count"`
+	// Min and Max are nil when there are no messages
+	Min *time.Time `json:"min"`
+	Max *time.Time `json:"max"`
+}
+
+type channelRow struct {
+	ChannelID string `json:"channel_id"`
+	Count     int    `json:"count"`
+}
+
+// Topic is a cluster of similar messages, with the ones closest to its center
+// as examples.
+type Topic struct {
+	Size     int
+	Examples []*ent.DiscordMessage
+}
+
+// UserProfile summarizes the activity of a single user.
+func UserProfile(
+	ctx context.Context,
+	entClient *ent.Client,
+	params ProfileParams,
+) (*Profile, error) {
+	user, err := entClient.DiscordUser.Query().
+		Where(discorduser.ID(params.UserID)).
+		WithMemberships(func(q *ent.DiscordGuildMemberQuery) {
+			if params.GuildID != "" {
+				q.Where(discordguildmember.GuildID(params.GuildID))
+			}
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if optedOut, err := ProfilingOptedOut(ctx, entClient, user); err != nil || optedOut {
+		return nil, cmp.Or(err, ErrProfilingOptOut)
+	}
+
+	preds := []predicate.DiscordMessage{
+		discordmessage.AuthorID(params.UserID),
+		visible(ctx),
+	}
+	if params.GuildID != "" {
+		preds = append(preds, discordmessage.GuildID(params.GuildID))
+	}
+
+	profile := &Profile{User: user}
+
+	var totals []totalsRow
+	err = entClient.DiscordMessage.Query().
+		Where(preds...).
+		Aggregate(
+			ent.Count(),
+			ent.Min(discordmessage.FieldTimestamp),
+			ent.Max(discordmessage.FieldTimestamp),
+		).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, err
+	}
+	if len(totals) == 0 || totals[0].Count == 0 {
+		return profile, nil
+	}
+	profile.MessageCount = totals[0].Count
+	profile.FirstMessage = *totals[0].Min
+	profile.LastMessage = *totals[0].Max
+
+	profile.ActiveHours, err = activeHours(ctx, entClient, preds)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.ActiveChannels, err = activeChannels(ctx, entClient, preds)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.TopReacted, err = TopReactedMessages(ctx, entClient, TopReactedParams{
+		GuildID:   params.GuildID,
+		AuthorIDs: []string{params.UserID},
+		Limit:     profileTopReacted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := entClient.DiscordMessageEmbedding.Query().
+		Where(
+			discordmessageembedding.Model(params.EmbeddingModel),
+			discordmessageembedding.HasMessageWith(preds...),
+		).
+		WithMessage(func(q *ent.DiscordMessageQuery) { WithDetails(q) }).
+		Order(discordmessageembedding.ByCreatedAt(sql.OrderDesc())).
+		Limit(profileMaxEmbeddings).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stored = lo.Filter(stored, func(e *ent.DiscordMessageEmbedding, _ int) bool {
+		return len([]rune(e.Edges.Message.Content)) >= profileMinLength
+	})
+
+	if len(stored) == 0 {
+		profile.Quotes, err = WithDetails(entClient.DiscordMessage.Query()).
+			Where(preds...).
+			Order(discordmessage.ByTimestamp(sql.OrderDesc())).
+			Limit(profileQuotes).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return profile, nil
+	}
+
+	profile.Topics, profile.Quotes = topics(stored)
+
+	return profile, nil
+}
+
+// ProfilingOptedOut tells whether the user asked not to be profiled. Opting
+// out of the archive includes the profiling.
+func ProfilingOptedOut(ctx context.Context, entClient *ent.Client, user *ent.DiscordUser) (bool, error) {
+	if user.ProfilingOptOut {
+		return true, nil
+	}
+
+	return OptedOut(ctx, entClient, user.ID)
+}
+
+// activeHours counts the messages per hour of the day, UTC, busiest first.
+func activeHours(
+	ctx context.Context,
+	entClient *ent.Client,
+	preds []predicate.DiscordMessage,
+) ([]HourCount, error) {
+	var hours []HourCount
+	err := entClient.DiscordMessage.Query().
+		Where(preds...).
+		Aggregate(
+			func(s *sql.Selector) string {
+				hour := fmt.Sprintf("extract(hour FROM %s AT TIME ZONE 'UTC')::int", s.C(discordmessage.FieldTimestamp))
+				s.GroupBy(hour).
+					OrderBy(sql.Desc(sql.Count("*")), hour).
+					Limit(profileTopHours)
+				return sql.As(hour, "hour")
+			},
+			ent.Count(),
+		).
+		Scan(ctx, &hours)
+
+	return hours, err
+}
+
+func activeChannels(
+	ctx context.Context,
+	entClient *ent.Client,
+	preds []predicate.DiscordMessage,
+) ([]ChannelCount, error) {
+	var counts []channelRow
+	err := entClient.DiscordMessage.Query().
+		Where(append(preds, discordmessage.ChannelIDNotNil())...).
+		GroupBy(discordmessage.FieldChannelID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	channels, err := entClient.DiscordChannel.Query().
+		Where(discordchannel.IDIn(lo.Map(counts, func(c channelRow, _ int) string {
+			return c.ChannelID
+		})...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := lo.SliceToMap(channels, func(c *ent.DiscordChannel) (string, string) {
+		return c.ID, c.Name
+	})
+
+	result := make([]ChannelCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, ChannelCount{
+			ChannelID: c.ChannelID,
+			Name:      names[c.ChannelID],
+			Count:     c.Count,
+		})
+	}
+	slices.SortFunc(result, func(a, b ChannelCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.ChannelID, b.ChannelID))
+	})
+
+	return result[:min(len(result), profileTopChannels)], nil
+}
+
+// topics clusters the embedded messages and picks the most representative
+// messages of every cluster, as well as of the whole set.
+func topics(stored []*ent.DiscordMessageEmbedding) ([]Topic, []*ent.DiscordMessage) {
+	vectors := lo.Map(stored, func(e *ent.DiscordMessageEmbedding, _ int) []float32 {
+		return e.Embedding.Slice()
+	})
+
+	k := min(max(len(stored)/15, 1), profileMaxTopics)
+	assignments, centroids := embeddings.KMeans(vectors, k)
+
+	result := make([]Topic, 0, len(centroids))
+	used := make(map[string]bool)
+	for c, centroid := range centroids {
+		members := lo.Filter(lo.Range(len(stored)), func(i int, _ int) bool {
+			return assignments[i] == c
+		})
+		if len(members) == 0 {
+			continue
+		}
+
+		examples := nearest(stored, vectors, members, centroid, profileTopicExamples)
+		for _, m := range examples {
+			used[m.ID] = true
+		}
+		result = append(result, Topic{
+			Size:     len(members),
+			Examples: examples,
+		})
+	}
+	slices.SortFunc(result, func(a, b Topic) int { return cmp.Compare(b.Size, a.Size) })
+
+	// Quotes are the messages closest to everything the user talks about that
+	// weren't already picked as topic examples
+	overall := embeddings.Centroids(vectors, make([]int, len(vectors)), 1)[0]
+	rest := lo.Filter(lo.Range(len(stored)), func(i int, _ int) bool {
+		return !used[stored[i].MessageID]
+	})
+	quotes := nearest(stored, vectors, rest, overall, profileQuotes)
+
+	return result, quotes
+}
+
+func nearest(
+	stored []*ent.DiscordMessageEmbedding,
+	vectors [][]float32,
+	candidates []int,
+	target []float32,
+	n int,
+) []*ent.DiscordMessage {
+	slices.SortFunc(candidates, func(a, b int) int {
+		return cmp.Compare(
+			embeddings.Cosine(vectors[b], target),
+			embeddings.Cosine(vectors[a], target),
+		)
+	})
+
+	return lo.Map(candidates[:min(len(candidates), n)], func(i int, _ int) *ent.DiscordMessage {
+		return stored[i].Edges.Message
+	})
+}
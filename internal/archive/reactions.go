@@ -0,0 +1,122 @@
+package archive
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordreaction"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+)
+
+type TopReactedParams struct {
+	GuildID   string
+	ChannelID string
+	AuthorIDs []string
+	// Since limits the search to messages sent after it. Zero means all time.
+	Since time.Time
+	Limit int
+}
+
+type ReactedMessage struct {
+	Message *ent.DiscordMessage
+	Total   int
+	Emojis  []EmojiCount
+}
+
+type EmojiCount struct {
+	Emoji string
+	Count int
+}
+
+type reactionCount struct {
+	MessageID string `json:"message_id"`
+	Count     int    `json:"count"`
+}
+
+// TopReactedMessages returns the messages with the most reactions, most
+// reacted first.
+func TopReactedMessages(
+	ctx context.Context,
+	entClient *ent.Client,
+	params TopReactedParams,
+) ([]ReactedMessage, error) {
+	preds := []predicate.DiscordMessage{visible(ctx)}
+	if params.GuildID != "" {
+		preds = append(preds, discordmessage.GuildID(params.GuildID))
+	}
+	if params.ChannelID != "" {
+		preds = append(preds, discordmessage.ChannelID(params.ChannelID))
+	}
+	if len(params.AuthorIDs) > 0 {
+		preds = append(preds, discordmessage.AuthorIDIn(params.AuthorIDs...))
+	}
+	if !params.Since.IsZero() {
+		preds = append(preds, discordmessage.TimestampGTE(params.Since))
+	}
+
+	var counts []reactionCount
+	err := entClient.DiscordReaction.Query().
+		Where(discordreaction.HasMessageWith(preds...)).
+		GroupBy(discordreaction.FieldMessageID).
+		Aggregate(func(s *sql.Selector) string {
+			// Rank in the database, guilds have way more reacted messages
+			// than anyone asks for
+			s.OrderBy(sql.Desc(sql.Count("*")), s.C(discordreaction.FieldMessageID)).
+				Limit(params.Limit)
+			return sql.As(sql.Count("*"), "count")
+		}).
+		Scan(ctx, &counts)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := entClient.DiscordMessage.Query().
+		Where(discordmessage.IDIn(lo.Map(counts, func(c reactionCount, _ int) string {
+			return c.MessageID
+		})...)).
+		WithUser().
+		WithReactions().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := lo.KeyBy(messages, func(m *ent.DiscordMessage) string { return m.ID })
+
+	result := make([]ReactedMessage, 0, len(counts))
+	for _, c := range counts {
+		m, ok := byID[c.MessageID]
+		if !ok {
+			continue
+		}
+		result = append(result, ReactedMessage{
+			Message: m,
+			Total:   c.Count,
+			Emojis:  CountEmojis(m.Edges.Reactions),
+		})
+	}
+
+	return result, nil
+}
+
+// CountEmojis tallies reactions per emoji, most used first.
+func CountEmojis(reactions []*ent.DiscordReaction) []EmojiCount {
+	counts := lo.CountValuesBy(reactions, func(r *ent.DiscordReaction) string {
+		return r.Emoji
+	})
+
+	emojis := lo.MapToSlice(counts, func(emoji string, count int) EmojiCount {
+		return EmojiCount{Emoji: emoji, Count: count}
+	})
+	slices.SortFunc(emojis, func(a, b EmojiCount) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Emoji, b.Emoji))
+	})
+
+	return emojis
+}
@@ -0,0 +1,260 @@
+package archive
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordmessage"
+
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+const (
+	// rrfK dampens the weight of the top ranks in reciprocal rank fusion
+	rrfK = 60
+	// hybridCandidates is how many results every retriever contributes to a
+	// hybrid search before fusion
+	hybridCandidates = 50
+)
+
+// searchDDL sets up what Ent can't express: a generated tsvector column over
+// the message content and its GIN index. The "simple" configuration keeps
+// every word as is, so usernames and inside jokes match literally.
+var searchDDL = []string{
+	`ALTER TABLE discord_messages ADD COLUMN IF NOT EXISTS content_tsv tsvector
+		GENERATED ALWAYS AS (to_tsvector('simple', content)) STORED`,
+	`CREATE INDEX IF NOT EXISTS discordmessage_content_tsv
+		ON discord_messages USING GIN (content_tsv)`,
+}
+
+// Migrate creates the full-text search column and index. It has to run after
+// the Ent auto migration.
+func Migrate(ctx context.Context, entClient *ent.Client) error {
+	for _, stmt := range searchDDL {
+		if _, err := entClient.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type SearchParams struct {
+	GuildID   string
+	ChannelID string
+	AuthorIDs []string
+	Before    time.Time
+	After     time.Time
+	Limit     int
+}
+
+type SearchResult struct {
+	Message *ent.DiscordMessage
+	// Snippet is the matching part of the message with the matched words in
+	// bold. It is only set by keyword searches.
+	Snippet string
+	Score   float64
+}
+
+// where renders the filters of params as SQL conditions on the messages
+// table aliased as m, appending their arguments to args.
+func (p SearchParams) where(ctx context.Context, args []any) (string, []any) {
+	conds := []string{optedOutSQL}
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if p.GuildID != "" {
+		add("m.guild_id = $%d", p.GuildID)
+	}
+	if p.ChannelID != "" {
+		add("m.channel_id = $%d", p.ChannelID)
+	}
+	if len(p.AuthorIDs) > 0 {
+		add("m.author_id = ANY($%d)", p.AuthorIDs)
+	}
+	if !p.Before.IsZero() {
+		add("m.timestamp < $%d", p.Before)
+	}
+	if !p.After.IsZero() {
+		add("m.timestamp > $%d", p.After)
+	}
+	hiddenSQL(ctx, add)
+
+	return strings.Join(conds, " AND "), args
+}
+
+// KeywordSearch runs a full-text search over the messages. The query uses the
+// web search syntax: "quoted phrases", OR and -excluded words.
+func KeywordSearch(
+	ctx context.Context,
+	entClient *ent.Client,
+	query string,
+	params SearchParams,
+) ([]SearchResult, error) {
+	where, args := params.where(ctx, []any{query})
+	args = append(args, params.Limit)
+
+	rows, err := entClient.QueryContext(ctx, fmt.Sprintf(`
+		SELECT m.id,
+			ts_rank_cd(m.content_tsv, q) AS rank,
+			ts_headline('simple', m.content, q,
+				'StartSel=**, StopSel=**, MaxWords=30, MinWords=10, MaxFragments=2')
+		FROM discord_messages m, websearch_to_tsquery('simple', $1) q
+		WHERE m.content_tsv @@ q AND %s
+		ORDER BY rank DESC, m.timestamp DESC
+		LIMIT $%d`, where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var (
+			id     string
+			result SearchResult
+		)
+		if err := rows.Scan(&id, &result.Score, &result.Snippet); err != nil {
+			return nil, err
+		}
+		result.Message = &ent.DiscordMessage{ID: id}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return loadResults(ctx, entClient, results)
+}
+
+// SemanticSearch returns the messages closest to vector, which has to come
+// from the embedding model named model. The vectors are compared cast to the
+// dimension of the model, which is what the per model HNSW index is on.
+func SemanticSearch(
+	ctx context.Context,
+	entClient *ent.Client,
+	vector []float32,
+	model string,
+	params SearchParams,
+) ([]SearchResult, error) {
+	where, args := params.where(ctx, []any{pgvector.NewVector(vector), model})
+	args = append(args, params.Limit)
+
+	rows, err := entClient.QueryContext(ctx, fmt.Sprintf(`
+		SELECT e.message_id, 1 - (e.embedding::vector(%[1]d) <=> $1::vector(%[1]d)) AS similarity
+		FROM discord_message_embeddings e
+		JOIN discord_messages m ON m.id = e.message_id
+		WHERE e.model = $2 AND %[2]s
+		ORDER BY e.embedding::vector(%[1]d) <=> $1::vector(%[1]d)
+		LIMIT $%[3]d`, len(vector), where, len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var (
+			id     string
+			result SearchResult
+		)
+		if err := rows.Scan(&id, &result.Score); err != nil {
+			return nil, err
+		}
+		result.Message = &ent.DiscordMessage{ID: id}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return loadResults(ctx, entClient, results)
+}
+
+// HybridSearch combines the keyword and semantic searches with reciprocal
+// rank fusion, so messages ranked well by both come first.
+func HybridSearch(
+	ctx context.Context,
+	entClient *ent.Client,
+	query string,
+	vector []float32,
+	model string,
+	params SearchParams,
+) ([]SearchResult, error) {
+	limit := params.Limit
+	params.Limit = max(limit, hybridCandidates)
+
+	keyword, err := KeywordSearch(ctx, entClient, query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	semantic, err := SemanticSearch(ctx, entClient, vector, model, params)
+	if err != nil {
+		return nil, err
+	}
+
+	fused := Fuse(keyword, semantic)
+
+	return fused[:min(len(fused), limit)], nil
+}
+
+// Fuse merges ranked result lists with reciprocal rank fusion. Snippets are
+// kept from the first list that has one.
+func Fuse(lists ...[]SearchResult) []SearchResult {
+	byID := make(map[string]*SearchResult)
+	var order []string
+	for _, list := range lists {
+		for rank, r := range list {
+			fused, ok := byID[r.Message.ID]
+			if !ok {
+				fused = &SearchResult{Message: r.Message}
+				byID[r.Message.ID] = fused
+				order = append(order, r.Message.ID)
+			}
+			if fused.Snippet == "" {
+				fused.Snippet = r.Snippet
+			}
+			fused.Score += 1 / float64(rrfK+rank+1)
+		}
+	}
+
+	results := lo.Map(order, func(id string, _ int) SearchResult { return *byID[id] })
+	slices.SortStableFunc(results, func(a, b SearchResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	return results
+}
+
+// loadResults replaces the placeholder messages of results with the stored
+// ones, keeping the order.
+func loadResults(
+	ctx context.Context,
+	entClient *ent.Client,
+	results []SearchResult,
+) ([]SearchResult, error) {
+	ids := lo.Map(results, func(r SearchResult, _ int) string { return r.Message.ID })
+
+	messages, err := WithDetails(entClient.DiscordMessage.Query()).
+		Where(discordmessage.IDIn(ids...), visible(ctx)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byID := lo.KeyBy(messages, func(m *ent.DiscordMessage) string { return m.ID })
+
+	return lo.FilterMap(results, func(r SearchResult, _ int) (SearchResult, bool) {
+		m, ok := byID[r.Message.ID]
+		r.Message = m
+		return r, ok
+	}), nil
+}
@@ -0,0 +1,96 @@
+package archive
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"sev0/ent"
+)
+
+func result(id, snippet string) SearchResult {
+	return SearchResult{Message: &ent.DiscordMessage{ID: id}, Snippet: snippet}
+}
+
+func TestFuse(t *testing.T) {
+	rrf := func(ranks ...int) float64 {
+		score := 0.0
+		for _, rank := range ranks {
+			score += 1 / float64(rrfK+rank+1)
+		}
+		return score
+	}
+
+	tests := []struct {
+		name     string
+		lists    [][]SearchResult
+		ids      []string
+		scores   []float64
+		snippets []string
+	}{
+		{
+			name:  "no lists",
+			lists: nil,
+		},
+		{
+			name:     "single list keeps its order",
+			lists:    [][]SearchResult{{result("a", ""), result("b", ""), result("c", "")}},
+			ids:      []string{"a", "b", "c"},
+			scores:   []float64{rrf(0), rrf(1), rrf(2)},
+			snippets: []string{"", "", ""},
+		},
+		{
+			name: "found by both beats found by one",
+			lists: [][]SearchResult{
+				{result("a", ""), result("b", "")},
+				{result("c", ""), result("b", "")},
+			},
+			ids:      []string{"b", "a", "c"},
+			scores:   []float64{rrf(1, 1), rrf(0), rrf(0)},
+			snippets: []string{"", "", ""},
+		},
+		{
+			name: "ties keep first seen order",
+			lists: [][]SearchResult{
+				{result("a", "")},
+				{result("b", "")},
+			},
+			ids:      []string{"a", "b"},
+			scores:   []float64{rrf(0), rrf(0)},
+			snippets: []string{"", ""},
+		},
+		{
+			name: "snippet from the first list that has one",
+			lists: [][]SearchResult{
+				{result("a", "")},
+				{result("a", "**second**")},
+				{result("a", "**third**")},
+			},
+			ids:      []string{"a"},
+			scores:   []float64{rrf(0, 0, 0)},
+			snippets: []string{"**second**"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fuse(tt.lists...)
+
+			ids := make([]string, len(got))
+			for i, r := range got {
+				ids[i] = r.Message.ID
+			}
+			if !slices.Equal(ids, tt.ids) {
+				t.Fatalf("ids = %v, want %v", ids, tt.ids)
+			}
+			for i, r := range got {
+				if math.Abs(r.Score-tt.scores[i]) > 1e-12 {
+					t.Errorf("score of %s = %v, want %v", r.Message.ID, r.Score, tt.scores[i])
+				}
+				if r.Snippet != tt.snippets[i] {
+					t.Errorf("snippet of %s = %q, want %q", r.Message.ID, r.Snippet, tt.snippets[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package archive
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"sev0/ent"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discorduser"
+	"sev0/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+var (
+	userMentionRe = regexp.MustCompile(`^<@!?(\d+)>$`)
+	snowflakeRe   = regexp.MustCompile(`^\d{15,20}$`)
+)
+
+const maxResolvedUsers = 5
+
+// ResolveUser finds the users that go, or went, by the given name. The name
+// can be a mention, a user ID, a username, a display name or a nickname in
+// the guild. Exact matches win over partial ones.
+func ResolveUser(
+	ctx context.Context,
+	entClient *ent.Client,
+	guildID string,
+	name string,
+) ([]*ent.DiscordUser, error) {
+	name = strings.TrimSpace(name)
+	if match := userMentionRe.FindStringSubmatch(name); match != nil {
+		name = match[1]
+	}
+
+	if snowflakeRe.MatchString(name) {
+		users, err := userQuery(entClient, guildID).
+			Where(discorduser.ID(name)).
+			All(ctx)
+		if err != nil || len(users) > 0 {
+			return users, err
+		}
+	}
+
+	name = strings.TrimPrefix(name, "@")
+	if name == "" {
+		return nil, nil
+	}
+
+	users, err := userQuery(entClient, guildID).
+		Where(namedPredicate(guildID, name, true)).
+		Limit(maxResolvedUsers).
+		All(ctx)
+	if err != nil || len(users) > 0 {
+		return users, err
+	}
+
+	return userQuery(entClient, guildID).
+		Where(namedPredicate(guildID, name, false)).
+		Limit(maxResolvedUsers).
+		All(ctx)
+}
+
+func userQuery(entClient *ent.Client, guildID string) *ent.DiscordUserQuery {
+	return entClient.DiscordUser.Query().
+		Where(userNotOptedOut()).
+		WithMemberships(func(q *ent.DiscordGuildMemberQuery) {
+			if guildID != "" {
+				q.Where(discordguildmember.GuildID(guildID))
+			}
+		}).
+		WithProfileChanges(func(q *ent.DiscordProfileChangeQuery) {
+			q.Where(discordprofilechange.KindNEQ(discordprofilechange.KindAvatar)).
+				Order(discordprofilechange.ByChangedAt(sql.OrderAsc()))
+		})
+}
+
+func namedPredicate(guildID, name string, exact bool) predicate.DiscordUser {
+	username := discorduser.UsernameContainsFold(name)
+	globalName := discorduser.GlobalNameContainsFold(name)
+	nickname := discordguildmember.NicknameContainsFold(name)
+	pastName := discordprofilechange.ValueContainsFold(name)
+	if exact {
+		username = discorduser.UsernameEqualFold(name)
+		globalName = discorduser.GlobalNameEqualFold(name)
+		nickname = discordguildmember.NicknameEqualFold(name)
+		pastName = discordprofilechange.ValueEqualFold(name)
+	}
+
+	memberPreds := []predicate.DiscordGuildMember{nickname}
+	if guildID != "" {
+		memberPreds = append(memberPreds, discordguildmember.GuildID(guildID))
+	}
+
+	return discorduser.Or(
+		username,
+		globalName,
+		discorduser.HasMembershipsWith(memberPreds...),
+		discorduser.HasProfileChangesWith(
+			discordprofilechange.KindNEQ(discordprofilechange.KindAvatar),
+			pastName,
+		),
+	)
+}
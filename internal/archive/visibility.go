@@ -0,0 +1,134 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"sev0/ent"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordmessage"
+	"sev0/ent/predicate"
+	"sev0/internal/contextkeys"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// hidden is what the archive must not surface for a request.
+type hidden struct {
+	all        bool
+	channelIDs []string
+}
+
+// WithHiddenChannels keeps the archive from surfacing the messages of the
+// given channels for the rest of the request, whatever asks for them.
+func WithHiddenChannels(ctx context.Context, channelIDs []string) context.Context {
+	return context.WithValue(ctx, contextkeys.HiddenChannelsKey, hidden{channelIDs: channelIDs})
+}
+
+// WithEverythingHidden keeps the archive from surfacing any message for the
+// rest of the request, for when the hidden channels can't be worked out.
+func WithEverythingHidden(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextkeys.HiddenChannelsKey, hidden{all: true})
+}
+
+func hiddenChannels(ctx context.Context) hidden {
+	h, _ := ctx.Value(contextkeys.HiddenChannelsKey).(hidden)
+	return h
+}
+
+// visible leaves out the messages the request must not see: those of users
+// that opted out and those of hidden channels.
+func visible(ctx context.Context) predicate.DiscordMessage {
+	h := hiddenChannels(ctx)
+	switch {
+	case h.all:
+		return predicate.DiscordMessage(func(s *sql.Selector) {
+			s.Where(sql.False())
+		})
+	case len(h.channelIDs) == 0:
+		return notOptedOut()
+	}
+
+	return discordmessage.And(
+		notOptedOut(),
+		discordmessage.ChannelIDNotIn(h.channelIDs...),
+	)
+}
+
+// ChannelPaths maps the archived channels of a guild to their path, the
+// channel itself followed by its parents: the category of a channel, or the
+// channel and category of a thread. Channels that have messages but were
+// never stored themselves only have their own ID in their path.
+func ChannelPaths(
+	ctx context.Context,
+	entClient *ent.Client,
+	guildID string,
+) (map[string][]string, error) {
+	channels, err := entClient.DiscordChannel.Query().
+		Where(discordchannel.GuildID(guildID)).
+		Select(discordchannel.FieldID, discordchannel.FieldParentID).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	messageChannelIDs, err := entClient.DiscordMessage.Query().
+		Where(
+			discordmessage.GuildID(guildID),
+			discordmessage.ChannelIDNotNil(),
+			discordmessage.ChannelIDNEQ(""),
+		).
+		Unique(true).
+		Select(discordmessage.FieldChannelID).
+		Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	parents := make(map[string]string, len(channels))
+	for _, c := range channels {
+		parents[c.ID] = c.ParentID
+	}
+
+	paths := make(map[string][]string, len(channels))
+	for _, c := range channels {
+		path := []string{c.ID}
+		// Threads are two levels deep at most, the loop guard is for bad data
+		for parent := parents[c.ID]; parent != "" && len(path) < 3; parent = parents[parent] {
+			path = append(path, parent)
+		}
+		paths[c.ID] = path
+	}
+	for _, id := range messageChannelIDs {
+		if _, ok := paths[id]; !ok {
+			paths[id] = []string{id}
+		}
+	}
+
+	return paths, nil
+}
+
+// hiddenSQL is visible for the raw search queries, over the message alias m.
+func hiddenSQL(ctx context.Context, add func(cond string, arg any)) {
+	h := hiddenChannels(ctx)
+	switch {
+	case h.all:
+		// Matches nothing
+		add("m.channel_id = ANY($%d)", []string{})
+	case len(h.channelIDs) > 0:
+		add("NOT (m.channel_id = ANY($%d))", h.channelIDs)
+	}
+}
+
+// visibleSQL is visible for the raw search queries, over the message alias m,
+// appending its arguments to args.
+func visibleSQL(ctx context.Context, args []any) (string, []any) {
+	conds := []string{optedOutSQL}
+	hiddenSQL(ctx, func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	})
+
+	return strings.Join(conds, " AND "), args
+}
@@ -0,0 +1,76 @@
+package archive
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestHiddenSQL(t *testing.T) {
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		conds []string
+		args  []any
+	}{
+		{
+			name: "nothing hidden",
+			ctx:  context.Background(),
+		},
+		{
+			name: "no channels hidden",
+			ctx:  WithHiddenChannels(context.Background(), nil),
+		},
+		{
+			name:  "hidden channels",
+			ctx:   WithHiddenChannels(context.Background(), []string{"1", "2"}),
+			conds: []string{"NOT (m.channel_id = ANY($1))"},
+			args:  []any{[]string{"1", "2"}},
+		},
+		{
+			name:  "everything hidden",
+			ctx:   WithEverythingHidden(context.Background()),
+			conds: []string{"m.channel_id = ANY($1)"},
+			args:  []any{[]string{}},
+		},
+		{
+			name:  "everything hidden wins over hidden channels",
+			ctx:   WithEverythingHidden(WithHiddenChannels(context.Background(), []string{"1"})),
+			conds: []string{"m.channel_id = ANY($1)"},
+			args:  []any{[]string{}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conds []string
+			var args []any
+			hiddenSQL(tt.ctx, func(cond string, arg any) {
+				args = append(args, arg)
+				conds = append(conds, fmt.Sprintf(cond, len(args)))
+			})
+
+			if !reflect.DeepEqual(conds, tt.conds) {
+				t.Errorf("conds = %q, want %q", conds, tt.conds)
+			}
+			if !reflect.DeepEqual(args, tt.args) {
+				t.Errorf("args = %v, want %v", args, tt.args)
+			}
+		})
+	}
+}
+
+func TestVisibleSQL(t *testing.T) {
+	ctx := WithHiddenChannels(context.Background(), []string{"1"})
+
+	where, args := visibleSQL(ctx, []any{"vector", "model"})
+
+	want := optedOutSQL + " AND NOT (m.channel_id = ANY($3))"
+	if where != want {
+		t.Errorf("where = %q, want %q", where, want)
+	}
+	if !reflect.DeepEqual(args, []any{"vector", "model", []string{"1"}}) {
+		t.Errorf("args = %v, want the hidden channels after the given ones", args)
+	}
+}
@@ -2,4 +2,11 @@ package contextkeys
 
 type contextKey string
 
-const UserIDKey = contextKey("userID")
+const (
+	UserIDKey  = contextKey("userID")
+	GuildIDKey = contextKey("guildID")
+	SourcesKey = contextKey("sources")
+	// HiddenChannelsKey holds the channels the archive must not surface for
+	// the request, see archive.WithHiddenChannels
+	HiddenChannelsKey = contextKey("hiddenChannels")
+)
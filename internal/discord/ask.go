@@ -0,0 +1,222 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"sev0/internal/archive"
+	"sev0/internal/genkitmagic"
+	"sev0/internal/genkitmagic/tools"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+	"github.com/samber/lo"
+)
+
+// maxFootnotes is how many characters of an answer the footnotes can take
+const maxFootnotes = 1000
+
+type askAnswer struct {
+	Answer      string   `json:"answer"                 jsonschema_description:"The answer in Discord markdown. Back every claim about what someone said or did with a [number] marker, where [1] is the first citation"`
+	Citations   []string `json:"citations"              jsonschema_description:"IDs of the messages the [number] markers refer to, in order. Only IDs of messages the tools returned"`
+	Reaction    string   `json:"reaction,omitempty"     jsonschema_description:"A single unicode emoji to react to your answer with, only when one really fits"`
+	ImagePrompt string   `json:"image_prompt,omitempty" jsonschema_description:"A prompt for a picture that goes with the answer, only when a picture would really add to it"`
+	Confidence  string   `json:"confidence"             jsonschema_description:"high when the messages settle the question, low when the answer is partly a guess" jsonschema:"enum=high,enum=low"`
+}
+
+func (a *askAnswer) Validate() error {
+	if strings.TrimSpace(a.Answer) == "" {
+		return errors.New("empty answer")
+	}
+	if a.Confidence != "high" && a.Confidence != "low" {
+		return fmt.Errorf("unknown confidence %q", a.Confidence)
+	}
+
+	return nil
+}
+
+// validReaction tells whether the model picked something Discord can react
+// with. Shortcodes and custom emojis the bot may not have access to are out.
+func validReaction(emoji string) bool {
+	return emoji != "" && len(emoji) <= 32 && !strings.ContainsFunc(emoji, func(r rune) bool {
+		return r < 0x80
+	})
+}
+
+func (b *DiscordBot) handleAsk(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "ask",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName),
+	})
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	options := i.ApplicationCommandData().Options
+	var question string
+	for _, opt := range options {
+		if opt.Name == "question" {
+			question = opt.StringValue()
+			break
+		}
+	}
+
+	b.logger.Info("Handling ask command", "question", question)
+
+	ctx, sources := tools.WithSources(ctx)
+	answer, model, err := genkitmagic.GenerateData[askAnswer](
+		ctx,
+		b.gm,
+		ai.WithPrompt(question),
+		ai.WithTools(b.gm.Tools()...),
+		ai.WithSystem(b.systemPrompt(
+			"You have access to a searchable database of all past messages from this server — use it to recall context, patterns, and memorable moments when replying. Please do not ask any follow up questions, just answer to the best of your ability with the information you have. When you bring up what someone said or did, cite the messages it comes from so people can check.",
+		)),
+	)
+
+	if err != nil {
+		b.logger.Error("failed to generate text", "err", err)
+		content := "I'm sorry, I encountered an error and couldn't process your question."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	answer.Answer = b.moderate(ctx, "ask", i.GuildID, i.ChannelID, invokingUser(i).ID, answer.Answer)
+	if answer.Answer == "" {
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: lo.ToPtr(blockedReply)})
+		return
+	}
+	if answer.ImagePrompt != "" {
+		answer.ImagePrompt = b.moderate(ctx, "ask", i.GuildID, i.ChannelID, invokingUser(i).ID, answer.ImagePrompt)
+	}
+
+	text, footnotes, cited, dropped := renderCitations(answer, sources)
+	if dropped > 0 {
+		b.logger.Warn("answer cited messages the tools never returned", "dropped", dropped)
+	}
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "ask_answered",
+		Properties: posthog.NewProperties().
+			Set("model", model).
+			Set("citations", cited).
+			Set("dropped_citations", dropped).
+			Set("confidence", answer.Confidence),
+	})
+
+	var note string
+	if answer.Confidence == "low" {
+		note = "\n-# 🤷 Partly a guess, the archive didn't quite settle this"
+	}
+	content := truncate(text, 2000-len(note)-len(footnotes)) + note + footnotes
+
+	embeds := []*discordgo.MessageEmbed{}
+	if answer.ImagePrompt != "" {
+		embeds = append(embeds, &discordgo.MessageEmbed{
+			Title:       "🎨 Picture this",
+			Description: truncate(answer.ImagePrompt, 4096),
+			Color:       0x9B59B6,
+		})
+	}
+
+	msg, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+		Embeds:  &embeds,
+	})
+	if err != nil {
+		b.logger.Error("failed to edit interaction response", "err", err)
+		return
+	}
+
+	if validReaction(answer.Reaction) {
+		if err := s.MessageReactionAdd(msg.ChannelID, msg.ID, answer.Reaction); err != nil {
+			b.logger.Warn("failed to react to answer", "emoji", answer.Reaction, "err", err)
+		}
+	}
+}
+
+// renderCitations checks the citations of an answer against the messages the
+// tools returned and renumbers the ones that hold up. It returns the text with
+// the markers linked, the footnotes that go under it and the number of kept
+// and dropped citations. Markers of made up citations are removed.
+func renderCitations(
+	answer *askAnswer,
+	sources *tools.Sources,
+) (string, string, int, int) {
+	var (
+		footnotes strings.Builder
+		numbers   = map[int]int{}
+		seen      = map[string]int{}
+		urls      []string
+		dropped   int
+	)
+	for n, id := range answer.Citations {
+		m := sources.Get(id)
+		if m == nil {
+			dropped++
+			continue
+		}
+		if prev, ok := seen[id]; ok {
+			numbers[n+1] = prev
+			continue
+		}
+
+		url := archive.JumpURL(m)
+		urls = append(urls, url)
+		seen[id] = len(urls)
+		numbers[n+1] = len(urls)
+
+		label := m.Timestamp.UTC().Format("Jan 2 2006")
+		if m.Edges.User != nil {
+			label = m.Edges.User.GlobalName + ", " + label
+		}
+		if url != "" {
+			label = "[" + label + "](<" + url + ">)"
+		}
+		// The inline markers link to the messages too, footnotes that don't
+		// fit are left out
+		line := fmt.Sprintf("\n-# [%d] %s", len(urls), label)
+		if footnotes.Len()+len(line) <= maxFootnotes {
+			footnotes.WriteString(line)
+		}
+	}
+
+	text := citationRe.ReplaceAllStringFunc(answer.Answer, func(ref string) string {
+		n, _ := strconv.Atoi(citationRe.FindStringSubmatch(ref)[1])
+		number, ok := numbers[n]
+		if !ok {
+			return ""
+		}
+		ref = "[" + strconv.Itoa(number) + "]"
+		if url := urls[number-1]; url != "" {
+			return "[" + ref + "](<" + url + ">)"
+		}
+
+		return ref
+	})
+
+	if footnotes.Len() > 0 {
+		return text, "\n" + footnotes.String(), len(urls), dropped
+	}
+
+	return text, "", len(urls), dropped
+}
@@ -0,0 +1,132 @@
+package discord
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/genkitmagic/tools"
+)
+
+var citedAt = time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+
+// citedMessage is an archived message by the named user, or by nobody known
+// when the name is empty. Messages without a guild have no jump URL.
+func citedMessage(id, name string, guild bool) *ent.DiscordMessage {
+	m := &ent.DiscordMessage{ID: id, ChannelID: "c", Timestamp: citedAt}
+	if guild {
+		m.GuildID = "g"
+	}
+	if name != "" {
+		m.Edges.User = &ent.DiscordUser{GlobalName: name}
+	}
+	return m
+}
+
+func TestRenderCitations(t *testing.T) {
+	url := func(id string) string { return "https://discord.com/channels/g/c/" + id }
+
+	// Each footnote of these takes exactly 200 characters, so five of them
+	// fill the budget
+	long := strings.Repeat("n", 139)
+	var budget []*ent.DiscordMessage
+	var budgetFootnotes strings.Builder
+	budgetFootnotes.WriteString("\n")
+	for n := 1; n <= 6; n++ {
+		id := fmt.Sprint(10 + n)
+		budget = append(budget, citedMessage(id, long, true))
+		if n <= 5 {
+			fmt.Fprintf(&budgetFootnotes, "\n-# [%d] [%s, Jan 2 2025](<%s>)", n, long, url(id))
+		}
+	}
+
+	sources := []*ent.DiscordMessage{
+		citedMessage("a", "Alice", true),
+		citedMessage("b", "Bob", true),
+		citedMessage("d", "", false),
+	}
+
+	tests := []struct {
+		name      string
+		answer    askAnswer
+		text      string
+		footnotes string
+		cited     int
+		dropped   int
+	}{
+		{
+			name:   "no citations",
+			answer: askAnswer{Answer: "nobody said anything"},
+			text:   "nobody said anything",
+		},
+		{
+			name:      "markers are linked",
+			answer:    askAnswer{Answer: "Alice did [1], Bob did [2]", Citations: []string{"a", "b"}},
+			text:      "Alice did [[1]](<" + url("a") + ">), Bob did [[2]](<" + url("b") + ">)",
+			footnotes: "\n\n-# [1] [Alice, Jan 2 2025](<" + url("a") + ">)\n-# [2] [Bob, Jan 2 2025](<" + url("b") + ">)",
+			cited:     2,
+		},
+		{
+			name:      "made up citations are dropped and the rest renumbered",
+			answer:    askAnswer{Answer: "made up [1], Bob did [2]", Citations: []string{"x", "b"}},
+			text:      "made up , Bob did [[1]](<" + url("b") + ">)",
+			footnotes: "\n\n-# [1] [Bob, Jan 2 2025](<" + url("b") + ">)",
+			cited:     1,
+			dropped:   1,
+		},
+		{
+			name:      "duplicates share a number",
+			answer:    askAnswer{Answer: "[1] [2] [3]", Citations: []string{"b", "a", "b"}},
+			text:      "[[1]](<" + url("b") + ">) [[2]](<" + url("a") + ">) [[1]](<" + url("b") + ">)",
+			footnotes: "\n\n-# [1] [Bob, Jan 2 2025](<" + url("b") + ">)\n-# [2] [Alice, Jan 2 2025](<" + url("a") + ">)",
+			cited:     2,
+		},
+		{
+			name:   "markers past the citations are removed",
+			answer: askAnswer{Answer: "who knows [4]", Citations: []string{}},
+			text:   "who knows ",
+		},
+		{
+			name:      "messages without a URL aren't linked",
+			answer:    askAnswer{Answer: "someone did [1]", Citations: []string{"d"}},
+			text:      "someone did [1]",
+			footnotes: "\n\n-# [1] Jan 2 2025",
+			cited:     1,
+		},
+		{
+			name: "footnotes over the budget are left out but still linked",
+			answer: askAnswer{
+				Answer:    "[1][2][3][4][5][6]",
+				Citations: []string{"11", "12", "13", "14", "15", "16"},
+			},
+			text: "[[1]](<" + url("11") + ">)[[2]](<" + url("12") + ">)[[3]](<" + url("13") + ">)" +
+				"[[4]](<" + url("14") + ">)[[5]](<" + url("15") + ">)[[6]](<" + url("16") + ">)",
+			footnotes: budgetFootnotes.String(),
+			cited:     6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, s := tools.WithSources(t.Context())
+			s.Add(sources...)
+			s.Add(budget...)
+
+			text, footnotes, cited, dropped := renderCitations(&tt.answer, s)
+			if text != tt.text {
+				t.Errorf("text = %q, want %q", text, tt.text)
+			}
+			if footnotes != tt.footnotes {
+				t.Errorf("footnotes = %q, want %q", footnotes, tt.footnotes)
+			}
+			if cited != tt.cited || dropped != tt.dropped {
+				t.Errorf("cited, dropped = %d, %d, want %d, %d", cited, dropped, tt.cited, tt.dropped)
+			}
+			if len(footnotes) > maxFootnotes+1 {
+				t.Errorf("footnotes take %d characters, over the budget of %d", len(footnotes), maxFootnotes)
+			}
+		})
+	}
+}
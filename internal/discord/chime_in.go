@@ -0,0 +1,327 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchimein"
+	"sev0/ent/discordguildsetting"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+	"sev0/internal/genkitmagic"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+)
+
+// chimeInContext is how many messages the classifier and the reply get to see.
+const chimeInContext = 20
+
+type chimeInScore struct {
+	Score  float64 `json:"score"  jsonschema_description:"How much a reply from you would add to the conversation right now, from 0 to 1"`
+	Reason string  `json:"reason" jsonschema_description:"Why, in a few words"`
+}
+
+// maybeChimeIn lets the bot join a conversation unprompted. Only a random
+// share of the messages get looked at, then a classifier decides whether the
+// conversation is worth a reply.
+func (b *DiscordBot) maybeChimeIn(
+	s *discordgo.Session,
+	m *discordgo.Message,
+) {
+	if m.Author == nil || m.Author.Bot || m.Content == "" {
+		return
+	}
+
+	ctx := context.WithValue(
+		context.Background(),
+		contextkeys.UserIDKey,
+		m.Author.ID,
+	)
+	ctx = context.WithValue(ctx, contextkeys.GuildIDKey, m.GuildID)
+	ctx, cancel := context.WithTimeout(ctx, 45*time.Second)
+	defer cancel()
+	// Nobody asked for a chime-in, only the readers of the channel count
+	ctx = b.withVisibility(ctx, s, m.GuildID, "", m.ChannelID)
+
+	guild, err := b.guildSettings(ctx, m.GuildID)
+	if err != nil {
+		b.logger.Error("failed to get guild settings", "err", err)
+		return
+	}
+	if !guild.ChimeIn {
+		return
+	}
+
+	channel, err := b.channelSettings(ctx, m.ChannelID)
+	if err != nil {
+		b.logger.Error("failed to get channel settings", "err", err)
+		return
+	}
+	if !channel.ChimeIn {
+		return
+	}
+
+	cooldownKey := "channel:" + m.ChannelID
+	if !b.chimeInCooldowns.ready(cooldownKey) || rand.Float64() >= guild.ChimeInProbability {
+		return
+	}
+
+	messages, err := archive.RecentMessages(ctx, b.entClient, archive.RecentParams{
+		GuildID:   m.GuildID,
+		ChannelID: m.ChannelID,
+		Before:    m.Timestamp.Add(time.Millisecond),
+		Limit:     chimeInContext,
+	})
+	if err != nil {
+		b.logger.Error("failed to load chime-in context", "err", err)
+		return
+	}
+
+	var chatLog strings.Builder
+	for _, msg := range messages {
+		chatLog.WriteString(chatLine(msg) + "\n")
+	}
+
+	score, _, err := genkitmagic.GenerateData[chimeInScore](
+		ctx,
+		b.gm,
+		ai.WithPrompt("The latest messages in the channel, oldest first:\n"+chatLog.String()),
+		ai.WithSystem(b.systemPrompt(
+			"You're lurking in a group chat. Rate how much a reply from you would add to the conversation right now: 1 if you have something genuinely funny or useful to say, 0 if you'd just be butting in. Most conversations don't need you.",
+		)),
+	)
+	if err != nil {
+		b.logger.Error("failed to score chime-in", "err", err)
+		return
+	}
+
+	b.logger.Debug("Scored chime-in", "message", m.ID, "score", score.Score, "reason", score.Reason)
+
+	if score.Score < guild.ChimeInThreshold {
+		return
+	}
+	cooldown := time.Duration(guild.ChimeInCooldown) * time.Second
+	if !b.chimeInCooldowns.take(cooldown, cooldownKey) {
+		return
+	}
+
+	stopTyping := b.keepTyping(s, m.ChannelID)
+	defer stopTyping()
+
+	resp, err := b.gm.GenerateText(
+		ctx,
+		ai.WithPrompt("The latest messages in the channel, oldest first:\n"+chatLog.String()),
+		ai.WithTools(b.gm.Tools()...),
+		ai.WithSystem(b.systemPrompt(fmt.Sprintf(
+			"You are <@%s> in this chat and nobody asked you anything, but you decided to chime in on the conversation. Write a single short chat message that fits right in. You have access to a searchable database of all past messages from this server if it helps.",
+			s.State.User.ID,
+		))),
+	)
+	if err != nil {
+		b.logger.Error("failed to generate text", "err", err)
+		return
+	}
+	if resp == "" {
+		return
+	}
+	if resp = b.moderate(ctx, "chime_in", m.GuildID, m.ChannelID, m.Author.ID, resp); resp == "" {
+		return
+	}
+
+	sent, err := s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
+		Content:         truncate(resp, 2000),
+		AllowedMentions: &discordgo.MessageAllowedMentions{},
+	})
+	if err != nil {
+		b.logger.Error("failed to send chime-in", "err", err)
+		return
+	}
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: m.Author.ID,
+		Event:      "chime_in",
+		Properties: posthog.NewProperties().
+			Set("guild_id", m.GuildID).
+			Set("channel_id", m.ChannelID).
+			Set("score", score.Score),
+	})
+
+	err = b.entClient.DiscordChimeIn.Create().
+		SetID(sent.ID).
+		SetGuildID(m.GuildID).
+		SetChannelID(m.ChannelID).
+		SetTriggerMessageID(m.ID).
+		SetScore(score.Score).
+		SetReason(score.Reason).
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to store chime-in", "err", err)
+	}
+}
+
+// countChimeInReaction keeps track of the reactions on the bot's chime-ins,
+// they aren't archived like the messages of the members.
+func (b *DiscordBot) countChimeInReaction(
+	ctx context.Context,
+	messageID string,
+	delta int,
+) {
+	update := b.entClient.DiscordChimeIn.UpdateOneID(messageID).
+		AddReactions(delta)
+	if delta < 0 {
+		// Reactions added while the bot was down were never counted
+		update.Where(discordchimein.ReactionsGTE(-delta))
+	}
+	err := update.Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		b.logger.Error("failed to count chime-in reaction", "err", err)
+	}
+}
+
+func (b *DiscordBot) handleChimeIn(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	sub := i.ApplicationCommandData().Options[0]
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "chime_in_settings",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("action", sub.Name),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var content string
+	var err error
+	switch sub.Name {
+	case "settings":
+		content, err = b.updateChimeInSettings(ctx, i.GuildID, sub.Options)
+	case "stats":
+		content, err = b.chimeInStats(ctx, i.GuildID)
+	}
+	if err != nil {
+		b.logger.Error("failed to handle chime-in command", "action", sub.Name, "err", err)
+		content = "I'm sorry, I encountered an error. Try again in a bit."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
+
+func (b *DiscordBot) updateChimeInSettings(
+	ctx context.Context,
+	guildID string,
+	options []*discordgo.ApplicationCommandInteractionDataOption,
+) (string, error) {
+	if len(options) > 0 {
+		create := b.entClient.DiscordGuildSetting.Create().SetID(guildID)
+		var updates []func(u *ent.DiscordGuildSettingUpsert)
+		for _, opt := range options {
+			switch opt.Name {
+			case "enabled":
+				create.SetChimeIn(opt.BoolValue())
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateChimeIn() })
+			case "probability":
+				create.SetChimeInProbability(float64(opt.IntValue()) / 100)
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateChimeInProbability() })
+			case "threshold":
+				create.SetChimeInThreshold(float64(opt.IntValue()) / 100)
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateChimeInThreshold() })
+			case "cooldown":
+				create.SetChimeInCooldown(int(opt.IntValue()) * 60)
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateChimeInCooldown() })
+			}
+		}
+
+		err := create.
+			OnConflictColumns(discordguildsetting.FieldID).
+			Update(func(u *ent.DiscordGuildSettingUpsert) {
+				for _, update := range updates {
+					update(u)
+				}
+			}).
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	settings, err := b.guildSettings(ctx, guildID)
+	if err != nil {
+		return "", err
+	}
+
+	state := "off"
+	if settings.ChimeIn {
+		state = "on, in the channels that allow it"
+	}
+
+	return fmt.Sprintf(
+		"Chime-in is %s. I look at %.0f%% of the messages, chime in when the conversation scores at least %.0f%%, and at most once every %d minutes per channel.",
+		state,
+		settings.ChimeInProbability*100,
+		settings.ChimeInThreshold*100,
+		settings.ChimeInCooldown/60,
+	), nil
+}
+
+func (b *DiscordBot) chimeInStats(
+	ctx context.Context,
+	guildID string,
+) (string, error) {
+	chimeIns, err := b.entClient.DiscordChimeIn.Query().
+		Where(
+			discordchimein.GuildID(guildID),
+			discordchimein.CreatedAtGT(time.Now().AddDate(0, 0, -30)),
+		).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(chimeIns) == 0 {
+		return "I haven't chimed in anywhere in the last 30 days.", nil
+	}
+
+	var reactions, reacted int
+	perChannel := make(map[string]int)
+	for _, c := range chimeIns {
+		reactions += c.Reactions
+		if c.Reactions > 0 {
+			reacted++
+		}
+		perChannel[c.ChannelID]++
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(
+		&sb,
+		"In the last 30 days I chimed in %d times, %d of them got reactions (%d reactions in total).\n",
+		len(chimeIns),
+		reacted,
+		reactions,
+	)
+	for channelID, count := range perChannel {
+		fmt.Fprintf(&sb, "- <#%s>: %d\n", channelID, count)
+	}
+
+	return sb.String(), nil
+}
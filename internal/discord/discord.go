@@ -9,29 +9,35 @@ import (
 
 	"sev0/ent"
 	"sev0/ent/discordmessage"
-	"sev0/ent/discorduser"
-	"sev0/internal/contextkeys"
+	"sev0/internal/archive"
+	"sev0/internal/embeddings"
 	"sev0/internal/genkitmagic"
+	"sev0/internal/moderation"
 
 	"github.com/bwmarrin/discordgo"
-	"github.com/firebase/genkit/go/ai"
-	"github.com/firebase/genkit/go/genkit"
 	"github.com/posthog/posthog-go"
+	"github.com/samber/lo"
 )
 
 type DiscordBot struct {
-	session         *discordgo.Session
-	entClient       *ent.Client
-	embedder        ai.Embedder
-	gm              genkitmagic.GenkitMagic
-	phc             posthog.Client
-	logger          *slog.Logger
-	commandHandlers map[string]func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate)
+	session    *discordgo.Session
+	entClient  *ent.Client
+	embeddings *embeddings.Registry
+	gm         genkitmagic.GenkitMagic
+	phc        posthog.Client
+	logger     *slog.Logger
+	router     *router
+	limiter    rateLimiter
+	searches   searchSessions
+	moderator  *moderation.Moderator
+
+	mentionCooldowns cooldowns
+	chimeInCooldowns cooldowns
 }
 
 func NewDiscordBot(
 	entClient *ent.Client,
-	embedder ai.Embedder,
+	registry *embeddings.Registry,
 	genkitMagic genkitmagic.GenkitMagic,
 	phc posthog.Client,
 	logger *slog.Logger,
@@ -43,22 +49,76 @@ func NewDiscordBot(
 	}
 
 	bot := &DiscordBot{
-		session:   dg,
-		entClient: entClient,
-		embedder:  embedder,
-		gm:        genkitMagic,
-		phc:       phc,
-		logger:    logger,
+		session:    dg,
+		entClient:  entClient,
+		embeddings: registry,
+		gm:         genkitMagic,
+		phc:        phc,
+		logger:     logger,
+		moderator:  moderation.New(genkitMagic),
 	}
 
-	bot.commandHandlers = map[string]func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate){
-		"ask": bot.handleAsk,
+	bot.router = newRouter(
+		bot.recoverInteractions,
+		bot.logInteractions,
+		bot.rateLimit,
+		bot.visibility,
+	)
+	bot.router.command("ask", bot.handleAsk, bot.guildOnly)
+	bot.router.command("hall-of-fame", bot.handleHallOfFame, bot.guildOnly)
+	bot.router.command("search", bot.handleSearch, bot.guildOnly)
+	bot.router.command("summarize", bot.handleSummarize, bot.guildOnly)
+	bot.router.command("profiling", bot.handleProfiling)
+	bot.router.command("dm", bot.handleDM)
+	bot.router.command("privacy", bot.handlePrivacy)
+	bot.router.command(
+		"channel",
+		bot.handleChannelSettings,
+		bot.guildOnly,
+		bot.requirePermissions(discordgo.PermissionManageChannels),
+	)
+	bot.router.command(
+		"chime-in",
+		bot.handleChimeIn,
+		bot.guildOnly,
+		bot.requirePermissions(discordgo.PermissionManageGuild),
+	)
+	bot.router.command(
+		"moderation",
+		bot.handleModeration,
+		bot.guildOnly,
+		bot.requirePermissions(discordgo.PermissionManageGuild),
+	)
+	bot.router.command(
+		"archive",
+		bot.handleArchive,
+		bot.guildOnly,
+		bot.requirePermissions(discordgo.PermissionManageGuild),
+	)
+	bot.router.command("What's their deal?", bot.handleWhatsTheirDeal, bot.guildOnly)
+	for name := range messageActions {
+		bot.router.command(name, bot.handleMessageAction, bot.guildOnly)
 	}
-	bot.session.Identify.Intents = discordgo.IntentsGuildMessages | discordgo.IntentMessageContent
-
-	bot.session.AddHandler(bot.messageCreate)
-	bot.session.AddHandler(bot.messageUpdate)
-	bot.session.AddHandler(bot.interactionCreate)
+	bot.router.component("search", bot.handleSearchPage)
+	bot.session.Identify.Intents = discordgo.IntentsGuilds |
+		discordgo.IntentsGuildMembers |
+		discordgo.IntentsGuildMessages |
+		discordgo.IntentsGuildMessageReactions |
+		discordgo.IntentsDirectMessages |
+		discordgo.IntentMessageContent
+
+	bot.session.AddHandler(recovered(bot, bot.messageCreate))
+	bot.session.AddHandler(recovered(bot, bot.messageUpdate))
+	bot.session.AddHandler(recovered(bot, bot.messageReactionAdd))
+	bot.session.AddHandler(recovered(bot, bot.messageReactionRemove))
+	bot.session.AddHandler(recovered(bot, bot.messageReactionRemoveAll))
+	bot.session.AddHandler(recovered(bot, bot.guildCreate))
+	bot.session.AddHandler(recovered(bot, bot.guildRoleCreate))
+	bot.session.AddHandler(recovered(bot, bot.guildRoleUpdate))
+	bot.session.AddHandler(recovered(bot, bot.guildMemberAdd))
+	bot.session.AddHandler(recovered(bot, bot.guildMemberUpdate))
+	bot.session.AddHandler(recovered(bot, bot.guildMemberRemove))
+	bot.session.AddHandler(recovered(bot, bot.interactionCreate))
 
 	return bot, nil
 }
@@ -130,7 +190,18 @@ func (b *DiscordBot) messageCreate(
 	s *discordgo.Session,
 	m *discordgo.MessageCreate,
 ) {
+	if m.GuildID == "" {
+		b.directMessage(s, m.Message)
+		return
+	}
+
 	b.messageCreateOrUpdate(s, m.Message)
+
+	if mentionsBot(s, m.Message) {
+		b.replyToMention(s, m.Message)
+	} else {
+		b.maybeChimeIn(s, m.Message)
+	}
 }
 
 func (b *DiscordBot) messageUpdate(
@@ -145,11 +216,12 @@ func (b *DiscordBot) messageCreateOrUpdate(
 	m *discordgo.Message,
 ) {
 	if m.GuildID == "" {
-		// Ignore DMs
+		// DMs are private, see directMessage
 		return
 	}
 
-	if m.Author.Bot {
+	if m.Author == nil || m.Author.Bot {
+		// Updates that only unfurl embeds come without an author
 		return
 	}
 
@@ -166,32 +238,55 @@ func (b *DiscordBot) messageCreateOrUpdate(
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	userID, err := b.entClient.DiscordUser.Create().
-		SetGlobalName(m.Author.GlobalName).
-		SetUsername(m.Author.Username).
-		SetID(m.Author.ID).
-		OnConflictColumns(discorduser.FieldID).
-		UpdateNewValues().
-		ID(ctx)
+	if b.optedOut(ctx, m.Author.ID) || !b.shouldIngest(ctx, s, m.GuildID, m.ChannelID) {
+		return
+	}
+
+	userID, err := b.upsertUser(ctx, m.Author)
 	if err != nil {
 		b.logger.Error("failed to create discord user: ", "err", err)
 	}
 
+	if m.Member != nil && userID != "" {
+		if err := b.upsertMember(ctx, m.GuildID, userID, m.Member); err != nil {
+			b.logger.Error("failed to create discord guild member: ", "err", err)
+		}
+	}
+
+	if _, err := b.upsertChannel(ctx, s, m.ChannelID); err != nil {
+		b.logger.Error("failed to create discord channel: ", "err", err)
+	}
+
 	create := b.entClient.DiscordMessage.Create().
 		SetID(m.ID).
 		SetContent(m.Content).
 		SetUserID(userID).
-		SetTimestamp(m.Timestamp)
+		SetGuildID(m.GuildID).
+		SetChannelID(m.ChannelID).
+		SetTimestamp(m.Timestamp).
+		SetSuspectedInjection(archive.LooksLikeInjection(m.Content))
 
 	if m.EditedTimestamp != nil {
 		create.SetEditedTimestamp(*m.EditedTimestamp)
 	}
 
+	if replyToID := b.replyToID(ctx, m); replyToID != "" {
+		create.SetReplyToID(replyToID)
+	}
+
 	err = create.OnConflictColumns(discordmessage.FieldID).
 		UpdateNewValues().
 		Exec(ctx)
 	if err != nil {
 		b.logger.Error("failed to create discord message: ", "err", err)
+		return
+	}
+
+	// Embedding takes a round trip to the provider, don't hold up the gateway
+	go b.embedMessage(&ent.DiscordMessage{ID: m.ID, Content: m.Content, ChannelID: m.ChannelID})
+
+	if err := b.storeMentions(ctx, s, m); err != nil {
+		b.logger.Error("failed to store message mentions: ", "err", err)
 	}
 
 	// jsonData, err := json.MarshalIndent(m, "", "  ")
@@ -216,107 +311,441 @@ var commands = []*discordgo.ApplicationCommand{
 			},
 		},
 	},
-}
-
-func (b *DiscordBot) interactionCreate(
-	s *discordgo.Session,
-	i *discordgo.InteractionCreate,
-) {
-	// Create a context with the user ID for logging and tracing.
-	var userID string
-	if i.Member != nil {
-		userID = i.Member.User.ID
-	} else {
-		userID = i.User.ID
-	}
-	ctx := context.WithValue(
-		context.Background(),
-		contextkeys.UserIDKey,
-		userID,
-	)
-
-	if h, ok := b.commandHandlers[i.ApplicationCommandData().Name]; ok {
-		h(ctx, s, i)
-	}
-}
-
-func (b *DiscordBot) handleAsk(
-	ctx context.Context,
-	s *discordgo.Session,
-	i *discordgo.InteractionCreate,
-) {
-	b.phc.Enqueue(posthog.Capture{
-		DistinctId: i.Member.User.ID,
-		Event:      "ask",
-		Properties: posthog.NewProperties().
-			Set("global_name", i.Member.User.GlobalName),
-	})
-
-	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
-		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
-	})
-	if err != nil {
-		b.logger.Error("failed to defer interaction", "err", err)
-		return
-	}
-
-	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
-	defer cancel()
-
-	options := i.ApplicationCommandData().Options
-	var question string
-	for _, opt := range options {
-		if opt.Name == "question" {
-			question = opt.StringValue()
-			break
-		}
-	}
-
-	b.logger.Info("Handling ask command", "question", question)
-
-	config := map[string]any{
-		"safetySettings": []map[string]any{
-			{"category": "HARM_CATEGORY_HARASSMENT", "threshold": "BLOCK_NONE"},
+	{
+		Name:        "hall-of-fame",
+		Description: "Show the most reacted messages",
+		Options: []*discordgo.ApplicationCommandOption{
 			{
-				"category":  "HARM_CATEGORY_HATE_SPEECH",
-				"threshold": "BLOCK_NONE",
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "period",
+				Description: "Time window to look at (defaults to all time)",
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Today", Value: "day"},
+					{Name: "This week", Value: "week"},
+					{Name: "This month", Value: "month"},
+					{Name: "This year", Value: "year"},
+					{Name: "All time", Value: "all"},
+				},
 			},
 			{
-				"category":  "HARM_CATEGORY_SEXUALLY_EXPLICIT",
-				"threshold": "BLOCK_NONE",
+				Type:        discordgo.ApplicationCommandOptionChannel,
+				Name:        "channel",
+				Description: "Only messages from this channel",
+				ChannelTypes: []discordgo.ChannelType{
+					discordgo.ChannelTypeGuildText,
+				},
 			},
 			{
-				"category":  "HARM_CATEGORY_DANGEROUS_CONTENT",
-				"threshold": "BLOCK_NONE",
+				Type:        discordgo.ApplicationCommandOptionUser,
+				Name:        "user",
+				Description: "Only messages from this user",
 			},
 		},
-	}
-
-	resp, err := genkit.GenerateText(
-		ctx,
-		b.gm.G,
-		ai.WithPrompt(question),
-		ai.WithTools(b.gm.RecentMessagesTool),
-		ai.WithSystem(
-			"You are a funny & troll Discord bot that lives in this server. You have access to a searchable database of all past messages from this server — use it to recall context, patterns, and memorable moments when replying. Please do not ask any follow up questions, just answer to the best of your ability with the information you have. You should also act like ThePrimeagen.",
-		),
-		ai.WithConfig(config),
-	)
-
-	var content string
-	if err != nil {
-		b.logger.Error("failed to generate text", "err", err)
-		content = "I'm sorry, I encountered an error and couldn't process your question."
-	} else if resp == "" {
-		content = "The model chose not to provide a response."
-	} else {
-		content = resp
-	}
-
-	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
-		Content: &content,
-	})
-	if err != nil {
-		b.logger.Error("failed to edit interaction response", "err", err)
-	}
+	},
+	{
+		Name:        "search",
+		Description: "Search the message archive",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "query",
+				Description: "What to look for. Supports \"phrases\", OR and -exclusions",
+				Required:    true,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionUser,
+				Name:        "from",
+				Description: "Only messages from this user",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionChannel,
+				Name:        "in",
+				Description: "Only messages from this channel",
+				ChannelTypes: []discordgo.ChannelType{
+					discordgo.ChannelTypeGuildText,
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "before",
+				Description: "Only messages before this day (YYYY-MM-DD)",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "after",
+				Description: "Only messages after this day (YYYY-MM-DD)",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionString,
+				Name:        "mode",
+				Description: "How to match messages (defaults to both)",
+				Choices: []*discordgo.ApplicationCommandOptionChoice{
+					{Name: "Exact words", Value: "keyword"},
+					{Name: "Meaning", Value: "semantic"},
+					{Name: "Both", Value: "hybrid"},
+				},
+			},
+		},
+	},
+	{
+		Name:        "summarize",
+		Description: "Catch up on what you missed in a channel",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionChannel,
+				Name:        "channel",
+				Description: "Channel to summarize (defaults to this one)",
+				ChannelTypes: []discordgo.ChannelType{
+					discordgo.ChannelTypeGuildText,
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "messages",
+				Description: "How many of the latest messages to summarize",
+				MinValue:    lo.ToPtr(10.0),
+				MaxValue:    2000,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionInteger,
+				Name:        "hours",
+				Description: "Summarize the last N hours instead",
+				MinValue:    lo.ToPtr(1.0),
+				MaxValue:    168,
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionBoolean,
+				Name:        "public",
+				Description: "Post the summary for everyone instead of just you",
+			},
+		},
+	},
+	{
+		Name: "Explain this",
+		Type: discordgo.MessageApplicationCommand,
+	},
+	{
+		Name: "Roast this",
+		Type: discordgo.MessageApplicationCommand,
+	},
+	{
+		Name: "Fact check",
+		Type: discordgo.MessageApplicationCommand,
+	},
+	{
+		Name: "What's their deal?",
+		Type: discordgo.UserApplicationCommand,
+	},
+	{
+		Name:        "profiling",
+		Description: "Choose whether the bot may profile you",
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "opt-out",
+				Description: "Stop the bot from profiling you",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "opt-in",
+				Description: "Let the bot profile you again",
+			},
+		},
+	},
+	{
+		Name:        "dm",
+		Description: "Chat with the bot in private",
+		Contexts: &[]discordgo.InteractionContextType{
+			discordgo.InteractionContextGuild,
+			discordgo.InteractionContextBotDM,
+		},
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "enable",
+				Description: "Let the bot answer your DMs",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "disable",
+				Description: "Stop the bot from answering your DMs",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "forget",
+				Description: "Wipe your DM history with the bot",
+			},
+		},
+	},
+	{
+		Name:        "privacy",
+		Description: "Control what the bot stores about you",
+		Contexts: &[]discordgo.InteractionContextType{
+			discordgo.InteractionContextGuild,
+			discordgo.InteractionContextBotDM,
+		},
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "opt-out",
+				Description: "Stop the bot from archiving your messages",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "opt-in",
+				Description: "Let the bot archive your messages again",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "export",
+				Description: "Get everything the bot stored about you in your DMs",
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "forget",
+				Description: "Delete everything the bot stored about you",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "confirm",
+						Description: "Yes, delete it all, this can't be undone",
+						Required:    true,
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:                     "archive",
+		Description:              "Choose which channels the bot archives and answers from",
+		DefaultMemberPermissions: lo.ToPtr(int64(discordgo.PermissionManageGuild)),
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "ingest",
+				Description: "Whether the messages of a channel get archived",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Name:        "channel",
+						Description: "Channel or category to change",
+						Required:    true,
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+							discordgo.ChannelTypeGuildCategory,
+						},
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "mode",
+						Description: "Allowing any channel stops archiving the ones that aren't",
+						Required:    true,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "Default", Value: "default"},
+							{Name: "Allow", Value: "allow"},
+							{Name: "Deny", Value: "deny"},
+						},
+					},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "retrieval",
+				Description: "Who the archived messages of a channel get surfaced to",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Name:        "channel",
+						Description: "Channel or category to change",
+						Required:    true,
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+							discordgo.ChannelTypeGuildCategory,
+						},
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "mode",
+						Description: "Who gets answers from the channel",
+						Required:    true,
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "Everyone", Value: "everyone"},
+							{Name: "Members who can see it", Value: "members"},
+							{Name: "Nobody", Value: "nobody"},
+						},
+					},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "settings",
+				Description: "Show which channels are archived and surfaced",
+			},
+		},
+	},
+	{
+		Name:                     "channel",
+		Description:              "Change how the bot behaves in a channel",
+		DefaultMemberPermissions: lo.ToPtr(int64(discordgo.PermissionManageChannels)),
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "mentions",
+				Description: "Whether the bot answers when mentioned or replied to",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "enabled",
+						Description: "Answer mentions and replies",
+						Required:    true,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Name:        "channel",
+						Description: "Channel to change (defaults to this one)",
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+						},
+					},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "chime-in",
+				Description: "Whether the bot joins conversations unprompted",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "enabled",
+						Description: "Chime in on conversations",
+						Required:    true,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionChannel,
+						Name:        "channel",
+						Description: "Channel to change (defaults to this one)",
+						ChannelTypes: []discordgo.ChannelType{
+							discordgo.ChannelTypeGuildText,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		Name:                     "chime-in",
+		Description:              "Configure the bot joining conversations unprompted",
+		DefaultMemberPermissions: lo.ToPtr(int64(discordgo.PermissionManageGuild)),
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "settings",
+				Description: "Show or change the chime-in settings of the server",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "enabled",
+						Description: "Kill switch for the whole server",
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "probability",
+						Description: "Percentage of messages that get looked at",
+						MinValue:    lo.ToPtr(0.0),
+						MaxValue:    100,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "threshold",
+						Description: "Score in percent a conversation needs for the bot to chime in",
+						MinValue:    lo.ToPtr(0.0),
+						MaxValue:    100,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "cooldown",
+						Description: "Minutes between chime-ins in a channel",
+						MinValue:    lo.ToPtr(0.0),
+						MaxValue:    1440,
+					},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "stats",
+				Description: "How often the bot chimed in and how it went",
+			},
+		},
+	},
+	{
+		Name:                     "moderation",
+		Description:              "Configure what the bot checks its answers for",
+		DefaultMemberPermissions: lo.ToPtr(int64(discordgo.PermissionManageGuild)),
+		Options: []*discordgo.ApplicationCommandOption{
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "settings",
+				Description: "Show or change the moderation settings of the server",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "redact",
+						Description: "Redact emails, tokens and keys from answers",
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "denylist-action",
+						Description: "What happens to answers with denylisted terms",
+						Choices: []*discordgo.ApplicationCommandOptionChoice{
+							{Name: "Mask the terms", Value: "mask"},
+							{Name: "Block the answer", Value: "block"},
+						},
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionBoolean,
+						Name:        "classifier",
+						Description: "Have a model rate answers before they are posted",
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "flag-threshold",
+						Description: "Classifier score in percent that gets an answer logged",
+						MinValue:    lo.ToPtr(0.0),
+						MaxValue:    100,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionInteger,
+						Name:        "block-threshold",
+						Description: "Classifier score in percent that blocks an answer",
+						MinValue:    lo.ToPtr(0.0),
+						MaxValue:    100,
+					},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "denylist",
+				Description: "Show or change the terms answers must not contain",
+				Options: []*discordgo.ApplicationCommandOption{
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "add",
+						Description: "Term to add",
+						MaxLength:   100,
+					},
+					{
+						Type:        discordgo.ApplicationCommandOptionString,
+						Name:        "remove",
+						Description: "Term to remove",
+						MaxLength:   100,
+					},
+				},
+			},
+			{
+				Type:        discordgo.ApplicationCommandOptionSubCommand,
+				Name:        "log",
+				Description: "The latest answers that got rewritten, blocked or flagged",
+			},
+		},
+	},
 }
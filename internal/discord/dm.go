@@ -0,0 +1,217 @@
+package discord
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discorddirectmessage"
+	"sev0/internal/contextkeys"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+)
+
+// dmMemory is how many of the previous DMs are replayed to the model.
+const dmMemory = 30
+
+// directMessage chats with users that enabled DM mode. DMs are stored apart
+// from the guild messages and the guild tools aren't offered here, so nothing
+// said in private leaks into a guild.
+func (b *DiscordBot) directMessage(
+	s *discordgo.Session,
+	m *discordgo.Message,
+) {
+	if m.Author == nil || m.Author.Bot || m.Content == "" {
+		return
+	}
+
+	ctx := context.WithValue(
+		context.Background(),
+		contextkeys.UserIDKey,
+		m.Author.ID,
+	)
+	ctx, cancel := context.WithTimeout(ctx, 45*time.Second)
+	defer cancel()
+
+	if b.optedOut(ctx, m.Author.ID) {
+		b.sendDM(s, m.ChannelID, "You opted out of being archived, so I don't keep DMs with you either. Run `/privacy opt-in` to chat again.")
+		return
+	}
+
+	userID, err := b.upsertUser(ctx, m.Author)
+	if err != nil {
+		b.logger.Error("failed to create discord user: ", "err", err)
+		return
+	}
+
+	user, err := b.entClient.DiscordUser.Get(ctx, userID)
+	if err != nil {
+		b.logger.Error("failed to get discord user", "err", err)
+		return
+	}
+	if !user.DmEnabled {
+		b.sendDM(s, m.ChannelID, "I only chat in DMs with people who asked for it, run `/dm enable` first.")
+		return
+	}
+
+	// Every DM is a model call, they share the budget of the commands
+	if !b.limiter.allow(m.Author.ID) {
+		b.sendDM(s, m.ChannelID, "Slow down, give me a few seconds.")
+		return
+	}
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: m.Author.ID,
+		Event:      "dm",
+		Properties: posthog.NewProperties().
+			Set("global_name", m.Author.GlobalName),
+	})
+
+	history, err := b.entClient.DiscordDirectMessage.Query().
+		Where(discorddirectmessage.UserID(userID)).
+		Order(ent.Desc(discorddirectmessage.FieldTimestamp)).
+		Limit(dmMemory).
+		All(ctx)
+	if err != nil {
+		b.logger.Error("failed to load dm history", "err", err)
+		return
+	}
+	slices.Reverse(history)
+
+	err = b.entClient.DiscordDirectMessage.Create().
+		SetID(m.ID).
+		SetUserID(userID).
+		SetChannelID(m.ChannelID).
+		SetContent(m.Content).
+		SetTimestamp(m.Timestamp).
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to store dm", "err", err)
+	}
+
+	stopTyping := b.keepTyping(s, m.ChannelID)
+	defer stopTyping()
+
+	messages := make([]*ai.Message, 0, len(history)+1)
+	for _, dm := range history {
+		if dm.FromBot {
+			messages = append(messages, ai.NewModelTextMessage(dm.Content))
+		} else {
+			messages = append(messages, ai.NewUserTextMessage(dm.Content))
+		}
+	}
+	messages = append(messages, ai.NewUserTextMessage(m.Content))
+
+	resp, err := b.gm.GenerateText(
+		ctx,
+		ai.WithMessages(messages...),
+		ai.WithSystem(b.systemPrompt(
+			"You're chatting with someone in private DMs. Keep it conversational and short, like a chat message. You don't have access to the server history here.",
+		)),
+	)
+	if err != nil {
+		b.logger.Error("failed to generate text", "err", err)
+		b.sendDM(s, m.ChannelID, "I'm sorry, I encountered an error and couldn't reply.")
+		return
+	}
+	if resp == "" {
+		return
+	}
+	if resp = b.moderate(ctx, "dm", "", m.ChannelID, userID, resp); resp == "" {
+		resp = blockedReply
+	}
+
+	sent := b.sendDM(s, m.ChannelID, truncate(resp, 2000))
+	if sent == nil {
+		return
+	}
+
+	err = b.entClient.DiscordDirectMessage.Create().
+		SetID(sent.ID).
+		SetUserID(userID).
+		SetChannelID(m.ChannelID).
+		SetFromBot(true).
+		SetContent(sent.Content).
+		SetTimestamp(sent.Timestamp).
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to store dm", "err", err)
+	}
+}
+
+func (b *DiscordBot) sendDM(
+	s *discordgo.Session,
+	channelID string,
+	content string,
+) *discordgo.Message {
+	sent, err := s.ChannelMessageSend(channelID, content)
+	if err != nil {
+		b.logger.Error("failed to send dm", "err", err)
+		return nil
+	}
+	return sent
+}
+
+func (b *DiscordBot) handleDM(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	action := i.ApplicationCommandData().Options[0].Name
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "dm_settings",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("action", action),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	userID, err := b.upsertUser(ctx, invokingUser(i))
+
+	var content string
+	switch action {
+	case "enable":
+		content = "Done, slide into my DMs."
+		if err == nil {
+			err = b.entClient.DiscordUser.UpdateOneID(userID).
+				SetDmEnabled(true).
+				Exec(ctx)
+		}
+	case "disable":
+		content = "Done, I'll leave your DMs on read. Run `/dm forget` to also wipe what we talked about."
+		if err == nil {
+			err = b.entClient.DiscordUser.UpdateOneID(userID).
+				SetDmEnabled(false).
+				Exec(ctx)
+		}
+	case "forget":
+		content = "Done, I forgot everything we talked about in DMs."
+		if err == nil {
+			_, err = b.entClient.DiscordDirectMessage.Delete().
+				Where(discorddirectmessage.UserID(userID)).
+				Exec(ctx)
+		}
+	}
+	if err != nil {
+		b.logger.Error("failed to update dm settings", "action", action, "err", err)
+		content = "I'm sorry, I couldn't save that. Try again in a bit."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
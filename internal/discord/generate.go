@@ -0,0 +1,9 @@
+package discord
+
+import "sev0/internal/archive"
+
+// systemPrompt prefixes the instructions of a task with the configured
+// persona, and tells the model how to read the chat messages it gets.
+func (b *DiscordBot) systemPrompt(instructions string) string {
+	return b.gm.Persona + " " + instructions + " " + archive.UntrustedInstructions
+}
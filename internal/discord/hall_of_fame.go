@@ -0,0 +1,149 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/posthog/posthog-go"
+)
+
+var hallOfFamePeriods = map[string]time.Duration{
+	"day":   24 * time.Hour,
+	"week":  7 * 24 * time.Hour,
+	"month": 30 * 24 * time.Hour,
+	"year":  365 * 24 * time.Hour,
+}
+
+func (b *DiscordBot) handleHallOfFame(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "hall_of_fame",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName),
+	})
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	params := archive.TopReactedParams{
+		GuildID: i.GuildID,
+		Limit:   10,
+	}
+	period := "all"
+	var scope []string
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "period":
+			period = opt.StringValue()
+		case "channel":
+			params.ChannelID = opt.Value.(string)
+			scope = append(scope, "in <#"+params.ChannelID+">")
+		case "user":
+			params.AuthorIDs = []string{opt.Value.(string)}
+			scope = append(scope, "by <@"+params.AuthorIDs[0]+">")
+		}
+	}
+	if d, ok := hallOfFamePeriods[period]; ok {
+		params.Since = time.Now().Add(-d)
+	}
+
+	b.logger.Info("Handling hall-of-fame command", "period", period)
+
+	if len(params.AuthorIDs) > 0 && b.profilingOptedOut(ctx, params.AuthorIDs[0]) {
+		content := "They opted out of being profiled, their messages can't be looked up by author."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	messages, err := archive.TopReactedMessages(ctx, b.entClient, params)
+	if err != nil {
+		b.logger.Error("failed to query top reacted messages", "err", err)
+		content := "I'm sorry, I couldn't dig up the hall of fame right now."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	if len(messages) == 0 {
+		content := "Nothing here yet. Y'all need to react to more messages."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	var desc strings.Builder
+	if len(scope) > 0 {
+		desc.WriteString("Messages " + strings.Join(scope, " ") + "\n\n")
+	}
+	for n, rm := range messages {
+		emojis := make([]string, 0, len(rm.Emojis))
+		for _, e := range rm.Emojis {
+			emojis = append(emojis, fmt.Sprintf("%s %d", archive.FormatEmoji(e.Emoji), e.Count))
+		}
+
+		fmt.Fprintf(&desc, "**%d.** %d reactions · %s\n", n+1, rm.Total, strings.Join(emojis, " "))
+		desc.WriteString(quote(rm.Message.Content, 200))
+		fmt.Fprintf(
+			&desc,
+			"— <@%s> · <t:%d:R>",
+			rm.Message.AuthorID,
+			rm.Message.Timestamp.Unix(),
+		)
+		if url := archive.JumpURL(rm.Message); url != "" {
+			desc.WriteString(" · [Jump](" + url + ")")
+		}
+		desc.WriteString("\n\n")
+	}
+
+	title := "🏆 Hall of Fame"
+	if period != "all" {
+		title += " of the " + period
+	}
+	embeds := []*discordgo.MessageEmbed{{
+		Title:       title,
+		Description: truncate(desc.String(), 4096),
+		Color:       0xF1C40F,
+	}}
+	b.editResponse(s, i, &discordgo.WebhookEdit{Embeds: &embeds})
+}
+
+func (b *DiscordBot) editResponse(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	edit *discordgo.WebhookEdit,
+) {
+	_, err := s.InteractionResponseEdit(i.Interaction, edit)
+	if err != nil {
+		b.logger.Error("failed to edit interaction response", "err", err)
+	}
+}
+
+// quote renders content as a Discord block quote, cut down to maxLen runes.
+func quote(content string, maxLen int) string {
+	lines := strings.Split(truncate(content, maxLen), "\n")
+	return "> " + strings.Join(lines, "\n> ") + "\n"
+}
+
+func truncate(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+
+	return string(runes[:maxLen-1]) + "…"
+}
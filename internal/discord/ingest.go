@@ -0,0 +1,235 @@
+package discord
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordrole"
+	"sev0/ent/discorduser"
+	"sev0/internal/embeddings"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/samber/lo"
+)
+
+var channelMentionRe = regexp.MustCompile(`<#(\d+)>`)
+
+// upsertUser stores the latest known names of a Discord user and keeps a
+// history of the previous ones.
+func (b *DiscordBot) upsertUser(
+	ctx context.Context,
+	u *discordgo.User,
+) (string, error) {
+	globalName := u.GlobalName
+	if globalName == "" {
+		// Users that never set a display name go by their username
+		globalName = u.Username
+	}
+
+	previous, err := b.entClient.DiscordUser.Get(ctx, u.ID)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", err
+	}
+
+	create := b.entClient.DiscordUser.Create().
+		SetGlobalName(globalName).
+		SetUsername(u.Username).
+		SetID(u.ID)
+
+	if u.Avatar != "" {
+		create.SetAvatar(u.Avatar)
+	}
+
+	userID, err := create.
+		OnConflictColumns(discorduser.FieldID).
+		UpdateNewValues().
+		Update(func(u *ent.DiscordUserUpsert) {
+			// The defaults of the settings would overwrite what the user chose
+			u.SetIgnore(discorduser.FieldProfilingOptOut)
+			u.SetIgnore(discorduser.FieldDmEnabled)
+		}).
+		ID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var changes []*ent.DiscordProfileChangeCreate
+	change := func(kind discordprofilechange.Kind, value string) {
+		changes = append(changes, b.entClient.DiscordProfileChange.Create().
+			SetUserID(userID).
+			SetKind(kind).
+			SetValue(value))
+	}
+	if previous == nil || previous.Username != u.Username {
+		change(discordprofilechange.KindUsername, u.Username)
+	}
+	if previous == nil || previous.GlobalName != globalName {
+		change(discordprofilechange.KindGlobalName, globalName)
+	}
+	if u.Avatar != "" && (previous == nil || previous.Avatar != u.Avatar) {
+		change(discordprofilechange.KindAvatar, u.Avatar)
+	}
+
+	if len(changes) > 0 {
+		err = b.entClient.DiscordProfileChange.CreateBulk(changes...).Exec(ctx)
+		if err != nil {
+			b.logger.Error("failed to record discord profile changes", "err", err)
+		}
+	}
+
+	return userID, nil
+}
+
+// upsertChannel stores the channel as currently known by the state cache.
+// Channels missing from the cache are skipped.
+func (b *DiscordBot) upsertChannel(
+	ctx context.Context,
+	s *discordgo.Session,
+	channelID string,
+) (bool, error) {
+	ch, err := s.State.Channel(channelID)
+	if err != nil || ch.GuildID == "" || ch.Name == "" {
+		return false, nil
+	}
+
+	err = b.entClient.DiscordChannel.Create().
+		SetID(ch.ID).
+		SetGuildID(ch.GuildID).
+		SetName(ch.Name).
+		SetParentID(ch.ParentID).
+		OnConflictColumns(discordchannel.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+
+	return err == nil, err
+}
+
+// upsertRole stores the role as currently known by the state cache. Roles
+// missing from the cache are skipped.
+func (b *DiscordBot) upsertRole(
+	ctx context.Context,
+	s *discordgo.Session,
+	guildID string,
+	roleID string,
+) (bool, error) {
+	role, err := s.State.Role(guildID, roleID)
+	if err != nil || role.Name == "" {
+		return false, nil
+	}
+
+	err = b.entClient.DiscordRole.Create().
+		SetID(role.ID).
+		SetGuildID(guildID).
+		SetName(role.Name).
+		OnConflictColumns(discordrole.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+
+	return err == nil, err
+}
+
+// replyToID returns the ID of the archived message m replies to, if any.
+func (b *DiscordBot) replyToID(
+	ctx context.Context,
+	m *discordgo.Message,
+) string {
+	ref := m.MessageReference
+	if m.Type != discordgo.MessageTypeReply || ref == nil || ref.MessageID == "" {
+		return ""
+	}
+
+	exists, err := b.entClient.DiscordMessage.Query().
+		Where(discordmessage.ID(ref.MessageID)).
+		Exist(ctx)
+	if err != nil {
+		b.logger.Error("failed to look up replied message", "err", err)
+		return ""
+	}
+	if !exists {
+		return ""
+	}
+
+	return ref.MessageID
+}
+
+// storeMentions replaces the users, roles and channels an archived message
+// mentions.
+func (b *DiscordBot) storeMentions(
+	ctx context.Context,
+	s *discordgo.Session,
+	m *discordgo.Message,
+) error {
+	var userIDs []string
+	for _, u := range m.Mentions {
+		if b.optedOut(ctx, u.ID) {
+			continue
+		}
+		id, err := b.upsertUser(ctx, u)
+		if err != nil {
+			b.logger.Error("failed to create mentioned discord user", "err", err)
+			continue
+		}
+		userIDs = append(userIDs, id)
+	}
+
+	var roleIDs []string
+	for _, id := range lo.Uniq(m.MentionRoles) {
+		ok, err := b.upsertRole(ctx, s, m.GuildID, id)
+		if err != nil {
+			b.logger.Error("failed to create mentioned discord role", "err", err)
+		}
+		if ok {
+			roleIDs = append(roleIDs, id)
+		}
+	}
+
+	var channelIDs []string
+	for _, match := range channelMentionRe.FindAllStringSubmatch(m.Content, -1) {
+		if lo.Contains(channelIDs, match[1]) {
+			continue
+		}
+		ok, err := b.upsertChannel(ctx, s, match[1])
+		if err != nil {
+			b.logger.Error("failed to create mentioned discord channel", "err", err)
+		}
+		if ok {
+			channelIDs = append(channelIDs, match[1])
+		}
+	}
+
+	return b.entClient.DiscordMessage.UpdateOneID(m.ID).
+		ClearMentionedUsers().
+		AddMentionedUserIDs(lo.Uniq(userIDs)...).
+		ClearMentionedRoles().
+		AddMentionedRoleIDs(roleIDs...).
+		ClearMentionedChannels().
+		AddMentionedChannelIDs(channelIDs...).
+		Exec(ctx)
+}
+
+func (b *DiscordBot) embedMessage(m *ent.DiscordMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	embedders, err := b.embeddings.Stored(ctx)
+	if err != nil {
+		b.logger.Error("failed to list embedding models", "err", err)
+		return
+	}
+
+	for _, embedder := range embedders {
+		err := embeddings.EmbedMessages(ctx, b.gm.G, b.entClient, embedder, m)
+		if err != nil {
+			b.logger.Error("failed to embed discord message", "model", embedder.Name(), "err", err)
+		}
+	}
+
+	if err := b.embeddings.ChunkChannel(ctx, m.ChannelID); err != nil {
+		b.logger.Error("failed to chunk discord channel", "err", err)
+	}
+}
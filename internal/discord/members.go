@@ -0,0 +1,200 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchannel"
+	"sev0/ent/discordguildmember"
+	"sev0/ent/discordprofilechange"
+	"sev0/ent/discordrole"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// upsertMember stores a user's membership in a guild and keeps a history of
+// their nicknames. The user has to be stored first.
+func (b *DiscordBot) upsertMember(
+	ctx context.Context,
+	guildID string,
+	userID string,
+	member *discordgo.Member,
+) error {
+	previous, err := b.entClient.DiscordGuildMember.Query().
+		Where(
+			discordguildmember.GuildID(guildID),
+			discordguildmember.UserID(userID),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	create := b.entClient.DiscordGuildMember.Create().
+		SetGuildID(guildID).
+		SetUserID(userID).
+		SetNickname(member.Nick).
+		SetRoles(member.Roles)
+
+	if !member.JoinedAt.IsZero() {
+		create.SetJoinedAt(member.JoinedAt)
+	}
+
+	err = create.
+		OnConflictColumns(
+			discordguildmember.FieldGuildID,
+			discordguildmember.FieldUserID,
+		).
+		UpdateNewValues().
+		ClearLeftAt().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if member.Nick != "" && (previous == nil || previous.Nickname != member.Nick) {
+		return b.entClient.DiscordProfileChange.Create().
+			SetUserID(userID).
+			SetGuildID(guildID).
+			SetKind(discordprofilechange.KindNickname).
+			SetValue(member.Nick).
+			Exec(ctx)
+	}
+
+	return nil
+}
+
+func (b *DiscordBot) guildMemberAdd(
+	s *discordgo.Session,
+	m *discordgo.GuildMemberAdd,
+) {
+	b.memberChanged(m.GuildID, m.Member)
+}
+
+func (b *DiscordBot) guildMemberUpdate(
+	s *discordgo.Session,
+	m *discordgo.GuildMemberUpdate,
+) {
+	b.memberChanged(m.GuildID, m.Member)
+}
+
+func (b *DiscordBot) memberChanged(guildID string, member *discordgo.Member) {
+	if member.User == nil || member.User.Bot {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if b.optedOut(ctx, member.User.ID) {
+		return
+	}
+
+	userID, err := b.upsertUser(ctx, member.User)
+	if err != nil {
+		b.logger.Error("failed to create discord user: ", "err", err)
+		return
+	}
+
+	if err := b.upsertMember(ctx, guildID, userID, member); err != nil {
+		b.logger.Error("failed to create discord guild member: ", "err", err)
+	}
+}
+
+func (b *DiscordBot) guildMemberRemove(
+	s *discordgo.Session,
+	m *discordgo.GuildMemberRemove,
+) {
+	if m.User == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := b.entClient.DiscordGuildMember.Update().
+		Where(
+			discordguildmember.GuildID(m.GuildID),
+			discordguildmember.UserID(m.User.ID),
+		).
+		SetLeftAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		b.logger.Error("failed to mark discord guild member as left", "err", err)
+	}
+}
+
+// guildCreate syncs the roles and channels of a guild whenever the bot
+// connects to it.
+func (b *DiscordBot) guildCreate(
+	s *discordgo.Session,
+	g *discordgo.GuildCreate,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	roles := make([]*ent.DiscordRoleCreate, 0, len(g.Roles))
+	for _, r := range g.Roles {
+		roles = append(roles, b.entClient.DiscordRole.Create().
+			SetID(r.ID).
+			SetGuildID(g.ID).
+			SetName(r.Name))
+	}
+	err := b.entClient.DiscordRole.CreateBulk(roles...).
+		OnConflictColumns(discordrole.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to sync discord roles", "err", err)
+	}
+
+	channels := make([]*ent.DiscordChannelCreate, 0, len(g.Channels))
+	for _, c := range g.Channels {
+		if c.Name == "" {
+			continue
+		}
+		channels = append(channels, b.entClient.DiscordChannel.Create().
+			SetID(c.ID).
+			SetGuildID(g.ID).
+			SetName(c.Name).
+			SetParentID(c.ParentID))
+	}
+	err = b.entClient.DiscordChannel.CreateBulk(channels...).
+		OnConflictColumns(discordchannel.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to sync discord channels", "err", err)
+	}
+}
+
+func (b *DiscordBot) guildRoleCreate(
+	s *discordgo.Session,
+	r *discordgo.GuildRoleCreate,
+) {
+	b.roleChanged(r.GuildRole)
+}
+
+func (b *DiscordBot) guildRoleUpdate(
+	s *discordgo.Session,
+	r *discordgo.GuildRoleUpdate,
+) {
+	b.roleChanged(r.GuildRole)
+}
+
+func (b *DiscordBot) roleChanged(r *discordgo.GuildRole) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := b.entClient.DiscordRole.Create().
+		SetID(r.Role.ID).
+		SetGuildID(r.GuildID).
+		SetName(r.Role.Name).
+		OnConflictColumns(discordrole.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to create discord role", "err", err)
+	}
+}
@@ -0,0 +1,233 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+)
+
+const (
+	// mentionCooldown applies to both the channel and the user that
+	// mentioned the bot.
+	mentionCooldown = 15 * time.Second
+	mentionContext  = 30
+)
+
+// cooldowns remembers until when keys are cooling down.
+type cooldowns struct {
+	mu    sync.Mutex
+	until map[string]time.Time
+}
+
+// ready reports whether none of the keys is cooling down.
+func (c *cooldowns) ready(keys ...string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for _, key := range keys {
+		if now.Before(c.until[key]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// take starts a cooldown of d on all keys, unless one of them is still
+// cooling down.
+func (c *cooldowns) take(d time.Duration, keys ...string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if c.until == nil {
+		c.until = make(map[string]time.Time)
+	}
+	for _, key := range keys {
+		if now.Before(c.until[key]) {
+			return false
+		}
+	}
+	for _, key := range keys {
+		c.until[key] = now.Add(d)
+	}
+
+	return true
+}
+
+// mentionsBot reports whether the message mentions the bot or replies to one
+// of its messages.
+func mentionsBot(s *discordgo.Session, m *discordgo.Message) bool {
+	botID := s.State.User.ID
+	if ref := m.ReferencedMessage; ref != nil && ref.Author != nil && ref.Author.ID == botID {
+		return true
+	}
+	for _, u := range m.Mentions {
+		if u.ID == botID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replyToMention answers a message that mentioned the bot, using the
+// messages before it in the channel as context.
+func (b *DiscordBot) replyToMention(
+	s *discordgo.Session,
+	m *discordgo.Message,
+) {
+	if m.Author == nil || m.Author.Bot {
+		return
+	}
+
+	ctx := context.WithValue(
+		context.Background(),
+		contextkeys.UserIDKey,
+		m.Author.ID,
+	)
+	ctx = context.WithValue(ctx, contextkeys.GuildIDKey, m.GuildID)
+	ctx, cancel := context.WithTimeout(ctx, 45*time.Second)
+	defer cancel()
+	ctx = b.withVisibility(ctx, s, m.GuildID, m.Author.ID, m.ChannelID)
+
+	settings, err := b.channelSettings(ctx, m.ChannelID)
+	if err != nil {
+		b.logger.Error("failed to get channel settings", "err", err)
+		return
+	}
+	if !settings.MentionReplies {
+		return
+	}
+
+	if !b.mentionCooldowns.take(mentionCooldown, "channel:"+m.ChannelID, "user:"+m.Author.ID) {
+		if err := s.MessageReactionAdd(m.ChannelID, m.ID, "⏳"); err != nil {
+			b.logger.Warn("failed to react to message", "err", err)
+		}
+		return
+	}
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: m.Author.ID,
+		Event:      "mention",
+		Properties: posthog.NewProperties().
+			Set("global_name", m.Author.GlobalName),
+	})
+
+	stopTyping := b.keepTyping(s, m.ChannelID)
+	defer stopTyping()
+
+	b.logger.Info("Handling mention", "message", m.ID)
+
+	prompt, err := b.mentionPrompt(ctx, s, m)
+	if err != nil {
+		b.logger.Error("failed to load mention context", "err", err)
+		return
+	}
+
+	resp, err := b.gm.GenerateText(
+		ctx,
+		ai.WithPrompt(prompt),
+		ai.WithTools(b.gm.Tools()...),
+		ai.WithSystem(b.systemPrompt(fmt.Sprintf(
+			"You are <@%s> in this chat. Someone mentioned you or replied to you, write your reply to their last message like a chat message, short and in the flow of the conversation. You have access to a searchable database of all past messages from this server if you need more context.",
+			s.State.User.ID,
+		))),
+	)
+	if err != nil {
+		b.logger.Error("failed to generate text", "err", err)
+		resp = "I'm sorry, I encountered an error and couldn't reply."
+	} else if resp != "" {
+		resp = b.moderate(ctx, "mention", m.GuildID, m.ChannelID, m.Author.ID, resp)
+		if resp == "" {
+			resp = blockedReply
+		}
+	}
+	if resp == "" {
+		return
+	}
+
+	_, err = s.ChannelMessageSendComplex(m.ChannelID, &discordgo.MessageSend{
+		Content:   truncate(resp, 2000),
+		Reference: m.Reference(),
+		AllowedMentions: &discordgo.MessageAllowedMentions{
+			RepliedUser: true,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to send reply", "err", err)
+	}
+}
+
+// mentionPrompt renders the recent messages of the channel before m as a chat
+// log, followed by m itself as the message to reply to.
+func (b *DiscordBot) mentionPrompt(
+	ctx context.Context,
+	s *discordgo.Session,
+	m *discordgo.Message,
+) (string, error) {
+	messages, err := archive.RecentMessages(ctx, b.entClient, archive.RecentParams{
+		GuildID:   m.GuildID,
+		ChannelID: m.ChannelID,
+		Before:    m.Timestamp,
+		Limit:     mentionContext,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var prompt strings.Builder
+	prompt.WriteString("The latest messages in the channel, oldest first:\n")
+	for _, msg := range messages {
+		prompt.WriteString(chatLine(msg) + "\n")
+	}
+
+	// The bot's own messages aren't archived
+	if ref := m.ReferencedMessage; ref != nil && ref.Author != nil && ref.Author.ID == s.State.User.ID {
+		fmt.Fprintf(&prompt, "\nThey replied to this message of yours:\n%s\n", archive.Untrusted(ref.Content))
+	}
+
+	fmt.Fprintf(
+		&prompt,
+		"\nReply to the last message, by %s:\n%s",
+		displayName(m.Author),
+		archive.Untrusted(m.Content),
+	)
+
+	return prompt.String(), nil
+}
+
+// keepTyping shows the typing indicator in a channel until the returned
+// function is called. Discord drops the indicator after 10 seconds, so it has
+// to be sent again while generating.
+func (b *DiscordBot) keepTyping(s *discordgo.Session, channelID string) func() {
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(8 * time.Second)
+		defer ticker.Stop()
+
+		for {
+			if err := s.ChannelTyping(channelID); err != nil {
+				b.logger.Warn("failed to send typing indicator", "err", err)
+			}
+
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
@@ -0,0 +1,184 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+)
+
+// messageActions are the message context-menu commands, keyed by name, with
+// the instructions given to the model for each of them.
+var messageActions = map[string]string{
+	"Explain this": "Someone right-clicked a message and asked you to explain it. Explain what the message means, what it is referring to and any inside joke behind it. Use the message history to dig up the context if needed.",
+	"Roast this":   "Someone right-clicked a message and asked you to roast it. Roast the message and its author, mercilessly but in good fun. Use the message history to find ammunition from their past messages.",
+	"Fact check":   "Someone right-clicked a message and asked you to fact check it. Check the claims made in the message, say plainly which ones are true, false or unverifiable and why. Use the message history if the claims are about the server or its members.",
+}
+
+func (b *DiscordBot) handleMessageAction(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	data := i.ApplicationCommandData()
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "message_action",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("action", data.Name),
+	})
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	target := data.Resolved.Messages[data.TargetID]
+	if target == nil {
+		content := "I can't see that message."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	b.logger.Info("Handling message action", "action", data.Name, "message", target.ID)
+
+	if data.Name == "Roast this" && target.Author != nil && b.profilingOptedOut(ctx, target.Author.ID) {
+		content := "They opted out of being profiled, so the roast is off. Try Explain this."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	subject, err := b.messageSubject(ctx, i.GuildID, target)
+	if err != nil {
+		b.logger.Error("failed to load message reply chain", "err", err)
+	}
+
+	resp, err := b.gm.GenerateText(
+		ctx,
+		ai.WithPrompt(subject),
+		ai.WithTools(b.gm.Tools()...),
+		ai.WithSystem(b.systemPrompt(
+			messageActions[data.Name]+" Please do not ask any follow up questions, just answer to the best of your ability with the information you have.",
+		)),
+	)
+
+	var content string
+	if err != nil {
+		b.logger.Error("failed to generate text", "err", err)
+		content = "I'm sorry, I encountered an error and couldn't process this message."
+	} else if resp == "" {
+		content = "The model chose not to provide a response."
+	} else if content = b.moderate(ctx, "message_action", i.GuildID, i.ChannelID, invokingUser(i).ID, resp); content == "" {
+		content = blockedReply
+	}
+
+	if url := messageURL(i.GuildID, target); url != "" {
+		content = truncate(url+"\n"+content, 2000)
+	}
+
+	b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+}
+
+// profilingOptedOut tells whether the user asked not to be profiled. Errors
+// count as opted out, see optedOut.
+func (b *DiscordBot) profilingOptedOut(ctx context.Context, userID string) bool {
+	user, err := b.entClient.DiscordUser.Get(ctx, userID)
+	if ent.IsNotFound(err) {
+		user, err = &ent.DiscordUser{ID: userID}, nil
+	}
+	if err == nil {
+		var optedOut bool
+		if optedOut, err = archive.ProfilingOptedOut(ctx, b.entClient, user); err == nil {
+			return optedOut
+		}
+	}
+
+	b.logger.ErrorContext(ctx, "failed to look up profiling opt-out", "err", err)
+	return true
+}
+
+// messageSubject renders the right-clicked message, and the reply chain that
+// led to it, as the prompt of a message action.
+func (b *DiscordBot) messageSubject(
+	ctx context.Context,
+	guildID string,
+	target *discordgo.Message,
+) (string, error) {
+	var chain []string
+	content := archive.Untrusted(target.Content)
+	thread, err := archive.Conversation(ctx, b.entClient, guildID, target.ID, 0)
+	switch {
+	case err == nil:
+		content = archive.PromptContent(thread.Anchor)
+		for _, m := range thread.Messages {
+			if m.ID != target.ID && thread.InReplyChain[m.ID] && m.Timestamp.Before(target.Timestamp) {
+				chain = append(chain, chatLine(m))
+			}
+		}
+	case ent.IsNotFound(err):
+		// Not archived, bot messages for instance. Use what Discord sent along.
+		if ref := target.ReferencedMessage; ref != nil && ref.Author != nil {
+			chain = append(chain, fmt.Sprintf("%s: %s", displayName(ref.Author), archive.Untrusted(ref.Content)))
+		}
+		err = nil
+	}
+
+	var prompt strings.Builder
+	if len(chain) > 0 {
+		prompt.WriteString("It was a reply in this conversation, oldest first:\n")
+		prompt.WriteString(strings.Join(chain, "\n"))
+		prompt.WriteString("\n\n")
+	}
+	fmt.Fprintf(
+		&prompt,
+		"The message (ID %s) by %s:\n%s",
+		target.ID,
+		displayName(target.Author),
+		content,
+	)
+
+	return prompt.String(), err
+}
+
+func chatLine(m *ent.DiscordMessage) string {
+	return fmt.Sprintf(
+		"%s %s: %s",
+		m.Timestamp.UTC().Format(time.DateTime),
+		m.Edges.User.GlobalName,
+		archive.PromptContent(m),
+	)
+}
+
+func displayName(u *discordgo.User) string {
+	if u == nil {
+		return "someone"
+	}
+	if u.GlobalName != "" {
+		return u.GlobalName
+	}
+
+	return u.Username
+}
+
+func messageURL(guildID string, m *discordgo.Message) string {
+	if guildID == "" {
+		return ""
+	}
+
+	return "https://discord.com/channels/" + guildID + "/" + m.ChannelID + "/" + m.ID
+}
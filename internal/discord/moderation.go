@@ -0,0 +1,286 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordguildsetting"
+	"sev0/ent/moderationevent"
+	"sev0/internal/moderation"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/posthog/posthog-go"
+)
+
+// blockedReply is posted instead of an answer the moderation blocked.
+const blockedReply = "I came up with something, but thought better of it."
+
+// moderationLogSize is how many events /moderation log shows
+const moderationLogSize = 10
+
+func moderationConfig(settings *ent.DiscordGuildSetting) moderation.Config {
+	return moderation.Config{
+		Denylist:        settings.ModerationDenylist,
+		BlockDenylisted: settings.ModerationDenylistAction == discordguildsetting.ModerationDenylistActionBlock,
+		Redact:          settings.ModerationRedact,
+		Classifier:      settings.ModerationClassifier,
+		FlagThreshold:   settings.ModerationFlagThreshold,
+		BlockThreshold:  settings.ModerationBlockThreshold,
+	}
+}
+
+// moderate runs a generated answer through the moderation of the guild, DMs
+// get the defaults, and records whatever it did. It returns the text to post,
+// which is empty when the answer was blocked.
+func (b *DiscordBot) moderate(
+	ctx context.Context,
+	source string,
+	guildID string,
+	channelID string,
+	userID string,
+	text string,
+) string {
+	settings, err := b.guildSettings(ctx, guildID)
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to load guild settings", "err", err)
+		return ""
+	}
+
+	verdict, err := b.moderator.Check(ctx, moderationConfig(settings), text)
+	if err != nil {
+		// The rules still ran, don't hold the answer back on the classifier
+		b.logger.WarnContext(ctx, "failed to classify answer", "err", err)
+	}
+	if verdict.Action == moderation.ActionAllow {
+		return verdict.Text
+	}
+
+	b.logger.InfoContext(
+		ctx,
+		"Moderated answer",
+		"source", source,
+		"action", verdict.Action,
+		"reasons", verdict.Reasons,
+	)
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: userID,
+		Event:      "moderation",
+		Properties: posthog.NewProperties().
+			Set("guild_id", guildID).
+			Set("source", source).
+			Set("action", string(verdict.Action)),
+	})
+
+	err = b.entClient.ModerationEvent.Create().
+		SetGuildID(guildID).
+		SetChannelID(channelID).
+		SetUserID(userID).
+		SetSource(source).
+		SetAction(moderationevent.Action(verdict.Action)).
+		SetReasons(verdict.Reasons).
+		SetNillableScore(verdict.Score).
+		SetOriginal(verdict.Original).
+		Exec(ctx)
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to store moderation event", "err", err)
+	}
+
+	return verdict.Text
+}
+
+func (b *DiscordBot) handleModeration(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	sub := i.ApplicationCommandData().Options[0]
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "moderation_settings",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("action", sub.Name),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var content string
+	var err error
+	switch sub.Name {
+	case "settings":
+		content, err = b.updateModerationSettings(ctx, i.GuildID, sub.Options)
+	case "denylist":
+		content, err = b.updateDenylist(ctx, i.GuildID, sub.Options)
+	case "log":
+		content, err = b.moderationLog(ctx, i.GuildID)
+	}
+	if err != nil {
+		b.logger.Error("failed to handle moderation command", "action", sub.Name, "err", err)
+		content = "I'm sorry, I encountered an error. Try again in a bit."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: truncate(content, 2000),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
+
+func (b *DiscordBot) updateModerationSettings(
+	ctx context.Context,
+	guildID string,
+	options []*discordgo.ApplicationCommandInteractionDataOption,
+) (string, error) {
+	if len(options) > 0 {
+		create := b.entClient.DiscordGuildSetting.Create().SetID(guildID)
+		var updates []func(u *ent.DiscordGuildSettingUpsert)
+		for _, opt := range options {
+			switch opt.Name {
+			case "redact":
+				create.SetModerationRedact(opt.BoolValue())
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateModerationRedact() })
+			case "classifier":
+				create.SetModerationClassifier(opt.BoolValue())
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateModerationClassifier() })
+			case "flag-threshold":
+				create.SetModerationFlagThreshold(float64(opt.IntValue()) / 100)
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateModerationFlagThreshold() })
+			case "block-threshold":
+				create.SetModerationBlockThreshold(float64(opt.IntValue()) / 100)
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateModerationBlockThreshold() })
+			case "denylist-action":
+				create.SetModerationDenylistAction(discordguildsetting.ModerationDenylistAction(opt.StringValue()))
+				updates = append(updates, func(u *ent.DiscordGuildSettingUpsert) { u.UpdateModerationDenylistAction() })
+			}
+		}
+
+		err := create.
+			OnConflictColumns(discordguildsetting.FieldID).
+			Update(func(u *ent.DiscordGuildSettingUpsert) {
+				for _, update := range updates {
+					update(u)
+				}
+			}).
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	settings, err := b.guildSettings(ctx, guildID)
+	if err != nil {
+		return "", err
+	}
+
+	redact := "left alone"
+	if settings.ModerationRedact {
+		redact = "redacted"
+	}
+	classifier := "off"
+	if settings.ModerationClassifier {
+		classifier = fmt.Sprintf(
+			"on, answers scoring %.0f%% get flagged and %.0f%% get blocked",
+			settings.ModerationFlagThreshold*100,
+			settings.ModerationBlockThreshold*100,
+		)
+	}
+
+	return fmt.Sprintf(
+		"Emails, tokens and keys are %s. Denylisted terms (%d) %s the answer. The classifier is %s.",
+		redact,
+		len(settings.ModerationDenylist),
+		map[discordguildsetting.ModerationDenylistAction]string{
+			discordguildsetting.ModerationDenylistActionMask:  "get masked in",
+			discordguildsetting.ModerationDenylistActionBlock: "block",
+		}[settings.ModerationDenylistAction],
+		classifier,
+	), nil
+}
+
+func (b *DiscordBot) updateDenylist(
+	ctx context.Context,
+	guildID string,
+	options []*discordgo.ApplicationCommandInteractionDataOption,
+) (string, error) {
+	settings, err := b.guildSettings(ctx, guildID)
+	if err != nil {
+		return "", err
+	}
+
+	denylist := slices.Clone(settings.ModerationDenylist)
+	for _, opt := range options {
+		term := strings.ToLower(strings.TrimSpace(opt.StringValue()))
+		if term == "" {
+			continue
+		}
+		switch opt.Name {
+		case "add":
+			if !slices.Contains(denylist, term) {
+				denylist = append(denylist, term)
+			}
+		case "remove":
+			denylist = slices.DeleteFunc(denylist, func(t string) bool { return t == term })
+		}
+	}
+
+	if len(options) > 0 {
+		err := b.entClient.DiscordGuildSetting.Create().
+			SetID(guildID).
+			SetModerationDenylist(denylist).
+			OnConflictColumns(discordguildsetting.FieldID).
+			UpdateModerationDenylist().
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if len(denylist) == 0 {
+		return "The denylist of the server is empty.", nil
+	}
+
+	return "Denylisted terms: ||" + strings.Join(denylist, ", ") + "||", nil
+}
+
+func (b *DiscordBot) moderationLog(
+	ctx context.Context,
+	guildID string,
+) (string, error) {
+	events, err := b.entClient.ModerationEvent.Query().
+		Where(moderationevent.GuildID(guildID)).
+		Order(ent.Desc(moderationevent.FieldCreatedAt)).
+		Limit(moderationLogSize).
+		All(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(events) == 0 {
+		return "Nothing got moderated yet.", nil
+	}
+
+	var sb strings.Builder
+	for _, e := range events {
+		fmt.Fprintf(
+			&sb,
+			"- <t:%d:R> **%s** %s in <#%s>: %s\n",
+			e.CreatedAt.Unix(),
+			e.Action,
+			e.Source,
+			e.ChannelID,
+			strings.Join(e.Reasons, ", "),
+		)
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,161 @@
+package discord
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"sev0/ent/privacyevent"
+	"sev0/ent/privacyoptout"
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/posthog/posthog-go"
+)
+
+// optedOut tells whether a user asked not to be archived. Errors count as
+// opted out, better to miss a message than to store one we shouldn't.
+func (b *DiscordBot) optedOut(ctx context.Context, userID string) bool {
+	optedOut, err := archive.OptedOut(ctx, b.entClient, userID)
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to look up privacy opt-out", "err", err)
+		return true
+	}
+
+	return optedOut
+}
+
+func (b *DiscordBot) handlePrivacy(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	sub := i.ApplicationCommandData().Options[0]
+	user := invokingUser(i)
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: user.ID,
+		Event:      "privacy",
+		Properties: posthog.NewProperties().
+			Set("global_name", user.GlobalName).
+			Set("action", sub.Name),
+	})
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	var content string
+	var action privacyevent.Action
+	records := 0
+	switch sub.Name {
+	case "opt-out":
+		content = "Done, I won't archive anything you say from now on, and what I archived before stays out of my answers. Run `/privacy forget` to also wipe it."
+		action = privacyevent.ActionOptOut
+		err = b.optOut(ctx, user.ID)
+	case "opt-in":
+		content = "Done, I'll archive your messages again."
+		action = privacyevent.ActionOptIn
+		_, err = b.entClient.PrivacyOptOut.Delete().
+			Where(privacyoptout.ID(user.ID)).
+			Exec(ctx)
+	case "export":
+		content = "Done, check your DMs."
+		action = privacyevent.ActionExport
+		records, err = b.exportUser(ctx, s, user.ID)
+	case "forget":
+		if len(sub.Options) == 0 || !sub.Options[0].BoolValue() {
+			content = "This deletes everything I stored about you and can't be undone. Run it again with `confirm: True` if you mean it."
+			break
+		}
+		content = "Done, I forgot everything about you. I won't archive you again unless you run `/privacy opt-in`."
+		action = privacyevent.ActionForget
+		// Opt out first so nothing gets archived while the rest goes
+		if err = b.optOut(ctx, user.ID); err == nil {
+			records, err = archive.ForgetUser(ctx, b.entClient, user.ID)
+		}
+	}
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to handle privacy command", "action", sub.Name, "err", err)
+		content = "I'm sorry, I couldn't do that. Try again in a bit."
+	} else if action != "" {
+		b.recordPrivacyEvent(ctx, i.GuildID, user.ID, action, records)
+	}
+
+	b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+}
+
+func (b *DiscordBot) optOut(ctx context.Context, userID string) error {
+	return b.entClient.PrivacyOptOut.Create().
+		SetID(userID).
+		OnConflictColumns(privacyoptout.FieldID).
+		Ignore().
+		Exec(ctx)
+}
+
+// exportUser DMs the user a JSON file of everything stored about them and
+// returns how many records it holds.
+func (b *DiscordBot) exportUser(
+	ctx context.Context,
+	s *discordgo.Session,
+	userID string,
+) (int, error) {
+	export, err := archive.ExportUser(ctx, b.entClient, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+
+	channel, err := s.UserChannelCreate(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = s.ChannelMessageSendComplex(channel.ID, &discordgo.MessageSend{
+		Content: fmt.Sprintf("Here's everything I have on you, %d records.", export.Records()),
+		Files: []*discordgo.File{{
+			Name:        "sev0-export-" + userID + ".json",
+			ContentType: "application/json",
+			Reader:      bytes.NewReader(data),
+		}},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return export.Records(), nil
+}
+
+func (b *DiscordBot) recordPrivacyEvent(
+	ctx context.Context,
+	guildID string,
+	userID string,
+	action privacyevent.Action,
+	records int,
+) {
+	err := b.entClient.PrivacyEvent.Create().
+		SetUserID(userID).
+		SetGuildID(guildID).
+		SetAction(action).
+		SetRecords(records).
+		Exec(ctx)
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to store privacy event", "err", err)
+	}
+}
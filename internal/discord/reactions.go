@@ -0,0 +1,120 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordreaction"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func (b *DiscordBot) messageReactionAdd(
+	s *discordgo.Session,
+	r *discordgo.MessageReactionAdd,
+) {
+	if r.GuildID == "" {
+		// Ignore DMs
+		return
+	}
+
+	if r.Member != nil && r.Member.User != nil && r.Member.User.Bot {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if b.optedOut(ctx, r.UserID) {
+		return
+	}
+
+	// Reactions can only be attached to messages that were archived
+	exists, err := b.entClient.DiscordMessage.Query().
+		Where(discordmessage.ID(r.MessageID)).
+		Exist(ctx)
+	if err != nil {
+		b.logger.Error("failed to look up reacted message", "err", err)
+		return
+	}
+	if !exists {
+		b.countChimeInReaction(ctx, r.MessageID, 1)
+		return
+	}
+
+	err = b.entClient.DiscordReaction.Create().
+		SetMessageID(r.MessageID).
+		SetUserID(r.UserID).
+		SetEmoji(r.Emoji.APIName()).
+		OnConflictColumns(
+			discordreaction.FieldMessageID,
+			discordreaction.FieldUserID,
+			discordreaction.FieldEmoji,
+		).
+		Ignore().
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to create discord reaction", "err", err)
+	}
+}
+
+func (b *DiscordBot) messageReactionRemove(
+	s *discordgo.Session,
+	r *discordgo.MessageReactionRemove,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	deleted, err := b.entClient.DiscordReaction.Delete().
+		Where(
+			discordreaction.MessageID(r.MessageID),
+			discordreaction.UserID(r.UserID),
+			discordreaction.Emoji(r.Emoji.APIName()),
+		).
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to delete discord reaction", "err", err)
+	}
+	// Only take back what messageReactionAdd counted
+	if deleted == 0 && r.GuildID != "" && !isBot(s, r.GuildID, r.UserID) && !b.optedOut(ctx, r.UserID) {
+		b.countChimeInReaction(ctx, r.MessageID, -1)
+	}
+}
+
+// isBot tells whether a user is a bot, the bot itself included. Removal
+// events don't come with the member like additions do.
+func isBot(s *discordgo.Session, guildID string, userID string) bool {
+	if userID == s.State.User.ID {
+		return true
+	}
+	if m, err := s.State.Member(guildID, userID); err == nil && m.User != nil {
+		return m.User.Bot
+	}
+	u, err := s.User(userID)
+
+	return err == nil && u.Bot
+}
+
+func (b *DiscordBot) messageReactionRemoveAll(
+	s *discordgo.Session,
+	r *discordgo.MessageReactionRemoveAll,
+) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := b.entClient.DiscordReaction.Delete().
+		Where(discordreaction.MessageID(r.MessageID)).
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to delete discord reactions", "err", err)
+	}
+
+	err = b.entClient.DiscordChimeIn.UpdateOneID(r.MessageID).
+		SetReactions(0).
+		Exec(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		b.logger.Error("failed to reset chime-in reactions", "err", err)
+	}
+}
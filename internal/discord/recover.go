@@ -0,0 +1,51 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// recovered wraps a gateway event handler so a panic in it gets logged and
+// reported instead of taking the whole bot down.
+func recovered[T any](b *DiscordBot, h func(*discordgo.Session, T)) func(*discordgo.Session, T) {
+	return func(s *discordgo.Session, e T) {
+		defer func() {
+			if r := recover(); r != nil {
+				b.logPanic(context.Background(), fmt.Sprintf("%T", e), r)
+			}
+		}()
+
+		h(s, e)
+	}
+}
+
+// recoverInteractions is the interaction flavour of recovered, it also lets
+// the user know their command blew up instead of leaving it hanging.
+func (b *DiscordBot) recoverInteractions(next interactionHandler) interactionHandler {
+	return func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+		defer func() {
+			if r := recover(); r != nil {
+				key, _ := b.router.route(i)
+				b.logPanic(ctx, key, r)
+				b.respondError(s, i, "I'm sorry, something broke while handling that.")
+			}
+		}()
+
+		next(ctx, s, i)
+	}
+}
+
+// logPanic logs at error level, which the PostHog slog handler also captures
+// as an exception attributed to the user in ctx.
+func (b *DiscordBot) logPanic(ctx context.Context, handler string, r any) {
+	b.logger.ErrorContext(
+		ctx,
+		"panic in handler",
+		"handler", handler,
+		"panic", fmt.Sprint(r),
+		"stack", string(debug.Stack()),
+	)
+}
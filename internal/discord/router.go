@@ -0,0 +1,263 @@
+package discord
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"sev0/internal/contextkeys"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+type interactionHandler func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate)
+
+// middleware wraps an interactionHandler, it runs for every interaction the
+// router dispatches or only for a single route.
+type middleware func(next interactionHandler) interactionHandler
+
+// router dispatches interactions to their handler by type: application
+// commands and autocomplete requests by command name, components and modal
+// submits by the custom ID prefix before the first ":".
+type router struct {
+	commands     map[string]interactionHandler
+	autocomplete map[string]interactionHandler
+	components   map[string]interactionHandler
+	modals       map[string]interactionHandler
+	middleware   []middleware
+}
+
+func newRouter(mw ...middleware) *router {
+	return &router{
+		commands:     make(map[string]interactionHandler),
+		autocomplete: make(map[string]interactionHandler),
+		components:   make(map[string]interactionHandler),
+		modals:       make(map[string]interactionHandler),
+		middleware:   mw,
+	}
+}
+
+func (r *router) command(name string, h interactionHandler, mw ...middleware) {
+	r.commands[name] = chain(h, mw)
+}
+
+func (r *router) autocompleteFor(name string, h interactionHandler, mw ...middleware) {
+	r.autocomplete[name] = chain(h, mw)
+}
+
+func (r *router) component(prefix string, h interactionHandler, mw ...middleware) {
+	r.components[prefix] = chain(h, mw)
+}
+
+func (r *router) modal(prefix string, h interactionHandler, mw ...middleware) {
+	r.modals[prefix] = chain(h, mw)
+}
+
+// route finds the handler for an interaction along with the key it was
+// registered under.
+func (r *router) route(i *discordgo.InteractionCreate) (string, interactionHandler) {
+	switch i.Type {
+	case discordgo.InteractionApplicationCommand:
+		name := i.ApplicationCommandData().Name
+		return name, r.commands[name]
+	case discordgo.InteractionApplicationCommandAutocomplete:
+		name := i.ApplicationCommandData().Name
+		return name, r.autocomplete[name]
+	case discordgo.InteractionMessageComponent:
+		prefix, _, _ := strings.Cut(i.MessageComponentData().CustomID, ":")
+		return prefix, r.components[prefix]
+	case discordgo.InteractionModalSubmit:
+		prefix, _, _ := strings.Cut(i.ModalSubmitData().CustomID, ":")
+		return prefix, r.modals[prefix]
+	}
+
+	return "", nil
+}
+
+func chain(h interactionHandler, mw []middleware) interactionHandler {
+	for idx := len(mw) - 1; idx >= 0; idx-- {
+		h = mw[idx](h)
+	}
+	return h
+}
+
+func (b *DiscordBot) interactionCreate(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	// Create a context with the user ID for logging and tracing.
+	ctx := context.WithValue(
+		context.Background(),
+		contextkeys.UserIDKey,
+		invokingUser(i).ID,
+	)
+	ctx = context.WithValue(ctx, contextkeys.GuildIDKey, i.GuildID)
+
+	chain(b.dispatch, b.router.middleware)(ctx, s, i)
+}
+
+// invokingUser is the user behind an interaction. Member is only set for
+// interactions in a guild, in DMs and user installed contexts it's User.
+func invokingUser(i *discordgo.InteractionCreate) *discordgo.User {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User
+	}
+	return i.User
+}
+
+func (b *DiscordBot) dispatch(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	key, h := b.router.route(i)
+	if h == nil {
+		b.logger.Warn("no handler for interaction", "type", i.Type, "key", key)
+		if i.Type == discordgo.InteractionMessageComponent || i.Type == discordgo.InteractionModalSubmit {
+			b.respondError(s, i, "This doesn't work anymore, run the command again.")
+		}
+		return
+	}
+
+	h(ctx, s, i)
+}
+
+// respondError tells the user something went wrong, whether or not the
+// interaction was already acknowledged.
+func (b *DiscordBot) respondError(
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+	content string,
+) {
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		// Autocomplete can only answer with choices
+		return
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err == nil {
+		return
+	}
+
+	// Already deferred or answered, so the response has to be edited instead
+	_, err = s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Content: &content,
+	})
+	if err != nil {
+		b.logger.Error("failed to respond with error", "err", err)
+	}
+}
+
+func (b *DiscordBot) logInteractions(next interactionHandler) interactionHandler {
+	return func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+		start := time.Now()
+		next(ctx, s, i)
+		key, _ := b.router.route(i)
+		b.logger.Info(
+			"Handled interaction",
+			"type", i.Type,
+			"key", key,
+			"user", ctx.Value(contextkeys.UserIDKey),
+			"guild", i.GuildID,
+			"took", time.Since(start),
+		)
+	}
+}
+
+// guildOnly turns away interactions from DMs, for handlers that look at the
+// history of the guild they're invoked in.
+func (b *DiscordBot) guildOnly(next interactionHandler) interactionHandler {
+	return func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+		if i.GuildID == "" {
+			b.respondError(s, i, "This only works in a server.")
+			return
+		}
+
+		next(ctx, s, i)
+	}
+}
+
+// requirePermissions only lets members through who have all of the given
+// permissions in the channel the interaction came from. Discord already
+// hides commands with DefaultMemberPermissions, but components and modals
+// have no such thing.
+func (b *DiscordBot) requirePermissions(perms int64) middleware {
+	return func(next interactionHandler) interactionHandler {
+		return func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+			if i.Member == nil || i.Member.Permissions&perms != perms {
+				b.respondError(s, i, "You're not allowed to do that.")
+				return
+			}
+
+			next(ctx, s, i)
+		}
+	}
+}
+
+const (
+	rateLimitBurst  = 5
+	rateLimitWindow = 30 * time.Second
+)
+
+// rateLimiter allows each user a burst of interactions per sliding window.
+type rateLimiter struct {
+	mu   sync.Mutex
+	hits map[string][]time.Time
+	// swept is when users without recent hits were last dropped
+	swept time.Time
+}
+
+func (rl *rateLimiter) allow(userID string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	if rl.hits == nil {
+		rl.hits = make(map[string][]time.Time)
+	}
+	if now.Sub(rl.swept) >= rateLimitWindow {
+		for id, hits := range rl.hits {
+			if now.Sub(hits[len(hits)-1]) >= rateLimitWindow {
+				delete(rl.hits, id)
+			}
+		}
+		rl.swept = now
+	}
+
+	recent := rl.hits[userID][:0]
+	for _, t := range rl.hits[userID] {
+		if now.Sub(t) < rateLimitWindow {
+			recent = append(recent, t)
+		}
+	}
+	if len(recent) >= rateLimitBurst {
+		rl.hits[userID] = recent
+		return false
+	}
+
+	rl.hits[userID] = append(recent, now)
+	return true
+}
+
+// rateLimit throttles commands and modal submits, which is where the model
+// calls happen. Buttons and autocomplete are cheap and fire a lot.
+func (b *DiscordBot) rateLimit(next interactionHandler) interactionHandler {
+	return func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+		if i.Type == discordgo.InteractionApplicationCommand || i.Type == discordgo.InteractionModalSubmit {
+			userID, _ := ctx.Value(contextkeys.UserIDKey).(string)
+			if !b.limiter.allow(userID) {
+				b.respondError(s, i, "Slow down, give me a few seconds.")
+				return
+			}
+		}
+
+		next(ctx, s, i)
+	}
+}
@@ -0,0 +1,82 @@
+package discord
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+func TestRouterDispatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     discordgo.InteractionType
+		data    discordgo.InteractionData
+		handler string
+	}{
+		{
+			name:    "command",
+			typ:     discordgo.InteractionApplicationCommand,
+			data:    discordgo.ApplicationCommandInteractionData{Name: "ask"},
+			handler: "ask command",
+		},
+		{
+			name:    "autocomplete",
+			typ:     discordgo.InteractionApplicationCommandAutocomplete,
+			data:    discordgo.ApplicationCommandInteractionData{Name: "ask"},
+			handler: "ask autocomplete",
+		},
+		{
+			name:    "component",
+			typ:     discordgo.InteractionMessageComponent,
+			data:    discordgo.MessageComponentInteractionData{CustomID: "search:key:2"},
+			handler: "search component",
+		},
+		{
+			name:    "modal submit",
+			typ:     discordgo.InteractionModalSubmit,
+			data:    discordgo.ModalSubmitInteractionData{CustomID: "feedback:123"},
+			handler: "feedback modal",
+		},
+		{
+			name: "autocomplete without a handler",
+			typ:  discordgo.InteractionApplicationCommandAutocomplete,
+			data: discordgo.ApplicationCommandInteractionData{Name: "search"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called []string
+			record := func(name string) interactionHandler {
+				return func(context.Context, *discordgo.Session, *discordgo.InteractionCreate) {
+					called = append(called, name)
+				}
+			}
+
+			b := &DiscordBot{logger: slog.New(slog.DiscardHandler)}
+			b.router = newRouter(b.recoverInteractions, b.logInteractions, b.rateLimit)
+			b.router.command("ask", record("ask command"))
+			b.router.autocompleteFor("ask", record("ask autocomplete"))
+			b.router.component("search", record("search component"))
+			b.router.modal("feedback", record("feedback modal"))
+
+			i := &discordgo.InteractionCreate{Interaction: &discordgo.Interaction{
+				Type: tt.typ,
+				Data: tt.data,
+				User: &discordgo.User{ID: "1"},
+			}}
+			chain(b.dispatch, b.router.middleware)(t.Context(), nil, i)
+
+			var want []string
+			if tt.handler != "" {
+				want = []string{tt.handler}
+			}
+			if !slices.Equal(called, want) {
+				t.Errorf("called %v, want %v", called, want)
+			}
+		})
+	}
+}
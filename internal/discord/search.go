@@ -0,0 +1,288 @@
+package discord
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/posthog/posthog-go"
+)
+
+const (
+	searchMaxResults = 50
+	searchPageSize   = 5
+	// searchSessionTTL is how long the pagination buttons of a search work
+	searchSessionTTL = 15 * time.Minute
+)
+
+type searchSession struct {
+	query   string
+	results []archive.SearchResult
+	expires time.Time
+}
+
+// searchSessions keeps the results of recent searches around so the
+// pagination buttons don't have to run the search again.
+type searchSessions struct {
+	mu       sync.Mutex
+	sessions map[string]*searchSession
+}
+
+func (ss *searchSessions) put(session *searchSession) string {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	now := time.Now()
+	for key, s := range ss.sessions {
+		if now.After(s.expires) {
+			delete(ss.sessions, key)
+		}
+	}
+
+	buf := make([]byte, 8)
+	_, _ = rand.Read(buf)
+	key := hex.EncodeToString(buf)
+
+	if ss.sessions == nil {
+		ss.sessions = make(map[string]*searchSession)
+	}
+	ss.sessions[key] = session
+
+	return key
+}
+
+func (ss *searchSessions) get(key string) (*searchSession, bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	s, ok := ss.sessions[key]
+	if !ok || time.Now().After(s.expires) {
+		return nil, false
+	}
+
+	return s, true
+}
+
+func (b *DiscordBot) handleSearch(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "search",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName),
+	})
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Flags: discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	// The results are only shown to the invoker
+	ctx = b.privately(ctx, s, i)
+
+	params := archive.SearchParams{
+		GuildID: i.GuildID,
+		Limit:   searchMaxResults,
+	}
+	var query string
+	mode := "hybrid"
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "query":
+			query = opt.StringValue()
+		case "from":
+			params.AuthorIDs = []string{opt.Value.(string)}
+		case "in":
+			params.ChannelID = opt.Value.(string)
+		case "mode":
+			mode = opt.StringValue()
+		case "before", "after":
+			t, err := time.Parse(time.DateOnly, opt.StringValue())
+			if err != nil {
+				content := fmt.Sprintf("`%s` should be a date like 2024-12-31.", opt.Name)
+				b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+				return
+			}
+			// Same as Discord's own search, the given day itself is excluded
+			if opt.Name == "before" {
+				params.Before = t
+			} else {
+				params.After = t.AddDate(0, 0, 1)
+			}
+		}
+	}
+
+	b.logger.Info("Handling search command", "query", query, "mode", mode)
+
+	if len(params.AuthorIDs) > 0 && b.profilingOptedOut(ctx, params.AuthorIDs[0]) {
+		content := "They opted out of being profiled, their messages can't be looked up by author."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	results, err := b.search(ctx, query, mode, params)
+	if err != nil {
+		b.logger.Error("failed to search messages", "err", err)
+		content := "I'm sorry, the search blew up. Try again in a bit."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	if len(results) == 0 {
+		content := "Nothing matched. Either nobody said it or you're misremembering."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	session := &searchSession{
+		query:   query,
+		results: results,
+		expires: time.Now().Add(searchSessionTTL),
+	}
+	key := b.searches.put(session)
+
+	embeds, components := renderSearchPage(key, session, 0)
+	b.editResponse(s, i, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	})
+}
+
+func (b *DiscordBot) search(
+	ctx context.Context,
+	query string,
+	mode string,
+	params archive.SearchParams,
+) ([]archive.SearchResult, error) {
+	if mode == "keyword" {
+		return archive.KeywordSearch(ctx, b.entClient, query, params)
+	}
+
+	vector, model, err := b.embeddings.EmbedQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == "semantic" {
+		return archive.SemanticSearch(ctx, b.entClient, vector, model, params)
+	}
+
+	return archive.HybridSearch(ctx, b.entClient, query, vector, model, params)
+}
+
+// handleSearchPage flips through the pages of a previous search. The custom
+// ID of the buttons is "search:<session key>:<page>".
+func (b *DiscordBot) handleSearchPage(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	if len(parts) != 3 {
+		return
+	}
+	page, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return
+	}
+
+	response := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{},
+	}
+
+	session, ok := b.searches.get(parts[1])
+	if ok {
+		embeds, components := renderSearchPage(parts[1], session, page)
+		response.Data.Embeds = embeds
+		response.Data.Components = components
+	} else {
+		response.Data.Content = "This search expired, run /search again."
+		response.Data.Embeds = []*discordgo.MessageEmbed{}
+		response.Data.Components = []discordgo.MessageComponent{}
+	}
+
+	if err := s.InteractionRespond(i.Interaction, response); err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
+
+func renderSearchPage(
+	key string,
+	session *searchSession,
+	page int,
+) ([]*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	pages := (len(session.results) + searchPageSize - 1) / searchPageSize
+	page = min(max(page, 0), pages-1)
+
+	start := page * searchPageSize
+	end := min(start+searchPageSize, len(session.results))
+
+	var desc strings.Builder
+	for _, r := range session.results[start:end] {
+		m := r.Message
+		fmt.Fprintf(&desc, "**%s** · <t:%d:R>", m.Edges.User.GlobalName, m.Timestamp.Unix())
+		if m.ChannelID != "" {
+			desc.WriteString(" · <#" + m.ChannelID + ">")
+		}
+		if url := archive.JumpURL(m); url != "" {
+			desc.WriteString(" · [Jump](" + url + ")")
+		}
+		desc.WriteString("\n")
+
+		text := r.Snippet
+		if text == "" {
+			text = archive.RenderContent(m)
+		}
+		desc.WriteString(quote(text, 300) + "\n")
+	}
+
+	embeds := []*discordgo.MessageEmbed{{
+		Title:       truncate("🔎 "+session.query, 256),
+		Description: truncate(desc.String(), 4096),
+		Color:       0x5865F2,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Page %d/%d · %d results", page+1, pages, len(session.results)),
+		},
+	}}
+
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{
+			Components: []discordgo.MessageComponent{
+				discordgo.Button{
+					Label:    "Previous",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("search:%s:%d", key, page-1),
+					Disabled: page == 0,
+				},
+				discordgo.Button{
+					Label:    "Next",
+					Style:    discordgo.SecondaryButton,
+					CustomID: fmt.Sprintf("search:%s:%d", key, page+1),
+					Disabled: page >= pages-1,
+				},
+			},
+		},
+	}
+
+	return embeds, components
+}
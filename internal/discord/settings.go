@@ -0,0 +1,130 @@
+package discord
+
+import (
+	"context"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchannelsetting"
+	"sev0/ent/discordguildsetting"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/posthog/posthog-go"
+)
+
+// channelSettings returns the settings of a channel, or the defaults when
+// nobody changed them yet.
+func (b *DiscordBot) channelSettings(
+	ctx context.Context,
+	channelID string,
+) (*ent.DiscordChannelSetting, error) {
+	settings, err := b.entClient.DiscordChannelSetting.Get(ctx, channelID)
+	if ent.IsNotFound(err) {
+		return &ent.DiscordChannelSetting{
+			ID:             channelID,
+			MentionReplies: discordchannelsetting.DefaultMentionReplies,
+			ChimeIn:        discordchannelsetting.DefaultChimeIn,
+		}, nil
+	}
+
+	return settings, err
+}
+
+// guildSettings returns the settings of a guild, or the defaults when nobody
+// changed them yet.
+func (b *DiscordBot) guildSettings(
+	ctx context.Context,
+	guildID string,
+) (*ent.DiscordGuildSetting, error) {
+	settings, err := b.entClient.DiscordGuildSetting.Get(ctx, guildID)
+	if ent.IsNotFound(err) {
+		return &ent.DiscordGuildSetting{
+			ID:                 guildID,
+			ChimeIn:            discordguildsetting.DefaultChimeIn,
+			ChimeInProbability: discordguildsetting.DefaultChimeInProbability,
+			ChimeInThreshold:   discordguildsetting.DefaultChimeInThreshold,
+			ChimeInCooldown:    discordguildsetting.DefaultChimeInCooldown,
+
+			ModerationDenylistAction: discordguildsetting.DefaultModerationDenylistAction,
+			ModerationRedact:         discordguildsetting.DefaultModerationRedact,
+			ModerationClassifier:     discordguildsetting.DefaultModerationClassifier,
+			ModerationFlagThreshold:  discordguildsetting.DefaultModerationFlagThreshold,
+			ModerationBlockThreshold: discordguildsetting.DefaultModerationBlockThreshold,
+		}, nil
+	}
+
+	return settings, err
+}
+
+func (b *DiscordBot) handleChannelSettings(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	sub := i.ApplicationCommandData().Options[0]
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "channel_settings",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("setting", sub.Name),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	channelID := i.ChannelID
+	var enabled bool
+	for _, opt := range sub.Options {
+		switch opt.Name {
+		case "channel":
+			channelID = opt.Value.(string)
+		case "enabled":
+			enabled = opt.BoolValue()
+		}
+	}
+
+	create := b.entClient.DiscordChannelSetting.Create().
+		SetID(channelID).
+		SetGuildID(i.GuildID)
+
+	var content string
+	var update func(u *ent.DiscordChannelSettingUpsert)
+	switch sub.Name {
+	case "mentions":
+		create.SetMentionReplies(enabled)
+		update = func(u *ent.DiscordChannelSettingUpsert) { u.UpdateMentionReplies() }
+		content = "I'll answer mentions and replies in <#" + channelID + "> again."
+		if !enabled {
+			content = "I'll keep quiet when mentioned in <#" + channelID + ">."
+		}
+	case "chime-in":
+		create.SetChimeIn(enabled)
+		update = func(u *ent.DiscordChannelSettingUpsert) { u.UpdateChimeIn() }
+		content = "I'll chime in on conversations in <#" + channelID + "> when the server allows it."
+		if !enabled {
+			content = "I'll only talk in <#" + channelID + "> when spoken to."
+		}
+	}
+
+	err := create.
+		OnConflictColumns(discordchannelsetting.FieldID).
+		Update(update).
+		Exec(ctx)
+	if err != nil {
+		b.logger.Error("failed to update channel settings", "setting", sub.Name, "err", err)
+		content = "I'm sorry, I couldn't save that. Try again in a bit."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
@@ -0,0 +1,239 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultSummaryMessages = 200
+	maxSummaryMessages     = 2000
+	// summaryChunkSize is roughly how many characters of chat log go into a
+	// single model call before switching to map-reduce
+	summaryChunkSize = 24000
+)
+
+var citationRe = regexp.MustCompile(`\[(\d+)\]`)
+
+func (b *DiscordBot) handleSummarize(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "summarize",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName),
+	})
+
+	params := archive.RecentParams{
+		GuildID:   i.GuildID,
+		ChannelID: i.ChannelID,
+		Limit:     defaultSummaryMessages,
+	}
+	public := false
+	var byCount, byHours bool
+	for _, opt := range i.ApplicationCommandData().Options {
+		switch opt.Name {
+		case "channel":
+			params.ChannelID = opt.Value.(string)
+		case "messages":
+			byCount = true
+			params.Limit = int(opt.IntValue())
+		case "hours":
+			byHours = true
+			// Setting both ends of the window gets the latest messages in it
+			// rather than the first ones
+			params.Before = time.Now()
+			params.After = params.Before.Add(-time.Duration(opt.IntValue()) * time.Hour)
+			params.Limit = maxSummaryMessages
+		case "public":
+			public = opt.BoolValue()
+		}
+	}
+
+	if byCount && byHours {
+		b.respondError(s, i, "Pick either `messages` or `hours`, not both.")
+		return
+	}
+
+	// The channel option can point anywhere in the guild, only summarize what
+	// the invoker could read themselves
+	if params.ChannelID != i.ChannelID {
+		const read = discordgo.PermissionViewChannel | discordgo.PermissionReadMessageHistory
+		perms, err := s.UserChannelPermissions(invokingUser(i).ID, params.ChannelID)
+		if err != nil || perms&read != read {
+			b.respondError(s, i, "You can't read that channel, so neither can I.")
+			return
+		}
+		// Posting the summary here would show it to people who can't read
+		// the channel it's about, keep it to the invoker then
+		if public && !b.audienceCanView(s, i.ChannelID, params.ChannelID) {
+			public = false
+		}
+	}
+
+	response := &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{},
+	}
+	if !public {
+		response.Data.Flags = discordgo.MessageFlagsEphemeral
+	}
+	if err := s.InteractionRespond(i.Interaction, response); err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 3*time.Minute)
+	defer cancel()
+	if !public {
+		ctx = b.privately(ctx, s, i)
+	}
+
+	b.logger.Info(
+		"Handling summarize command",
+		"channel", params.ChannelID,
+		"limit", params.Limit,
+	)
+
+	messages, err := archive.RecentMessages(ctx, b.entClient, params)
+	if err != nil {
+		b.logger.Error("failed to load messages to summarize", "err", err)
+		content := "I'm sorry, I couldn't load the messages to summarize."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	if len(messages) == 0 {
+		content := "Nothing to summarize, it's been dead quiet."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	summary, err := b.summarize(ctx, messages)
+	if err != nil {
+		b.logger.Error("failed to summarize messages", "err", err)
+		content := "I'm sorry, I encountered an error and couldn't summarize this."
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+		return
+	}
+
+	summary = b.moderate(ctx, "summarize", i.GuildID, i.ChannelID, invokingUser(i).ID, summary)
+	if summary == "" {
+		b.editResponse(s, i, &discordgo.WebhookEdit{Content: lo.ToPtr(blockedReply)})
+		return
+	}
+
+	embeds := []*discordgo.MessageEmbed{{
+		Title: fmt.Sprintf("📜 Catch-up on %d messages", len(messages)),
+		Description: truncate(
+			linkCitations(summary, messages),
+			4096,
+		),
+		Color: 0x2ECC71,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf(
+				"%s → %s",
+				messages[0].Timestamp.UTC().Format("Jan 2 15:04"),
+				messages[len(messages)-1].Timestamp.UTC().Format("Jan 2 15:04 UTC"),
+			),
+		},
+	}}
+	b.editResponse(s, i, &discordgo.WebhookEdit{Embeds: &embeds})
+}
+
+// summarize condenses a chat log. Logs that don't fit a single call are split
+// into chunks that are summarized on their own first and merged after.
+func (b *DiscordBot) summarize(
+	ctx context.Context,
+	messages []*ent.DiscordMessage,
+) (string, error) {
+	chunks := chunkChatLog(messages, summaryChunkSize)
+
+	notes := chunks
+	if len(chunks) > 1 {
+		notes = make([]string, 0, len(chunks))
+		for n, chunk := range chunks {
+			note, err := b.gm.GenerateText(
+				ctx,
+				ai.WithPrompt(chunk),
+				ai.WithSystem(fmt.Sprintf(
+					"You are taking notes on part %d of %d of a Discord chat log. Every line starts with a message number in square brackets. List the topics discussed, decisions made and the most notable messages, keeping the [number] of the messages you mention. Be factual and terse. %s",
+					n+1,
+					len(chunks),
+					archive.UntrustedInstructions,
+				)),
+			)
+			if err != nil {
+				return "", err
+			}
+			notes = append(notes, note)
+		}
+	}
+
+	return b.gm.GenerateText(
+		ctx,
+		ai.WithPrompt(strings.Join(notes, "\n\n---\n\n")),
+		ai.WithSystem(b.systemPrompt(
+			"Someone is catching up on a Discord channel and asked you for a summary of what they missed. You are given the chat log, or notes taken on parts of it, where messages are referred to by [number]. Write a short summary with three sections in Discord markdown: **Key topics**, **Decisions** and **Notable messages**. Cite messages by their [number] so they can be linked. Skip a section if there is nothing to put in it.",
+		)),
+	)
+}
+
+// chunkChatLog renders messages as numbered lines and splits them into chunks
+// of about size characters. Numbers start at 1 and are shared by all chunks.
+func chunkChatLog(messages []*ent.DiscordMessage, size int) []string {
+	var (
+		chunks  []string
+		current strings.Builder
+	)
+	for n, m := range messages {
+		line := fmt.Sprintf(
+			"[%d] %s %s: %s\n",
+			n+1,
+			m.Timestamp.UTC().Format("Jan 2 15:04"),
+			m.Edges.User.GlobalName,
+			archive.PromptContent(m),
+		)
+		if current.Len() > 0 && current.Len()+len(line) > size {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+		current.WriteString(line)
+	}
+	if current.Len() > 0 {
+		chunks = append(chunks, current.String())
+	}
+
+	return chunks
+}
+
+// linkCitations turns the [number] references of a summary into jump links
+// to the messages.
+func linkCitations(summary string, messages []*ent.DiscordMessage) string {
+	return citationRe.ReplaceAllStringFunc(summary, func(ref string) string {
+		n, err := strconv.Atoi(citationRe.FindStringSubmatch(ref)[1])
+		if err != nil || n < 1 || n > len(messages) {
+			return ref
+		}
+		if url := archive.JumpURL(messages[n-1]); url != "" {
+			return "[" + ref + "](" + url + ")"
+		}
+
+		return ref
+	})
+}
@@ -0,0 +1,190 @@
+package discord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/firebase/genkit/go/ai"
+	"github.com/posthog/posthog-go"
+	"github.com/samber/lo"
+)
+
+func (b *DiscordBot) handleWhatsTheirDeal(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	data := i.ApplicationCommandData()
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "whats_their_deal",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName),
+	})
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	})
+	if err != nil {
+		b.logger.Error("failed to defer interaction", "err", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	b.logger.Info("Handling what's their deal", "user", data.TargetID)
+
+	var profile *archive.Profile
+	embedder, err := b.embeddings.Active(ctx)
+	if err == nil {
+		profile, err = archive.UserProfile(ctx, b.entClient, archive.ProfileParams{
+			GuildID:        i.GuildID,
+			UserID:         data.TargetID,
+			EmbeddingModel: embedder.Name(),
+		})
+	}
+	var content string
+	switch {
+	case errors.Is(err, archive.ErrProfilingOptOut):
+		content = fmt.Sprintf("<@%s> opted out of being profiled. Their deal is privacy.", data.TargetID)
+	case ent.IsNotFound(err):
+		content = fmt.Sprintf("I've got nothing on <@%s>. Lurker behavior.", data.TargetID)
+	case err != nil:
+		b.logger.Error("failed to build user profile", "err", err)
+		content = "I'm sorry, I encountered an error and couldn't profile them."
+	case profile.MessageCount == 0:
+		content = fmt.Sprintf("<@%s> never said a word here. Lurker behavior.", data.TargetID)
+	default:
+		resp, err := b.gm.GenerateText(
+			ctx,
+			ai.WithPrompt(renderProfile(profile)),
+			ai.WithSystem(b.systemPrompt(
+				"Someone right-clicked a member and asked what their deal is. Using the profile built from their message history, write a short, funny character sketch of them: what they are always on about, when and where they show up, and what the server loves them for. Name the topics from the example messages. Quote them when it's funny. Please do not ask any follow up questions.",
+			)),
+		)
+		if err != nil {
+			b.logger.Error("failed to generate text", "err", err)
+			content = "I'm sorry, I encountered an error and couldn't profile them."
+		} else if resp == "" {
+			content = "The model chose not to provide a response."
+		} else if content = b.moderate(ctx, "whats_their_deal", i.GuildID, i.ChannelID, invokingUser(i).ID, resp); content == "" {
+			content = blockedReply
+		}
+	}
+
+	b.editResponse(s, i, &discordgo.WebhookEdit{Content: &content})
+}
+
+// renderProfile writes a profile down as plain text for the model.
+func renderProfile(p *archive.Profile) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "Member: %s (@%s)", p.User.GlobalName, p.User.Username)
+	if len(p.User.Edges.Memberships) > 0 && p.User.Edges.Memberships[0].Nickname != "" {
+		fmt.Fprintf(&sb, ", nicknamed %s", p.User.Edges.Memberships[0].Nickname)
+	}
+	fmt.Fprintf(
+		&sb,
+		"\nMessages: %d, from %s to %s\n",
+		p.MessageCount,
+		p.FirstMessage.UTC().Format(time.DateOnly),
+		p.LastMessage.UTC().Format(time.DateOnly),
+	)
+
+	fmt.Fprintf(
+		&sb,
+		"Most active hours (UTC): %s\n",
+		strings.Join(lo.Map(p.ActiveHours, func(h archive.HourCount, _ int) string {
+			return strconv.Itoa(h.Hour) + "h"
+		}), ", "),
+	)
+	fmt.Fprintf(
+		&sb,
+		"Most active channels: %s\n",
+		strings.Join(lo.Map(p.ActiveChannels, func(c archive.ChannelCount, _ int) string {
+			return fmt.Sprintf("#%s (%d)", c.Name, c.Count)
+		}), ", "),
+	)
+
+	if len(p.Topics) > 0 {
+		sb.WriteString("\nTopics, as clusters of similar messages:\n")
+		for _, t := range p.Topics {
+			fmt.Fprintf(&sb, "- %d messages, for example:\n", t.Size)
+			for _, m := range t.Examples {
+				fmt.Fprintf(&sb, "  > %s\n", archive.PromptContent(m))
+			}
+		}
+	}
+
+	if len(p.TopReacted) > 0 {
+		sb.WriteString("\nMost reacted messages:\n")
+		for _, rm := range p.TopReacted {
+			fmt.Fprintf(&sb, "- (%d reactions) %s\n", rm.Total, archive.PromptContent(rm.Message))
+		}
+	}
+
+	if len(p.Quotes) > 0 {
+		sb.WriteString("\nRepresentative quotes:\n")
+		for _, m := range p.Quotes {
+			fmt.Fprintf(&sb, "- %s\n", archive.PromptContent(m))
+		}
+	}
+
+	return sb.String()
+}
+
+func (b *DiscordBot) handleProfiling(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	optOut := i.ApplicationCommandData().Options[0].Name == "opt-out"
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "profiling",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("opt_out", optOut),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	content := "Done, I won't profile you anymore."
+	if !optOut {
+		content = "Done, you're fair game again."
+	}
+
+	userID, err := b.upsertUser(ctx, invokingUser(i))
+	if err == nil {
+		err = b.entClient.DiscordUser.UpdateOneID(userID).
+			SetProfilingOptOut(optOut).
+			Exec(ctx)
+	}
+	if err != nil {
+		b.logger.Error("failed to update profiling opt-out", "err", err)
+		content = "I'm sorry, I couldn't save that. Try again in a bit."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: content,
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
@@ -0,0 +1,402 @@
+package discord
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordguildsetting"
+	"sev0/internal/archive"
+
+	"github.com/bwmarrin/discordgo"
+	"github.com/posthog/posthog-go"
+	"github.com/samber/lo"
+)
+
+// statePath is the channel followed by its parents as known by the state
+// cache, see archive.ChannelPaths.
+func statePath(s *discordgo.Session, channelID string) []string {
+	path := []string{channelID}
+	for id := channelID; len(path) < 3; {
+		ch, err := s.State.Channel(id)
+		if err != nil || ch.ParentID == "" {
+			break
+		}
+		path = append(path, ch.ParentID)
+		id = ch.ParentID
+	}
+
+	return path
+}
+
+// ingested tells whether the guild archives the messages of the channel with
+// the given path.
+func ingested(settings *ent.DiscordGuildSetting, path []string) bool {
+	if len(settings.IngestAllowlist) > 0 && !lo.Some(path, settings.IngestAllowlist) {
+		return false
+	}
+
+	return !lo.Some(path, settings.IngestDenylist)
+}
+
+// shouldIngest tells whether a message of the channel may be archived.
+// Errors count as no, better to miss a message than to store one we
+// shouldn't.
+func (b *DiscordBot) shouldIngest(
+	ctx context.Context,
+	s *discordgo.Session,
+	guildID string,
+	channelID string,
+) bool {
+	settings, err := b.guildSettings(ctx, guildID)
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to load guild settings", "err", err)
+		return false
+	}
+
+	return ingested(settings, statePath(s, channelID))
+}
+
+// visibility hides the channels the answer to an interaction must not come
+// from for the rest of it. Answers are taken to be posted in the channel of
+// the interaction, handlers that answer ephemerally widen it with privately.
+func (b *DiscordBot) visibility(next interactionHandler) interactionHandler {
+	return func(ctx context.Context, s *discordgo.Session, i *discordgo.InteractionCreate) {
+		next(b.withVisibility(ctx, s, i.GuildID, invokingUser(i).ID, i.ChannelID), s, i)
+	}
+}
+
+// privately is visibility for an answer only the invoking user sees.
+func (b *DiscordBot) privately(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) context.Context {
+	return b.withVisibility(ctx, s, i.GuildID, invokingUser(i).ID, "")
+}
+
+// withVisibility hides, for what's left of ctx, the archived channels that
+// aren't ingested, that are denied to retrieval, or that are restricted and
+// that the readers of the answer can't all see. The readers are the user and
+// whoever reads the destination channel, the destination is empty when the
+// answer is only for the user and the user is empty when nobody asked for it.
+// Outside of a guild the tools can reach every guild, so the settings of
+// every guild apply, and only the user reads the answer. When any of it can't
+// be worked out, everything is hidden.
+func (b *DiscordBot) withVisibility(
+	ctx context.Context,
+	s *discordgo.Session,
+	guildID string,
+	userID string,
+	destinationID string,
+) context.Context {
+	if guildID == "" {
+		destinationID = ""
+	}
+
+	queryCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var guilds []*ent.DiscordGuildSetting
+	var err error
+	if guildID != "" {
+		var settings *ent.DiscordGuildSetting
+		settings, err = b.guildSettings(queryCtx, guildID)
+		guilds = []*ent.DiscordGuildSetting{settings}
+	} else {
+		guilds, err = b.entClient.DiscordGuildSetting.Query().
+			Where(discordguildsetting.Or(
+				discordguildsetting.IngestAllowlistNotNil(),
+				discordguildsetting.IngestDenylistNotNil(),
+				discordguildsetting.RetrievalDenylistNotNil(),
+				discordguildsetting.RestrictedChannelsNotNil(),
+			)).
+			All(queryCtx)
+	}
+	if err != nil {
+		b.logger.ErrorContext(ctx, "failed to load guild settings", "err", err)
+		return archive.WithEverythingHidden(ctx)
+	}
+
+	var hidden []string
+	for _, settings := range guilds {
+		channelIDs, err := b.hiddenChannels(queryCtx, s, settings, userID, destinationID)
+		if err != nil {
+			b.logger.ErrorContext(ctx, "failed to resolve hidden channels", "guild", settings.ID, "err", err)
+			return archive.WithEverythingHidden(ctx)
+		}
+		hidden = append(hidden, channelIDs...)
+	}
+
+	return archive.WithHiddenChannels(ctx, hidden)
+}
+
+func (b *DiscordBot) hiddenChannels(
+	ctx context.Context,
+	s *discordgo.Session,
+	settings *ent.DiscordGuildSetting,
+	userID string,
+	destinationID string,
+) ([]string, error) {
+	if len(settings.IngestAllowlist) == 0 &&
+		len(settings.IngestDenylist) == 0 &&
+		len(settings.RetrievalDenylist) == 0 &&
+		len(settings.RestrictedChannels) == 0 {
+		return nil, nil
+	}
+
+	paths, err := archive.ChannelPaths(ctx, b.entClient, settings.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var hidden []string
+	for channelID, path := range paths {
+		if len(path) == 1 {
+			// The channel may never have been stored, its parents are only
+			// known to the state cache then
+			path = statePath(s, channelID)
+		}
+		if !ingested(settings, path) ||
+			lo.Some(path, settings.RetrievalDenylist) ||
+			lo.Some(path, settings.RestrictedChannels) && !b.surfaced(s, userID, destinationID, channelID) {
+			hidden = append(hidden, channelID)
+		}
+	}
+
+	return hidden, nil
+}
+
+// surfaced tells whether a restricted channel may go into an answer for the
+// user posted in the destination, see withVisibility.
+func (b *DiscordBot) surfaced(
+	s *discordgo.Session,
+	userID string,
+	destinationID string,
+	channelID string,
+) bool {
+	if userID == "" && destinationID == "" {
+		return false
+	}
+	if userID != "" && !b.canView(s, userID, channelID) {
+		return false
+	}
+
+	return destinationID == "" || b.audienceCanView(s, destinationID, channelID)
+}
+
+// lookupChannel finds a channel in the state cache, or asks the API for it.
+func lookupChannel(s *discordgo.Session, channelID string) (*discordgo.Channel, error) {
+	if ch, err := s.State.Channel(channelID); err == nil {
+		return ch, nil
+	}
+
+	return s.Channel(channelID)
+}
+
+// permissionParent is the channel whose permissions apply to ch. Threads have
+// no permissions of their own, those of the channel they were started from
+// apply.
+func permissionParent(ch *discordgo.Channel) string {
+	if ch.IsThread() {
+		return ch.ParentID
+	}
+
+	return ch.ID
+}
+
+// canView tells whether the user can see the channel.
+func (b *DiscordBot) canView(s *discordgo.Session, userID string, channelID string) bool {
+	if userID == "" {
+		return false
+	}
+
+	ch, err := lookupChannel(s, channelID)
+	if err != nil {
+		return false
+	}
+
+	perms, err := s.UserChannelPermissions(userID, permissionParent(ch))
+	return err == nil && perms&discordgo.PermissionViewChannel != 0
+}
+
+// audienceCanView tells whether everyone who reads the destination can see
+// the channel. Only the sure cases count: the channel shares its permissions
+// with the destination, or the whole server can see it.
+func (b *DiscordBot) audienceCanView(
+	s *discordgo.Session,
+	destinationID string,
+	channelID string,
+) bool {
+	dest, err := lookupChannel(s, destinationID)
+	if err != nil {
+		return false
+	}
+	ch, err := lookupChannel(s, channelID)
+	if err != nil {
+		return false
+	}
+
+	if ch.Type == discordgo.ChannelTypeGuildPrivateThread {
+		// Only its members see a private thread, whatever the permissions
+		return ch.ID == dest.ID
+	}
+	if permissionParent(ch) == permissionParent(dest) {
+		return true
+	}
+
+	return everyoneCanView(s, ch.GuildID, permissionParent(ch))
+}
+
+// everyoneCanView tells whether every member of the guild can see the
+// channel: @everyone may, and no overwrite takes it away from anyone.
+func everyoneCanView(s *discordgo.Session, guildID string, channelID string) bool {
+	everyone, err := s.State.Role(guildID, guildID)
+	if err != nil {
+		return false
+	}
+	ch, err := lookupChannel(s, channelID)
+	if err != nil {
+		return false
+	}
+
+	view := everyone.Permissions&discordgo.PermissionViewChannel != 0
+	for _, o := range ch.PermissionOverwrites {
+		switch {
+		case o.ID == guildID && o.Type == discordgo.PermissionOverwriteTypeRole:
+			view = view && o.Deny&discordgo.PermissionViewChannel == 0 ||
+				o.Allow&discordgo.PermissionViewChannel != 0
+		case o.Deny&discordgo.PermissionViewChannel != 0:
+			return false
+		}
+	}
+
+	return view
+}
+
+func (b *DiscordBot) handleArchive(
+	ctx context.Context,
+	s *discordgo.Session,
+	i *discordgo.InteractionCreate,
+) {
+	sub := i.ApplicationCommandData().Options[0]
+
+	b.phc.Enqueue(posthog.Capture{
+		DistinctId: invokingUser(i).ID,
+		Event:      "archive_settings",
+		Properties: posthog.NewProperties().
+			Set("global_name", invokingUser(i).GlobalName).
+			Set("action", sub.Name),
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	var channelID, mode string
+	for _, opt := range sub.Options {
+		switch opt.Name {
+		case "channel":
+			channelID = opt.Value.(string)
+		case "mode":
+			mode = opt.StringValue()
+		}
+	}
+
+	content, err := b.updateArchiveSettings(ctx, i.GuildID, sub.Name, channelID, mode)
+	if err != nil {
+		b.logger.Error("failed to update archive settings", "action", sub.Name, "err", err)
+		content = "I'm sorry, I couldn't save that. Try again in a bit."
+	}
+
+	err = s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseChannelMessageWithSource,
+		Data: &discordgo.InteractionResponseData{
+			Content: truncate(content, 2000),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		},
+	})
+	if err != nil {
+		b.logger.Error("failed to respond to interaction", "err", err)
+	}
+}
+
+func (b *DiscordBot) updateArchiveSettings(
+	ctx context.Context,
+	guildID string,
+	action string,
+	channelID string,
+	mode string,
+) (string, error) {
+	settings, err := b.guildSettings(ctx, guildID)
+	if err != nil {
+		return "", err
+	}
+
+	// Every list a channel can be on for the subcommand, and the one the mode
+	// puts it on
+	var lists map[string]*[]string
+	switch action {
+	case "ingest":
+		lists = map[string]*[]string{
+			"allow": &settings.IngestAllowlist,
+			"deny":  &settings.IngestDenylist,
+		}
+	case "retrieval":
+		lists = map[string]*[]string{
+			"members": &settings.RestrictedChannels,
+			"nobody":  &settings.RetrievalDenylist,
+		}
+	}
+
+	if lists != nil {
+		for name, list := range lists {
+			*list = slices.DeleteFunc(slices.Clone(*list), func(id string) bool { return id == channelID })
+			if name == mode {
+				*list = append(*list, channelID)
+			}
+		}
+
+		err := b.entClient.DiscordGuildSetting.Create().
+			SetID(guildID).
+			SetIngestAllowlist(settings.IngestAllowlist).
+			SetIngestDenylist(settings.IngestDenylist).
+			SetRetrievalDenylist(settings.RetrievalDenylist).
+			SetRestrictedChannels(settings.RestrictedChannels).
+			OnConflictColumns(discordguildsetting.FieldID).
+			Update(func(u *ent.DiscordGuildSettingUpsert) {
+				u.UpdateIngestAllowlist()
+				u.UpdateIngestDenylist()
+				u.UpdateRetrievalDenylist()
+				u.UpdateRestrictedChannels()
+			}).
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	channels := func(ids []string) string {
+		if len(ids) == 0 {
+			return "none"
+		}
+		return strings.Join(lo.Map(ids, func(id string, _ int) string { return "<#" + id + ">" }), ", ")
+	}
+
+	archived := "Everything is archived"
+	if len(settings.IngestAllowlist) > 0 {
+		archived = "Only " + channels(settings.IngestAllowlist) + " are archived"
+	}
+	if len(settings.IngestDenylist) > 0 {
+		archived += ", except " + channels(settings.IngestDenylist)
+	}
+
+	return fmt.Sprintf(
+		"%s. Never surfaced: %s. Only surfaced to members who can see them: %s.",
+		archived,
+		channels(settings.RetrievalDenylist),
+		channels(settings.RestrictedChannels),
+	), nil
+}
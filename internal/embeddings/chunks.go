@@ -0,0 +1,263 @@
+package embeddings
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"sev0/ent"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+const (
+	// chunkMaxGap ends a chunk when the channel went quiet for longer
+	chunkMaxGap = 15 * time.Minute
+	// chunkMaxTokens ends a chunk when it gets longer, in estimated tokens
+	chunkMaxTokens = 256
+	// chunkOverlap is how many messages of a chunk the next one starts with,
+	// when it continues the same conversation
+	chunkOverlap = 2
+	// chunkBatch is how many messages are chunked at a time
+	chunkBatch = 1000
+)
+
+// estimateTokens is the usual four characters per token rule of thumb.
+func estimateTokens(s string) int {
+	return utf8.RuneCountInString(s)/4 + 1
+}
+
+// ChunkMessages groups consecutive messages of a channel, oldest first, into
+// overlapping windows. A window ends at a gap in the conversation or when it
+// gets too long.
+func ChunkMessages(messages []*ent.DiscordMessage) [][]*ent.DiscordMessage {
+	var (
+		chunks  [][]*ent.DiscordMessage
+		current []*ent.DiscordMessage
+		tokens  int
+	)
+	for _, m := range messages {
+		t := estimateTokens(m.Content)
+		if len(current) > 0 {
+			gap := m.Timestamp.Sub(current[len(current)-1].Timestamp) > chunkMaxGap
+			if gap || tokens+t > chunkMaxTokens {
+				chunks = append(chunks, current)
+
+				// Keep at least one message out of the overlap, so every chunk
+				// starts after the previous one
+				keep := min(chunkOverlap, len(current)-1)
+				if gap {
+					keep = 0
+				}
+				current = slices.Clone(current[len(current)-keep:])
+				tokens = lo.SumBy(current, func(m *ent.DiscordMessage) int {
+					return estimateTokens(m.Content)
+				})
+			}
+		}
+
+		current = append(current, m)
+		tokens += t
+	}
+	if len(current) > 0 {
+		chunks = append(chunks, current)
+	}
+
+	return chunks
+}
+
+// chunkContent renders a chunk as the chat log that gets embedded. The
+// messages need their user loaded.
+func chunkContent(messages []*ent.DiscordMessage) string {
+	var sb strings.Builder
+	for _, m := range messages {
+		name := "someone"
+		if m.Edges.User != nil {
+			name = m.Edges.User.GlobalName
+		}
+		fmt.Fprintf(&sb, "%s: %s\n", name, m.Content)
+	}
+
+	return sb.String()
+}
+
+// chunkLocks keeps a channel from being chunked twice at the same time.
+var chunkLocks sync.Map
+
+// ChunkChannel chunks the messages of a channel that came in since its latest
+// chunk started, and embeds the new chunks with every stored model. Only
+// closed chunks are stored, those followed by another one or by a gap, so a
+// growing conversation isn't embedded over again with every message. Until
+// then its messages are found by their own vectors.
+func (r *Registry) ChunkChannel(ctx context.Context, channelID string) error {
+	lock, _ := chunkLocks.LoadOrStore(channelID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	embedders, err := r.Stored(ctx)
+	if err != nil {
+		return err
+	}
+
+	var previous time.Time
+	for {
+		var start time.Time
+		latest, err := r.entClient.DiscordMessageChunk.Query().
+			Where(discordmessagechunk.ChannelID(channelID)).
+			Order(ent.Desc(discordmessagechunk.FieldStartAt)).
+			First(ctx)
+		switch {
+		case err == nil:
+			start = latest.StartAt
+		case !ent.IsNotFound(err):
+			return err
+		}
+		if !previous.IsZero() && !start.After(previous) {
+			// A single chunk spans the whole batch, nothing left to do
+			return nil
+		}
+		previous = start
+
+		messages, err := r.entClient.DiscordMessage.Query().
+			Where(
+				discordmessage.ChannelID(channelID),
+				discordmessage.TimestampGTE(start),
+			).
+			WithUser().
+			Order(ent.Asc(discordmessage.FieldTimestamp)).
+			Limit(chunkBatch).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+
+		chunks := ChunkMessages(messages)
+		full := len(messages) == chunkBatch
+		if len(chunks) > 0 {
+			last := chunks[len(chunks)-1]
+			quiet := time.Since(last[len(last)-1].Timestamp) > chunkMaxGap
+			if full && len(chunks) > 1 || !full && !quiet {
+				// The last chunk may go on past the batch, or with the next
+				// message
+				chunks = chunks[:len(chunks)-1]
+			}
+		}
+
+		for _, chunk := range chunks {
+			if latest != nil && chunk[0].ID == latest.ID && chunkContent(chunk) == latest.Content {
+				// Stored and embedded already
+				continue
+			}
+			if err := r.storeChunk(ctx, chunk, embedders); err != nil {
+				return err
+			}
+		}
+
+		if !full {
+			return nil
+		}
+	}
+}
+
+func (r *Registry) storeChunk(
+	ctx context.Context,
+	messages []*ent.DiscordMessage,
+	embedders []ai.Embedder,
+) error {
+	first := messages[0]
+	content := chunkContent(messages)
+
+	err := r.entClient.DiscordMessageChunk.Create().
+		SetID(first.ID).
+		SetGuildID(first.GuildID).
+		SetChannelID(first.ChannelID).
+		SetStartAt(first.Timestamp).
+		SetEndAt(messages[len(messages)-1].Timestamp).
+		SetContent(content).
+		OnConflictColumns(discordmessagechunk.FieldID).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = r.entClient.DiscordMessageChunk.UpdateOneID(first.ID).
+		ClearMessages().
+		AddMessageIDs(lo.Map(messages, func(m *ent.DiscordMessage, _ int) string {
+			return m.ID
+		})...).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	chunk := &ent.DiscordMessageChunk{ID: first.ID, Content: content}
+	for _, embedder := range embedders {
+		if err := EmbedChunks(ctx, r.g, r.entClient, embedder, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// EmbedChunks embeds the given chunks in a single request and stores the
+// vectors, replacing whatever was stored for the same model before.
+func EmbedChunks(
+	ctx context.Context,
+	g *genkit.Genkit,
+	entClient *ent.Client,
+	embedder ai.Embedder,
+	chunks ...*ent.DiscordMessageChunk,
+) error {
+	if len(chunks) == 0 {
+		return nil
+	}
+
+	resp, err := genkit.Embed(
+		ctx,
+		g,
+		ai.WithEmbedder(embedder),
+		ai.WithTextDocs(lo.Map(chunks, func(c *ent.DiscordMessageChunk, _ int) string {
+			return c.Content
+		})...),
+	)
+	if err != nil {
+		return err
+	}
+	if len(resp.Embeddings) != len(chunks) {
+		return fmt.Errorf(
+			"embedder returned %d embeddings for %d chunks",
+			len(resp.Embeddings),
+			len(chunks),
+		)
+	}
+
+	model := embedder.Name()
+	creates := make([]*ent.DiscordChunkEmbeddingCreate, 0, len(chunks))
+	for i, c := range chunks {
+		creates = append(creates, entClient.DiscordChunkEmbedding.Create().
+			SetID(c.ID+":"+model).
+			SetChunkID(c.ID).
+			SetModel(model).
+			SetEmbedding(pgvector.NewVector(resp.Embeddings[i].Embedding)))
+	}
+
+	return entClient.DiscordChunkEmbedding.CreateBulk(creates...).
+		OnConflictColumns(
+			discordchunkembedding.FieldChunkID,
+			discordchunkembedding.FieldModel,
+		).
+		UpdateEmbedding().
+		Exec(ctx)
+}
@@ -0,0 +1,97 @@
+package embeddings
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"sev0/ent"
+)
+
+var chunkEpoch = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// posted is a message that comes the given delay after the previous one and
+// is worth the given estimated tokens.
+type posted struct {
+	after  time.Duration
+	tokens int
+}
+
+func TestChunkMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		messages []posted
+		chunks   [][]int
+	}{
+		{
+			name:     "no messages",
+			messages: nil,
+			chunks:   nil,
+		},
+		{
+			name:     "single message",
+			messages: []posted{{0, 10}},
+			chunks:   [][]int{{0}},
+		},
+		{
+			name:     "gap of exactly the max stays together",
+			messages: []posted{{0, 10}, {chunkMaxGap, 10}},
+			chunks:   [][]int{{0, 1}},
+		},
+		{
+			name:     "longer gap splits without overlap",
+			messages: []posted{{0, 10}, {time.Minute, 10}, {chunkMaxGap + time.Second, 10}},
+			chunks:   [][]int{{0, 1}, {2}},
+		},
+		{
+			name:     "exactly the token budget stays together",
+			messages: []posted{{0, 128}, {time.Minute, 128}},
+			chunks:   [][]int{{0, 1}},
+		},
+		{
+			name:     "over the token budget splits with overlap",
+			messages: []posted{{0, 60}, {time.Minute, 60}, {time.Minute, 60}, {time.Minute, 60}, {time.Minute, 60}},
+			chunks:   [][]int{{0, 1, 2, 3}, {2, 3, 4}},
+		},
+		{
+			name:     "overlap leaves out at least one message",
+			messages: []posted{{0, 100}, {time.Minute, 100}, {time.Minute, 100}, {time.Minute, 100}},
+			chunks:   [][]int{{0, 1}, {1, 2}, {2, 3}},
+		},
+		{
+			name:     "message over the budget gets a chunk of its own",
+			messages: []posted{{0, 10}, {time.Minute, 300}, {time.Minute, 10}},
+			chunks:   [][]int{{0}, {1}, {2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages := make([]*ent.DiscordMessage, len(tt.messages))
+			ts := chunkEpoch
+			for i, m := range tt.messages {
+				ts = ts.Add(m.after)
+				// estimateTokens counts four characters per token, plus one
+				messages[i] = &ent.DiscordMessage{
+					ID:        fmt.Sprint(i),
+					Content:   strings.Repeat("a", (m.tokens-1)*4),
+					Timestamp: ts,
+				}
+			}
+
+			got := ChunkMessages(messages)
+
+			ids := make([][]int, len(got))
+			for i, chunk := range got {
+				for _, m := range chunk {
+					ids[i] = append(ids[i], slices.Index(messages, m))
+				}
+			}
+			if !slices.EqualFunc(ids, tt.chunks, slices.Equal) {
+				t.Errorf("chunks = %v, want %v", ids, tt.chunks)
+			}
+		})
+	}
+}
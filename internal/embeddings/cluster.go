@@ -0,0 +1,130 @@
+package embeddings
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+const kMeansIterations = 20
+
+// KMeans groups vectors into k clusters by cosine similarity. It returns the
+// cluster of every vector and the normalized cluster centroids. The seeding
+// is deterministic so the same input always yields the same clusters.
+func KMeans(vectors [][]float32, k int) ([]int, [][]float32) {
+	if len(vectors) == 0 || k <= 0 {
+		return nil, nil
+	}
+	k = min(k, len(vectors))
+
+	points := make([][]float32, len(vectors))
+	for i, v := range vectors {
+		points[i] = Normalize(v)
+	}
+
+	// k-means++ seeding
+	rng := rand.New(rand.NewPCG(1, uint64(len(points))))
+	centroids := [][]float32{points[rng.IntN(len(points))]}
+	distances := make([]float64, len(points))
+	for len(centroids) < k {
+		var total float64
+		for i, p := range points {
+			distances[i] = math.Inf(1)
+			for _, c := range centroids {
+				distances[i] = min(distances[i], 1-Cosine(p, c))
+			}
+			total += distances[i]
+		}
+		if total == 0 {
+			break
+		}
+
+		target := rng.Float64() * total
+		next := len(points) - 1
+		for i, d := range distances {
+			target -= d
+			if target <= 0 {
+				next = i
+				break
+			}
+		}
+		centroids = append(centroids, points[next])
+	}
+
+	assignments := make([]int, len(points))
+	for range kMeansIterations {
+		changed := false
+		for i, p := range points {
+			best, bestSim := 0, math.Inf(-1)
+			for c, centroid := range centroids {
+				if sim := Cosine(p, centroid); sim > bestSim {
+					best, bestSim = c, sim
+				}
+			}
+			if assignments[i] != best {
+				assignments[i] = best
+				changed = true
+			}
+		}
+
+		centroids = Centroids(points, assignments, len(centroids))
+		if !changed {
+			break
+		}
+	}
+
+	return assignments, centroids
+}
+
+// Centroids averages the points of every cluster and normalizes the result.
+func Centroids(points [][]float32, assignments []int, k int) [][]float32 {
+	sums := make([][]float32, k)
+	for i, p := range points {
+		c := assignments[i]
+		if sums[c] == nil {
+			sums[c] = make([]float32, len(p))
+		}
+		for d, x := range p {
+			sums[c][d] += x
+		}
+	}
+
+	for c := range sums {
+		sums[c] = Normalize(sums[c])
+	}
+
+	return sums
+}
+
+// Cosine returns the cosine similarity of two vectors.
+func Cosine(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range min(len(a), len(b)) {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// Normalize returns a copy of v scaled to unit length.
+func Normalize(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	norm = math.Sqrt(norm)
+
+	out := make([]float32, len(v))
+	if norm == 0 {
+		return out
+	}
+	for i, x := range v {
+		out[i] = float32(float64(x) / norm)
+	}
+
+	return out
+}
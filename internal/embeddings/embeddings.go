@@ -0,0 +1,90 @@
+// Package embeddings turns archived messages into vectors
+package embeddings
+
+import (
+	"context"
+	"fmt"
+
+	"sev0/ent"
+	"sev0/ent/discordmessageembedding"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/pgvector/pgvector-go"
+	"github.com/samber/lo"
+)
+
+// EmbedMessages embeds the given messages in a single request and stores the
+// vectors, replacing whatever was stored for the same model before.
+func EmbedMessages(
+	ctx context.Context,
+	g *genkit.Genkit,
+	entClient *ent.Client,
+	embedder ai.Embedder,
+	messages ...*ent.DiscordMessage,
+) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	resp, err := genkit.Embed(
+		ctx,
+		g,
+		ai.WithEmbedder(embedder),
+		ai.WithTextDocs(lo.Map(messages, func(m *ent.DiscordMessage, _ int) string {
+			return m.Content
+		})...),
+	)
+	if err != nil {
+		return err
+	}
+	if len(resp.Embeddings) != len(messages) {
+		return fmt.Errorf(
+			"embedder returned %d embeddings for %d messages",
+			len(resp.Embeddings),
+			len(messages),
+		)
+	}
+
+	model := embedder.Name()
+	creates := make([]*ent.DiscordMessageEmbeddingCreate, 0, len(messages))
+	for i, m := range messages {
+		creates = append(creates, entClient.DiscordMessageEmbedding.Create().
+			SetID(m.ID+":"+model).
+			SetMessageID(m.ID).
+			SetModel(model).
+			SetEmbedding(pgvector.NewVector(resp.Embeddings[i].Embedding)))
+	}
+
+	return entClient.DiscordMessageEmbedding.CreateBulk(creates...).
+		OnConflictColumns(
+			discordmessageembedding.FieldMessageID,
+			discordmessageembedding.FieldModel,
+		).
+		UpdateEmbedding().
+		Exec(ctx)
+}
+
+// EmbedQuery embeds a search query so it can be compared with the stored
+// message vectors of the same embedder.
+func EmbedQuery(
+	ctx context.Context,
+	g *genkit.Genkit,
+	embedder ai.Embedder,
+	query string,
+) ([]float32, error) {
+	resp, err := genkit.Embed(
+		ctx,
+		g,
+		ai.WithEmbedder(embedder),
+		ai.WithTextDocs(query),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Embeddings) != 1 {
+		return nil, fmt.Errorf("embedder returned %d embeddings for 1 query", len(resp.Embeddings))
+	}
+
+	return resp.Embeddings[0].Embedding, nil
+}
@@ -0,0 +1,378 @@
+package embeddings
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordchunkembedding"
+	"sev0/ent/discordmessage"
+	"sev0/ent/discordmessagechunk"
+	"sev0/ent/discordmessageembedding"
+	"sev0/ent/embeddingmodel"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+)
+
+// ErrNotReady is returned when activating a model that doesn't have a vector
+// for every archived message yet.
+var ErrNotReady = errors.New("embeddings: model is not fully reembedded")
+
+// ErrInvalidModelName is returned when registering a model whose name can't
+// safely go into the DDL of its index.
+var ErrInvalidModelName = errors.New("embeddings: invalid model name")
+
+// modelNameRe matches the names of the providers, like
+// "googleai/text-embedding-004" or "ollama/nomic-embed-text:v1.5".
+var modelNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._:/-]*$`)
+
+// Registry keeps track of the embedding models vectors are stored for, and of
+// the one the searches use. Switching the active model is a single
+// transaction, so searches never mix vectors of two models.
+type Registry struct {
+	g         *genkit.Genkit
+	entClient *ent.Client
+	// fallback is used until a model is activated, and when the active one
+	// isn't configured in this process
+	fallback ai.Embedder
+	logger   *slog.Logger
+}
+
+func NewRegistry(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+	fallback ai.Embedder,
+	logger *slog.Logger,
+) *Registry {
+	return &Registry{g: g, entClient: entClient, fallback: fallback, logger: logger}
+}
+
+// Bootstrap registers the configured embedder and activates it, unless
+// another model already is.
+func (r *Registry) Bootstrap(ctx context.Context) (*ent.EmbeddingModel, error) {
+	if _, err := r.Register(ctx, r.fallback); err != nil {
+		return nil, err
+	}
+
+	active, err := r.entClient.EmbeddingModel.Query().
+		Where(embeddingmodel.Active(true)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		// Nothing to switch from, and new messages get its vectors anyway
+		if err := r.activate(ctx, r.fallback.Name()); err != nil {
+			return nil, err
+		}
+		return r.entClient.EmbeddingModel.Get(ctx, r.fallback.Name())
+	}
+
+	return active, err
+}
+
+// Register adds the model of the embedder to the registry and creates the
+// ANN index over its vectors. The dimension is found out by embedding a probe.
+func (r *Registry) Register(
+	ctx context.Context,
+	embedder ai.Embedder,
+) (*ent.EmbeddingModel, error) {
+	if !modelNameRe.MatchString(embedder.Name()) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidModelName, embedder.Name())
+	}
+
+	model, err := r.entClient.EmbeddingModel.Get(ctx, embedder.Name())
+	if err == nil {
+		// Models registered before a table existed miss its index
+		return model, r.createIndexes(ctx, model.ID, model.Dimensions)
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	probe, err := EmbedQuery(ctx, r.g, embedder, "dimension probe")
+	if err != nil {
+		return nil, fmt.Errorf("probing %s: %w", embedder.Name(), err)
+	}
+
+	if err := r.createIndexes(ctx, embedder.Name(), len(probe)); err != nil {
+		return nil, err
+	}
+
+	return r.entClient.EmbeddingModel.Create().
+		SetID(embedder.Name()).
+		SetDimensions(len(probe)).
+		Save(ctx)
+}
+
+func (r *Registry) createIndexes(ctx context.Context, model string, dimensions int) error {
+	for _, table := range []string{"discord_message_embeddings", "discord_chunk_embeddings"} {
+		if err := r.createIndex(ctx, table, model, dimensions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createIndex builds a partial HNSW index over the vectors of a single model.
+// The column itself has no dimension, so the index is on a typed cast that the
+// search queries repeat. A concurrent build that failed leaves an invalid
+// index behind, which is dropped and built again.
+func (r *Registry) createIndex(
+	ctx context.Context,
+	table string,
+	model string,
+	dimensions int,
+) error {
+	hash := sha1.Sum([]byte(table + model))
+	name := table + "_hnsw_" + hex.EncodeToString(hash[:8])
+
+	valid, exists, err := r.indexValid(ctx, name)
+	if err != nil {
+		return err
+	}
+	if exists && valid {
+		return nil
+	}
+	if exists {
+		r.logger.WarnContext(
+			ctx,
+			"Rebuilding invalid embedding index",
+			"index", name,
+			"model", model,
+		)
+		if _, err := r.entClient.ExecContext(ctx, "DROP INDEX CONCURRENTLY IF EXISTS "+name); err != nil {
+			return err
+		}
+	}
+
+	// The model can't be a bind parameter in DDL, Register only lets through
+	// names that are safe to quote as they are
+	_, err = r.entClient.ExecContext(ctx, fmt.Sprintf(`
+		CREATE INDEX CONCURRENTLY IF NOT EXISTS %s
+		ON %s
+		USING hnsw ((embedding::vector(%d)) vector_cosine_ops)
+		WHERE model = '%s'`,
+		name,
+		table,
+		dimensions,
+		model,
+	))
+
+	return err
+}
+
+// indexValid tells whether the named index exists and whether it's usable.
+func (r *Registry) indexValid(ctx context.Context, name string) (valid bool, exists bool, err error) {
+	rows, err := r.entClient.QueryContext(ctx, `
+		SELECT i.indisvalid
+		FROM pg_index i
+		JOIN pg_class c ON c.oid = i.indexrelid
+		WHERE c.relname = $1 AND pg_catalog.pg_table_is_visible(c.oid)`, name)
+	if err != nil {
+		return false, false, err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		exists = true
+		if err := rows.Scan(&valid); err != nil {
+			return false, false, err
+		}
+	}
+
+	return valid, exists, rows.Err()
+}
+
+// Activate makes the searches use the model from now on. The model must have
+// been reembedded to the end, see Reembed.
+func (r *Registry) Activate(ctx context.Context, name string) error {
+	model, err := r.entClient.EmbeddingModel.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if model.ReadyAt == nil {
+		return fmt.Errorf("%w: %s", ErrNotReady, name)
+	}
+
+	return r.activate(ctx, name)
+}
+
+func (r *Registry) activate(ctx context.Context, name string) error {
+	tx, err := r.entClient.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = tx.EmbeddingModel.Update().
+		Where(embeddingmodel.Active(true)).
+		SetActive(false).
+		Exec(ctx)
+	if err == nil {
+		err = tx.EmbeddingModel.UpdateOneID(name).
+			SetActive(true).
+			Exec(ctx)
+	}
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+
+	return tx.Commit()
+}
+
+// Active returns the embedder the searches should use. When the active model
+// isn't configured in this process, the fallback is used instead.
+func (r *Registry) Active(ctx context.Context) (ai.Embedder, error) {
+	active, err := r.entClient.EmbeddingModel.Query().
+		Where(embeddingmodel.Active(true)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return r.fallback, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	embedder := genkit.LookupEmbedder(r.g, active.ID)
+	if embedder == nil {
+		r.logger.WarnContext(
+			ctx,
+			"Active embedding model is not configured, falling back",
+			"model", active.ID,
+			"fallback", r.fallback.Name(),
+		)
+		return r.fallback, nil
+	}
+
+	return embedder, nil
+}
+
+// EmbedQuery embeds a search query with the active model, it returns the
+// vector along with the name of the model.
+func (r *Registry) EmbedQuery(
+	ctx context.Context,
+	query string,
+) ([]float32, string, error) {
+	embedder, err := r.Active(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	vector, err := EmbedQuery(ctx, r.g, embedder, query)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return vector, embedder.Name(), nil
+}
+
+// Stored returns the embedders of every registered model that is configured
+// in this process. New messages get a vector from all of them, so a model
+// that is being rebuilt doesn't miss the messages that come in meanwhile.
+func (r *Registry) Stored(ctx context.Context) ([]ai.Embedder, error) {
+	models, err := r.entClient.EmbeddingModel.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var embedders []ai.Embedder
+	for _, m := range models {
+		if embedder := genkit.LookupEmbedder(r.g, m.ID); embedder != nil {
+			embedders = append(embedders, embedder)
+		}
+	}
+
+	return embedders, nil
+}
+
+// Lookup finds a configured embedder by name.
+func (r *Registry) Lookup(name string) ai.Embedder {
+	return genkit.LookupEmbedder(r.g, name)
+}
+
+// Reembed gives every archived message and chunk that has none yet a vector
+// from the embedder, batchSize at a time. Channels are chunked first if they
+// weren't yet. It can be stopped and started again, it picks up where it left
+// off. progress is called after every batch with the number of messages and
+// chunks embedded so far.
+func (r *Registry) Reembed(
+	ctx context.Context,
+	embedder ai.Embedder,
+	batchSize int,
+	progress func(done int),
+) error {
+	if _, err := r.Register(ctx, embedder); err != nil {
+		return err
+	}
+
+	model := embedder.Name()
+	done := 0
+	for {
+		messages, err := r.entClient.DiscordMessage.Query().
+			Where(discordmessage.Not(discordmessage.HasEmbeddingsWith(
+				discordmessageembedding.Model(model),
+			))).
+			Order(ent.Asc(discordmessage.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(messages) == 0 {
+			break
+		}
+
+		if err := EmbedMessages(ctx, r.g, r.entClient, embedder, messages...); err != nil {
+			return err
+		}
+
+		done += len(messages)
+		progress(done)
+	}
+
+	channelIDs, err := r.entClient.DiscordMessage.Query().
+		Where(discordmessage.ChannelIDNEQ("")).
+		Unique(true).
+		Select(discordmessage.FieldChannelID).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+	for _, channelID := range channelIDs {
+		if err := r.ChunkChannel(ctx, channelID); err != nil {
+			return err
+		}
+	}
+
+	for {
+		chunks, err := r.entClient.DiscordMessageChunk.Query().
+			Where(discordmessagechunk.Not(discordmessagechunk.HasEmbeddingsWith(
+				discordchunkembedding.Model(model),
+			))).
+			Order(ent.Asc(discordmessagechunk.FieldID)).
+			Limit(batchSize).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		if len(chunks) == 0 {
+			break
+		}
+
+		if err := EmbedChunks(ctx, r.g, r.entClient, embedder, chunks...); err != nil {
+			return err
+		}
+
+		done += len(chunks)
+		progress(done)
+	}
+
+	return r.entClient.EmbeddingModel.UpdateOneID(model).
+		SetReadyAt(time.Now()).
+		Exec(ctx)
+}
@@ -0,0 +1,28 @@
+package embeddings
+
+import "testing"
+
+func TestModelNameRe(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"googleai/text-embedding-004", true},
+		{"ollama/nomic-embed-text:v1.5", true},
+		{"openai/text-embedding-3-small", true},
+		{"", false},
+		{"/leading-slash", false},
+		{"it's", false},
+		{"a' OR 1=1 --", false},
+		{"two words", false},
+		{"semi;colon", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := modelNameRe.MatchString(tt.name); got != tt.valid {
+				t.Errorf("modelNameRe matches %q = %v, want %v", tt.name, got, tt.valid)
+			}
+		})
+	}
+}
@@ -3,38 +3,159 @@ package genkitmagic
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"sev0/ent"
+	"sev0/internal/embeddings"
 	"sev0/internal/genkitmagic/tools"
 
 	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/core/api"
 	"github.com/firebase/genkit/go/genkit"
 	"github.com/firebase/genkit/go/plugins/compat_oai/openai"
 	"github.com/firebase/genkit/go/plugins/googlegenai"
 )
 
+// DefaultPersona is who the bot pretends to be unless BOT_PERSONA says
+// otherwise.
+const DefaultPersona = "You are a funny & troll Discord bot that lives in this server. You should also act like ThePrimeagen."
+
+// DefaultEmbeddingModel embeds the messages unless EMBEDDING_MODEL says
+// otherwise.
+const DefaultEmbeddingModel = "openai/text-embedding-3-small"
+
 type GenkitMagic struct {
-	G   *genkit.Genkit
+	G *genkit.Genkit
+	// OAI is nil without an OPENAI_API_KEY
 	OAI *openai.OpenAI
+	// Embeddings knows which embedding model the searches use
+	Embeddings *embeddings.Registry
+	// Persona opens the system prompt of everything the bot writes
+	Persona string
+	// Models are tried in order by Generate
+	Models       []string
+	ModelTimeout time.Duration
+	Logger       *slog.Logger
+	// supports is what the local models can take, keyed by their full name.
+	// The others are sent everything.
+	supports map[string]ai.ModelSupports
 
-	RecentMessagesTool ai.Tool
+	RecentMessagesTool      ai.Tool
+	TopReactedMessagesTool  ai.Tool
+	ConversationContextTool ai.Tool
+	WhoIsTool               ai.Tool
+	UserProfileTool         ai.Tool
+	KeywordSearchTool       ai.Tool
+	SemanticSearchTool      ai.Tool
 }
 
 func Init(
 	ctx context.Context,
 	entClient *ent.Client,
+	logger *slog.Logger,
 ) (GenkitMagic, error) {
-	oai := &openai.OpenAI{}
+	models, modelTimeout, err := modelsFromEnv()
+	if err != nil {
+		return GenkitMagic{}, err
+	}
+
+	// Cloud providers are only registered when they have a key, so the bot can
+	// run on local models alone
+	var plugins []api.Plugin
+	providers := make(map[string]bool)
+	supports := make(map[string]ai.ModelSupports)
+	if os.Getenv("GEMINI_API_KEY") != "" || os.Getenv("GOOGLE_API_KEY") != "" {
+		plugins = append(plugins, &googlegenai.GoogleAI{})
+		providers["googleai"] = true
+	}
+	var oai *openai.OpenAI
+	if os.Getenv("OPENAI_API_KEY") != "" {
+		oai = &openai.OpenAI{}
+		plugins = append(plugins, oai)
+		providers["openai"] = true
+	}
+	if local := localPluginFromEnv(); local != nil {
+		plugins = append(plugins, local)
+		providers[localProvider] = true
+		for name, s := range local.models {
+			supports[localProvider+"/"+name] = s
+		}
+	}
+
+	models = slices.DeleteFunc(models, func(model string) bool {
+		provider, _, _ := strings.Cut(model, "/")
+		if !providers[provider] {
+			logger.Warn("Skipping model of unconfigured provider", "model", model)
+			return true
+		}
+		return false
+	})
+	if len(models) == 0 {
+		return GenkitMagic{}, errors.New("none of the MODELS has a configured provider")
+	}
+
 	g := genkit.Init(ctx,
-		genkit.WithPlugins(&googlegenai.GoogleAI{}, oai),
-		genkit.WithDefaultModel("googleai/gemini-flash-latest"),
+		genkit.WithPlugins(plugins...),
+		genkit.WithDefaultModel(models[0]),
 	)
 
+	embedderName := os.Getenv("EMBEDDING_MODEL")
+	if embedderName == "" {
+		embedderName = DefaultEmbeddingModel
+	}
+	embedder := genkit.LookupEmbedder(g, embedderName)
+	if embedder == nil {
+		return GenkitMagic{}, fmt.Errorf("embedding model %q is not registered", embedderName)
+	}
+	registry := embeddings.NewRegistry(g, entClient, embedder, logger)
+
 	recentMessagesTool := tools.DefineRecentMessagesTool(g, entClient)
+	topReactedMessagesTool := tools.DefineTopReactedMessagesTool(g, entClient)
+	conversationContextTool := tools.DefineConversationContextTool(g, entClient)
+	whoIsTool := tools.DefineWhoIsTool(g, entClient)
+	userProfileTool := tools.DefineUserProfileTool(g, entClient, registry)
+	keywordSearchTool := tools.DefineKeywordSearchTool(g, entClient, registry)
+	semanticSearchTool := tools.DefineSemanticSearchTool(g, entClient, registry)
+
+	persona := os.Getenv("BOT_PERSONA")
+	if persona == "" {
+		persona = DefaultPersona
+	}
 
 	return GenkitMagic{
-		G:                  g,
-		OAI:                oai,
-		RecentMessagesTool: recentMessagesTool,
+		G:                       g,
+		Persona:                 persona,
+		Models:                  models,
+		ModelTimeout:            modelTimeout,
+		Logger:                  logger,
+		supports:                supports,
+		OAI:                     oai,
+		Embeddings:              registry,
+		RecentMessagesTool:      recentMessagesTool,
+		TopReactedMessagesTool:  topReactedMessagesTool,
+		ConversationContextTool: conversationContextTool,
+		WhoIsTool:               whoIsTool,
+		UserProfileTool:         userProfileTool,
+		KeywordSearchTool:       keywordSearchTool,
+		SemanticSearchTool:      semanticSearchTool,
 	}, nil
 }
+
+// Tools returns every tool the model can use to look into the server history.
+func (gm GenkitMagic) Tools() []ai.ToolRef {
+	return []ai.ToolRef{
+		gm.RecentMessagesTool,
+		gm.TopReactedMessagesTool,
+		gm.ConversationContextTool,
+		gm.WhoIsTool,
+		gm.UserProfileTool,
+		gm.KeywordSearchTool,
+		gm.SemanticSearchTool,
+	}
+}
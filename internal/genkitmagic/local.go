@@ -0,0 +1,103 @@
+package genkitmagic
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/core/api"
+	"github.com/firebase/genkit/go/plugins/compat_oai"
+)
+
+const localProvider = "local"
+
+// localPlugin serves models from an OpenAI compatible server, like Ollama or
+// llama.cpp, so the bot can run without any cloud keys.
+type localPlugin struct {
+	compat_oai.OpenAICompatible
+
+	models    map[string]ai.ModelSupports
+	embedders []string
+}
+
+// localPluginFromEnv configures the local provider from the environment, it
+// returns nil when LOCAL_OAI_BASE_URL isn't set.
+//
+// LOCAL_OAI_MODELS lists the chat models, each with the capabilities it has
+// on top of plain text: "llama3.1:8b+tools,llava+vision".
+// LOCAL_OAI_EMBEDDERS lists the embedding models.
+func localPluginFromEnv() *localPlugin {
+	baseURL := os.Getenv("LOCAL_OAI_BASE_URL")
+	if baseURL == "" {
+		return nil
+	}
+
+	apiKey := os.Getenv("LOCAL_OAI_API_KEY")
+	if apiKey == "" {
+		// Local servers don't check it, but the client would fall back to
+		// OPENAI_API_KEY and send the real key along
+		apiKey = localProvider
+	}
+
+	p := &localPlugin{
+		OpenAICompatible: compat_oai.OpenAICompatible{
+			Provider: localProvider,
+			APIKey:   apiKey,
+			BaseURL:  baseURL,
+		},
+		models: make(map[string]ai.ModelSupports),
+	}
+
+	for spec := range strings.SplitSeq(os.Getenv("LOCAL_OAI_MODELS"), ",") {
+		name, flags, _ := strings.Cut(strings.TrimSpace(spec), "+")
+		if name == "" {
+			continue
+		}
+
+		supports := ai.ModelSupports{Multiturn: true, SystemRole: true}
+		for flag := range strings.SplitSeq(flags, "+") {
+			switch flag {
+			case "tools":
+				supports.Tools = true
+			case "vision":
+				supports.Media = true
+			}
+		}
+		p.models[name] = supports
+	}
+
+	for name := range strings.SplitSeq(os.Getenv("LOCAL_OAI_EMBEDDERS"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			p.embedders = append(p.embedders, name)
+		}
+	}
+
+	return p
+}
+
+// Name implements genkit.Plugin.
+func (p *localPlugin) Name() string {
+	return localProvider
+}
+
+// Init implements genkit.Plugin.
+func (p *localPlugin) Init(ctx context.Context) []api.Action {
+	actions := p.OpenAICompatible.Init(ctx)
+
+	for name, supports := range p.models {
+		model := p.DefineModel(localProvider, name, ai.ModelOptions{
+			Label:    "Local " + name,
+			Supports: &supports,
+		})
+		actions = append(actions, model.(api.Action))
+	}
+	for _, name := range p.embedders {
+		embedder := p.DefineEmbedder(localProvider, name, &ai.EmbedderOptions{
+			Label: "Local " + name,
+		})
+		actions = append(actions, embedder.(api.Action))
+	}
+
+	return actions
+}
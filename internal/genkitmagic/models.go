@@ -0,0 +1,283 @@
+package genkitmagic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+)
+
+// DefaultModels are tried in order unless MODELS says otherwise.
+const DefaultModels = "googleai/gemini-flash-latest,openai/gpt-4.1-mini"
+
+// defaultModelTimeout is how long a single round trip to a model gets unless
+// MODEL_TIMEOUT says otherwise. The tool calls in between don't count.
+const defaultModelTimeout = 20 * time.Second
+
+var (
+	ErrEmptyResponse = errors.New("model returned an empty response")
+	ErrBlocked       = errors.New("model blocked the response")
+	ErrMalformed     = errors.New("model returned a malformed answer")
+)
+
+// malformedRetries is how many more times a model is asked again when its
+// structured answer doesn't hold up, before falling back to the next one.
+const malformedRetries = 1
+
+// Validator is implemented by structured outputs that can tell whether a
+// parsed answer makes sense.
+type Validator interface {
+	Validate() error
+}
+
+// safetyConfig turns off the Gemini safety filters, the bot is expected to be
+// rude.
+var safetyConfig = map[string]any{
+	"safetySettings": []map[string]any{
+		{"category": "HARM_CATEGORY_HARASSMENT", "threshold": "BLOCK_NONE"},
+		{
+			"category":  "HARM_CATEGORY_HATE_SPEECH",
+			"threshold": "BLOCK_NONE",
+		},
+		{
+			"category":  "HARM_CATEGORY_SEXUALLY_EXPLICIT",
+			"threshold": "BLOCK_NONE",
+		},
+		{
+			"category":  "HARM_CATEGORY_DANGEROUS_CONTENT",
+			"threshold": "BLOCK_NONE",
+		},
+	},
+}
+
+// modelsFromEnv reads the comma separated list of models from MODELS, in the
+// "provider/model" form Genkit registers them under.
+func modelsFromEnv() ([]string, time.Duration, error) {
+	list := os.Getenv("MODELS")
+	if list == "" {
+		list = DefaultModels
+	}
+
+	var models []string
+	for model := range strings.SplitSeq(list, ",") {
+		if model = strings.TrimSpace(model); model != "" {
+			models = append(models, model)
+		}
+	}
+	if len(models) == 0 {
+		return nil, 0, errors.New("MODELS does not name any model")
+	}
+
+	timeout := defaultModelTimeout
+	if s := os.Getenv("MODEL_TIMEOUT"); s != "" {
+		var err error
+		if timeout, err = time.ParseDuration(s); err != nil {
+			return nil, 0, fmt.Errorf("invalid MODEL_TIMEOUT: %w", err)
+		}
+	}
+
+	return models, timeout, nil
+}
+
+// modelConfig is the provider specific config of a model.
+func modelConfig(model string) any {
+	if strings.HasPrefix(model, "googleai/") {
+		return safetyConfig
+	}
+	return nil
+}
+
+// Generate tries the configured models in order until one of them answers,
+// falling back on errors, timeouts, blocked and empty responses. Along with
+// the response it returns the model that answered. Options must not pick a
+// model or config, that's up to the routing.
+func (gm GenkitMagic) Generate(
+	ctx context.Context,
+	opts ...ai.GenerateOption,
+) (*ai.ModelResponse, string, error) {
+	return gm.generate(ctx, nil, opts)
+}
+
+// GenerateText is Generate for when only the text of the answer matters.
+func (gm GenkitMagic) GenerateText(
+	ctx context.Context,
+	opts ...ai.GenerateOption,
+) (string, error) {
+	resp, _, err := gm.Generate(ctx, opts...)
+	if err != nil {
+		return "", err
+	}
+	return resp.Text(), nil
+}
+
+// GenerateData is Generate with structured output. An answer that doesn't
+// parse into Out, or fails its Validate when Out is a Validator, is asked for
+// again before moving on to the next model. It also returns the model that
+// answered.
+func GenerateData[Out any](
+	ctx context.Context,
+	gm GenkitMagic,
+	opts ...ai.GenerateOption,
+) (*Out, string, error) {
+	var value Out
+	opts = append(slices.Clip(opts), ai.WithOutputType(value))
+
+	_, model, err := gm.generate(ctx, func(resp *ai.ModelResponse) error {
+		value = *new(Out)
+		if err := resp.Output(&value); err != nil {
+			return fmt.Errorf("%w: %w", ErrMalformed, err)
+		}
+		if v, ok := any(&value).(Validator); ok {
+			if err := v.Validate(); err != nil {
+				return fmt.Errorf("%w: %w", ErrMalformed, err)
+			}
+		}
+		return nil
+	}, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &value, model, nil
+}
+
+func (gm GenkitMagic) generate(
+	ctx context.Context,
+	accept func(resp *ai.ModelResponse) error,
+	opts []ai.GenerateOption,
+) (*ai.ModelResponse, string, error) {
+	var errs []error
+	for idx, model := range gm.Models {
+		resp, err := gm.generateWith(ctx, model, accept, opts)
+		for retry := 0; retry < malformedRetries && errors.Is(err, ErrMalformed); retry++ {
+			gm.Logger.WarnContext(ctx, "Model answer was malformed, retrying", "model", model, "err", err)
+			resp, err = gm.generateWith(ctx, model, accept, opts)
+		}
+		if err == nil {
+			gm.Logger.InfoContext(ctx, "Model answered", "model", model, "fallbacks", idx)
+			return resp, model, nil
+		}
+
+		gm.Logger.WarnContext(ctx, "Model failed, falling back", "model", model, "err", err)
+		errs = append(errs, fmt.Errorf("%s: %w", model, err))
+
+		if ctx.Err() != nil {
+			// Out of time for every model, not just this one
+			break
+		}
+	}
+
+	return nil, "", errors.Join(errs...)
+}
+
+func (gm GenkitMagic) generateWith(
+	ctx context.Context,
+	model string,
+	accept func(resp *ai.ModelResponse) error,
+	opts []ai.GenerateOption,
+) (*ai.ModelResponse, error) {
+	opts = append(
+		slices.Clip(opts),
+		ai.WithModelName(model),
+		ai.WithMiddleware(timeoutRoundTrips(gm.ModelTimeout), gm.fitSupports(model)),
+	)
+	if config := modelConfig(model); config != nil {
+		opts = append(opts, ai.WithConfig(config))
+	}
+
+	resp, err := genkit.Generate(ctx, gm.G, opts...)
+	switch {
+	case err != nil:
+		return nil, err
+	case resp.FinishReason == ai.FinishReasonBlocked:
+		return nil, fmt.Errorf("%w: %s", ErrBlocked, resp.FinishMessage)
+	case resp.Text() == "":
+		return nil, ErrEmptyResponse
+	}
+
+	if accept != nil {
+		if err := accept(resp); err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// timeoutRoundTrips gives every request to the model its own timeout. Slow
+// tools would otherwise eat into it and cause a fallback that runs them all
+// over again, they are bounded by the context of the caller instead.
+func timeoutRoundTrips(timeout time.Duration) ai.ModelMiddleware {
+	return func(next ai.ModelFunc) ai.ModelFunc {
+		return func(
+			ctx context.Context,
+			req *ai.ModelRequest,
+			cb ai.ModelStreamCallback,
+		) (*ai.ModelResponse, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+
+			return next(ctx, req, cb)
+		}
+	}
+}
+
+// fitSupports strips what the model can't take from every request, instead of
+// Genkit refusing it. A local model without tools answers from the prompt
+// alone, one without vision gets told an attachment was left out.
+func (gm GenkitMagic) fitSupports(model string) ai.ModelMiddleware {
+	return func(next ai.ModelFunc) ai.ModelFunc {
+		return func(
+			ctx context.Context,
+			req *ai.ModelRequest,
+			cb ai.ModelStreamCallback,
+		) (*ai.ModelResponse, error) {
+			supports, ok := gm.supports[model]
+			if !ok {
+				return next(ctx, req, cb)
+			}
+
+			fitted := *req
+			if !supports.Tools && len(req.Tools) > 0 {
+				gm.Logger.DebugContext(ctx, "Model doesn't support tools, leaving them out", "model", model)
+				fitted.Tools = nil
+				fitted.ToolChoice = ""
+			}
+			if !supports.Media {
+				fitted.Messages = withoutMedia(req.Messages)
+			}
+
+			return next(ctx, &fitted, cb)
+		}
+	}
+}
+
+// withoutMedia replaces the media parts of the messages with a note, the
+// messages themselves are left untouched.
+func withoutMedia(messages []*ai.Message) []*ai.Message {
+	fitted := make([]*ai.Message, len(messages))
+	for i, msg := range messages {
+		if !slices.ContainsFunc(msg.Content, (*ai.Part).IsMedia) {
+			fitted[i] = msg
+			continue
+		}
+
+		stripped := *msg
+		stripped.Content = make([]*ai.Part, 0, len(msg.Content))
+		for _, part := range msg.Content {
+			if part.IsMedia() {
+				part = ai.NewTextPart("[attachment left out]")
+			}
+			stripped.Content = append(stripped.Content, part)
+		}
+		fitted[i] = &stripped
+	}
+
+	return fitted
+}
@@ -0,0 +1,91 @@
+package tools
+
+import (
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/samber/lo"
+)
+
+type ConversationContextInput struct {
+	MessageID     string `json:"message_id"               jsonschema_description:"ID of the message to reconstruct the conversation around."`
+	WindowMinutes int    `json:"window_minutes,omitempty" jsonschema_description:"Also include messages sent in the same channel within this many minutes of the message (0-120). Defaults to 10."`
+}
+
+type ConversationContextOutput struct {
+	Messages []ThreadMessage `json:"messages"`
+	Note     string          `json:"note,omitempty"`
+}
+
+type ThreadMessage struct {
+	ID            string    `json:"id"`
+	Content       string    `json:"content"`
+	Author        string    `json:"author"`
+	Timestamp     time.Time `json:"timestamp"`
+	ChannelID     string    `json:"channel_id,omitempty"`
+	ReplyToID     string    `json:"reply_to_id,omitempty"`
+	ReplyToAuthor string    `json:"reply_to_author,omitempty"`
+	InReplyChain  bool      `json:"in_reply_chain"`
+}
+
+func DefineConversationContextTool(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+) ai.Tool {
+	return genkit.DefineTool(
+		g,
+		"conversation_context",
+		"Reconstruct the conversation around a message: the chain of replies it belongs to plus what was said around it in the same channel, oldest first. Use it to understand who was replying to whom before quoting or judging a message.",
+		func(ctx *ai.ToolContext, input ConversationContextInput) (*ConversationContextOutput, error) {
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			window := 10 * time.Minute
+			if input.WindowMinutes > 0 {
+				window = time.Duration(min(input.WindowMinutes, 120)) * time.Minute
+			}
+
+			thread, err := archive.Conversation(ctx, entClient, guildID, input.MessageID, window)
+			if ent.IsNotFound(err) {
+				return &ConversationContextOutput{
+					Note: "No archived message with this ID.",
+				}, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			recordSources(ctx, thread.Messages...)
+
+			authors := lo.SliceToMap(
+				thread.Messages,
+				func(item *ent.DiscordMessage) (string, string) {
+					return item.ID, item.Edges.User.GlobalName
+				})
+
+			output := lo.Map(
+				thread.Messages,
+				func(item *ent.DiscordMessage, index int) ThreadMessage {
+					return ThreadMessage{
+						ID:            item.ID,
+						Content:       archive.PromptContent(item),
+						Author:        item.Edges.User.GlobalName,
+						Timestamp:     item.Timestamp,
+						ChannelID:     item.ChannelID,
+						ReplyToID:     item.ReplyToID,
+						ReplyToAuthor: authors[item.ReplyToID],
+						InReplyChain:  thread.InReplyChain[item.ID],
+					}
+				})
+
+			return &ConversationContextOutput{
+					Messages: output,
+				},
+				nil
+		},
+	)
+}
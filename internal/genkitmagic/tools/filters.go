@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"context"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+
+	"github.com/samber/lo"
+)
+
+// messageFilters resolves the channel and author names a model passed to a
+// tool. A non empty note means nothing matched and should be handed back to
+// the model instead of results.
+func messageFilters(
+	ctx context.Context,
+	entClient *ent.Client,
+	guildID string,
+	channel string,
+	author string,
+) (channelID string, authorIDs []string, note string, err error) {
+	if channel != "" {
+		ch, err := archive.ResolveChannel(ctx, entClient, guildID, channel)
+		if ent.IsNotFound(err) {
+			return "", nil, "No channel goes by this name.", nil
+		}
+		if err != nil {
+			return "", nil, "", err
+		}
+		channelID = ch.ID
+	}
+
+	if author != "" {
+		users, err := archive.ResolveUser(ctx, entClient, guildID, author)
+		if err != nil {
+			return "", nil, "", err
+		}
+		if len(users) == 0 {
+			return "", nil, "Nobody in the archive goes by this name.", nil
+		}
+		// Pulling up everything someone said is profiling them
+		authorIDs = lo.FilterMap(users, func(u *ent.DiscordUser, _ int) (string, bool) {
+			return u.ID, !u.ProfilingOptOut
+		})
+		if len(authorIDs) == 0 {
+			return "", nil, "They opted out of being profiled, their messages can't be looked up by author.", nil
+		}
+	}
+
+	return channelID, authorIDs, "", nil
+}
@@ -0,0 +1,179 @@
+package tools
+
+import (
+	"context"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+	"sev0/internal/embeddings"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultSearchResults = 20
+	maxSearchResults     = 50
+)
+
+type KeywordSearchInput struct {
+	Query   string     `json:"query"             jsonschema_description:"Words to look for. Use \"double quotes\" for exact phrases, OR between alternatives and a leading - to exclude a word."`
+	Hybrid  bool       `json:"hybrid,omitempty"  jsonschema_description:"Also rank messages by meaning and merge both rankings. Use it when the exact wording is uncertain."`
+	Channel string     `json:"channel,omitempty" jsonschema_description:"Only messages from this channel, by name or ID."`
+	Author  string     `json:"author,omitempty"  jsonschema_description:"Only messages from this member, by mention, ID or any of their names."`
+	Before  *time.Time `json:"before,omitempty"  jsonschema_description:"Only messages sent before this RFC 3339 timestamp."`
+	After   *time.Time `json:"after,omitempty"   jsonschema_description:"Only messages sent after this RFC 3339 timestamp."`
+	Limit   int        `json:"limit,omitempty"   jsonschema_description:"How many messages to return (1-50). Defaults to 20."`
+}
+
+type SearchOutput struct {
+	Results []SearchHit `json:"results,omitempty"`
+	// Conversations are set instead of results by conversation level searches
+	Conversations []Conversation `json:"conversations,omitempty"`
+	Note          string         `json:"note,omitempty"`
+}
+
+// Conversation is an excerpt of consecutive messages of a channel.
+type Conversation struct {
+	Messages []Message `json:"messages"`
+}
+
+type SearchHit struct {
+	Message
+	// Snippet highlights the matched words in bold
+	Snippet string `json:"snippet,omitempty"`
+}
+
+func DefineKeywordSearchTool(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+	registry *embeddings.Registry,
+) ai.Tool {
+	return genkit.DefineTool(
+		g,
+		"keyword_search",
+		"Full-text search over every archived message. Best for exact phrases, usernames, links and quoted inside jokes. Supports \"phrases\", OR and -exclusions, and a hybrid mode that also ranks by meaning.",
+		func(ctx *ai.ToolContext, input KeywordSearchInput) (*SearchOutput, error) {
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			params, note, err := searchParams(
+				ctx,
+				entClient,
+				guildID,
+				input.Channel,
+				input.Author,
+				input.Before,
+				input.After,
+				input.Limit,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if note != "" {
+				return &SearchOutput{Note: note}, nil
+			}
+
+			var results []archive.SearchResult
+			if input.Hybrid {
+				vector, model, err := registry.EmbedQuery(ctx, input.Query)
+				if err != nil {
+					return nil, err
+				}
+				results, err = archive.HybridSearch(
+					ctx,
+					entClient,
+					input.Query,
+					vector,
+					model,
+					params,
+				)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				results, err = archive.KeywordSearch(ctx, entClient, input.Query, params)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return toSearchOutput(ctx, entClient, results)
+		},
+	)
+}
+
+func searchParams(
+	ctx context.Context,
+	entClient *ent.Client,
+	guildID string,
+	channel string,
+	author string,
+	before *time.Time,
+	after *time.Time,
+	limit int,
+) (archive.SearchParams, string, error) {
+	channelID, authorIDs, note, err := messageFilters(ctx, entClient, guildID, channel, author)
+	if err != nil || note != "" {
+		return archive.SearchParams{}, note, err
+	}
+
+	params := archive.SearchParams{
+		GuildID:   guildID,
+		ChannelID: channelID,
+		AuthorIDs: authorIDs,
+		Limit:     defaultSearchResults,
+	}
+	if limit > 0 {
+		params.Limit = min(limit, maxSearchResults)
+	}
+	if before != nil {
+		params.Before = *before
+	}
+	if after != nil {
+		params.After = *after
+	}
+
+	return params, "", nil
+}
+
+func toSearchOutput(
+	ctx context.Context,
+	entClient *ent.Client,
+	results []archive.SearchResult,
+) (*SearchOutput, error) {
+	messages := lo.Map(results, func(r archive.SearchResult, _ int) *ent.DiscordMessage {
+		return r.Message
+	})
+	channels, err := archive.ChannelNames(ctx, entClient, messages)
+	if err != nil {
+		return nil, err
+	}
+	recordSources(ctx, messages...)
+
+	output := lo.Map(
+		results,
+		func(item archive.SearchResult, index int) SearchHit {
+			return SearchHit{
+				Message: toMessage(item.Message, channels),
+				Snippet: snippet(item),
+			}
+		})
+
+	return &SearchOutput{
+			Results: output,
+		},
+		nil
+}
+
+// snippet fences the highlighted excerpt of a hit like its content, and hides
+// it when the message is a suspected injection.
+func snippet(item archive.SearchResult) string {
+	if item.Snippet == "" || archive.Withheld(item.Message) {
+		return ""
+	}
+
+	return archive.Untrusted(item.Snippet)
+}
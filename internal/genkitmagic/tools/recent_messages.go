@@ -1,27 +1,43 @@
 package tools
 
 import (
+	"time"
+
 	"sev0/ent"
-	"sev0/ent/discordmessage"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/firebase/genkit/go/ai"
 	"github.com/firebase/genkit/go/genkit"
 	"github.com/samber/lo"
 )
 
+const (
+	defaultRecentMessages = 50
+	maxRecentMessages     = 200
+)
+
 type RecentMessagesInput struct {
-	Limit int `json:"limit,omitempty"`
+	Limit   int        `json:"limit,omitempty"   jsonschema_description:"How many messages to return (1-200). Defaults to 50."`
+	Before  *time.Time `json:"before,omitempty"  jsonschema_description:"Only messages sent before this RFC 3339 timestamp."`
+	After   *time.Time `json:"after,omitempty"   jsonschema_description:"Only messages sent after this RFC 3339 timestamp. Without before, the messages right after it are returned."`
+	Channel string     `json:"channel,omitempty" jsonschema_description:"Only messages from this channel, by name or ID."`
+	Author  string     `json:"author,omitempty"  jsonschema_description:"Only messages from this member, by mention, ID or any of their names."`
 }
 
 type RecentMessagesOutput struct {
 	Messages []Message `json:"messages"`
+	Note     string    `json:"note,omitempty"`
 }
 
 type Message struct {
-	Content string `json:"content"`
-	Author  string `json:"author"`
-	// Timestamp time.Time `json:"timestamp"`
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	Author    string    `json:"author"`
+	Timestamp time.Time `json:"timestamp"`
+	Channel   string    `json:"channel,omitempty"`
+	ChannelID string    `json:"channel_id,omitempty"`
+	ReplyToID string    `json:"reply_to_id,omitempty"`
 }
 
 func DefineRecentMessagesTool(
@@ -31,23 +47,55 @@ func DefineRecentMessagesTool(
 	return genkit.DefineTool(
 		g,
 		"recent_messages",
-		"Retrieve recent Discord messages from the server’s message database to give the chatbot real context, continuity, and humor based on prior conversations.",
+		"Retrieve recent Discord messages from the server’s message database to give the chatbot real context, continuity, and humor based on prior conversations. Messages come oldest first, with IDs and timestamps; filter by time window, channel or author.",
 		func(ctx *ai.ToolContext, input RecentMessagesInput) (*RecentMessagesOutput, error) {
-			messages, err := entClient.DiscordMessage.Query().
-				Order(discordmessage.ByTimestamp(sql.OrderDesc())).
-				Limit(max(input.Limit, 100)).WithUser().All(ctx)
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			params := archive.RecentParams{
+				GuildID: guildID,
+				Limit:   defaultRecentMessages,
+			}
+			if input.Limit > 0 {
+				params.Limit = min(input.Limit, maxRecentMessages)
+			}
+			if input.Before != nil {
+				params.Before = *input.Before
+			}
+			if input.After != nil {
+				params.After = *input.After
+			}
+
+			channelID, authorIDs, note, err := messageFilters(
+				ctx,
+				entClient,
+				guildID,
+				input.Channel,
+				input.Author,
+			)
 			if err != nil {
 				return nil, err
 			}
+			if note != "" {
+				return &RecentMessagesOutput{Note: note}, nil
+			}
+			params.ChannelID = channelID
+			params.AuthorIDs = authorIDs
+
+			messages, err := archive.RecentMessages(ctx, entClient, params)
+			if err != nil {
+				return nil, err
+			}
+
+			channels, err := archive.ChannelNames(ctx, entClient, messages)
+			if err != nil {
+				return nil, err
+			}
+			recordSources(ctx, messages...)
 
 			output := lo.Map(
 				messages,
 				(func(item *ent.DiscordMessage, index int) Message {
-					return Message{
-						Content: item.Content,
-						Author:  item.Edges.User.GlobalName,
-						// Timestamp: item.Timestamp,
-					}
+					return toMessage(item, channels)
 				}))
 
 			return &RecentMessagesOutput{
@@ -57,3 +105,19 @@ func DefineRecentMessagesTool(
 		},
 	)
 }
+
+func toMessage(item *ent.DiscordMessage, channels map[string]string) Message {
+	msg := Message{
+		ID:        item.ID,
+		Content:   archive.PromptContent(item),
+		Author:    item.Edges.User.GlobalName,
+		Timestamp: item.Timestamp,
+		ChannelID: item.ChannelID,
+		ReplyToID: item.ReplyToID,
+	}
+	if name, ok := channels[item.ChannelID]; ok {
+		msg.Channel = "#" + name
+	}
+
+	return msg
+}
@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"context"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+	"sev0/internal/embeddings"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/samber/lo"
+)
+
+type SemanticSearchInput struct {
+	Query     string     `json:"query"                jsonschema_description:"What the messages should be about, in natural language."`
+	ByMessage bool       `json:"by_message,omitempty" jsonschema_description:"Match single messages instead of conversation excerpts. Short messages match poorly, so only use it for long, self-contained messages."`
+	Channel   string     `json:"channel,omitempty" jsonschema_description:"Only messages from this channel, by name or ID."`
+	Author    string     `json:"author,omitempty"  jsonschema_description:"Only messages from this member, by mention, ID or any of their names."`
+	Before    *time.Time `json:"before,omitempty"  jsonschema_description:"Only messages sent before this RFC 3339 timestamp."`
+	After     *time.Time `json:"after,omitempty"   jsonschema_description:"Only messages sent after this RFC 3339 timestamp."`
+	Limit     int        `json:"limit,omitempty"   jsonschema_description:"How many messages to return (1-50). Defaults to 20."`
+}
+
+func DefineSemanticSearchTool(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+	registry *embeddings.Registry,
+) ai.Tool {
+	return genkit.DefineTool(
+		g,
+		"semantic_search",
+		"Search every archived message by meaning rather than wording. Best for recalling discussions about a subject when the exact words are unknown. Returns whole conversation excerpts unless asked for single messages.",
+		func(ctx *ai.ToolContext, input SemanticSearchInput) (*SearchOutput, error) {
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			params, note, err := searchParams(
+				ctx,
+				entClient,
+				guildID,
+				input.Channel,
+				input.Author,
+				input.Before,
+				input.After,
+				input.Limit,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if note != "" {
+				return &SearchOutput{Note: note}, nil
+			}
+
+			vector, model, err := registry.EmbedQuery(ctx, input.Query)
+			if err != nil {
+				return nil, err
+			}
+
+			if !input.ByMessage {
+				chunks, err := archive.ChunkSearch(ctx, entClient, vector, model, params)
+				if err != nil {
+					return nil, err
+				}
+				// Channels that weren't chunked yet only have message vectors
+				if len(chunks) > 0 {
+					return toConversationOutput(ctx, entClient, chunks)
+				}
+			}
+
+			results, err := archive.SemanticSearch(
+				ctx,
+				entClient,
+				vector,
+				model,
+				params,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return toSearchOutput(ctx, entClient, results)
+		},
+	)
+}
+
+func toConversationOutput(
+	ctx context.Context,
+	entClient *ent.Client,
+	chunks []archive.ChunkResult,
+) (*SearchOutput, error) {
+	messages := lo.FlatMap(chunks, func(c archive.ChunkResult, _ int) []*ent.DiscordMessage {
+		return c.Messages
+	})
+	channels, err := archive.ChannelNames(ctx, entClient, messages)
+	if err != nil {
+		return nil, err
+	}
+	recordSources(ctx, messages...)
+
+	output := lo.Map(chunks, func(c archive.ChunkResult, _ int) Conversation {
+		return Conversation{
+			Messages: lo.Map(c.Messages, func(m *ent.DiscordMessage, _ int) Message {
+				return toMessage(m, channels)
+			}),
+		}
+	})
+
+	return &SearchOutput{Conversations: output}, nil
+}
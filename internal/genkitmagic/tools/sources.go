@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"context"
+	"sync"
+
+	"sev0/ent"
+	"sev0/internal/contextkeys"
+)
+
+// Sources collects the messages the tools returned during a generation, so
+// the messages an answer cites can be checked against what the model actually
+// saw.
+type Sources struct {
+	mu       sync.Mutex
+	messages map[string]*ent.DiscordMessage
+}
+
+// WithSources returns a context the tools record the messages they return in.
+func WithSources(ctx context.Context) (context.Context, *Sources) {
+	sources := &Sources{messages: map[string]*ent.DiscordMessage{}}
+	return context.WithValue(ctx, contextkeys.SourcesKey, sources), sources
+}
+
+// Get returns a message the tools returned, nil if none did.
+func (s *Sources) Get(id string) *ent.DiscordMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.messages[id]
+}
+
+// Add records messages as seen by the model.
+func (s *Sources) Add(messages ...*ent.DiscordMessage) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, m := range messages {
+		s.messages[m.ID] = m
+	}
+}
+
+// recordSources adds messages to the sources of the context, if it has any.
+// Tools call it with every message they return.
+func recordSources(ctx context.Context, messages ...*ent.DiscordMessage) {
+	if sources, ok := ctx.Value(contextkeys.SourcesKey).(*Sources); ok {
+		sources.Add(messages...)
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"strconv"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/samber/lo"
+)
+
+type TopReactedMessagesInput struct {
+	Days    int    `json:"days,omitempty"    jsonschema_description:"Only consider messages from the last N days. 0 means all time."`
+	Channel string `json:"channel,omitempty" jsonschema_description:"Only messages from this channel, by name or ID."`
+	Author  string `json:"author,omitempty"  jsonschema_description:"Only messages from this member, by mention, ID or any of their names."`
+	Limit   int    `json:"limit,omitempty"   jsonschema_description:"How many messages to return (1-25). Defaults to 10."`
+}
+
+type TopReactedMessagesOutput struct {
+	Messages []ReactedMessage `json:"messages"`
+	Note     string           `json:"note,omitempty"`
+}
+
+type ReactedMessage struct {
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	Author    string    `json:"author"`
+	Timestamp time.Time `json:"timestamp"`
+	ChannelID string    `json:"channel_id,omitempty"`
+	Reactions int       `json:"reactions"`
+	Emojis    []string  `json:"emojis"`
+}
+
+func DefineTopReactedMessagesTool(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+) ai.Tool {
+	return genkit.DefineTool(
+		g,
+		"top_reacted_messages",
+		"Find the messages that got the most reactions from the server, optionally within a time window, channel or author. Reactions are the best signal of what the community found funny or memorable.",
+		func(ctx *ai.ToolContext, input TopReactedMessagesInput) (*TopReactedMessagesOutput, error) {
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			channelID, authorIDs, note, err := messageFilters(
+				ctx,
+				entClient,
+				guildID,
+				input.Channel,
+				input.Author,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if note != "" {
+				return &TopReactedMessagesOutput{Note: note}, nil
+			}
+
+			params := archive.TopReactedParams{
+				GuildID:   guildID,
+				ChannelID: channelID,
+				AuthorIDs: authorIDs,
+				Limit:     10,
+			}
+			if input.Limit > 0 {
+				params.Limit = min(input.Limit, 25)
+			}
+			if input.Days > 0 {
+				params.Since = time.Now().AddDate(0, 0, -input.Days)
+			}
+
+			messages, err := archive.TopReactedMessages(ctx, entClient, params)
+			if err != nil {
+				return nil, err
+			}
+
+			recordSources(ctx, lo.Map(messages, reactedMessage)...)
+
+			output := lo.Map(
+				messages,
+				func(item archive.ReactedMessage, index int) ReactedMessage {
+					return toReactedMessage(item)
+				})
+
+			return &TopReactedMessagesOutput{
+					Messages: output,
+				},
+				nil
+		},
+	)
+}
+
+func toReactedMessage(item archive.ReactedMessage) ReactedMessage {
+	return ReactedMessage{
+		ID:        item.Message.ID,
+		Content:   archive.PromptContent(item.Message),
+		Author:    item.Message.Edges.User.GlobalName,
+		Timestamp: item.Message.Timestamp,
+		ChannelID: item.Message.ChannelID,
+		Reactions: item.Total,
+		Emojis: lo.Map(item.Emojis, func(e archive.EmojiCount, _ int) string {
+			return archive.FormatEmoji(e.Emoji) + " x" + strconv.Itoa(e.Count)
+		}),
+	}
+}
+
+func reactedMessage(item archive.ReactedMessage, _ int) *ent.DiscordMessage {
+	return item.Message
+}
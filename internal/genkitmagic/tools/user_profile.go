@@ -0,0 +1,156 @@
+package tools
+
+import (
+	"errors"
+	"time"
+
+	"sev0/ent"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+	"sev0/internal/embeddings"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/samber/lo"
+)
+
+type UserProfileInput struct {
+	Name string `json:"name" jsonschema_description:"A mention, user ID, username, display name or nickname of the member."`
+}
+
+type UserProfileOutput struct {
+	ID             string            `json:"id,omitempty"`
+	Username       string            `json:"username,omitempty"`
+	DisplayName    string            `json:"display_name,omitempty"`
+	Nickname       string            `json:"nickname,omitempty"`
+	MessageCount   int               `json:"message_count"`
+	FirstMessage   *time.Time        `json:"first_message,omitempty"`
+	LastMessage    *time.Time        `json:"last_message,omitempty"`
+	ActiveHoursUTC []int             `json:"active_hours_utc,omitempty"`
+	ActiveChannels []ChannelActivity `json:"active_channels,omitempty"`
+	Topics         []TopicSummary    `json:"topics,omitempty"`
+	TopReacted     []ReactedMessage  `json:"top_reacted,omitempty"`
+	Quotes         []Quote           `json:"quotes,omitempty"`
+	OtherMatches   []string          `json:"other_matches,omitempty"`
+	Note           string            `json:"note,omitempty"`
+}
+
+type ChannelActivity struct {
+	Channel  string `json:"channel"`
+	Messages int    `json:"messages"`
+}
+
+type TopicSummary struct {
+	Messages int     `json:"messages"`
+	Examples []Quote `json:"examples"`
+}
+
+type Quote struct {
+	ID        string    `json:"id"`
+	Content   string    `json:"content"`
+	Timestamp time.Time `json:"timestamp"`
+	ChannelID string    `json:"channel_id,omitempty"`
+}
+
+func DefineUserProfileTool(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+	registry *embeddings.Registry,
+) ai.Tool {
+	return genkit.DefineTool(
+		g,
+		"user_profile",
+		"Summarize what a member is like: how much and when they post, where they hang out, what they keep talking about (as clusters of similar messages, name the topics yourself), their most reacted messages and a few representative quotes.",
+		func(ctx *ai.ToolContext, input UserProfileInput) (*UserProfileOutput, error) {
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			users, err := archive.ResolveUser(ctx, entClient, guildID, input.Name)
+			if err != nil {
+				return nil, err
+			}
+			if len(users) == 0 {
+				return &UserProfileOutput{
+					Note: "Nobody in the archive goes by this name.",
+				}, nil
+			}
+
+			embedder, err := registry.Active(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			profile, err := archive.UserProfile(ctx, entClient, archive.ProfileParams{
+				GuildID:        guildID,
+				UserID:         users[0].ID,
+				EmbeddingModel: embedder.Name(),
+			})
+			if errors.Is(err, archive.ErrProfilingOptOut) {
+				return &UserProfileOutput{
+					Note: "This member opted out of being profiled. Do not profile them.",
+				}, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			recordSources(ctx, profile.Quotes...)
+			recordSources(ctx, lo.Map(profile.TopReacted, reactedMessage)...)
+			for _, topic := range profile.Topics {
+				recordSources(ctx, topic.Examples...)
+			}
+
+			return toUserProfileOutput(profile, users[1:]), nil
+		},
+	)
+}
+
+func toUserProfileOutput(
+	profile *archive.Profile,
+	otherMatches []*ent.DiscordUser,
+) *UserProfileOutput {
+	output := &UserProfileOutput{
+		ID:           profile.User.ID,
+		Username:     profile.User.Username,
+		DisplayName:  profile.User.GlobalName,
+		MessageCount: profile.MessageCount,
+		ActiveHoursUTC: lo.Map(profile.ActiveHours, func(h archive.HourCount, _ int) int {
+			return h.Hour
+		}),
+		ActiveChannels: lo.Map(
+			profile.ActiveChannels,
+			func(c archive.ChannelCount, _ int) ChannelActivity {
+				return ChannelActivity{Channel: "#" + c.Name, Messages: c.Count}
+			}),
+		Topics: lo.Map(profile.Topics, func(t archive.Topic, _ int) TopicSummary {
+			return TopicSummary{Messages: t.Size, Examples: lo.Map(t.Examples, toQuote)}
+		}),
+		TopReacted: lo.Map(
+			profile.TopReacted,
+			func(item archive.ReactedMessage, _ int) ReactedMessage {
+				return toReactedMessage(item)
+			}),
+		Quotes: lo.Map(profile.Quotes, toQuote),
+		OtherMatches: lo.Map(otherMatches, func(u *ent.DiscordUser, _ int) string {
+			return u.GlobalName + " (" + u.Username + ")"
+		}),
+	}
+
+	if len(profile.User.Edges.Memberships) > 0 {
+		output.Nickname = profile.User.Edges.Memberships[0].Nickname
+	}
+	if profile.MessageCount > 0 {
+		output.FirstMessage = &profile.FirstMessage
+		output.LastMessage = &profile.LastMessage
+	}
+
+	return output
+}
+
+func toQuote(m *ent.DiscordMessage, _ int) Quote {
+	return Quote{
+		ID:        m.ID,
+		Content:   archive.PromptContent(m),
+		Timestamp: m.Timestamp,
+		ChannelID: m.ChannelID,
+	}
+}
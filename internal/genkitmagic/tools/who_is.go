@@ -0,0 +1,122 @@
+package tools
+
+import (
+	"time"
+
+	"sev0/ent"
+	"sev0/ent/discordrole"
+	"sev0/internal/archive"
+	"sev0/internal/contextkeys"
+
+	"github.com/firebase/genkit/go/ai"
+	"github.com/firebase/genkit/go/genkit"
+	"github.com/samber/lo"
+)
+
+type WhoIsInput struct {
+	Name string `json:"name" jsonschema_description:"A mention, user ID, username, display name or nickname. Old names work too."`
+}
+
+type WhoIsOutput struct {
+	Users []UserInfo `json:"users"`
+}
+
+type UserInfo struct {
+	ID          string     `json:"id"`
+	Username    string     `json:"username"`
+	DisplayName string     `json:"display_name"`
+	Nickname    string     `json:"nickname,omitempty"`
+	Roles       []string   `json:"roles,omitempty"`
+	JoinedAt    *time.Time `json:"joined_at,omitempty"`
+	LeftAt      *time.Time `json:"left_at,omitempty"`
+	PastNames   []PastName `json:"past_names,omitempty"`
+	// ProfilingOptOut users only get their current names looked up
+	ProfilingOptOut bool `json:"profiling_opt_out,omitempty"`
+}
+
+type PastName struct {
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	FirstSeen time.Time `json:"first_seen"`
+}
+
+func DefineWhoIsTool(
+	g *genkit.Genkit,
+	entClient *ent.Client,
+) ai.Tool {
+	return genkit.DefineTool(
+		g,
+		"who_is",
+		"Look up who a server member is from any name they go or went by: mentions, usernames, display names and nicknames, past or present. Returns their current names, roles, when they joined or left, and their name history, unless they opted out of being profiled.",
+		func(ctx *ai.ToolContext, input WhoIsInput) (*WhoIsOutput, error) {
+			guildID, _ := ctx.Value(contextkeys.GuildIDKey).(string)
+
+			users, err := archive.ResolveUser(ctx, entClient, guildID, input.Name)
+			if err != nil {
+				return nil, err
+			}
+
+			roleIDs := lo.FlatMap(users, func(u *ent.DiscordUser, _ int) []string {
+				return lo.FlatMap(u.Edges.Memberships, func(m *ent.DiscordGuildMember, _ int) []string {
+					return m.Roles
+				})
+			})
+			roles, err := entClient.DiscordRole.Query().
+				Where(discordrole.IDIn(lo.Uniq(roleIDs)...)).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			roleNames := lo.SliceToMap(roles, func(r *ent.DiscordRole) (string, string) {
+				return r.ID, r.Name
+			})
+
+			output := lo.Map(
+				users,
+				func(item *ent.DiscordUser, index int) UserInfo {
+					info := UserInfo{
+						ID:          item.ID,
+						Username:    item.Username,
+						DisplayName: item.GlobalName,
+						PastNames: lo.Map(
+							item.Edges.ProfileChanges,
+							func(c *ent.DiscordProfileChange, _ int) PastName {
+								return PastName{
+									Kind:      c.Kind.String(),
+									Name:      c.Value,
+									FirstSeen: c.ChangedAt,
+								}
+							}),
+					}
+
+					if item.ProfilingOptOut {
+						info.PastNames = nil
+						info.ProfilingOptOut = true
+					}
+
+					if len(item.Edges.Memberships) > 0 {
+						m := item.Edges.Memberships[0]
+						info.Nickname = m.Nickname
+						if item.ProfilingOptOut {
+							return info
+						}
+						info.LeftAt = m.LeftAt
+						if !m.JoinedAt.IsZero() {
+							info.JoinedAt = &m.JoinedAt
+						}
+						info.Roles = lo.FilterMap(m.Roles, func(id string, _ int) (string, bool) {
+							name, ok := roleNames[id]
+							return name, ok && name != "@everyone"
+						})
+					}
+
+					return info
+				})
+
+			return &WhoIsOutput{
+					Users: output,
+				},
+				nil
+		},
+	)
+}
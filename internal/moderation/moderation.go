@@ -0,0 +1,188 @@
+// Package moderation checks generated answers before the bot posts them
+package moderation
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"sev0/internal/genkitmagic"
+
+	"github.com/firebase/genkit/go/ai"
+)
+
+type Action string
+
+const (
+	ActionAllow   Action = ""
+	ActionRewrite Action = "rewrite"
+	ActionBlock   Action = "block"
+	ActionFlag    Action = "flag"
+)
+
+// Config is how strict the moderation is, it comes from the guild settings.
+type Config struct {
+	// Denylist holds terms answers must not contain, matched as whole words
+	// regardless of case
+	Denylist []string
+	// BlockDenylisted blocks answers with denylisted terms instead of masking
+	// the terms
+	BlockDenylisted bool
+	// Redact removes emails, tokens and keys
+	Redact bool
+	// Classifier has a model rate the answer
+	Classifier     bool
+	FlagThreshold  float64
+	BlockThreshold float64
+}
+
+// Verdict is what the moderation made of an answer.
+type Verdict struct {
+	// Text is the answer to post, rewritten if needed. It is empty when the
+	// answer is blocked.
+	Text string
+	// Original is the answer as generated, with the secrets redacted even
+	// when the config doesn't ask for it. It is what gets logged.
+	Original string
+	Action   Action
+	Reasons  []string
+	// Score is the classifier score, nil when the classifier didn't run
+	Score *float64
+}
+
+// redactions are the patterns of things that shouldn't be repeated, mostly
+// secrets people pasted in the chat once and that the archive remembers.
+var redactions = []struct {
+	name string
+	re   *regexp.Regexp
+}{
+	{"private key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{"discord token", regexp.MustCompile(`\b[MNO][A-Za-z\d_-]{23,27}\.[A-Za-z\d_-]{6}\.[A-Za-z\d_-]{27,40}\b`)},
+	{"jwt", regexp.MustCompile(`\beyJ[A-Za-z\d_-]{8,}\.eyJ[A-Za-z\d_-]{8,}\.[A-Za-z\d_-]{8,}\b`)},
+	{"api key", regexp.MustCompile(`\b(?:sk-[A-Za-z\d_-]{20,}|AIza[A-Za-z\d_-]{35}|gh[pousr]_[A-Za-z\d]{36,}|xox[abprs]-[A-Za-z\d-]{10,}|AKIA[A-Z\d]{16})\b`)},
+	{"email", regexp.MustCompile(`\b[A-Za-z\d._%+-]+@[A-Za-z\d.-]+\.[A-Za-z]{2,}\b`)},
+}
+
+var wordChar = regexp.MustCompile(`\w`)
+
+type classification struct {
+	Score  float64 `json:"score"  jsonschema_description:"How harmful the message is, from 0 to 1"`
+	Reason string  `json:"reason" jsonschema_description:"Why, in a few words"`
+}
+
+type Moderator struct {
+	gm genkitmagic.GenkitMagic
+	// denylist applies to every guild, from MODERATION_DENYLIST
+	denylist []string
+}
+
+func New(gm genkitmagic.GenkitMagic) *Moderator {
+	var denylist []string
+	for term := range strings.SplitSeq(os.Getenv("MODERATION_DENYLIST"), ",") {
+		if term = strings.TrimSpace(term); term != "" {
+			denylist = append(denylist, term)
+		}
+	}
+
+	return &Moderator{gm: gm, denylist: denylist}
+}
+
+// Check runs an answer through the rules, then through the classifier if the
+// config asks for it. The rules are applied even when the classifier fails,
+// the error is returned along with their verdict.
+func (m *Moderator) Check(ctx context.Context, cfg Config, text string) (Verdict, error) {
+	redacted, found := redact(text)
+	v := Verdict{Text: text, Original: redacted}
+
+	if cfg.Redact && len(found) > 0 {
+		v.Text = redacted
+		v.Action = ActionRewrite
+		for _, name := range found {
+			v.Reasons = append(v.Reasons, "redacted "+name)
+		}
+	}
+
+	for _, term := range slices.Concat(m.denylist, cfg.Denylist) {
+		if term == "" {
+			continue
+		}
+		re := termPattern(term)
+		if !re.MatchString(v.Text) {
+			continue
+		}
+		v.Reasons = append(v.Reasons, "denylisted term "+term)
+		if cfg.BlockDenylisted {
+			return block(v), nil
+		}
+		v.Text = re.ReplaceAllString(v.Text, "[removed]")
+		v.Action = ActionRewrite
+	}
+
+	if !cfg.Classifier {
+		return v, nil
+	}
+
+	c, _, err := genkitmagic.GenerateData[classification](
+		ctx,
+		m.gm,
+		ai.WithPrompt(v.Text),
+		ai.WithSystem(
+			"You review messages a Discord bot is about to post in a group chat. The bot is meant to be rude and sarcastic, and banter, swearing and roasting are fine. Rate how harmful the message is: slurs and hate against groups, threats, harassment that goes past banter, sexual content involving minors, encouragement of self-harm, or personal information that could be used to find or hurt someone.",
+		),
+	)
+	if err != nil {
+		return v, fmt.Errorf("classifying answer: %w", err)
+	}
+
+	v.Score = &c.Score
+	switch {
+	case c.Score >= cfg.BlockThreshold:
+		v.Reasons = append(v.Reasons, "classifier: "+c.Reason)
+		return block(v), nil
+	case c.Score >= cfg.FlagThreshold:
+		v.Reasons = append(v.Reasons, "classifier: "+c.Reason)
+		if v.Action == ActionAllow {
+			v.Action = ActionFlag
+		}
+	}
+
+	return v, nil
+}
+
+// redact masks the secrets in the text, it also returns the names of the
+// patterns that matched.
+func redact(text string) (string, []string) {
+	var found []string
+	for _, r := range redactions {
+		if r.re.MatchString(text) {
+			text = r.re.ReplaceAllString(text, "[redacted "+r.name+"]")
+			found = append(found, r.name)
+		}
+	}
+
+	return text, found
+}
+
+// termPattern matches a denylisted term as a whole word, regardless of case.
+// Word boundaries only work next to word characters, so terms starting or
+// ending with something else are matched as they are on that side.
+func termPattern(term string) *regexp.Regexp {
+	pattern := regexp.QuoteMeta(term)
+	if wordChar.MatchString(term[:1]) {
+		pattern = `\b` + pattern
+	}
+	if wordChar.MatchString(term[len(term)-1:]) {
+		pattern += `\b`
+	}
+
+	return regexp.MustCompile(`(?i)` + pattern)
+}
+
+func block(v Verdict) Verdict {
+	v.Text = ""
+	v.Action = ActionBlock
+	return v
+}
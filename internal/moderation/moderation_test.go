@@ -0,0 +1,141 @@
+package moderation
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTermPattern(t *testing.T) {
+	tests := []struct {
+		term    string
+		text    string
+		matches bool
+	}{
+		{"heck", "what the heck", true},
+		{"heck", "What The HECK", true},
+		{"heck", "heck.", true},
+		{"heck", "checking", false},
+		{"heck", "hecks", false},
+		{"two words", "say two  words", false},
+		{"two words", "say Two Words now", true},
+		{"c++", "I write C++ daily", true},
+		{"c++", "abc++", false},
+		{"@here", "ping @here", true},
+		{"@here", "ping@here", true},
+		{"@here", "@heretic", false},
+		{"a.b", "axb", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.term+" in "+tt.text, func(t *testing.T) {
+			if got := termPattern(tt.term).MatchString(tt.text); got != tt.matches {
+				t.Errorf("termPattern(%q) matches %q = %v, want %v", tt.term, tt.text, got, tt.matches)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	const token = "MTA5ODc2NTQzMjEwOTg3NjU0.GaBcDe.abcdefghijklmnopqrstuvwxyz0123"
+
+	tests := []struct {
+		name     string
+		denylist []string
+		cfg      Config
+		text     string
+		want     Verdict
+	}{
+		{
+			name: "nothing to do",
+			cfg:  Config{Redact: true, Denylist: []string{"heck"}},
+			text: "all good",
+			want: Verdict{Text: "all good", Original: "all good"},
+		},
+		{
+			name: "redacts secrets",
+			cfg:  Config{Redact: true},
+			text: "mail bob@example.com with " + token,
+			want: Verdict{
+				Text:     "mail [redacted email] with [redacted discord token]",
+				Original: "mail [redacted email] with [redacted discord token]",
+				Action:   ActionRewrite,
+				Reasons:  []string{"redacted discord token", "redacted email"},
+			},
+		},
+		{
+			name: "original hides secrets even without redaction",
+			cfg:  Config{},
+			text: "mail bob@example.com",
+			want: Verdict{Text: "mail bob@example.com", Original: "mail [redacted email]"},
+		},
+		{
+			name: "masks denylisted terms",
+			cfg:  Config{Denylist: []string{"heck"}},
+			text: "Heck, what the heck",
+			want: Verdict{
+				Text:     "[removed], what the [removed]",
+				Original: "Heck, what the heck",
+				Action:   ActionRewrite,
+				Reasons:  []string{"denylisted term heck"},
+			},
+		},
+		{
+			name:     "global denylist applies too",
+			denylist: []string{"darn"},
+			cfg:      Config{Denylist: []string{"heck"}},
+			text:     "darn it",
+			want: Verdict{
+				Text:     "[removed] it",
+				Original: "darn it",
+				Action:   ActionRewrite,
+				Reasons:  []string{"denylisted term darn"},
+			},
+		},
+		{
+			name: "blocks denylisted terms",
+			cfg:  Config{Denylist: []string{"heck"}, BlockDenylisted: true},
+			text: "what the heck",
+			want: Verdict{
+				Original: "what the heck",
+				Action:   ActionBlock,
+				Reasons:  []string{"denylisted term heck"},
+			},
+		},
+		{
+			name: "blocking keeps the redaction reasons",
+			cfg:  Config{Redact: true, Denylist: []string{"heck"}, BlockDenylisted: true},
+			text: "heck, bob@example.com",
+			want: Verdict{
+				Original: "heck, [redacted email]",
+				Action:   ActionBlock,
+				Reasons:  []string{"redacted email", "denylisted term heck"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Moderator{denylist: tt.denylist}
+
+			got, err := m.Check(t.Context(), tt.cfg, tt.text)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if got.Text != tt.want.Text {
+				t.Errorf("Text = %q, want %q", got.Text, tt.want.Text)
+			}
+			if got.Original != tt.want.Original {
+				t.Errorf("Original = %q, want %q", got.Original, tt.want.Original)
+			}
+			if got.Action != tt.want.Action {
+				t.Errorf("Action = %q, want %q", got.Action, tt.want.Action)
+			}
+			if !slices.Equal(got.Reasons, tt.want.Reasons) {
+				t.Errorf("Reasons = %q, want %q", got.Reasons, tt.want.Reasons)
+			}
+			if got.Score != nil {
+				t.Errorf("Score = %v, want nil without the classifier", *got.Score)
+			}
+		})
+	}
+}